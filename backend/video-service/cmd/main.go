@@ -2,18 +2,30 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 	"video-service/config"
+	"video-service/internal/domain"
 	"video-service/internal/infrastructure/db"
+	"video-service/internal/infrastructure/directory"
+	"video-service/internal/infrastructure/events"
+	"video-service/internal/infrastructure/media"
+	"video-service/internal/infrastructure/storage"
 	grpcHandler "video-service/internal/interface/grpc"
+	httpHandler "video-service/internal/interface/http"
+	"video-service/internal/interface/worker"
 	"video-service/internal/pkg/logger"
 	"video-service/internal/usecase"
 	pb "video-service/proto"
 
+	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -78,12 +90,151 @@ func main() {
 	videoRepo := db.NewVideoRepository(database)
 	likeRepo := db.NewUserVideoLikeRepository(database)
 	viewRepo := db.NewUserVideoViewRepository(database)
+	uploadSessionRepo := db.NewUploadSessionRepository(database)
+	renditionRepo := db.NewVideoRenditionRepository(database)
+	tagRepo := db.NewTagRepository(database)
+	trendingRepo := db.NewTrendingRepository(database)
+	searchRepo := db.NewVideoSearchRepository(database)
+	shareRepo := db.NewShareRepository(database)
+	favoriteRepo := db.NewFavoriteRepository(database)
+	collectionRepo := db.NewCollectionRepository(database)
+	trashRepo := db.NewTrashRepository(database)
+	moderationRepo := db.NewModerationRepository(database)
+	blockRepo := db.NewBlockRepository(database)
+	notificationRepo := db.NewNotificationRepository(database)
+	analyticsRepo := db.NewAnalyticsRepository(database)
+	jobQueue := db.NewJobQueue(database, cfg.Processing.LockTimeout)
+	outboxRepo := db.NewOutboxRepository(database)
+	idempotencyRepo := db.NewIdempotencyRepository(database)
+	publicationRepo := db.NewPublicationRepository(database)
+	pinRepo := db.NewPinRepository(database)
+	playlistRepo := db.NewPlaylistRepository(database)
+	transactor := db.NewTransactor(database)
 
 	logger.Info("Repositories initialized successfully")
 
+	objectStorage, err := storage.NewLocalStorage(cfg.Storage.LocalPath, cfg.Storage.BaseURL)
+	if err != nil {
+		logger.Fatal("Failed to initialize object storage",
+			zap.String("path", cfg.Storage.LocalPath),
+			zap.Error(err),
+		)
+	}
+
+	logger.Info("Object storage initialized successfully",
+		zap.String("path", cfg.Storage.LocalPath),
+	)
+
+	var userDirectory domain.UserDirectory
+	if cfg.Directory.UserServiceURL != "" {
+		userDirectory = directory.NewHTTPUserDirectory(cfg.Directory.UserServiceURL, cfg.Directory.Timeout)
+	} else {
+		logger.Warn("USER_DIRECTORY_URL is not set, mentions will not be resolved, " +
+			"followers-only and friends-only videos are shown to their owners only " +
+			"and blocking fails after storing the block because follows cannot be removed")
+		userDirectory = directory.NewNoopUserDirectory()
+	}
+
+	var publisher domain.Publisher
+	if len(cfg.Kafka.Brokers) > 0 {
+		publisher = events.NewKafkaPublisher(cfg.Kafka.Brokers, cfg.Kafka.Topic)
+		logger.Info("Kafka publisher initialized",
+			zap.Strings("brokers", cfg.Kafka.Brokers),
+			zap.String("topic", cfg.Kafka.Topic),
+		)
+	} else {
+		logger.Warn("KAFKA_BROKERS is not set, video events will stay pending in the outbox")
+	}
+
 	logger.Info("Initializing use cases")
 
-	videoUseCase := usecase.NewVideoUseCase(videoRepo, likeRepo, viewRepo)
+	notificationHub := events.NewNotificationHub(cfg.Notification.StreamBufferSize)
+	notifier := usecase.NewNotifier(notificationRepo, notificationHub)
+
+	videoUseCase := usecase.NewVideoUseCase(videoRepo, likeRepo, viewRepo, jobQueue, tagRepo, userDirectory,
+		blockRepo, transactor, outboxRepo, notifier, usecase.ViewPolicy{
+			DedupWindow:     cfg.View.DedupWindow,
+			MinWatchTime:    cfg.View.MinWatchTime,
+			RateWindow:      cfg.View.RateWindow,
+			MaxViewsPerUser: cfg.View.MaxViewsPerUser,
+			MaxViewsPerIP:   cfg.View.MaxViewsPerIP,
+			BurstWindow:     cfg.View.BurstWindow,
+			BurstViewers:    cfg.View.BurstViewers,
+		})
+	tagUseCase := usecase.NewTagUseCase(tagRepo)
+	trendingUseCase := usecase.NewTrendingUseCase(trendingRepo, usecase.TrendingPolicy{
+		Windows: usecase.DefaultTrendingWindows,
+		Weights: domain.TrendingWeights{
+			View:    cfg.Trending.ViewWeight,
+			Like:    cfg.Trending.LikeWeight,
+			Comment: cfg.Trending.CommentWeight,
+			Share:   cfg.Trending.ShareWeight,
+		},
+	})
+	searchUseCase := usecase.NewSearchUseCase(searchRepo, usecase.SearchPolicy{
+		PopularityWeight: cfg.Search.PopularityWeight,
+	})
+	shareUseCase := usecase.NewShareUseCase(videoRepo, shareRepo, userDirectory, blockRepo, usecase.SharePolicy{
+		LinkBaseURL: cfg.Share.LinkBaseURL,
+	})
+	favoriteUseCase := usecase.NewFavoriteUseCase(videoRepo, favoriteRepo, collectionRepo, blockRepo,
+		transactor)
+	trashUseCase := usecase.NewTrashUseCase(trashRepo, objectStorage, transactor, outboxRepo, usecase.TrashPolicy{
+		Retention:      cfg.Trash.Retention,
+		PurgeBatchSize: cfg.Trash.PurgeBatchSize,
+	})
+	moderators := make([]uuid.UUID, len(cfg.Moderation.ModeratorIDs))
+	for i, moderatorID := range cfg.Moderation.ModeratorIDs {
+		moderators[i], err = uuid.Parse(moderatorID)
+		if err != nil {
+			logger.Fatal("Invalid moderator ID in MODERATOR_IDS",
+				zap.String("moderator_id", moderatorID),
+				zap.Error(err),
+			)
+		}
+	}
+	moderationUseCase := usecase.NewModerationUseCase(videoRepo, moderationRepo, viewRepo, userDirectory, blockRepo,
+		transactor, outboxRepo, usecase.ModerationPolicy{Moderators: moderators})
+	blockUseCase := usecase.NewBlockUseCase(blockRepo, userDirectory)
+	notificationUseCase := usecase.NewNotificationUseCase(notificationRepo, videoRepo, blockRepo,
+		trendingRepo, notifier, notificationHub)
+	feedUseCase := usecase.NewFeedUseCase(videoRepo, likeRepo, favoriteRepo, userDirectory, blockRepo)
+	analyticsUseCase := usecase.NewAnalyticsUseCase(videoRepo, analyticsRepo, usecase.AnalyticsPolicy{
+		RetentionStep: cfg.Analytics.RetentionStep,
+	})
+	uploadUseCase := usecase.NewUploadUseCase(uploadSessionRepo, videoRepo, jobQueue, objectStorage,
+		transactor, outboxRepo, usecase.UploadPolicy{
+			MaxSizeBytes:      cfg.Upload.MaxSizeBytes,
+			AllowedContainers: cfg.Upload.AllowedContainers,
+			SessionTTL:        cfg.Upload.SessionTTL,
+		})
+	idempotencyUseCase := usecase.NewIdempotencyUseCase(idempotencyRepo, usecase.IdempotencyPolicy{
+		TTL:            cfg.Idempotency.TTL,
+		PendingTimeout: cfg.Idempotency.PendingTimeout,
+	})
+	publishingUseCase := usecase.NewPublishingUseCase(videoRepo, publicationRepo, tagRepo, userDirectory,
+		blockRepo, transactor, outboxRepo, notifier, usecase.PublishingPolicy{BatchSize: cfg.Publishing.BatchSize})
+	pinUseCase := usecase.NewPinUseCase(videoRepo, pinRepo)
+	playlistUseCase := usecase.NewPlaylistUseCase(videoRepo, playlistRepo, userDirectory, blockRepo)
+	outboxRelay := usecase.NewOutboxRelay(transactor, outboxRepo, publisher, usecase.OutboxRelayPolicy{
+		BatchSize:      cfg.Outbox.BatchSize,
+		RetryBaseDelay: cfg.Outbox.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Outbox.RetryMaxDelay,
+	})
+	transcoder := media.NewFFmpegTranscoder(cfg.Processing.FFmpegPath, cfg.Processing.FFprobePath)
+	processingUseCase := usecase.NewProcessingUseCase(jobQueue, videoRepo, renditionRepo, objectStorage, transcoder,
+		usecase.ProcessingPolicy{
+			Renditions:     usecase.DefaultRenditions,
+			WorkDir:        cfg.Processing.WorkDir,
+			RetryBaseDelay: cfg.Processing.RetryBaseDelay,
+			RetryMaxDelay:  cfg.Processing.RetryMaxDelay,
+			PreviewLength:  cfg.Processing.PreviewLength,
+			PreviewWidth:   cfg.Processing.PreviewWidth,
+			PreviewFPS:     cfg.Processing.PreviewFPS,
+			HLSSegment:     cfg.Processing.HLSSegment,
+			// Renewing three times per timeout survives a missed heartbeat.
+			HeartbeatInterval: cfg.Processing.LockTimeout / 3,
+		})
 
 	logger.Info("Use cases initialized successfully")
 
@@ -99,23 +250,101 @@ func main() {
 		)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcHandler.UnaryErrorInterceptor,
+			grpcHandler.NewIdempotencyInterceptor(idempotencyUseCase),
+		),
+		grpc.ChainStreamInterceptor(grpcHandler.StreamErrorInterceptor),
+	)
 
 	logger.Info("gRPC server configured successfully",
 		zap.String("address", lis.Addr().String()),
 	)
 
-	videoHandler := grpcHandler.NewVideoHandler(videoUseCase)
+	videoServer := &grpcHandler.VideoServer{
+		VideoHandler:        grpcHandler.NewVideoHandler(videoUseCase),
+		UploadHandler:       grpcHandler.NewUploadHandler(uploadUseCase),
+		TagHandler:          grpcHandler.NewTagHandler(tagUseCase),
+		TrendingHandler:     grpcHandler.NewTrendingHandler(trendingUseCase),
+		SearchHandler:       grpcHandler.NewSearchHandler(searchUseCase),
+		ShareHandler:        grpcHandler.NewShareHandler(shareUseCase),
+		FavoriteHandler:     grpcHandler.NewFavoriteHandler(favoriteUseCase),
+		TrashHandler:        grpcHandler.NewTrashHandler(trashUseCase),
+		ModerationHandler:   grpcHandler.NewModerationHandler(moderationUseCase),
+		BlockHandler:        grpcHandler.NewBlockHandler(blockUseCase),
+		NotificationHandler: grpcHandler.NewNotificationHandler(notificationUseCase),
+		AnalyticsHandler:    grpcHandler.NewAnalyticsHandler(analyticsUseCase),
+		FeedHandler:         grpcHandler.NewFeedHandler(feedUseCase),
+		PublishingHandler:   grpcHandler.NewPublishingHandler(publishingUseCase),
+		PinHandler:          grpcHandler.NewPinHandler(pinUseCase),
+		PlaylistHandler:     grpcHandler.NewPlaylistHandler(playlistUseCase),
+	}
 
-	pb.RegisterVideoServiceServer(s, videoHandler)
+	pb.RegisterVideoServiceServer(s, videoServer)
 
 	reflection.Register(s)
 
 	logger.Info("gRPC reflection enabled for development")
 
-	_, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	processingWorker := worker.NewJobWorker("processing", processingUseCase,
+		cfg.Processing.Workers, cfg.Processing.PollInterval)
+
+	trendingWorker := worker.NewPeriodicWorker("trending", trendingUseCase.RefreshTrending,
+		cfg.Trending.RefreshInterval)
+
+	outboxWorker := worker.NewJobWorker("outbox", outboxRelay, 1, cfg.Outbox.PollInterval)
+
+	trashWorker := worker.NewPeriodicWorker("trash-purge", trashUseCase.PurgeExpired, cfg.Trash.PurgeInterval)
+
+	analyticsWorker := worker.NewPeriodicWorker("analytics-rollup", analyticsUseCase.RollupDailyStats,
+		cfg.Analytics.RollupInterval)
+
+	idempotencyWorker := worker.NewPeriodicWorker("idempotency-purge", idempotencyUseCase.PurgeExpired,
+		cfg.Idempotency.PurgeInterval)
+
+	publishWorker := worker.NewPeriodicWorker("scheduled-publish", publishingUseCase.PublishDue,
+		cfg.Publishing.PollInterval)
+
+	var workers sync.WaitGroup
+	workers.Add(6)
+	go func() {
+		defer workers.Done()
+		processingWorker.Run(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		trendingWorker.Run(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		trashWorker.Run(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		analyticsWorker.Run(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		idempotencyWorker.Run(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		publishWorker.Run(ctx)
+	}()
+	// Without a broker the relay is not started, so events are kept in the
+	// outbox instead of being marked published and lost.
+	if publisher != nil {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			outboxWorker.Run(ctx)
+		}()
+	}
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
@@ -129,17 +358,68 @@ func main() {
 		}
 	}()
 
+	httpServer := httpHandler.NewServer(":"+cfg.Server.HTTPPort,
+		httpHandler.NewMediaHandler(objectStorage, usecase.NewMediaUseCase(videoRepo), cfg.Storage.PublicPath))
+
+	go func() {
+		logger.Info("Starting HTTP media server",
+			zap.String("address", httpServer.Addr),
+		)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve HTTP", zap.Error(err))
+			cancel()
+		}
+	}()
+
+	gateway, err := httpHandler.NewGateway(ctx, "localhost:"+cfg.Server.GRPCPort)
+	if err != nil {
+		logger.Fatal("Failed to initialize REST gateway", zap.Error(err))
+	}
+	gatewayServer := httpHandler.NewGatewayServer(":"+cfg.Gateway.Port, gateway, cfg.Gateway.AllowedOrigins)
+
+	go func() {
+		logger.Info("Starting REST gateway",
+			zap.String("address", gatewayServer.Addr),
+			zap.Strings("allowed_origins", cfg.Gateway.AllowedOrigins),
+		)
+		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve REST gateway", zap.Error(err))
+			cancel()
+		}
+	}()
+
 	logger.Info("Video service is running. Press Ctrl+C to exit.")
 
 	<-c
 
 	logger.Info("Shutting down gracefully...")
 
+	cancel()
+	workers.Wait()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Failed to shut down HTTP server", zap.Error(err))
+	}
+	if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Failed to shut down REST gateway", zap.Error(err))
+	}
+
+	if publisher != nil {
+		if err := publisher.Close(); err != nil {
+			logger.Error("Failed to close event publisher", zap.Error(err))
+		}
+	}
+
 	if sqlDB, err := database.DB(); err == nil {
 		sqlDB.Close()
 		logger.Info("Database connection closed")
 	}
 
+	// Notification streams never end on their own, so they are closed first
+	// to let GracefulStop return.
+	notificationHub.Close()
 	s.GracefulStop()
 
 	logger.Info("Server stopped")
@@ -1,11 +1,32 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Kafka    KafkaConfig
+	Database     DatabaseConfig
+	Server       ServerConfig
+	Kafka        KafkaConfig
+	Storage      StorageConfig
+	Upload       UploadConfig
+	Processing   ProcessingConfig
+	Directory    DirectoryConfig
+	Trending     TrendingConfig
+	Search       SearchConfig
+	Outbox       OutboxConfig
+	Share        ShareConfig
+	Trash        TrashConfig
+	Moderation   ModerationConfig
+	Notification NotificationConfig
+	Analytics    AnalyticsConfig
+	View         ViewConfig
+	Gateway      GatewayConfig
+	Idempotency  IdempotencyConfig
+	Publishing   PublishingConfig
 }
 
 type DatabaseConfig struct {
@@ -19,6 +40,7 @@ type DatabaseConfig struct {
 
 type ServerConfig struct {
 	GRPCPort string
+	HTTPPort string
 	Host     string
 }
 
@@ -27,6 +49,110 @@ type KafkaConfig struct {
 	Topic   string
 }
 
+type StorageConfig struct {
+	LocalPath  string
+	BaseURL    string
+	PublicPath string
+}
+
+type UploadConfig struct {
+	MaxSizeBytes      int64
+	AllowedContainers []string
+	SessionTTL        time.Duration
+}
+
+type ProcessingConfig struct {
+	FFmpegPath     string
+	FFprobePath    string
+	WorkDir        string
+	Workers        int
+	PollInterval   time.Duration
+	LockTimeout    time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	PreviewLength  time.Duration
+	PreviewWidth   int
+	PreviewFPS     int
+	HLSSegment     time.Duration
+}
+
+// DirectoryConfig points at the user service that resolves usernames and
+// answers follow-graph queries. See the directory package for the endpoints
+// it must serve.
+type DirectoryConfig struct {
+	UserServiceURL string
+	Timeout        time.Duration
+}
+
+type TrendingConfig struct {
+	RefreshInterval time.Duration
+	ViewWeight      float64
+	LikeWeight      float64
+	CommentWeight   float64
+	ShareWeight     float64
+}
+
+type SearchConfig struct {
+	PopularityWeight float64
+}
+
+type ShareConfig struct {
+	LinkBaseURL string
+}
+
+type TrashConfig struct {
+	Retention      time.Duration
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+}
+
+type ModerationConfig struct {
+	ModeratorIDs []string
+}
+
+type NotificationConfig struct {
+	StreamBufferSize int
+}
+
+type AnalyticsConfig struct {
+	RollupInterval time.Duration
+	RetentionStep  int
+}
+
+type ViewConfig struct {
+	DedupWindow     time.Duration
+	MinWatchTime    int
+	RateWindow      time.Duration
+	MaxViewsPerUser int64
+	MaxViewsPerIP   int64
+	BurstWindow     time.Duration
+	BurstViewers    int64
+}
+
+type IdempotencyConfig struct {
+	TTL            time.Duration
+	PendingTimeout time.Duration
+	PurgeInterval  time.Duration
+}
+
+type PublishingConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// GatewayConfig configures the REST/JSON gateway in front of the gRPC API.
+type GatewayConfig struct {
+	Port           string
+	AllowedOrigins []string
+}
+
+type OutboxConfig struct {
+	BatchSize      int
+	PollInterval   time.Duration
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
 func LoadConfig() (*Config, error) {
 	return &Config{
 		Database: DatabaseConfig{
@@ -39,11 +165,142 @@ func LoadConfig() (*Config, error) {
 		},
 		Server: ServerConfig{
 			GRPCPort: os.Getenv("GRPC_PORT"),
+			HTTPPort: getEnv("HTTP_PORT", "8080"),
 			Host:     os.Getenv("SERVER_HOST"),
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{os.Getenv("KAFKA_BROKERS")},
-			Topic:   os.Getenv("KAFKA_TOPIC"),
+			Brokers: getEnvList("KAFKA_BROKERS", nil),
+			Topic:   getEnv("KAFKA_TOPIC", "video-events"),
+		},
+		Storage: StorageConfig{
+			LocalPath:  getEnv("STORAGE_LOCAL_PATH", "./data/media"),
+			BaseURL:    getEnv("STORAGE_BASE_URL", "/media"),
+			PublicPath: getEnv("STORAGE_PUBLIC_PATH", "/media"),
+		},
+		Upload: UploadConfig{
+			MaxSizeBytes:      getEnvInt64("UPLOAD_MAX_SIZE_BYTES", 512<<20),
+			AllowedContainers: getEnvList("UPLOAD_ALLOWED_CONTAINERS", []string{"mp4", "mov", "webm"}),
+			SessionTTL:        getEnvDuration("UPLOAD_SESSION_TTL", 24*time.Hour),
+		},
+		Processing: ProcessingConfig{
+			FFmpegPath:     getEnv("FFMPEG_PATH", "ffmpeg"),
+			FFprobePath:    getEnv("FFPROBE_PATH", "ffprobe"),
+			WorkDir:        getEnv("PROCESSING_WORK_DIR", os.TempDir()),
+			Workers:        int(getEnvInt64("PROCESSING_WORKERS", 2)),
+			PollInterval:   getEnvDuration("PROCESSING_POLL_INTERVAL", 2*time.Second),
+			LockTimeout:    getEnvDuration("PROCESSING_LOCK_TIMEOUT", 30*time.Minute),
+			RetryBaseDelay: getEnvDuration("PROCESSING_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:  getEnvDuration("PROCESSING_RETRY_MAX_DELAY", 30*time.Minute),
+			PreviewLength:  getEnvDuration("PREVIEW_LENGTH", 3*time.Second),
+			PreviewWidth:   int(getEnvInt64("PREVIEW_WIDTH", 320)),
+			PreviewFPS:     int(getEnvInt64("PREVIEW_FPS", 10)),
+			HLSSegment:     getEnvDuration("HLS_SEGMENT_DURATION", 4*time.Second),
+		},
+		Directory: DirectoryConfig{
+			UserServiceURL: os.Getenv("USER_DIRECTORY_URL"),
+			Timeout:        getEnvDuration("USER_DIRECTORY_TIMEOUT", 2*time.Second),
+		},
+		Trending: TrendingConfig{
+			RefreshInterval: getEnvDuration("TRENDING_REFRESH_INTERVAL", 10*time.Minute),
+			ViewWeight:      getEnvFloat("TRENDING_VIEW_WEIGHT", 1),
+			LikeWeight:      getEnvFloat("TRENDING_LIKE_WEIGHT", 3),
+			CommentWeight:   getEnvFloat("TRENDING_COMMENT_WEIGHT", 4),
+			ShareWeight:     getEnvFloat("TRENDING_SHARE_WEIGHT", 5),
+		},
+		Search: SearchConfig{
+			PopularityWeight: getEnvFloat("SEARCH_POPULARITY_WEIGHT", 0.1),
+		},
+		Share: ShareConfig{
+			LinkBaseURL: getEnv("SHARE_LINK_BASE_URL", "/s"),
+		},
+		Outbox: OutboxConfig{
+			BatchSize:      int(getEnvInt64("OUTBOX_BATCH_SIZE", 100)),
+			PollInterval:   getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			RetryBaseDelay: getEnvDuration("OUTBOX_RETRY_BASE_DELAY", time.Second),
+			RetryMaxDelay:  getEnvDuration("OUTBOX_RETRY_MAX_DELAY", 5*time.Minute),
+		},
+		Trash: TrashConfig{
+			Retention:      getEnvDuration("TRASH_RETENTION", 30*24*time.Hour),
+			PurgeInterval:  getEnvDuration("TRASH_PURGE_INTERVAL", time.Hour),
+			PurgeBatchSize: int(getEnvInt64("TRASH_PURGE_BATCH_SIZE", 100)),
+		},
+		Moderation: ModerationConfig{
+			ModeratorIDs: getEnvList("MODERATOR_IDS", nil),
+		},
+		Notification: NotificationConfig{
+			StreamBufferSize: int(getEnvInt64("NOTIFICATION_STREAM_BUFFER_SIZE", 32)),
+		},
+		Analytics: AnalyticsConfig{
+			RollupInterval: getEnvDuration("ANALYTICS_ROLLUP_INTERVAL", 15*time.Minute),
+			RetentionStep:  int(getEnvInt64("ANALYTICS_RETENTION_STEP", 10)),
+		},
+		View: ViewConfig{
+			DedupWindow:     getEnvDuration("VIEW_DEDUP_WINDOW", 24*time.Hour),
+			MinWatchTime:    int(getEnvInt64("VIEW_MIN_WATCH_TIME", 3)),
+			RateWindow:      getEnvDuration("VIEW_RATE_WINDOW", time.Minute),
+			MaxViewsPerUser: getEnvInt64("VIEW_MAX_PER_USER", 60),
+			MaxViewsPerIP:   getEnvInt64("VIEW_MAX_PER_IP", 300),
+			BurstWindow:     getEnvDuration("VIEW_BURST_WINDOW", 10*time.Minute),
+			BurstViewers:    getEnvInt64("VIEW_BURST_VIEWERS", 20),
+		},
+		Gateway: GatewayConfig{
+			Port:           getEnv("GATEWAY_PORT", "8081"),
+			AllowedOrigins: getEnvList("GATEWAY_ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:            getEnvDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			PendingTimeout: getEnvDuration("IDEMPOTENCY_PENDING_TIMEOUT", time.Minute),
+			PurgeInterval:  getEnvDuration("IDEMPOTENCY_PURGE_INTERVAL", time.Hour),
+		},
+		Publishing: PublishingConfig{
+			PollInterval: getEnvDuration("PUBLISH_POLL_INTERVAL", 30*time.Second),
+			BatchSize:    int(getEnvInt64("PUBLISH_BATCH_SIZE", 100)),
 		},
 	}, nil
 }
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt64(key string, fallback int64) int64 {
+	value, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvList(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
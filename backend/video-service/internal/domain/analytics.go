@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidDateRange = NewInvalidArgumentError("start_date",
+	"start_date must not be after end_date or more than a year before it")
+
+// EngagementStats are the counters rolled up per video and day. WatchTime is
+// in the same unit as Video.Duration. UniqueViewers is distinct per video and
+// day, so totals over several days or videos are an upper bound.
+type EngagementStats struct {
+	Views          int64 `json:"views" gorm:"not null;default:0"`
+	UniqueViewers  int64 `json:"unique_viewers" gorm:"not null;default:0"`
+	TotalWatchTime int64 `json:"total_watch_time" gorm:"not null;default:0"`
+	CompletedViews int64 `json:"completed_views" gorm:"not null;default:0"`
+	Likes          int64 `json:"likes" gorm:"not null;default:0"`
+	Shares         int64 `json:"shares" gorm:"not null;default:0"`
+}
+
+func (stats *EngagementStats) Add(other EngagementStats) {
+	stats.Views += other.Views
+	stats.UniqueViewers += other.UniqueViewers
+	stats.TotalWatchTime += other.TotalWatchTime
+	stats.CompletedViews += other.CompletedViews
+	stats.Likes += other.Likes
+	stats.Shares += other.Shares
+}
+
+func (stats EngagementStats) AverageWatchTime() float64 {
+	if stats.Views == 0 {
+		return 0
+	}
+	return float64(stats.TotalWatchTime) / float64(stats.Views)
+}
+
+func (stats EngagementStats) CompletionRate() float64 {
+	if stats.Views == 0 {
+		return 0
+	}
+	return float64(stats.CompletedViews) / float64(stats.Views)
+}
+
+type VideoDailyStats struct {
+	VideoID         uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key"`
+	Day             time.Time `json:"day" gorm:"type:date;primary_key"`
+	UserID          uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index:idx_video_daily_stats_user_day,priority:1"`
+	EngagementStats `gorm:"embedded"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// VideoRetention counts the views on Day that watched at least Percent of
+// the video.
+type VideoRetention struct {
+	VideoID uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key"`
+	Day     time.Time `json:"day" gorm:"type:date;primary_key"`
+	Percent int       `json:"percent" gorm:"primary_key;autoIncrement:false"`
+	Viewers int64     `json:"viewers" gorm:"not null"`
+}
+
+func (VideoRetention) TableName() string {
+	return "video_retention"
+}
+
+type RetentionPoint struct {
+	Percent int   `json:"percent"`
+	Viewers int64 `json:"viewers"`
+	// Rate is Viewers relative to the views that started the video.
+	Rate float64 `json:"rate" gorm:"-"`
+}
+
+type DailyStats struct {
+	Day             time.Time `json:"day"`
+	EngagementStats `gorm:"embedded"`
+}
+
+type VideoStats struct {
+	VideoID         uuid.UUID `json:"video_id"`
+	EngagementStats `gorm:"embedded"`
+}
+
+type VideoAnalytics struct {
+	From      time.Time          `json:"from"`
+	To        time.Time          `json:"to"`
+	Totals    EngagementStats    `json:"totals"`
+	Daily     []*VideoDailyStats `json:"daily"`
+	Retention []*RetentionPoint  `json:"retention"`
+}
+
+type CreatorAnalytics struct {
+	From      time.Time       `json:"from"`
+	To        time.Time       `json:"to"`
+	Totals    EngagementStats `json:"totals"`
+	Daily     []*DailyStats   `json:"daily"`
+	TopVideos []*VideoStats   `json:"top_videos"`
+}
+
+// AnalyticsRepository ranges are inclusive calendar days in UTC.
+type AnalyticsRepository interface {
+	// Rollup replaces the stats and retention rows for day with ones
+	// recomputed from raw views, likes and shares, so it can run repeatedly.
+	Rollup(ctx context.Context, day time.Time, retentionStep int) error
+	ListVideoDailyStats(ctx context.Context, videoID uuid.UUID, from, to time.Time) ([]*VideoDailyStats, error)
+	GetRetention(ctx context.Context, videoID uuid.UUID, from, to time.Time) ([]*RetentionPoint, error)
+	ListCreatorDailyStats(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*DailyStats, error)
+	ListTopVideos(ctx context.Context, userID uuid.UUID, from, to time.Time, limit int) ([]*VideoStats, error)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCannotBlockSelf = NewInvalidArgumentError("blocked_user_id", "users cannot block themselves")
+	// ErrFollowsNotRemoved is returned when no user directory is configured to
+	// drop follows for a block. The block itself is stored.
+	ErrFollowsNotRemoved = &FailedPreconditionError{
+		Reason: "user directory is not configured, follows were not removed",
+	}
+)
+
+type UserBlock struct {
+	BlockerID uuid.UUID `json:"blocker_id" gorm:"type:uuid;primary_key"`
+	BlockedID uuid.UUID `json:"blocked_id" gorm:"type:uuid;primary_key;index"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BlockRepository interface {
+	// Block is a no-op when the block already exists.
+	Block(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	Unblock(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	ListBlocked(ctx context.Context, blockerID uuid.UUID, limit, offset int) ([]*UserBlock, error)
+	CountBlocked(ctx context.Context, blockerID uuid.UUID) (int64, error)
+	// BlockedAmong returns which of userIDs have blocked userID or were
+	// blocked by them, in a single lookup.
+	BlockedAmong(ctx context.Context, userID uuid.UUID, userIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
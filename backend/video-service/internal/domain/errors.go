@@ -0,0 +1,106 @@
+package domain
+
+import "errors"
+
+// The error types below tell the transport layer how to report a failure
+// without it having to know every sentinel. Repositories and use cases
+// return them, most often as one of the package's Err values.
+
+var (
+	ErrVideoNotFound         = &NotFoundError{Resource: "video"}
+	ErrUploadSessionNotFound = &NotFoundError{Resource: "upload session"}
+	ErrViewNotFound          = &NotFoundError{Resource: "view"}
+)
+
+// NotFoundError reports a resource that does not exist or that the caller
+// may not see; the two are deliberately indistinguishable.
+type NotFoundError struct {
+	Resource string
+}
+
+func (e *NotFoundError) Error() string {
+	return e.Resource + " not found"
+}
+
+// InvalidArgumentError reports a request value the domain rejects. Field
+// names the request field in snake_case and may be empty.
+type InvalidArgumentError struct {
+	Field  string
+	Reason string
+}
+
+func NewInvalidArgumentError(field, reason string) *InvalidArgumentError {
+	return &InvalidArgumentError{Field: field, Reason: reason}
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return e.Reason
+}
+
+// ConflictError reports a write that collides with existing state, such as
+// a unique constraint. Err keeps the underlying cause for logs.
+type ConflictError struct {
+	Reason string
+	Err    error
+}
+
+func (e *ConflictError) Error() string {
+	return e.Reason
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// AbortedError reports a write that lost a race with another writer. The
+// caller should re-read the resource and retry.
+type AbortedError struct {
+	Reason string
+}
+
+func (e *AbortedError) Error() string {
+	return e.Reason
+}
+
+// FailedPreconditionError reports a request that is valid but cannot be
+// applied to the resource in its current state.
+type FailedPreconditionError struct {
+	Reason string
+}
+
+func (e *FailedPreconditionError) Error() string {
+	return e.Reason
+}
+
+// ResourceExhaustedError reports a caller that has used up a quota, such as
+// a rate limit. Retrying later may succeed.
+type ResourceExhaustedError struct {
+	Reason string
+}
+
+func (e *ResourceExhaustedError) Error() string {
+	return e.Reason
+}
+
+// DataLossError reports content that arrived corrupted, such as an upload
+// whose checksum does not match the one announced for it.
+type DataLossError struct {
+	Reason string
+}
+
+func (e *DataLossError) Error() string {
+	return e.Reason
+}
+
+type PermissionDeniedError struct {
+	Reason string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return e.Reason
+}
+
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventVideoCreated   EventType = "video.created"
+	EventVideoPublished EventType = "video.published"
+	EventVideoUpdated   EventType = "video.updated"
+	EventVideoDeleted   EventType = "video.deleted"
+	EventVideoRestored  EventType = "video.restored"
+	EventVideoPurged    EventType = "video.purged"
+	EventVideoModerated EventType = "video.moderated"
+	EventVideoLiked     EventType = "video.liked"
+	EventVideoUnliked   EventType = "video.unliked"
+	EventVideoViewed    EventType = "video.viewed"
+)
+
+// VideoEvent is a change to a video that other services may react to. Only
+// the fields relevant to Type are populated.
+type VideoEvent struct {
+	Type       EventType
+	VideoID    uuid.UUID
+	UserID     uuid.UUID
+	Video      *Video
+	LikeCount  int64
+	ViewCount  int64
+	WatchTime  int
+	OccurredAt time.Time
+}
+
+type OutboxEvent struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	EventType   EventType  `json:"event_type" gorm:"type:varchar(50);not null"`
+	AggregateID uuid.UUID  `json:"aggregate_id" gorm:"type:uuid;not null"`
+	Payload     []byte     `json:"payload" gorm:"type:bytea;not null"`
+	Attempts    int        `json:"attempts" gorm:"default:0"`
+	LastError   string     `json:"last_error"`
+	AvailableAt time.Time  `json:"available_at" gorm:"not null;index:idx_outbox_pending,where:published_at IS NULL"`
+	PublishedAt *time.Time `json:"published_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type OutboxRepository interface {
+	Append(ctx context.Context, event *VideoEvent) error
+	FetchPending(ctx context.Context, limit int) ([]*OutboxEvent, error)
+	MarkPublished(ctx context.Context, ids []uuid.UUID) error
+	MarkFailed(ctx context.Context, ids []uuid.UUID, lastError string, retryAt time.Time) error
+}
+
+// EventMessage is what leaves the service. ID doubles as the deduplication
+// key, since the outbox relay only guarantees at-least-once delivery.
+type EventMessage struct {
+	ID      uuid.UUID
+	Type    EventType
+	Key     string
+	Payload []byte
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, messages ...EventMessage) error
+	Close() error
+}
+
+// Transactor runs fn in a database transaction carried by the returned
+// context, so repositories called with it share the same commit.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
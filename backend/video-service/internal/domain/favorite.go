@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCollectionNotFound    = &NotFoundError{Resource: "collection"}
+	ErrNotCollectionOwner    = &PermissionDeniedError{Reason: "user does not own this collection"}
+	ErrInvalidCollectionName = NewInvalidArgumentError("name",
+		"collection name must be between 1 and 100 characters")
+	ErrInvalidCollectionsOrder = NewInvalidArgumentError("collection_ids",
+		"collection order must list each of the user's collections exactly once")
+)
+
+type Favorite struct {
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;primary_key"`
+	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key;index"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Collection struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	Name      string    `json:"name" gorm:"type:varchar(100);not null"`
+	IsPublic  bool      `json:"is_public"`
+	Position  int       `json:"position" gorm:"not null;default:0"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CollectionVideo struct {
+	CollectionID uuid.UUID `json:"collection_id" gorm:"type:uuid;primary_key"`
+	VideoID      uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key;index"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// FavoriteRepository lists videos as seen by their collector: videos that
+// are not public only appear to the collector if they also own them.
+type FavoriteRepository interface {
+	// Add and Remove return the video's favorite count after the change.
+	Add(ctx context.Context, userID, videoID uuid.UUID) (int64, error)
+	Remove(ctx context.Context, userID, videoID uuid.UUID) (int64, error)
+	GetFavoriteCount(ctx context.Context, videoID uuid.UUID) (int64, error)
+	ListVideos(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountVideos(ctx context.Context, userID uuid.UUID) (int64, error)
+	// FavoritedAmong returns which of videoIDs the user has favorited.
+	FavoritedAmong(ctx context.Context, userID uuid.UUID, videoIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
+
+type CollectionRepository interface {
+	Create(ctx context.Context, collection *Collection) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Collection, error)
+	Update(ctx context.Context, collection *Collection) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByUserID(ctx context.Context, userID uuid.UUID, publicOnly bool, limit, offset int) ([]*Collection, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID, publicOnly bool) (int64, error)
+	Reorder(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
+	AddVideo(ctx context.Context, collectionID, videoID uuid.UUID) error
+	RemoveVideo(ctx context.Context, collectionID, videoID uuid.UUID) error
+	// ListVideos and CountVideos show the viewer their own videos in any
+	// state and everyone else's only while public and published, leaving out
+	// owners blocked with the viewer.
+	ListVideos(ctx context.Context, collection *Collection, viewerID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountVideos(ctx context.Context, collection *Collection, viewerID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyInUse  = &AbortedError{Reason: "a request with this idempotency key is still in progress"}
+	ErrIdempotencyKeyReused = NewInvalidArgumentError("idempotency-key",
+		"idempotency key was already used for a different request")
+)
+
+// IdempotencyRecord remembers the response to a mutating request so that a
+// retry carrying the same key gets the original answer instead of repeating
+// the write. Response is nil while the original request is still running.
+type IdempotencyRecord struct {
+	UserID      string    `json:"user_id" gorm:"type:varchar(64);primary_key"`
+	Method      string    `json:"method" gorm:"type:varchar(128);primary_key"`
+	Key         string    `json:"key" gorm:"type:varchar(128);primary_key"`
+	RequestHash string    `json:"request_hash" gorm:"type:varchar(64);not null"`
+	Response    []byte    `json:"response"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at" gorm:"not null;index"`
+}
+
+type IdempotencyRepository interface {
+	// Reserve stores record unless an unexpired record holds the same key,
+	// in which case it returns that record and false.
+	Reserve(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, userID, method, key string, response []byte, expiresAt time.Time) error
+	Release(ctx context.Context, userID, method, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobType string
+
+const (
+	JobTypeTranscode JobType = "transcode"
+	JobTypeThumbnail JobType = "thumbnail"
+)
+
+type JobStatus string
+
+const (
+	JobStatusQueued  JobStatus = "queued"
+	JobStatusRunning JobStatus = "running"
+	JobStatusDone    JobStatus = "done"
+	JobStatusFailed  JobStatus = "failed"
+)
+
+type Job struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	Type        JobType    `json:"type" gorm:"type:varchar(50);not null"`
+	VideoID     uuid.UUID  `json:"video_id" gorm:"type:uuid;not null;index"`
+	Payload     string     `json:"payload" gorm:"type:jsonb;not null;default:'{}'"`
+	Status      JobStatus  `json:"status" gorm:"type:varchar(20);not null;index:idx_jobs_status_run_at,priority:1"`
+	Attempts    int        `json:"attempts" gorm:"not null;default:0"`
+	MaxAttempts int        `json:"max_attempts" gorm:"not null"`
+	RunAt       time.Time  `json:"run_at" gorm:"not null;index:idx_jobs_status_run_at,priority:2"`
+	LockedAt    *time.Time `json:"locked_at"`
+	LastError   string     `json:"last_error"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type JobQueue interface {
+	Enqueue(ctx context.Context, job *Job) error
+	Dequeue(ctx context.Context, types []JobType) (*Job, error)
+	Complete(ctx context.Context, id uuid.UUID) error
+	Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error
+	Fail(ctx context.Context, id uuid.UUID, lastError string) error
+	// Heartbeat renews the lock on a running job so that it is not reclaimed
+	// while its worker is still busy with it.
+	Heartbeat(ctx context.Context, id uuid.UUID) error
+}
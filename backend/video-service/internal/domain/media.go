@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MediaInfo struct {
+	Width    int
+	Height   int
+	Duration time.Duration
+}
+
+type Rendition struct {
+	Name         string
+	Height       int
+	VideoBitrate string
+	AudioBitrate string
+}
+
+type PreviewSpec struct {
+	Start  time.Duration
+	Length time.Duration
+	Width  int
+	FPS    int
+}
+
+type Transcoder interface {
+	Probe(ctx context.Context, inputPath string) (*MediaInfo, error)
+	Transcode(ctx context.Context, inputPath, outputPath string, rendition Rendition) error
+	ExtractFrame(ctx context.Context, inputPath, outputPath string, at time.Duration) error
+	CreatePreview(ctx context.Context, inputPath, outputPath string, spec PreviewSpec) error
+	PackageHLS(ctx context.Context, inputPath, outputDir string, segmentDuration time.Duration) error
+}
+
+type VideoRendition struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	VideoID    uuid.UUID `json:"video_id" gorm:"type:uuid;not null;uniqueIndex:idx_video_renditions_video_name"`
+	Name       string    `json:"name" gorm:"not null;uniqueIndex:idx_video_renditions_video_name"`
+	Width      int       `json:"width"`
+	Height     int       `json:"height" gorm:"not null"`
+	Bitrate    string    `json:"bitrate"`
+	Bandwidth  int       `json:"bandwidth"`
+	StorageKey string    `json:"storage_key" gorm:"not null"`
+	URL        string    `json:"url" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type VideoRenditionRepository interface {
+	Upsert(ctx context.Context, rendition *VideoRendition) error
+	ListByVideoID(ctx context.Context, videoID uuid.UUID) ([]*VideoRendition, error)
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidReportReason     = NewInvalidArgumentError("reason", "unsupported report reason")
+	ErrInvalidModerationAction = NewInvalidArgumentError("action", "unsupported moderation action")
+	ErrCannotReportOwnVideo    = NewInvalidArgumentError("video_id", "users cannot report their own videos")
+	ErrNotModerator            = &PermissionDeniedError{Reason: "user is not a moderator"}
+	ErrModerationReasonMissing = NewInvalidArgumentError("reason",
+		"a reason is required to restrict or take down a video")
+)
+
+// ModerationState is the outcome of moderating a video. Restricted videos
+// stay reachable directly and on their creator's profile but are left out
+// of discovery; taken-down videos are only visible to their owner.
+type ModerationState string
+
+const (
+	ModerationStateActive     ModerationState = "active"
+	ModerationStateRestricted ModerationState = "restricted"
+	ModerationStateTakenDown  ModerationState = "taken_down"
+)
+
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonCopyright      ReportReason = "copyright"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonHateSpeech     ReportReason = "hate_speech"
+	ReportReasonNudity         ReportReason = "nudity"
+	ReportReasonViolence       ReportReason = "violence"
+	ReportReasonChildSafety    ReportReason = "child_safety"
+	ReportReasonOther          ReportReason = "other"
+)
+
+var reportReasonSeverity = map[ReportReason]int{
+	ReportReasonSpam:           1,
+	ReportReasonOther:          1,
+	ReportReasonMisinformation: 2,
+	ReportReasonCopyright:      2,
+	ReportReasonHarassment:     3,
+	ReportReasonHateSpeech:     3,
+	ReportReasonNudity:         3,
+	ReportReasonViolence:       4,
+	ReportReasonChildSafety:    5,
+}
+
+// Severity ranks how urgently a report needs a moderator. Unknown reasons
+// have a severity of zero.
+func (reason ReportReason) Severity() int {
+	return reportReasonSeverity[reason]
+}
+
+type ModerationActionType string
+
+const (
+	ModerationActionDismiss  ModerationActionType = "dismiss"
+	ModerationActionRestrict ModerationActionType = "restrict"
+	ModerationActionTakeDown ModerationActionType = "take_down"
+	ModerationActionStrike   ModerationActionType = "strike"
+)
+
+type CaseStatus string
+
+const (
+	CaseStatusOpen     CaseStatus = "open"
+	CaseStatusResolved CaseStatus = "resolved"
+)
+
+// VideoReport is one user's report against a video. A reporter counts once
+// per video, however many times they report it.
+type VideoReport struct {
+	ID         uuid.UUID    `json:"id" gorm:"type:uuid;primary_key"`
+	VideoID    uuid.UUID    `json:"video_id" gorm:"type:uuid;not null;uniqueIndex:idx_video_reports_reporter"`
+	ReporterID uuid.UUID    `json:"reporter_id" gorm:"type:uuid;not null;uniqueIndex:idx_video_reports_reporter"`
+	Reason     ReportReason `json:"reason" gorm:"type:varchar(30);not null"`
+	Details    string       `json:"details" gorm:"type:text"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// ModerationCase aggregates the reports filed against a video since it was
+// last resolved. A new report against a resolved case reopens it.
+type ModerationCase struct {
+	VideoID         uuid.UUID              `json:"video_id" gorm:"type:uuid;primary_key"`
+	Status          CaseStatus             `json:"status" gorm:"type:varchar(20);not null;index"`
+	Severity        int                    `json:"severity" gorm:"not null"`
+	ReportCount     int64                  `json:"report_count" gorm:"not null"`
+	FirstReportedAt time.Time              `json:"first_reported_at" gorm:"not null"`
+	LastReportedAt  time.Time              `json:"last_reported_at" gorm:"not null"`
+	ResolvedAt      *time.Time             `json:"resolved_at"`
+	Video           *Video                 `json:"video" gorm:"-"`
+	ReasonCounts    map[ReportReason]int64 `json:"reason_counts" gorm:"-"`
+}
+
+type ModerationAction struct {
+	ID          uuid.UUID            `json:"id" gorm:"type:uuid;primary_key"`
+	VideoID     uuid.UUID            `json:"video_id" gorm:"type:uuid;not null;index"`
+	ModeratorID uuid.UUID            `json:"moderator_id" gorm:"type:uuid;not null"`
+	Action      ModerationActionType `json:"action" gorm:"type:varchar(20);not null"`
+	Reason      string               `json:"reason" gorm:"type:text"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+type CreatorStrike struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	UserID      uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	VideoID     uuid.UUID `json:"video_id" gorm:"type:uuid;not null"`
+	ModeratorID uuid.UUID `json:"moderator_id" gorm:"type:uuid;not null"`
+	Reason      string    `json:"reason" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ModerationRepository interface {
+	// Report stores the report and opens or updates the video's case. It
+	// returns false when the reporter had already reported the video.
+	Report(ctx context.Context, report *VideoReport) (bool, error)
+	// ListQueue returns open cases, most severe and most reported first.
+	ListQueue(ctx context.Context, limit, offset int) ([]*ModerationCase, error)
+	CountQueue(ctx context.Context) (int64, error)
+	ResolveCase(ctx context.Context, videoID uuid.UUID) error
+	SetVideoState(ctx context.Context, videoID uuid.UUID, state ModerationState, reason string) error
+	RecordAction(ctx context.Context, action *ModerationAction) error
+	ListActions(ctx context.Context, videoID uuid.UUID) ([]*ModerationAction, error)
+	// AddStrike stores the strike and returns the creator's strike count.
+	AddStrike(ctx context.Context, strike *CreatorStrike) (int64, error)
+}
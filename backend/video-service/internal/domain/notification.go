@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidNotificationType   = NewInvalidArgumentError("type", "unsupported notification type")
+	ErrNotificationVideoMissing  = NewInvalidArgumentError("video_id", "comment notifications require a video_id")
+	ErrNotificationNotVideoOwner = NewInvalidArgumentError("recipient_id",
+		"comment notifications must go to the video's owner")
+)
+
+type NotificationType string
+
+const (
+	NotificationTypeLike    NotificationType = "like"
+	NotificationTypeComment NotificationType = "comment"
+	NotificationTypeFollow  NotificationType = "follow"
+	NotificationTypeMention NotificationType = "mention"
+)
+
+// Notification aggregates every actor who did the same thing to the same
+// video, or followed the user, since the user last read it. ActorCount
+// counts each actor once, so clients render "LastActorID and ActorCount-1
+// others".
+type Notification struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	UserID      uuid.UUID        `json:"user_id" gorm:"type:uuid;not null;index"`
+	Type        NotificationType `json:"type" gorm:"type:varchar(20);not null"`
+	VideoID     *uuid.UUID       `json:"video_id" gorm:"type:uuid"`
+	LastActorID uuid.UUID        `json:"last_actor_id" gorm:"type:uuid;not null"`
+	ActorCount  int64            `json:"actor_count" gorm:"not null;default:0"`
+	ReadAt      *time.Time       `json:"read_at"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+type NotificationActor struct {
+	NotificationID uuid.UUID `json:"notification_id" gorm:"type:uuid;primary_key"`
+	ActorID        uuid.UUID `json:"actor_id" gorm:"type:uuid;primary_key"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Activity is something an actor did that the recipient should hear about.
+type Activity struct {
+	Type        NotificationType
+	RecipientID uuid.UUID
+	ActorID     uuid.UUID
+	VideoID     *uuid.UUID
+}
+
+type NotificationRepository interface {
+	// Record folds the activity into the recipient's unread notification of
+	// the same type and video. It returns nil when the actor was already
+	// counted there.
+	Record(ctx context.Context, activity *Activity) (*Notification, error)
+	List(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*Notification, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	// MarkRead marks the given notifications read, or all of the user's
+	// notifications when ids is empty.
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
+}
+
+// NotificationHub fans new notifications out to the recipient's live
+// subscribers in this process.
+type NotificationHub interface {
+	Publish(notification *Notification)
+	// Subscribe returns the user's new notifications until cancel is called
+	// or the hub is closed, when the channel is closed.
+	Subscribe(userID uuid.UUID) (notifications <-chan *Notification, cancel func())
+}
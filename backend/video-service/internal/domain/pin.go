@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPinnedVideos is how many videos a creator can pin to the top of their
+// profile.
+const MaxPinnedVideos = 3
+
+var (
+	ErrPinLimitReached = &FailedPreconditionError{
+		Reason: fmt.Sprintf("at most %d videos can be pinned", MaxPinnedVideos),
+	}
+	ErrVideoNotPinnable = &FailedPreconditionError{Reason: "only published public or follower videos can be pinned"}
+	ErrPinConflict      = &AbortedError{Reason: "another pin was added at the same time; retry"}
+)
+
+// VideoPin places a video on its owner's profile above the sorted grid.
+// Slot runs from 1 to MaxPinnedVideos and is unique per user, which is what
+// enforces the limit.
+type VideoPin struct {
+	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_video_pins_user_slot"`
+	Slot      int       `json:"slot" gorm:"not null;uniqueIndex:idx_video_pins_user_slot"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PinRepository interface {
+	// Pin takes the user's lowest free slot for the video. Pinning a video
+	// that is already pinned does nothing. It returns ErrPinLimitReached when
+	// every slot is taken and ErrPinConflict when a concurrent pin took the
+	// free slot first.
+	Pin(ctx context.Context, userID, videoID uuid.UUID) error
+	Unpin(ctx context.Context, videoID uuid.UUID) error
+}
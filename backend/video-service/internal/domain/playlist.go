@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPlaylistNotFound     = &NotFoundError{Resource: "playlist"}
+	ErrVideoNotInPlaylist   = &NotFoundError{Resource: "playlist video"}
+	ErrNotPlaylistOwner     = &PermissionDeniedError{Reason: "user does not own this playlist"}
+	ErrInvalidPlaylistTitle = NewInvalidArgumentError("title",
+		"playlist title must be between 1 and 150 characters")
+	ErrInvalidPlaylistDescription = NewInvalidArgumentError("description",
+		"playlist description must be at most 5000 characters")
+	ErrInvalidPlaylistOrder = NewInvalidArgumentError("video_ids",
+		"playlist order must list each of the playlist's videos exactly once")
+)
+
+// Playlist is an ordered series of its creator's own videos.
+type Playlist struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	UserID      uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	Title       string    `json:"title" gorm:"type:varchar(150);not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	CoverURL    string    `json:"cover_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PlaylistVideo struct {
+	PlaylistID uuid.UUID `json:"playlist_id" gorm:"type:uuid;primary_key"`
+	VideoID    uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key;index"`
+	Position   int       `json:"position" gorm:"not null;default:0"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PlaylistVideosQuery selects a playlist's items in playlist order. Owners
+// see every item, including drafts and taken-down videos, so they can edit
+// the full series; everyone else only sees published videos with one of
+// Visibilities.
+type PlaylistVideosQuery struct {
+	PlaylistID   uuid.UUID
+	Owner        bool
+	Visibilities []Visibility
+	// AfterPosition, when set, skips the items up to and including it.
+	AfterPosition *int
+	Limit         int
+	Offset        int
+}
+
+type PlaylistRepository interface {
+	Create(ctx context.Context, playlist *Playlist) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Playlist, error)
+	Update(ctx context.Context, playlist *Playlist) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Playlist, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	// ListByVideoID returns the playlists holding the video, oldest first.
+	ListByVideoID(ctx context.Context, videoID uuid.UUID) ([]*Playlist, error)
+	// AddVideo appends the video to the end of the playlist. Adding a video
+	// that is already there does nothing.
+	AddVideo(ctx context.Context, playlistID, videoID uuid.UUID) error
+	RemoveVideo(ctx context.Context, playlistID, videoID uuid.UUID) error
+	// Reorder returns ErrInvalidPlaylistOrder unless videoIDs lists each of
+	// the playlist's videos exactly once.
+	Reorder(ctx context.Context, playlistID uuid.UUID, videoIDs []uuid.UUID) error
+	// Position returns ErrVideoNotInPlaylist when the video is not an item.
+	Position(ctx context.Context, playlistID, videoID uuid.UUID) (int, error)
+	ListVideos(ctx context.Context, query PlaylistVideosQuery) ([]*Video, error)
+	CountVideos(ctx context.Context, query PlaylistVideosQuery) (int64, error)
+}
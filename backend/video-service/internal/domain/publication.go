@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrVideoAlreadyPublished = &FailedPreconditionError{Reason: "video is already published"}
+	ErrVideoNotScheduled     = &FailedPreconditionError{Reason: "video is not scheduled"}
+	ErrPublishAtNotInFuture  = NewInvalidArgumentError("publish_at", "publish_at must be in the future")
+)
+
+type PublicationRepository interface {
+	// ListDrafts returns the user's drafts and scheduled videos, most
+	// recently changed first.
+	ListDrafts(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountDrafts(ctx context.Context, userID uuid.UUID) (int64, error)
+	// SetPublication moves video to its PublicationState and PublishAt, but
+	// only while the stored state is still from; otherwise it returns
+	// ErrVideoModified.
+	SetPublication(ctx context.Context, video *Video, from PublicationState) error
+	// PublishDue publishes up to limit scheduled videos whose time has come
+	// and returns them. Rows claimed by a concurrent caller are skipped, so
+	// each video is returned exactly once across replicas.
+	PublishDue(ctx context.Context, now time.Time, limit int) ([]*Video, error)
+}
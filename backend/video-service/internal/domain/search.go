@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSearchQuery  = NewInvalidArgumentError("query", "search query has no searchable terms")
+	ErrInvalidSearchCursor = NewInvalidArgumentError("cursor", "invalid search cursor")
+)
+
+type SearchCursor struct {
+	Score float64
+	ID    uuid.UUID
+}
+
+type VideoSearchQuery struct {
+	Terms []string
+	// ViewerID also sees their own unpublished matches, and never those of
+	// users blocked with them.
+	ViewerID         *uuid.UUID
+	CreatorID        *uuid.UUID
+	CreatedAfter     *time.Time
+	CreatedBefore    *time.Time
+	MinDuration      int
+	MaxDuration      int
+	PopularityWeight float64
+	After            *SearchCursor
+	Limit            int
+}
+
+type VideoSearchResult struct {
+	Video *Video
+	Score float64
+}
+
+type VideoSearchRepository interface {
+	Search(ctx context.Context, query VideoSearchQuery) ([]*VideoSearchResult, error)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidShareChannel = NewInvalidArgumentError("channel",
+		"channel must be one of: copy_link, direct_message, external_app")
+	ErrShareLinkNotFound = &NotFoundError{Resource: "share link"}
+)
+
+type ShareChannel string
+
+const (
+	ShareChannelCopyLink      ShareChannel = "copy_link"
+	ShareChannelDirectMessage ShareChannel = "direct_message"
+	ShareChannelExternalApp   ShareChannel = "external_app"
+)
+
+type VideoShare struct {
+	ID        uuid.UUID    `json:"id" gorm:"type:uuid;primary_key"`
+	VideoID   uuid.UUID    `json:"video_id" gorm:"type:uuid;not null;index"`
+	UserID    uuid.UUID    `json:"user_id" gorm:"type:uuid;not null;index"`
+	Channel   ShareChannel `json:"channel" gorm:"type:varchar(20);not null"`
+	Code      string       `json:"code" gorm:"type:varchar(16);not null;uniqueIndex"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// ShareClick is one visit through a share link. ViewerID is nil for
+// visitors who are not signed in.
+type ShareClick struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key"`
+	ShareID   uuid.UUID  `json:"share_id" gorm:"type:uuid;not null;index"`
+	ViewerID  *uuid.UUID `json:"viewer_id" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type ShareChannelStats struct {
+	Channel ShareChannel `json:"channel"`
+	Shares  int64        `json:"shares"`
+	Clicks  int64        `json:"clicks"`
+}
+
+type SharerStats struct {
+	UserID uuid.UUID `json:"user_id"`
+	Shares int64     `json:"shares"`
+	Clicks int64     `json:"clicks"`
+}
+
+type ShareAnalytics struct {
+	TotalShares int64                `json:"total_shares"`
+	TotalClicks int64                `json:"total_clicks"`
+	Channels    []*ShareChannelStats `json:"channels"`
+	TopSharers  []*SharerStats       `json:"top_sharers"`
+}
+
+type ShareRepository interface {
+	// Create stores the share and returns the video's updated share count.
+	Create(ctx context.Context, share *VideoShare) (int64, error)
+	GetByCode(ctx context.Context, code string) (*VideoShare, error)
+	RecordClick(ctx context.Context, click *ShareClick) error
+	GetAnalytics(ctx context.Context, videoID uuid.UUID, topSharers int) (*ShareAnalytics, error)
+}
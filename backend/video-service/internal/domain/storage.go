@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+var ErrObjectNotFound = &NotFoundError{Resource: "object"}
+
+type ObjectInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+type ObjectStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Append(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	Move(ctx context.Context, srcKey, dstKey string) error
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every object whose key lies under prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+	URL(key string) string
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidHashtagSort = NewInvalidArgumentError("sort", "sort must be one of: recent, popular")
+
+type HashtagSort string
+
+const (
+	HashtagSortRecent  HashtagSort = "recent"
+	HashtagSortPopular HashtagSort = "popular"
+)
+
+type Hashtag struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	Name      string    `json:"name" gorm:"type:varchar(100);not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type VideoHashtag struct {
+	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key"`
+	HashtagID uuid.UUID `json:"hashtag_id" gorm:"type:uuid;primary_key;index"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type VideoMention struct {
+	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;primary_key;index"`
+	Username  string    `json:"username" gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type HashtagStats struct {
+	Name       string `json:"name"`
+	VideoCount int64  `json:"video_count"`
+	TotalViews int64  `json:"total_views"`
+}
+
+type TagRepository interface {
+	ReplaceVideoTags(ctx context.Context, videoID uuid.UUID, hashtags []string, mentions []*VideoMention) error
+	// The video listings and counts leave out owners blocked with viewerID;
+	// GetHashtagStats counts every public video.
+	GetVideosByHashtag(ctx context.Context, name string, sort HashtagSort, viewerID uuid.UUID,
+		limit, offset int) ([]*Video, error)
+	CountVideosByHashtag(ctx context.Context, name string, viewerID uuid.UUID) (int64, error)
+	GetHashtagStats(ctx context.Context, name string) (*HashtagStats, error)
+	GetVideosByMention(ctx context.Context, userID, viewerID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountVideosByMention(ctx context.Context, userID, viewerID uuid.UUID) (int64, error)
+	GetVideoMentions(ctx context.Context, videoID uuid.UUID) ([]*VideoMention, error)
+}
+
+// Relationship describes the follow graph between a viewer and a video owner
+// as seen from the viewer.
+type Relationship struct {
+	Following  bool `json:"following"`
+	FollowedBy bool `json:"followed_by"`
+}
+
+// UserDirectory looks up users and their follow graph, both owned by another
+// service. Usernames that do not exist are left out of the result.
+type UserDirectory interface {
+	ResolveUsernames(ctx context.Context, usernames []string) (map[string]uuid.UUID, error)
+	GetRelationship(ctx context.Context, viewerID, ownerID uuid.UUID) (*Relationship, error)
+	// RemoveFollows drops any follow between the two users, in both
+	// directions.
+	RemoveFollows(ctx context.Context, userID, otherID uuid.UUID) error
+}
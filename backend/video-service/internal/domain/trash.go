@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrRestoreWindowExpired = &FailedPreconditionError{Reason: "video was deleted too long ago to be restored"}
+
+// TrashRepository works on soft-deleted videos, which every other
+// repository treats as gone.
+type TrashRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*Video, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	ListExpired(ctx context.Context, deletedBefore time.Time, limit int) ([]*Video, error)
+	// Purge permanently deletes the video together with its likes, views,
+	// tags, shares, favorites, renditions and jobs. It reports false when the
+	// video is no longer in the trash.
+	Purge(ctx context.Context, id uuid.UUID) (bool, error)
+}
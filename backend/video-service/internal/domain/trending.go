@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidTrendingWindow = NewInvalidArgumentError("window", "window must be one of: day, week, month")
+
+type TrendingWindow string
+
+const (
+	TrendingWindowDay   TrendingWindow = "day"
+	TrendingWindowWeek  TrendingWindow = "week"
+	TrendingWindowMonth TrendingWindow = "month"
+)
+
+type TrendingVideo struct {
+	Window     TrendingWindow `json:"window" gorm:"column:window_name;type:varchar(10);primary_key"`
+	VideoID    uuid.UUID      `json:"video_id" gorm:"type:uuid;primary_key"`
+	Region     string         `json:"region" gorm:"type:varchar(8);not null;default:''"`
+	Score      float64        `json:"score" gorm:"not null"`
+	ComputedAt time.Time      `json:"computed_at"`
+}
+
+type TrendingHashtagScore struct {
+	Window     TrendingWindow `json:"window" gorm:"column:window_name;type:varchar(10);primary_key"`
+	Region     string         `json:"region" gorm:"type:varchar(8);primary_key"`
+	HashtagID  uuid.UUID      `json:"hashtag_id" gorm:"type:uuid;primary_key"`
+	Score      float64        `json:"score" gorm:"not null"`
+	ComputedAt time.Time      `json:"computed_at"`
+}
+
+func (TrendingHashtagScore) TableName() string {
+	return "trending_hashtags"
+}
+
+type TrendingHashtag struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// VideoComment records that a comment was left on a video. Comments are
+// owned by another service; only what trending scores need is kept here.
+type VideoComment struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
+	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;not null;index"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+type TrendingWeights struct {
+	View    float64
+	Like    float64
+	Comment float64
+	Share   float64
+}
+
+// TrendingRefresh describes one materialization pass: engagement newer than
+// Since counts toward the score, halving in weight every HalfLife.
+type TrendingRefresh struct {
+	Window   TrendingWindow
+	Since    time.Time
+	Now      time.Time
+	HalfLife time.Duration
+	Weights  TrendingWeights
+}
+
+type TrendingRepository interface {
+	// Refresh replaces the window's scores. Concurrent refreshes of one
+	// window, such as from several replicas, run one after another.
+	Refresh(ctx context.Context, refresh TrendingRefresh) error
+	RecordComment(ctx context.Context, comment *VideoComment) error
+	// GetTrendingVideos leaves out owners blocked with viewerID, which may be
+	// uuid.Nil for signed-out viewers.
+	GetTrendingVideos(ctx context.Context, window TrendingWindow, region string, viewerID uuid.UUID,
+		limit, offset int) ([]*Video, error)
+	GetTrendingHashtags(ctx context.Context, window TrendingWindow, region string, limit int) ([]*TrendingHashtag, error)
+}
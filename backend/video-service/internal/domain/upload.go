@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UploadStatus string
+
+const (
+	UploadStatusPending   UploadStatus = "pending"
+	UploadStatusCompleted UploadStatus = "completed"
+)
+
+var (
+	ErrUploadTooLarge         = NewInvalidArgumentError("total_size", "upload exceeds maximum allowed size")
+	ErrUnsupportedContainer   = NewInvalidArgumentError("file_name", "unsupported video container")
+	ErrUploadChecksumMismatch = &DataLossError{Reason: "upload checksum mismatch"}
+	ErrUploadOffsetMismatch   = &FailedPreconditionError{Reason: "upload offset does not match received size"}
+	ErrUploadIncomplete       = &FailedPreconditionError{Reason: "upload is incomplete"}
+	ErrUploadNotPending       = &FailedPreconditionError{Reason: "upload session is not pending"}
+	ErrUploadExpired          = &FailedPreconditionError{Reason: "upload session has expired"}
+	ErrNotUploadOwner         = &PermissionDeniedError{Reason: "user does not own this upload session"}
+)
+
+type UploadSession struct {
+	ID           uuid.UUID    `json:"id" gorm:"type:uuid;primary_key"`
+	UserID       uuid.UUID    `json:"user_id" gorm:"type:uuid;not null;index"`
+	Title        string       `json:"title" gorm:"not null"`
+	Description  string       `json:"description"`
+	Duration     int          `json:"duration" gorm:"not null"`
+	Visibility   Visibility   `json:"visibility" gorm:"type:varchar(20);not null;default:'public'"`
+	Region       string       `json:"region" gorm:"type:varchar(8);not null;default:''"`
+	Draft        bool         `json:"draft" gorm:"not null;default:false"`
+	FileName     string       `json:"file_name" gorm:"not null"`
+	Container    string       `json:"container" gorm:"not null"`
+	TotalSize    int64        `json:"total_size" gorm:"not null"`
+	ReceivedSize int64        `json:"received_size" gorm:"default:0"`
+	Checksum     string       `json:"checksum" gorm:"not null"`
+	ObjectKey    string       `json:"object_key" gorm:"not null"`
+	Status       UploadStatus `json:"status" gorm:"type:varchar(20);not null"`
+	VideoID      *uuid.UUID   `json:"video_id" gorm:"type:uuid"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+func (session *UploadSession) Remaining() int64 {
+	return session.TotalSize - session.ReceivedSize
+}
+
+type UploadSessionRepository interface {
+	Create(ctx context.Context, session *UploadSession) error
+	GetByID(ctx context.Context, id uuid.UUID) (*UploadSession, error)
+	// GetByIDForUpdate locks the session until the surrounding transaction
+	// ends, so only one stream at a time appends to or completes it.
+	GetByIDForUpdate(ctx context.Context, id uuid.UUID) (*UploadSession, error)
+	UpdateReceivedSize(ctx context.Context, id uuid.UUID, receivedSize int64) error
+	MarkCompleted(ctx context.Context, id, videoID uuid.UUID) error
+}
@@ -2,36 +2,172 @@ package domain
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotVideoOwner      = &PermissionDeniedError{Reason: "user does not own this video"}
+	ErrInvalidCoverTime   = NewInvalidArgumentError("cover_time_ms", "cover time is outside the video duration")
+	ErrInvalidVisibility  = NewInvalidArgumentError("visibility", "unsupported video visibility")
+	ErrViewRateLimited    = &ResourceExhaustedError{Reason: "too many views in a short period"}
+	ErrVideoModified      = &AbortedError{Reason: "video was modified by another request; reload it and retry"}
+	ErrInvalidVideoSort   = NewInvalidArgumentError("sort", "sort must be one of: latest, popular, oldest")
+	ErrInvalidVideoCursor = NewInvalidArgumentError("cursor", "invalid video cursor")
+	ErrVideoNotStored     = &FailedPreconditionError{
+		Reason: "covers can only be generated for videos uploaded to this service"}
+)
+
+// Visibility controls who may open a video. Unlisted videos can be opened
+// by anyone holding their ID or a share link but never appear in listings.
+type Visibility string
+
+const (
+	VisibilityPublic    Visibility = "public"
+	VisibilityFollowers Visibility = "followers"
+	VisibilityFriends   Visibility = "friends"
+	VisibilityPrivate   Visibility = "private"
+	VisibilityUnlisted  Visibility = "unlisted"
+)
+
+// PublicationState tracks a video from draft to published. Only published
+// videos can be seen by anyone but their owner. Video.PublishAt holds when a
+// scheduled video goes live, or when a published one did.
+type PublicationState string
+
+const (
+	PublicationStateDraft     PublicationState = "draft"
+	PublicationStateScheduled PublicationState = "scheduled"
+	PublicationStatePublished PublicationState = "published"
+)
+
+type ProcessingStatus string
+
+const (
+	ProcessingStatusUploaded   ProcessingStatus = "uploaded"
+	ProcessingStatusProcessing ProcessingStatus = "processing"
+	ProcessingStatusReady      ProcessingStatus = "ready"
+	ProcessingStatusFailed     ProcessingStatus = "failed"
 )
 
 type Video struct {
-	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
-	UserID       uuid.UUID `json:"user_id" gorm:"type:uuid;not null"`
-	Title        string    `json:"title" gorm:"not null"`
-	Description  string    `json:"description"`
-	VideoURL     string    `json:"video_url" gorm:"not null"`
-	ThumbnailURL string    `json:"thumbnail_url"`
-	Duration     int       `json:"duration" gorm:"not null"`
-	ViewCount    int64     `json:"view_count" gorm:"default:0"`
-	LikeCount    int64     `json:"like_count" gorm:"default:0"`
-	ShareCount   int64     `json:"share_count" gorm:"default:0"`
-	IsPublic     bool      `json:"is_public"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID               uuid.UUID        `json:"id" gorm:"type:uuid;primary_key"`
+	UserID           uuid.UUID        `json:"user_id" gorm:"type:uuid;not null"`
+	Title            string           `json:"title" gorm:"not null"`
+	Description      string           `json:"description"`
+	VideoURL         string           `json:"video_url" gorm:"not null"`
+	StorageKey       string           `json:"storage_key"`
+	ThumbnailURL     string           `json:"thumbnail_url"`
+	PreviewURL       string           `json:"preview_url"`
+	PlaylistURL      string           `json:"playlist_url"`
+	CoverTimeMs      int              `json:"cover_time_ms" gorm:"default:0"`
+	Duration         int              `json:"duration" gorm:"not null"`
+	ViewCount        int64            `json:"view_count" gorm:"default:0"`
+	LikeCount        int64            `json:"like_count" gorm:"default:0"`
+	ShareCount       int64            `json:"share_count" gorm:"default:0"`
+	FavoriteCount    int64            `json:"favorite_count" gorm:"default:0"`
+	Visibility       Visibility       `json:"visibility" gorm:"type:varchar(20);not null;default:'public';index"`
+	ModerationState  ModerationState  `json:"moderation_state" gorm:"type:varchar(20);not null;default:'active';index"`
+	ModerationReason string           `json:"moderation_reason" gorm:"type:text"`
+	Region           string           `json:"region" gorm:"type:varchar(8);not null;default:'';index"`
+	ProcessingStatus ProcessingStatus `json:"processing_status" gorm:"type:varchar(20);not null;default:'ready'"`
+	PublicationState PublicationState `json:"publication_state" gorm:"type:varchar(20);not null;default:'published';index"`
+	PublishAt        *time.Time       `json:"publish_at" gorm:"index"`
+	CreatedAt        time.Time        `json:"created_at"`
+	UpdatedAt        time.Time        `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt   `json:"deleted_at" gorm:"index"`
+	// Version counts metadata edits made through VideoRepository.Update;
+	// counters and processing results do not change it.
+	Version int64 `json:"version" gorm:"not null;default:1"`
+}
+
+func (video *Video) Published() bool {
+	return video.PublicationState == PublicationStatePublished
+}
+
+// PublishedAt is when a published video went live, which listings sort by.
+// Videos stored before publishing was tracked fall back to their creation.
+func (video *Video) PublishedAt() time.Time {
+	if video.PublishAt != nil {
+		return *video.PublishAt
+	}
+	return video.CreatedAt
+}
+
+// Pinnable reports whether the video could show up on its owner's profile
+// for others, which is all a pin is for.
+func (video *Video) Pinnable() bool {
+	return video.Published() && video.Visibility != VisibilityPrivate && video.Visibility != VisibilityUnlisted
+}
+
+// ETag identifies the metadata revision a client last read. It is opaque to
+// clients and only ever compared for equality.
+func (video *Video) ETag() string {
+	return strconv.FormatInt(video.Version, 10)
+}
+
+// VideoSort orders a creator's profile grid.
+type VideoSort string
+
+const (
+	VideoSortLatest  VideoSort = "latest"
+	VideoSortPopular VideoSort = "popular"
+	VideoSortOldest  VideoSort = "oldest"
+)
+
+// VideoCursor holds the sort key of the last video on a page. Besides ID,
+// only the field its Sort orders by is meaningful. ViewCount is the count
+// when that page was read, so popular order is approximate: a video whose
+// count moves past it before the next page is skipped or repeated.
+type VideoCursor struct {
+	Sort        VideoSort
+	PublishedAt time.Time
+	ViewCount   int64
+	ID          uuid.UUID
+}
+
+type UserVideosQuery struct {
+	UserID       uuid.UUID
+	Visibilities []Visibility
+	// OwnerListing is only set for the owner's own listing, which also shows
+	// taken-down videos and videos that are still processing.
+	OwnerListing bool
+	// Pinned selects the user's pinned videos, most recently pinned first.
+	// Otherwise pinned videos are left out so they are not listed twice.
+	Pinned bool
+	Sort   VideoSort
+	After  *VideoCursor
+	Limit  int
+	Offset int
 }
 
 type VideoRepository interface {
 	Create(ctx context.Context, video *Video) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Video, error)
-	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Video, error)
-	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
-	GetPublicVideos(ctx context.Context, limit, offset int) ([]*Video, error)
-	CountPublicVideos(ctx context.Context) (int64, error)
+	// GetByIDs returns the videos that exist, in no particular order.
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Video, error)
+	// GetByUserID and CountByUserID leave out taken-down videos and videos
+	// that are not ready unless ownerListing is set.
+	GetByUserID(ctx context.Context, query UserVideosQuery) ([]*Video, error)
+	CountByUserID(ctx context.Context, userID uuid.UUID, visibilities []Visibility,
+		ownerListing bool) (int64, error)
+	// GetPublicVideos and CountPublicVideos leave out owners blocked with
+	// viewerID, which may be uuid.Nil for signed-out viewers.
+	GetPublicVideos(ctx context.Context, viewerID uuid.UUID, limit, offset int) ([]*Video, error)
+	CountPublicVideos(ctx context.Context, viewerID uuid.UUID) (int64, error)
+	// Update writes the editable metadata only if the stored version still
+	// equals video.Version, returning ErrVideoModified otherwise, and then
+	// advances video.Version. Making a video private or unlisted unpins it.
 	Update(ctx context.Context, video *Video) error
+	// Delete moves the video to the trash and unpins it.
 	Delete(ctx context.Context, id uuid.UUID) error
+	UpdateProcessingStatus(ctx context.Context, id uuid.UUID, status ProcessingStatus) error
+	SetCoverTime(ctx context.Context, id uuid.UUID, coverTimeMs int) error
+	UpdateThumbnails(ctx context.Context, id uuid.UUID, thumbnailURL, previewURL string) error
+	UpdatePlaylistURL(ctx context.Context, id uuid.UUID, playlistURL string) error
 }
 
 type UserVideoLike struct {
@@ -42,23 +178,59 @@ type UserVideoLike struct {
 }
 
 type UserVideoLikeRepository interface {
-	Create(ctx context.Context, like *UserVideoLike) error
+	// Create reports false, without error, when the user already liked the
+	// video.
+	Create(ctx context.Context, like *UserVideoLike) (bool, error)
 	Delete(ctx context.Context, userID, videoID uuid.UUID) error
 	Exists(ctx context.Context, userID, videoID uuid.UUID) (bool, error)
 	CountByVideoID(ctx context.Context, videoID uuid.UUID) (int64, error)
+	// LikedAmong returns which of videoIDs the user has liked.
+	LikedAmong(ctx context.Context, userID uuid.UUID, videoIDs []uuid.UUID) (map[uuid.UUID]bool, error)
 }
 
+// ViewFlagReason is set on views that were stored for review instead of
+// being counted.
+type ViewFlagReason string
+
+const ViewFlagBurst ViewFlagReason = "burst"
+
 type UserVideoView struct {
-	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key"`
-	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null"`
-	VideoID   uuid.UUID `json:"video_id" gorm:"type:uuid;not null"`
-	WatchTime int       `json:"watch_time" gorm:"default:0"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         uuid.UUID      `json:"id" gorm:"type:uuid;primary_key"`
+	UserID     uuid.UUID      `json:"user_id" gorm:"type:uuid;not null"`
+	VideoID    uuid.UUID      `json:"video_id" gorm:"type:uuid;not null"`
+	WatchTime  int            `json:"watch_time" gorm:"default:0"`
+	ClientIP   string         `json:"client_ip" gorm:"type:varchar(45);not null;default:''"`
+	FlagReason ViewFlagReason `json:"flag_reason" gorm:"type:varchar(16);not null;default:''"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// ViewActivity is the recent traffic a new view is judged against.
+type ViewActivity struct {
+	// UserViews and IPViews count every view since the rate window started.
+	UserViews int64
+	IPViews   int64
+	// IPVideoViewers counts distinct users viewing the same video from the
+	// same IP since the burst window started.
+	IPVideoViewers int64
 }
 
 type UserVideoViewRepository interface {
 	Create(ctx context.Context, view *UserVideoView) error
 	Delete(ctx context.Context, userID, videoID uuid.UUID) error
 	Exists(ctx context.Context, userID, videoID uuid.UUID) (bool, error)
+	// CountByVideoID counts only views that were not flagged.
 	CountByVideoID(ctx context.Context, videoID uuid.UUID) (int64, error)
+	// FindRecent returns the user's latest counted view of the video created
+	// after since, or ErrViewNotFound.
+	FindRecent(ctx context.Context, userID, videoID uuid.UUID, since time.Time) (*UserVideoView, error)
+	// LockViewer holds a lock on the user's views of the video until the
+	// surrounding transaction ends, so concurrent views are judged one at
+	// a time.
+	LockViewer(ctx context.Context, userID, videoID uuid.UUID) error
+	// ExtendWatchTime never lowers the stored watch time.
+	ExtendWatchTime(ctx context.Context, viewID uuid.UUID, watchTime int) error
+	GetActivity(ctx context.Context, userID, videoID uuid.UUID, clientIP string,
+		rateSince, burstSince time.Time) (*ViewActivity, error)
+	ListFlagged(ctx context.Context, limit, offset int) ([]*UserVideoView, error)
+	CountFlagged(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Days are passed as dates rather than timestamps so the session time zone
+// cannot shift them.
+const analyticsDayLayout = "2006-01-02"
+
+const rollupDailyStatsSQL = `
+WITH views AS (
+	SELECT user_video_views.video_id, COUNT(*) AS views,
+		COUNT(DISTINCT user_video_views.user_id) AS unique_viewers,
+		SUM(user_video_views.watch_time) AS total_watch_time,
+		COUNT(*) FILTER (WHERE videos.duration > 0 AND user_video_views.watch_time >= videos.duration)
+			AS completed_views
+	FROM user_video_views
+	JOIN videos ON videos.id = user_video_views.video_id
+	WHERE user_video_views.created_at >= @start AND user_video_views.created_at < @end
+		AND user_video_views.flag_reason = ''
+	GROUP BY user_video_views.video_id
+), likes AS (
+	SELECT video_id, COUNT(*) AS likes FROM user_video_likes
+	WHERE created_at >= @start AND created_at < @end
+	GROUP BY video_id
+), shares AS (
+	SELECT video_id, COUNT(*) AS shares FROM video_shares
+	WHERE created_at >= @start AND created_at < @end
+	GROUP BY video_id
+), active AS (
+	SELECT video_id FROM views UNION SELECT video_id FROM likes UNION SELECT video_id FROM shares
+)
+INSERT INTO video_daily_stats (video_id, day, user_id, views, unique_viewers, total_watch_time,
+	completed_views, likes, shares, updated_at)
+SELECT videos.id, CAST(@day AS date), videos.user_id, COALESCE(views.views, 0),
+	COALESCE(views.unique_viewers, 0), COALESCE(views.total_watch_time, 0),
+	COALESCE(views.completed_views, 0), COALESCE(likes.likes, 0), COALESCE(shares.shares, 0), @now
+FROM active
+JOIN videos ON videos.id = active.video_id
+LEFT JOIN views ON views.video_id = active.video_id
+LEFT JOIN likes ON likes.video_id = active.video_id
+LEFT JOIN shares ON shares.video_id = active.video_id`
+
+const rollupRetentionSQL = `
+INSERT INTO video_retention (video_id, day, percent, viewers)
+SELECT user_video_views.video_id, CAST(@day AS date), points.percent,
+	COUNT(*) FILTER (WHERE user_video_views.watch_time * 100 >= videos.duration * points.percent)
+FROM user_video_views
+JOIN videos ON videos.id = user_video_views.video_id
+CROSS JOIN generate_series(0, 100, CAST(@step AS integer)) AS points(percent)
+WHERE user_video_views.created_at >= @start AND user_video_views.created_at < @end
+	AND videos.duration > 0 AND user_video_views.flag_reason = ''
+GROUP BY user_video_views.video_id, points.percent`
+
+const engagementSumsSelect = `SUM(views) AS views, SUM(unique_viewers) AS unique_viewers,
+	SUM(total_watch_time) AS total_watch_time, SUM(completed_views) AS completed_views,
+	SUM(likes) AS likes, SUM(shares) AS shares`
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) domain.AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+func (repository *analyticsRepository) Rollup(ctx context.Context, day time.Time, retentionStep int) error {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	args := map[string]any{
+		"day":   start.Format(analyticsDayLayout),
+		"start": start,
+		"end":   start.AddDate(0, 0, 1),
+		"now":   time.Now(),
+		"step":  retentionStep,
+	}
+
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("day = ?", args["day"]).Delete(&domain.VideoDailyStats{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("day = ?", args["day"]).Delete(&domain.VideoRetention{}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec(rollupDailyStatsSQL, args).Error; err != nil {
+			return err
+		}
+		return tx.Exec(rollupRetentionSQL, args).Error
+	})
+}
+
+func (repository *analyticsRepository) ListVideoDailyStats(ctx context.Context, videoID uuid.UUID,
+	from, to time.Time) ([]*domain.VideoDailyStats, error) {
+
+	var stats []*domain.VideoDailyStats
+	err := withTx(ctx, repository.db).
+		Where("video_id = ?", videoID).
+		Where("day BETWEEN ? AND ?", from.Format(analyticsDayLayout), to.Format(analyticsDayLayout)).
+		Order("day ASC").
+		Find(&stats).Error
+	return stats, err
+}
+
+func (repository *analyticsRepository) GetRetention(ctx context.Context, videoID uuid.UUID,
+	from, to time.Time) ([]*domain.RetentionPoint, error) {
+
+	var points []*domain.RetentionPoint
+	err := withTx(ctx, repository.db).
+		Model(&domain.VideoRetention{}).
+		Select("percent, SUM(viewers) AS viewers").
+		Where("video_id = ?", videoID).
+		Where("day BETWEEN ? AND ?", from.Format(analyticsDayLayout), to.Format(analyticsDayLayout)).
+		Group("percent").
+		Order("percent ASC").
+		Scan(&points).Error
+	return points, err
+}
+
+func (repository *analyticsRepository) ListCreatorDailyStats(ctx context.Context, userID uuid.UUID,
+	from, to time.Time) ([]*domain.DailyStats, error) {
+
+	var stats []*domain.DailyStats
+	err := withTx(ctx, repository.db).
+		Model(&domain.VideoDailyStats{}).
+		Select("day, "+engagementSumsSelect).
+		Where("user_id = ?", userID).
+		Where("day BETWEEN ? AND ?", from.Format(analyticsDayLayout), to.Format(analyticsDayLayout)).
+		Group("day").
+		Order("day ASC").
+		Scan(&stats).Error
+	return stats, err
+}
+
+func (repository *analyticsRepository) ListTopVideos(ctx context.Context, userID uuid.UUID,
+	from, to time.Time, limit int) ([]*domain.VideoStats, error) {
+
+	var stats []*domain.VideoStats
+	err := withTx(ctx, repository.db).
+		Model(&domain.VideoDailyStats{}).
+		Select("video_id, "+engagementSumsSelect).
+		Where("user_id = ?", userID).
+		Where("day BETWEEN ? AND ?", from.Format(analyticsDayLayout), to.Format(analyticsDayLayout)).
+		Group("video_id").
+		Order("views DESC, video_id ASC").
+		Limit(limit).
+		Scan(&stats).Error
+	return stats, err
+}
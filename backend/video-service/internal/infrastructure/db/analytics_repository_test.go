@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRollupAndQueries(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	likeRepo := NewUserVideoLikeRepository(db)
+	shareRepo := NewShareRepository(db)
+	repo := NewAnalyticsRepository(db)
+
+	ownerID := uuid.New()
+	popular := createTestVideo()
+	popular.UserID = ownerID
+	require.NoError(t, videoRepo.Create(context.Background(), popular))
+	quiet := createTestVideo()
+	quiet.UserID = ownerID
+	require.NoError(t, videoRepo.Create(context.Background(), quiet))
+
+	viewerID := uuid.New()
+	for _, watchTime := range []int{120, 60, 30} {
+		require.NoError(t, viewRepo.Create(context.Background(),
+			&domain.UserVideoView{UserID: viewerID, VideoID: popular.ID, WatchTime: watchTime}))
+	}
+	require.NoError(t, viewRepo.Create(context.Background(),
+		&domain.UserVideoView{UserID: uuid.New(), VideoID: popular.ID, WatchTime: 0}))
+	likeVideo(t, likeRepo, &domain.UserVideoLike{UserID: viewerID, VideoID: popular.ID})
+	_, err := shareRepo.Create(context.Background(), createTestShare(quiet.ID, domain.ShareChannelCopyLink))
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	// Running the rollup twice must not double count.
+	require.NoError(t, repo.Rollup(context.Background(), today, 50))
+	require.NoError(t, repo.Rollup(context.Background(), today, 50))
+
+	daily, err := repo.ListVideoDailyStats(context.Background(), popular.ID, today.AddDate(0, 0, -1), today)
+	require.NoError(t, err)
+	require.Len(t, daily, 1)
+	assert.Equal(t, ownerID, daily[0].UserID)
+	assert.Equal(t, domain.EngagementStats{
+		Views:          4,
+		UniqueViewers:  2,
+		TotalWatchTime: 210,
+		CompletedViews: 1,
+		Likes:          1,
+	}, daily[0].EngagementStats)
+
+	retention, err := repo.GetRetention(context.Background(), popular.ID, today, today)
+	require.NoError(t, err)
+	require.Len(t, retention, 3)
+	assert.Equal(t, []int64{4, 2, 1}, []int64{retention[0].Viewers, retention[1].Viewers, retention[2].Viewers})
+	assert.Equal(t, 50, retention[1].Percent)
+
+	creatorDaily, err := repo.ListCreatorDailyStats(context.Background(), ownerID, today, today)
+	require.NoError(t, err)
+	require.Len(t, creatorDaily, 1)
+	assert.Equal(t, int64(4), creatorDaily[0].Views)
+	assert.Equal(t, int64(1), creatorDaily[0].Shares)
+
+	top, err := repo.ListTopVideos(context.Background(), ownerID, today, today, 10)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, popular.ID, top[0].VideoID)
+	assert.Equal(t, quiet.ID, top[1].VideoID)
+}
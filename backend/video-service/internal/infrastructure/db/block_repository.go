@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type blockRepository struct {
+	db *gorm.DB
+}
+
+func NewBlockRepository(db *gorm.DB) domain.BlockRepository {
+	return &blockRepository{db: db}
+}
+
+func (repository *blockRepository) Block(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.UserBlock{
+			BlockerID: blockerID,
+			BlockedID: blockedID,
+			CreatedAt: time.Now(),
+		}).Error
+}
+
+func (repository *blockRepository) Unblock(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&domain.UserBlock{}).Error
+}
+
+func (repository *blockRepository) ListBlocked(ctx context.Context, blockerID uuid.UUID,
+	limit, offset int) ([]*domain.UserBlock, error) {
+
+	var blocks []*domain.UserBlock
+	err := withTx(ctx, repository.db).
+		Where("blocker_id = ?", blockerID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&blocks).Error
+	return blocks, err
+}
+
+func (repository *blockRepository) CountBlocked(ctx context.Context, blockerID uuid.UUID) (int64, error) {
+	var count int64
+	err := withTx(ctx, repository.db).
+		Model(&domain.UserBlock{}).
+		Where("blocker_id = ?", blockerID).
+		Count(&count).Error
+	return count, err
+}
+
+func (repository *blockRepository) BlockedAmong(ctx context.Context, userID uuid.UUID,
+	userIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	blocked := make(map[uuid.UUID]bool)
+	if len(userIDs) == 0 {
+		return blocked, nil
+	}
+
+	var blocks []*domain.UserBlock
+	err := withTx(ctx, repository.db).
+		Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)",
+			userID, userIDs, userID, userIDs).
+		Find(&blocks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range blocks {
+		if block.BlockerID == userID {
+			blocked[block.BlockedID] = true
+		} else {
+			blocked[block.BlockerID] = true
+		}
+	}
+	return blocked, nil
+}
+
+// notBlockedWith drops videos whose owner has blocked the viewer or was
+// blocked by them. Filtering in the query rather than after it keeps pages
+// full and totals exact.
+func notBlockedWith(query *gorm.DB, viewerID uuid.UUID) *gorm.DB {
+	if viewerID == uuid.Nil {
+		return query
+	}
+	return query.Where("NOT EXISTS (SELECT 1 FROM user_blocks WHERE "+
+		"(user_blocks.blocker_id = ? AND user_blocks.blocked_id = videos.user_id) OR "+
+		"(user_blocks.blocked_id = ? AND user_blocks.blocker_id = videos.user_id))", viewerID, viewerID)
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockAndUnblock(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewBlockRepository(db)
+	userID := uuid.New()
+	blockedID := uuid.New()
+
+	require.NoError(t, repo.Block(context.Background(), userID, blockedID))
+	require.NoError(t, repo.Block(context.Background(), userID, blockedID), "blocking twice is a no-op")
+
+	blocks, err := repo.ListBlocked(context.Background(), userID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, blockedID, blocks[0].BlockedID)
+
+	count, err := repo.CountBlocked(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, repo.Unblock(context.Background(), userID, blockedID))
+	count, err = repo.CountBlocked(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestBlockedAmong_BothDirections(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewBlockRepository(db)
+	userID := uuid.New()
+	blockedByUser := uuid.New()
+	blockerOfUser := uuid.New()
+	stranger := uuid.New()
+
+	require.NoError(t, repo.Block(context.Background(), userID, blockedByUser))
+	require.NoError(t, repo.Block(context.Background(), blockerOfUser, userID))
+
+	blocked, err := repo.BlockedAmong(context.Background(), userID,
+		[]uuid.UUID{blockedByUser, blockerOfUser, stranger})
+	require.NoError(t, err)
+	assert.True(t, blocked[blockedByUser])
+	assert.True(t, blocked[blockerOfUser])
+	assert.False(t, blocked[stranger])
+}
+
+func TestListingsLeaveOutBlockedOwnersBeforePaging(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	trendingRepo := NewTrendingRepository(db)
+	searchRepo := NewVideoSearchRepository(db)
+	blockRepo := NewBlockRepository(db)
+	viewerID := uuid.New()
+	region := strings.ToUpper(uuid.NewString()[:6])
+	word := "zq" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+
+	visible := createTestVideo()
+	visible.Title = word + " visible"
+	visible.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), visible))
+	recordViews(t, NewUserVideoViewRepository(db), visible.ID, 1)
+	blocked := createTestVideo()
+	blocked.CreatedAt = time.Now().Add(time.Minute)
+	blocked.Title = word + " blocked"
+	blocked.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), blocked))
+	recordViews(t, NewUserVideoViewRepository(db), blocked.ID, 3)
+	require.NoError(t, blockRepo.Block(context.Background(), viewerID, blocked.UserID))
+
+	total, err := videoRepo.CountPublicVideos(context.Background(), uuid.Nil)
+	require.NoError(t, err)
+	count, err := videoRepo.CountPublicVideos(context.Background(), viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, total-1, count)
+	public, err := videoRepo.GetPublicVideos(context.Background(), viewerID, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, public, 1, "the blocked video is newer but must not take the only slot")
+	assert.Equal(t, visible.ID, public[0].ID)
+
+	now := time.Now().Add(time.Second)
+	require.NoError(t, trendingRepo.Refresh(context.Background(), domain.TrendingRefresh{
+		Window:   domain.TrendingWindowDay,
+		Since:    now.Add(-24 * time.Hour),
+		Now:      now,
+		HalfLife: 6 * time.Hour,
+		Weights:  domain.TrendingWeights{View: 1},
+	}))
+	trending, err := trendingRepo.GetTrendingVideos(context.Background(), domain.TrendingWindowDay, region, viewerID, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, trending, 1)
+	assert.Equal(t, visible.ID, trending[0].ID)
+
+	results, err := searchRepo.Search(context.Background(), domain.VideoSearchQuery{
+		Terms:            []string{word},
+		ViewerID:         &viewerID,
+		PopularityWeight: 0.1,
+		Limit:            1,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, visible.ID, results[0].Video.ID)
+}
@@ -0,0 +1,195 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionRepository(db *gorm.DB) domain.CollectionRepository {
+	return &collectionRepository{db: db}
+}
+
+// Create appends the collection after the user's existing ones.
+func (repository *collectionRepository) Create(ctx context.Context, collection *domain.Collection) error {
+	collection.ID = uuid.New()
+	collection.CreatedAt = time.Now()
+	collection.UpdatedAt = collection.CreatedAt
+
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		var position *int
+		err := tx.Model(&domain.Collection{}).
+			Where("user_id = ?", collection.UserID).
+			Select("MAX(position)").
+			Scan(&position).Error
+		if err != nil {
+			return err
+		}
+		if position != nil {
+			collection.Position = *position + 1
+		}
+
+		return tx.Create(collection).Error
+	})
+	return translateError(err, nil)
+}
+
+func (repository *collectionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Collection, error) {
+	var collection domain.Collection
+	err := withTx(ctx, repository.db).First(&collection, "id = ?", id).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrCollectionNotFound)
+	}
+	return &collection, nil
+}
+
+func (repository *collectionRepository) Update(ctx context.Context, collection *domain.Collection) error {
+	collection.UpdatedAt = time.Now()
+	err := withTx(ctx, repository.db).
+		Model(&domain.Collection{}).
+		Where("id = ?", collection.ID).
+		Updates(map[string]any{
+			"name":       collection.Name,
+			"is_public":  collection.IsPublic,
+			"updated_at": collection.UpdatedAt,
+		}).Error
+	return translateError(err, nil)
+}
+
+func (repository *collectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&domain.CollectionVideo{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Collection{}, "id = ?", id).Error
+	})
+}
+
+func (repository *collectionRepository) ListByUserID(ctx context.Context, userID uuid.UUID, publicOnly bool,
+	limit, offset int) ([]*domain.Collection, error) {
+
+	var collections []*domain.Collection
+	err := repository.userCollections(ctx, userID, publicOnly).
+		Order("position ASC, created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&collections).Error
+
+	return collections, err
+}
+
+func (repository *collectionRepository) CountByUserID(ctx context.Context, userID uuid.UUID,
+	publicOnly bool) (int64, error) {
+
+	var count int64
+	err := repository.userCollections(ctx, userID, publicOnly).Count(&count).Error
+	return count, err
+}
+
+func (repository *collectionRepository) userCollections(ctx context.Context, userID uuid.UUID,
+	publicOnly bool) *gorm.DB {
+
+	query := withTx(ctx, repository.db).Model(&domain.Collection{}).Where("user_id = ?", userID)
+	if publicOnly {
+		query = query.Where("is_public = ?", true)
+	}
+	return query
+}
+
+func (repository *collectionRepository) Reorder(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		var existing []uuid.UUID
+		err := tx.Model(&domain.Collection{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).
+			Pluck("id", &existing).Error
+		if err != nil {
+			return err
+		}
+		if !sameIDs(existing, ids) {
+			return domain.ErrInvalidCollectionsOrder
+		}
+
+		for position, id := range ids {
+			err := tx.Model(&domain.Collection{}).
+				Where("id = ?", id).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sameIDs(existing, ordered []uuid.UUID) bool {
+	if len(existing) != len(ordered) {
+		return false
+	}
+	remaining := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
+
+func (repository *collectionRepository) AddVideo(ctx context.Context, collectionID, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.CollectionVideo{
+			CollectionID: collectionID,
+			VideoID:      videoID,
+			CreatedAt:    time.Now(),
+		}).Error
+}
+
+func (repository *collectionRepository) RemoveVideo(ctx context.Context, collectionID, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Where("collection_id = ? AND video_id = ?", collectionID, videoID).
+		Delete(&domain.CollectionVideo{}).Error
+}
+
+func (repository *collectionRepository) ListVideos(ctx context.Context, collection *domain.Collection,
+	viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.collectionVideos(ctx, collection, viewerID).
+		Select("videos.*").
+		Order("collection_videos.created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *collectionRepository) CountVideos(ctx context.Context, collection *domain.Collection,
+	viewerID uuid.UUID) (int64, error) {
+
+	var count int64
+	err := repository.collectionVideos(ctx, collection, viewerID).Count(&count).Error
+	return count, err
+}
+
+func (repository *collectionRepository) collectionVideos(ctx context.Context,
+	collection *domain.Collection, viewerID uuid.UUID) *gorm.DB {
+
+	return visibleToViewer(withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Joins("JOIN collection_videos ON collection_videos.video_id = videos.id").
+		Where("collection_videos.collection_id = ?", collection.ID), viewerID)
+}
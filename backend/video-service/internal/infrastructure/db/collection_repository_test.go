@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionCreateAppendsPosition(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewCollectionRepository(db)
+	userID := uuid.New()
+
+	first := &domain.Collection{UserID: userID, Name: "First"}
+	require.NoError(t, repo.Create(context.Background(), first))
+	second := &domain.Collection{UserID: userID, Name: "Second", IsPublic: true}
+	require.NoError(t, repo.Create(context.Background(), second))
+
+	assert.Equal(t, 0, first.Position)
+	assert.Equal(t, 1, second.Position)
+
+	publicOnly, err := repo.ListByUserID(context.Background(), userID, true, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, publicOnly, 1)
+	assert.Equal(t, second.ID, publicOnly[0].ID)
+}
+
+func TestCollectionReorder(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewCollectionRepository(db)
+	userID := uuid.New()
+
+	first := &domain.Collection{UserID: userID, Name: "First"}
+	require.NoError(t, repo.Create(context.Background(), first))
+	second := &domain.Collection{UserID: userID, Name: "Second"}
+	require.NoError(t, repo.Create(context.Background(), second))
+
+	err := repo.Reorder(context.Background(), userID, []uuid.UUID{first.ID})
+	assert.ErrorIs(t, err, domain.ErrInvalidCollectionsOrder)
+
+	require.NoError(t, repo.Reorder(context.Background(), userID, []uuid.UUID{second.ID, first.ID}))
+
+	collections, err := repo.ListByUserID(context.Background(), userID, false, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, collections, 2)
+	assert.Equal(t, second.ID, collections[0].ID)
+}
+
+func TestCollectionDeleteAndGetByID(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewCollectionRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	collection := &domain.Collection{UserID: uuid.New(), Name: "Saved"}
+	require.NoError(t, repo.Create(context.Background(), collection))
+	require.NoError(t, repo.AddVideo(context.Background(), collection.ID, video.ID))
+	require.NoError(t, repo.AddVideo(context.Background(), collection.ID, video.ID))
+
+	videos, err := repo.ListVideos(context.Background(), collection, collection.UserID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, videos, 1)
+
+	require.NoError(t, repo.Delete(context.Background(), collection.ID))
+
+	_, err = repo.GetByID(context.Background(), collection.ID)
+	assert.ErrorIs(t, err, domain.ErrCollectionNotFound)
+}
+
+func TestCollectionListVideosHidesOwnersPrivateVideosFromOthers(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewCollectionRepository(db)
+	ownerID := uuid.New()
+
+	public := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), public))
+	private := createTestVideo()
+	private.UserID = ownerID
+	private.Visibility = domain.VisibilityPrivate
+	require.NoError(t, videoRepo.Create(context.Background(), private))
+
+	collection := &domain.Collection{UserID: ownerID, Name: "Shared", IsPublic: true}
+	require.NoError(t, repo.Create(context.Background(), collection))
+	for _, video := range []*domain.Video{public, private} {
+		require.NoError(t, repo.AddVideo(context.Background(), collection.ID, video.ID))
+	}
+
+	for _, viewerID := range []uuid.UUID{uuid.New(), uuid.Nil} {
+		videos, err := repo.ListVideos(context.Background(), collection, viewerID, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, videos, 1)
+		assert.Equal(t, public.ID, videos[0].ID)
+
+		count, err := repo.CountVideos(context.Background(), collection, viewerID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	}
+
+	videos, err := repo.ListVideos(context.Background(), collection, ownerID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, videos, 2)
+}
+
+func TestCollectionListVideosLeavesOutBlockedOwnersBeforePaging(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	blockRepo := NewBlockRepository(db)
+	repo := NewCollectionRepository(db)
+	viewerID := uuid.New()
+
+	collection := &domain.Collection{UserID: uuid.New(), Name: "Shared", IsPublic: true}
+	require.NoError(t, repo.Create(context.Background(), collection))
+
+	blocked := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), blocked))
+	require.NoError(t, repo.AddVideo(context.Background(), collection.ID, blocked.ID))
+	visible := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), visible))
+	require.NoError(t, repo.AddVideo(context.Background(), collection.ID, visible.ID))
+	// The blocked owner's video is the newest, so it would fill the first page.
+	require.NoError(t, db.Model(&domain.CollectionVideo{}).Where("video_id = ?", blocked.ID).
+		Update("created_at", time.Now().Add(time.Hour)).Error)
+	require.NoError(t, blockRepo.Block(context.Background(), blocked.UserID, viewerID))
+
+	videos, err := repo.ListVideos(context.Background(), collection, viewerID, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, videos, 1)
+	assert.Equal(t, visible.ID, videos[0].ID)
+
+	count, err := repo.CountVideos(context.Background(), collection, viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,24 @@
+package db
+
+import (
+	"errors"
+	"video-service/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+// translateError maps gorm errors onto the domain's error types so callers
+// never see gorm's. notFound is what a missing row means to the caller. Unique
+// violations only surface as gorm.ErrDuplicatedKey because the connection is
+// opened with TranslateError.
+func translateError(err, notFound error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound) && notFound != nil:
+		return notFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return &domain.ConflictError{Reason: "resource already exists", Err: err}
+	}
+	return err
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type favoriteRepository struct {
+	db *gorm.DB
+}
+
+func NewFavoriteRepository(db *gorm.DB) domain.FavoriteRepository {
+	return &favoriteRepository{db: db}
+}
+
+func (repository *favoriteRepository) Add(ctx context.Context, userID, videoID uuid.UUID) (int64, error) {
+	var favoriteCount int64
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.Favorite{
+			UserID:    userID,
+			VideoID:   videoID,
+			CreatedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return adjustFavoriteCount(tx, videoID, result.RowsAffected, &favoriteCount)
+	})
+
+	return favoriteCount, err
+}
+
+// Remove also takes the video out of every collection the user owns, since
+// collections are subsets of the user's favorites.
+func (repository *favoriteRepository) Remove(ctx context.Context, userID, videoID uuid.UUID) (int64, error) {
+	var favoriteCount int64
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("video_id = ?", videoID).
+			Where("collection_id IN (?)", tx.Model(&domain.Collection{}).Select("id").Where("user_id = ?", userID)).
+			Delete(&domain.CollectionVideo{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("user_id = ? AND video_id = ?", userID, videoID).Delete(&domain.Favorite{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return adjustFavoriteCount(tx, videoID, -result.RowsAffected, &favoriteCount)
+	})
+
+	return favoriteCount, err
+}
+
+func adjustFavoriteCount(tx *gorm.DB, videoID uuid.UUID, delta int64, favoriteCount *int64) error {
+	if delta == 0 {
+		return tx.Model(&domain.Video{}).Where("id = ?", videoID).Pluck("favorite_count", favoriteCount).Error
+	}
+	return tx.Raw("UPDATE videos SET favorite_count = favorite_count + ? WHERE id = ? RETURNING favorite_count",
+		delta, videoID).Scan(favoriteCount).Error
+}
+
+func (repository *favoriteRepository) GetFavoriteCount(ctx context.Context, videoID uuid.UUID) (int64, error) {
+	var favoriteCount int64
+	err := withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ?", videoID).
+		Pluck("favorite_count", &favoriteCount).Error
+
+	return favoriteCount, err
+}
+
+func (repository *favoriteRepository) ListVideos(ctx context.Context, userID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.favoritedVideos(ctx, userID).
+		Select("videos.*").
+		Order("favorites.created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *favoriteRepository) CountVideos(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := repository.favoritedVideos(ctx, userID).Count(&count).Error
+	return count, err
+}
+
+func (repository *favoriteRepository) FavoritedAmong(ctx context.Context, userID uuid.UUID,
+	videoIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	favorited := make(map[uuid.UUID]bool)
+	if len(videoIDs) == 0 {
+		return favorited, nil
+	}
+
+	var favoritedIDs []uuid.UUID
+	err := withTx(ctx, repository.db).
+		Model(&domain.Favorite{}).
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Pluck("video_id", &favoritedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, videoID := range favoritedIDs {
+		favorited[videoID] = true
+	}
+	return favorited, nil
+}
+
+func (repository *favoriteRepository) favoritedVideos(ctx context.Context, userID uuid.UUID) *gorm.DB {
+	return visibleToViewer(withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Joins("JOIN favorites ON favorites.video_id = videos.id").
+		Where("favorites.user_id = ?", userID), userID)
+}
+
+// visibleToViewer hides videos that are no longer public, are not ready or
+// published yet or were taken down, unless the viewer owns them. Followers
+// and friends videos are hidden too: a list spanning many owners cannot ask
+// the user directory about each of them before paging. Videos of owners
+// blocked with the viewer are always hidden.
+func visibleToViewer(query *gorm.DB, viewerID uuid.UUID) *gorm.DB {
+	return notBlockedWith(query, viewerID).Where("((videos.visibility = ? AND videos.processing_status = ? AND videos.moderation_state <> ? "+
+		"AND videos.publication_state = ?) OR videos.user_id = ?)",
+		domain.VisibilityPublic, domain.ProcessingStatusReady, domain.ModerationStateTakenDown,
+		domain.PublicationStatePublished, viewerID)
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavoriteAddAndRemoveMaintainCount(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewFavoriteRepository(db)
+	collectionRepo := NewCollectionRepository(db)
+	userID := uuid.New()
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	count, err := repo.Add(context.Background(), userID, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.Add(context.Background(), userID, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	collection := &domain.Collection{UserID: userID, Name: "Later"}
+	require.NoError(t, collectionRepo.Create(context.Background(), collection))
+	require.NoError(t, collectionRepo.AddVideo(context.Background(), collection.ID, video.ID))
+
+	count, err = repo.Remove(context.Background(), userID, video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	inCollection, err := collectionRepo.CountVideos(context.Background(), collection, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), inCollection)
+}
+
+func TestFavoriteListVideosHidesPrivateVideos(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewFavoriteRepository(db)
+	userID := uuid.New()
+
+	public := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), public))
+	private := createTestVideo()
+	private.Visibility = domain.VisibilityPrivate
+	require.NoError(t, videoRepo.Create(context.Background(), private))
+	own := createTestVideo()
+	own.UserID = userID
+	own.Visibility = domain.VisibilityPrivate
+	require.NoError(t, videoRepo.Create(context.Background(), own))
+
+	for _, video := range []*domain.Video{public, private, own} {
+		_, err := repo.Add(context.Background(), userID, video.ID)
+		require.NoError(t, err)
+	}
+
+	videos, err := repo.ListVideos(context.Background(), userID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, videos, 2)
+	assert.ElementsMatch(t, []uuid.UUID{public.ID, own.ID}, []uuid.UUID{videos[0].ID, videos[1].ID})
+
+	count, err := repo.CountVideos(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) domain.IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+func (repository *idempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (
+	*domain.IdempotencyRecord, bool, error) {
+
+	// An expired record is taken over in place, so a key freed by a crashed
+	// request or an elapsed TTL can be reused before the purge runs.
+	result := withTx(ctx, repository.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "method"}, {Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"request_hash", "response", "created_at", "expires_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "idempotency_records.expires_at <= ?", Vars: []any{record.CreatedAt}},
+			}},
+		}).
+		Create(record)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return record, true, nil
+	}
+
+	var existing domain.IdempotencyRecord
+	err := withTx(ctx, repository.db).
+		Where("user_id = ? AND method = ? AND key = ?", record.UserID, record.Method, record.Key).
+		First(&existing).Error
+	if err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+func (repository *idempotencyRepository) Complete(ctx context.Context, userID, method, key string,
+	response []byte, expiresAt time.Time) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.IdempotencyRecord{}).
+		Where("user_id = ? AND method = ? AND key = ?", userID, method, key).
+		Updates(map[string]any{
+			"response":   response,
+			"expires_at": expiresAt,
+		}).Error
+}
+
+func (repository *idempotencyRepository) Release(ctx context.Context, userID, method, key string) error {
+	return withTx(ctx, repository.db).
+		Where("user_id = ? AND method = ? AND key = ?", userID, method, key).
+		Delete(&domain.IdempotencyRecord{}).Error
+}
+
+func (repository *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := withTx(ctx, repository.db).
+		Where("expires_at <= ?", now).
+		Delete(&domain.IdempotencyRecord{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestIdempotencyRecord(now time.Time) *domain.IdempotencyRecord {
+	return &domain.IdempotencyRecord{
+		UserID:      uuid.NewString(),
+		Method:      "/video.VideoService/CreateVideo",
+		Key:         uuid.NewString(),
+		RequestHash: "hash",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Minute),
+	}
+}
+
+func TestIdempotencyReserveCompleteAndReplay(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewIdempotencyRepository(db)
+	now := time.Now()
+	record := createTestIdempotencyRecord(now)
+
+	_, reserved, err := repo.Reserve(context.Background(), record)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	retry := *record
+	existing, reserved, err := repo.Reserve(context.Background(), &retry)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Nil(t, existing.Response, "the first request has not finished yet")
+
+	require.NoError(t, repo.Complete(context.Background(), record.UserID, record.Method, record.Key,
+		[]byte("response"), now.Add(time.Hour)))
+
+	existing, reserved, err = repo.Reserve(context.Background(), &retry)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, []byte("response"), existing.Response)
+}
+
+func TestIdempotencyReserveTakesOverExpiredRecord(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewIdempotencyRepository(db)
+	now := time.Now()
+	record := createTestIdempotencyRecord(now.Add(-time.Hour))
+
+	_, reserved, err := repo.Reserve(context.Background(), record)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	retry := *record
+	retry.CreatedAt = now
+	retry.ExpiresAt = now.Add(time.Minute)
+	_, reserved, err = repo.Reserve(context.Background(), &retry)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestIdempotencyReleaseAndDeleteExpired(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewIdempotencyRepository(db)
+	now := time.Now()
+
+	released := createTestIdempotencyRecord(now)
+	_, _, err := repo.Reserve(context.Background(), released)
+	require.NoError(t, err)
+	require.NoError(t, repo.Release(context.Background(), released.UserID, released.Method, released.Key))
+
+	retry := *released
+	_, reserved, err := repo.Reserve(context.Background(), &retry)
+	require.NoError(t, err)
+	assert.True(t, reserved, "a released key can be used again")
+
+	expired := createTestIdempotencyRecord(now.Add(-2 * time.Minute))
+	_, _, err = repo.Reserve(context.Background(), expired)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteExpired(context.Background(), now)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, int64(1))
+}
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultJobMaxAttempts = 5
+
+const staleJobError = "worker stopped responding on the last attempt"
+
+type jobQueue struct {
+	db          *gorm.DB
+	lockTimeout time.Duration
+}
+
+func NewJobQueue(db *gorm.DB, lockTimeout time.Duration) domain.JobQueue {
+	return &jobQueue{db: db, lockTimeout: lockTimeout}
+}
+
+func (queue *jobQueue) Enqueue(ctx context.Context, job *domain.Job) error {
+	job.ID = uuid.New()
+	job.Status = domain.JobStatusQueued
+	job.CreatedAt = time.Now()
+	job.UpdatedAt = job.CreatedAt
+	if job.RunAt.IsZero() {
+		job.RunAt = job.CreatedAt
+	}
+	if job.MaxAttempts == 0 {
+		job.MaxAttempts = defaultJobMaxAttempts
+	}
+	if job.Payload == "" {
+		job.Payload = "{}"
+	}
+	return withTx(ctx, queue.db).Create(job).Error
+}
+
+// Dequeue claims the oldest runnable job. Jobs left running past the lock
+// timeout belong to a crashed worker and are handed out again, unless that
+// was their last attempt.
+func (queue *jobQueue) Dequeue(ctx context.Context, types []domain.JobType) (*domain.Job, error) {
+	var job domain.Job
+	err := withTx(ctx, queue.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := queue.failExhaustedJobs(tx, types, now); err != nil {
+			return err
+		}
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("type IN ?", types).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_at < ?)",
+				domain.JobStatusQueued, now,
+				domain.JobStatusRunning, now.Add(-queue.lockTimeout)).
+			Order("run_at ASC").
+			First(&job).Error
+		if err != nil {
+			return err
+		}
+
+		job.Status = domain.JobStatusRunning
+		job.Attempts++
+		job.LockedAt = &now
+		job.UpdatedAt = now
+		return tx.Model(&job).Updates(map[string]any{
+			"status":     job.Status,
+			"attempts":   job.Attempts,
+			"locked_at":  job.LockedAt,
+			"updated_at": job.UpdatedAt,
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// failExhaustedJobs fails stale jobs that have no attempts left, as the
+// processing worker does when a last attempt errors. Only a failed transcode
+// fails its video; a thumbnail job may belong to a video that is already
+// ready.
+func (queue *jobQueue) failExhaustedJobs(tx *gorm.DB, types []domain.JobType, now time.Time) error {
+	var exhausted []domain.Job
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("type IN ?", types).
+		Where("status = ? AND locked_at < ? AND attempts >= max_attempts",
+			domain.JobStatusRunning, now.Add(-queue.lockTimeout)).
+		Find(&exhausted).Error
+	if err != nil || len(exhausted) == 0 {
+		return err
+	}
+
+	jobIDs := make([]uuid.UUID, len(exhausted))
+	var videoIDs []uuid.UUID
+	for i, job := range exhausted {
+		jobIDs[i] = job.ID
+		if job.Type == domain.JobTypeTranscode {
+			videoIDs = append(videoIDs, job.VideoID)
+		}
+	}
+
+	err = tx.Model(&domain.Job{}).
+		Where("id IN ?", jobIDs).
+		Updates(map[string]any{
+			"status":     domain.JobStatusFailed,
+			"locked_at":  nil,
+			"last_error": staleJobError,
+			"updated_at": now,
+		}).Error
+	if err != nil || len(videoIDs) == 0 {
+		return err
+	}
+	return tx.Model(&domain.Video{}).
+		Where("id IN ?", videoIDs).
+		Update("processing_status", domain.ProcessingStatusFailed).Error
+}
+
+func (queue *jobQueue) Complete(ctx context.Context, id uuid.UUID) error {
+	return withTx(ctx, queue.db).
+		Model(&domain.Job{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     domain.JobStatusDone,
+			"locked_at":  nil,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (queue *jobQueue) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	return withTx(ctx, queue.db).
+		Model(&domain.Job{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     domain.JobStatusQueued,
+			"run_at":     runAt,
+			"locked_at":  nil,
+			"last_error": lastError,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (queue *jobQueue) Fail(ctx context.Context, id uuid.UUID, lastError string) error {
+	return withTx(ctx, queue.db).
+		Model(&domain.Job{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     domain.JobStatusFailed,
+			"locked_at":  nil,
+			"last_error": lastError,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (queue *jobQueue) Heartbeat(ctx context.Context, id uuid.UUID) error {
+	return withTx(ctx, queue.db).
+		Model(&domain.Job{}).
+		Where("id = ? AND status = ?", id, domain.JobStatusRunning).
+		Updates(map[string]any{
+			"locked_at":  time.Now(),
+			"updated_at": time.Now(),
+		}).Error
+}
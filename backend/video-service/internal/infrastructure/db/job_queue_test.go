@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func cleanJobs(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	require.NoError(t, db.Exec("DELETE FROM jobs").Error)
+}
+
+func TestJobQueueEnqueueAndDequeue(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	queue := NewJobQueue(db, time.Minute)
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: uuid.New()}
+
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+	assert.Equal(t, domain.JobStatusQueued, job.Status)
+	assert.Equal(t, defaultJobMaxAttempts, job.MaxAttempts)
+
+	claimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, job.ID, claimed.ID)
+	assert.Equal(t, domain.JobStatusRunning, claimed.Status)
+	assert.Equal(t, 1, claimed.Attempts)
+
+	again, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	assert.Nil(t, again)
+}
+
+func TestJobQueueDequeueSkipsFutureJobs(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	queue := NewJobQueue(db, time.Minute)
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: uuid.New(), RunAt: time.Now().Add(time.Hour)}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	claimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+}
+
+func TestJobQueueRetryAndComplete(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	queue := NewJobQueue(db, time.Minute)
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: uuid.New()}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	claimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+
+	require.NoError(t, queue.Retry(context.Background(), claimed.ID, time.Now().Add(-time.Second), "boom"))
+
+	retried, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NotNil(t, retried)
+	assert.Equal(t, 2, retried.Attempts)
+	assert.Equal(t, "boom", retried.LastError)
+
+	require.NoError(t, queue.Complete(context.Background(), retried.ID))
+
+	var stored domain.Job
+	require.NoError(t, db.First(&stored, "id = ?", retried.ID).Error)
+	assert.Equal(t, domain.JobStatusDone, stored.Status)
+}
+
+func TestJobQueueReclaimsStaleRunningJobs(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	queue := NewJobQueue(db, time.Minute)
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: uuid.New()}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	_, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&domain.Job{}).Where("id = ?", job.ID).
+		Update("locked_at", time.Now().Add(-2*time.Minute)).Error)
+
+	reclaimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NotNil(t, reclaimed)
+	assert.Equal(t, job.ID, reclaimed.ID)
+}
+
+func TestJobQueueFailsStaleJobsOnTheirLastAttempt(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	videoRepo := NewVideoRepository(db)
+	queue := NewJobQueue(db, time.Minute)
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: video.ID, MaxAttempts: 1}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	_, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&domain.Job{}).Where("id = ?", job.ID).
+		Update("locked_at", time.Now().Add(-2*time.Minute)).Error)
+
+	reclaimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	assert.Nil(t, reclaimed)
+
+	var stored domain.Job
+	require.NoError(t, db.First(&stored, "id = ?", job.ID).Error)
+	assert.Equal(t, domain.JobStatusFailed, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Nil(t, stored.LockedAt)
+
+	failed, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProcessingStatusFailed, failed.ProcessingStatus)
+}
+
+func TestJobQueueStaleThumbnailJobLeavesVideoReady(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	videoRepo := NewVideoRepository(db)
+	queue := NewJobQueue(db, time.Minute)
+	video := createTestVideo()
+	video.ProcessingStatus = domain.ProcessingStatusReady
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	job := &domain.Job{Type: domain.JobTypeThumbnail, VideoID: video.ID, MaxAttempts: 1}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	_, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeThumbnail})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&domain.Job{}).Where("id = ?", job.ID).
+		Update("locked_at", time.Now().Add(-2*time.Minute)).Error)
+
+	reclaimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeThumbnail})
+	require.NoError(t, err)
+	assert.Nil(t, reclaimed)
+
+	var stored domain.Job
+	require.NoError(t, db.First(&stored, "id = ?", job.ID).Error)
+	assert.Equal(t, domain.JobStatusFailed, stored.Status)
+
+	ready, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProcessingStatusReady, ready.ProcessingStatus)
+}
+
+func TestJobQueueHeartbeatKeepsJobClaimed(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+	cleanJobs(t, db)
+
+	queue := NewJobQueue(db, time.Minute)
+	job := &domain.Job{Type: domain.JobTypeTranscode, VideoID: uuid.New()}
+	require.NoError(t, queue.Enqueue(context.Background(), job))
+
+	_, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&domain.Job{}).Where("id = ?", job.ID).
+		Update("locked_at", time.Now().Add(-2*time.Minute)).Error)
+	require.NoError(t, queue.Heartbeat(context.Background(), job.ID))
+
+	reclaimed, err := queue.Dequeue(context.Background(), []domain.JobType{domain.JobTypeTranscode})
+	require.NoError(t, err)
+	assert.Nil(t, reclaimed)
+}
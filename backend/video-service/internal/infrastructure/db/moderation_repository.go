@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// openCaseSQL adds a report to the video's case. A resolved case starts over
+// so that its counts only cover reports the moderators have not seen yet.
+const openCaseSQL = `
+INSERT INTO moderation_cases (video_id, status, severity, report_count, first_reported_at, last_reported_at)
+VALUES (@video_id, @open, @severity, 1, @now, @now)
+ON CONFLICT (video_id) DO UPDATE SET
+	severity = CASE WHEN moderation_cases.status = @open
+		THEN GREATEST(moderation_cases.severity, EXCLUDED.severity) ELSE EXCLUDED.severity END,
+	report_count = CASE WHEN moderation_cases.status = @open
+		THEN moderation_cases.report_count + 1 ELSE 1 END,
+	first_reported_at = CASE WHEN moderation_cases.status = @open
+		THEN moderation_cases.first_reported_at ELSE EXCLUDED.first_reported_at END,
+	last_reported_at = EXCLUDED.last_reported_at,
+	status = @open,
+	resolved_at = NULL`
+
+type moderationRepository struct {
+	db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) domain.ModerationRepository {
+	return &moderationRepository{db: db}
+}
+
+func (repository *moderationRepository) Report(ctx context.Context, report *domain.VideoReport) (bool, error) {
+	report.ID = uuid.New()
+	report.CreatedAt = time.Now()
+
+	created := false
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		created = true
+
+		return tx.Exec(openCaseSQL, map[string]any{
+			"video_id": report.VideoID,
+			"open":     domain.CaseStatusOpen,
+			"severity": report.Reason.Severity(),
+			"now":      report.CreatedAt,
+		}).Error
+	})
+
+	return created, err
+}
+
+func (repository *moderationRepository) ListQueue(ctx context.Context, limit, offset int) (
+	[]*domain.ModerationCase, error) {
+
+	var cases []*domain.ModerationCase
+	err := repository.openCases(ctx).
+		Select("moderation_cases.*").
+		Order("moderation_cases.severity DESC, moderation_cases.report_count DESC").
+		Order("moderation_cases.first_reported_at").
+		Limit(limit).
+		Offset(offset).
+		Find(&cases).Error
+	if err != nil || len(cases) == 0 {
+		return cases, err
+	}
+
+	if err := repository.loadCaseDetails(ctx, cases); err != nil {
+		return nil, err
+	}
+	return cases, nil
+}
+
+func (repository *moderationRepository) CountQueue(ctx context.Context) (int64, error) {
+	var count int64
+	err := repository.openCases(ctx).Count(&count).Error
+	return count, err
+}
+
+// openCases leaves out videos that were deleted while their case was open,
+// since there is nothing left to moderate.
+func (repository *moderationRepository) openCases(ctx context.Context) *gorm.DB {
+	return withTx(ctx, repository.db).
+		Model(&domain.ModerationCase{}).
+		Joins("JOIN videos ON videos.id = moderation_cases.video_id AND videos.deleted_at IS NULL").
+		Where("moderation_cases.status = ?", domain.CaseStatusOpen)
+}
+
+type reasonCount struct {
+	VideoID uuid.UUID
+	Reason  domain.ReportReason
+	Reports int64
+}
+
+func (repository *moderationRepository) loadCaseDetails(ctx context.Context, cases []*domain.ModerationCase) error {
+	videoIDs := make([]uuid.UUID, len(cases))
+	byVideo := make(map[uuid.UUID]*domain.ModerationCase, len(cases))
+	for i, moderationCase := range cases {
+		videoIDs[i] = moderationCase.VideoID
+		moderationCase.ReasonCounts = map[domain.ReportReason]int64{}
+		byVideo[moderationCase.VideoID] = moderationCase
+	}
+
+	var videos []*domain.Video
+	if err := withTx(ctx, repository.db).Where("id IN ?", videoIDs).Find(&videos).Error; err != nil {
+		return err
+	}
+	for _, video := range videos {
+		byVideo[video.ID].Video = video
+	}
+
+	var counts []reasonCount
+	err := withTx(ctx, repository.db).
+		Table("video_reports").
+		Select("video_reports.video_id, video_reports.reason, COUNT(*) AS reports").
+		Joins("JOIN moderation_cases ON moderation_cases.video_id = video_reports.video_id").
+		Where("video_reports.video_id IN ?", videoIDs).
+		Where("video_reports.created_at >= moderation_cases.first_reported_at").
+		Group("video_reports.video_id, video_reports.reason").
+		Scan(&counts).Error
+	if err != nil {
+		return err
+	}
+
+	for _, count := range counts {
+		byVideo[count.VideoID].ReasonCounts[count.Reason] = count.Reports
+	}
+	return nil
+}
+
+func (repository *moderationRepository) ResolveCase(ctx context.Context, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Model(&domain.ModerationCase{}).
+		Where("video_id = ? AND status = ?", videoID, domain.CaseStatusOpen).
+		Updates(map[string]any{
+			"status":      domain.CaseStatusResolved,
+			"resolved_at": time.Now(),
+		}).Error
+}
+
+func (repository *moderationRepository) SetVideoState(ctx context.Context, videoID uuid.UUID,
+	state domain.ModerationState, reason string) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ?", videoID).
+		Updates(map[string]any{
+			"moderation_state":  state,
+			"moderation_reason": reason,
+			"updated_at":        time.Now(),
+		}).Error
+}
+
+func (repository *moderationRepository) RecordAction(ctx context.Context, action *domain.ModerationAction) error {
+	action.ID = uuid.New()
+	action.CreatedAt = time.Now()
+	return withTx(ctx, repository.db).Create(action).Error
+}
+
+func (repository *moderationRepository) ListActions(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.ModerationAction, error) {
+
+	var actions []*domain.ModerationAction
+	err := withTx(ctx, repository.db).
+		Where("video_id = ?", videoID).
+		Order("created_at DESC").
+		Find(&actions).Error
+	return actions, err
+}
+
+func (repository *moderationRepository) AddStrike(ctx context.Context, strike *domain.CreatorStrike) (int64, error) {
+	strike.ID = uuid.New()
+	strike.CreatedAt = time.Now()
+
+	var strikeCount int64
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(strike).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.CreatorStrike{}).Where("user_id = ?", strike.UserID).Count(&strikeCount).Error
+	})
+
+	return strikeCount, err
+}
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationReportAndQueue(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewModerationRepository(db)
+
+	spammy := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), spammy))
+	violent := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), violent))
+
+	reporterID := uuid.New()
+	created, err := repo.Report(context.Background(), &domain.VideoReport{
+		VideoID: spammy.ID, ReporterID: reporterID, Reason: domain.ReportReasonSpam,
+	})
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = repo.Report(context.Background(), &domain.VideoReport{
+		VideoID: spammy.ID, ReporterID: reporterID, Reason: domain.ReportReasonViolence,
+	})
+	require.NoError(t, err)
+	assert.False(t, created, "a reporter counts once per video")
+
+	_, err = repo.Report(context.Background(), &domain.VideoReport{
+		VideoID: spammy.ID, ReporterID: uuid.New(), Reason: domain.ReportReasonSpam,
+	})
+	require.NoError(t, err)
+	_, err = repo.Report(context.Background(), &domain.VideoReport{
+		VideoID: violent.ID, ReporterID: uuid.New(), Reason: domain.ReportReasonViolence,
+	})
+	require.NoError(t, err)
+
+	cases, err := repo.ListQueue(context.Background(), 100, 0)
+	require.NoError(t, err)
+	violentAt, spammyAt := -1, -1
+	for i, moderationCase := range cases {
+		switch moderationCase.VideoID {
+		case violent.ID:
+			violentAt = i
+		case spammy.ID:
+			spammyAt = i
+			assert.Equal(t, int64(2), moderationCase.ReportCount)
+			assert.Equal(t, domain.ReportReasonSpam.Severity(), moderationCase.Severity)
+			assert.Equal(t, int64(2), moderationCase.ReasonCounts[domain.ReportReasonSpam])
+			require.NotNil(t, moderationCase.Video)
+			assert.Equal(t, spammy.Title, moderationCase.Video.Title)
+		}
+	}
+	require.NotEqual(t, -1, violentAt)
+	require.NotEqual(t, -1, spammyAt)
+	assert.Less(t, violentAt, spammyAt, "more severe cases come first")
+
+	require.NoError(t, repo.ResolveCase(context.Background(), spammy.ID))
+	cases, err = repo.ListQueue(context.Background(), 100, 0)
+	require.NoError(t, err)
+	for _, moderationCase := range cases {
+		assert.NotEqual(t, spammy.ID, moderationCase.VideoID)
+	}
+
+	_, err = repo.Report(context.Background(), &domain.VideoReport{
+		VideoID: spammy.ID, ReporterID: uuid.New(), Reason: domain.ReportReasonNudity,
+	})
+	require.NoError(t, err)
+	cases, err = repo.ListQueue(context.Background(), 100, 0)
+	require.NoError(t, err)
+	for _, moderationCase := range cases {
+		if moderationCase.VideoID == spammy.ID {
+			assert.Equal(t, int64(1), moderationCase.ReportCount, "a reopened case starts over")
+			assert.Equal(t, domain.ReportReasonNudity.Severity(), moderationCase.Severity)
+		}
+	}
+}
+
+func TestModerationActionsAndStrikes(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewModerationRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	moderatorID := uuid.New()
+
+	require.NoError(t, repo.SetVideoState(context.Background(), video.ID,
+		domain.ModerationStateTakenDown, "graphic violence"))
+	require.NoError(t, repo.RecordAction(context.Background(), &domain.ModerationAction{
+		VideoID:     video.ID,
+		ModeratorID: moderatorID,
+		Action:      domain.ModerationActionTakeDown,
+		Reason:      "graphic violence",
+	}))
+
+	moderated, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModerationStateTakenDown, moderated.ModerationState)
+	assert.Equal(t, "graphic violence", moderated.ModerationReason)
+
+	actions, err := repo.ListActions(context.Background(), video.ID)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, domain.ModerationActionTakeDown, actions[0].Action)
+
+	for i := 1; i <= 2; i++ {
+		strikes, err := repo.AddStrike(context.Background(), &domain.CreatorStrike{
+			UserID:      video.UserID,
+			VideoID:     video.ID,
+			ModeratorID: moderatorID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), strikes)
+	}
+}
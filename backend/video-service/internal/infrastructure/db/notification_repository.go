@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// openNotificationSQL finds or starts the recipient's unread notification
+// for the activity. The no-op update lets RETURNING see an existing row.
+const openNotificationSQL = `
+INSERT INTO notifications (id, user_id, type, video_id, last_actor_id, actor_count, created_at, updated_at)
+VALUES (@id, @user_id, @type, @video_id, @actor_id, 0, @now, @now)
+ON CONFLICT (user_id, type, ` + notificationVideoKey + `) WHERE read_at IS NULL
+DO UPDATE SET updated_at = notifications.updated_at
+RETURNING id`
+
+// notificationVideoKey lets follow notifications, which have no video,
+// share one unread group.
+const notificationVideoKey = `COALESCE(video_id, '00000000-0000-0000-0000-000000000000'::uuid)`
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) domain.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (repository *notificationRepository) Record(ctx context.Context, activity *domain.Activity) (
+	*domain.Notification, error) {
+
+	var notification *domain.Notification
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var notificationID uuid.UUID
+		err := tx.Raw(openNotificationSQL, map[string]any{
+			"id":       uuid.New(),
+			"user_id":  activity.RecipientID,
+			"type":     activity.Type,
+			"video_id": activity.VideoID,
+			"actor_id": activity.ActorID,
+			"now":      now,
+		}).Scan(&notificationID).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.NotificationActor{
+			NotificationID: notificationID,
+			ActorID:        activity.ActorID,
+			CreatedAt:      now,
+		})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		err = tx.Model(&domain.Notification{}).
+			Where("id = ?", notificationID).
+			Updates(map[string]any{
+				"actor_count":   gorm.Expr("actor_count + 1"),
+				"last_actor_id": activity.ActorID,
+				"updated_at":    now,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		notification = &domain.Notification{}
+		return tx.First(notification, "id = ?", notificationID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+func (repository *notificationRepository) List(ctx context.Context, userID uuid.UUID, unreadOnly bool,
+	limit, offset int) ([]*domain.Notification, error) {
+
+	var notifications []*domain.Notification
+	err := repository.userNotifications(ctx, userID, unreadOnly).
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&notifications).Error
+	return notifications, err
+}
+
+func (repository *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := repository.userNotifications(ctx, userID, true).Count(&count).Error
+	return count, err
+}
+
+func (repository *notificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	query := repository.userNotifications(ctx, userID, true)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	return query.Update("read_at", time.Now()).Error
+}
+
+func (repository *notificationRepository) userNotifications(ctx context.Context, userID uuid.UUID,
+	unreadOnly bool) *gorm.DB {
+
+	query := withTx(ctx, repository.db).
+		Model(&domain.Notification{}).
+		Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	return query
+}
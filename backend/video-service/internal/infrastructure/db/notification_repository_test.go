@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordNotification_AggregatesUnreadActors(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+	recipientID := uuid.New()
+	videoID := uuid.New()
+	firstActor, secondActor := uuid.New(), uuid.New()
+
+	like := func(actorID uuid.UUID) *domain.Activity {
+		return &domain.Activity{
+			Type:        domain.NotificationTypeLike,
+			RecipientID: recipientID,
+			ActorID:     actorID,
+			VideoID:     &videoID,
+		}
+	}
+
+	first, err := repo.Record(ctx, like(firstActor))
+	require.NoError(t, err)
+	require.NotNil(t, first)
+	assert.Equal(t, int64(1), first.ActorCount)
+
+	second, err := repo.Record(ctx, like(secondActor))
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, int64(2), second.ActorCount)
+	assert.Equal(t, secondActor, second.LastActorID)
+
+	repeated, err := repo.Record(ctx, like(firstActor))
+	require.NoError(t, err)
+	assert.Nil(t, repeated, "an actor is only counted once per notification")
+
+	unread, err := repo.CountUnread(ctx, recipientID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), unread)
+}
+
+func TestMarkRead_StartsNewGroup(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewNotificationRepository(db)
+	ctx := context.Background()
+	recipientID := uuid.New()
+	actorID := uuid.New()
+	follow := &domain.Activity{Type: domain.NotificationTypeFollow, RecipientID: recipientID, ActorID: actorID}
+
+	first, err := repo.Record(ctx, follow)
+	require.NoError(t, err)
+	require.NoError(t, repo.MarkRead(ctx, recipientID, nil))
+
+	unread, err := repo.CountUnread(ctx, recipientID)
+	require.NoError(t, err)
+	assert.Zero(t, unread)
+
+	second, err := repo.Record(ctx, follow)
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	assert.NotEqual(t, first.ID, second.ID)
+
+	notifications, err := repo.List(ctx, recipientID, false, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, second.ID, notifications[0].ID)
+	assert.NotNil(t, notifications[1].ReadAt)
+
+	notifications, err = repo.List(ctx, recipientID, true, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, notifications, 1)
+}
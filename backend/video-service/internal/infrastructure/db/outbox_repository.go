@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/infrastructure/events"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) domain.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (repository *outboxRepository) Append(ctx context.Context, event *domain.VideoEvent) error {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	id := uuid.New()
+	payload, err := events.Encode(id, event)
+	if err != nil {
+		return err
+	}
+
+	return withTx(ctx, repository.db).Create(&domain.OutboxEvent{
+		ID:          id,
+		EventType:   event.Type,
+		AggregateID: event.VideoID,
+		Payload:     payload,
+		AvailableAt: event.OccurredAt,
+		CreatedAt:   event.OccurredAt,
+	}).Error
+}
+
+// FetchPending locks the returned rows until the surrounding transaction
+// ends, so concurrent relays never publish the same batch.
+func (repository *outboxRepository) FetchPending(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
+	var pending []*domain.OutboxEvent
+	err := withTx(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("published_at IS NULL").
+		Where("available_at <= ?", time.Now()).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&pending).Error
+
+	return pending, err
+}
+
+func (repository *outboxRepository) MarkPublished(ctx context.Context, ids []uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Model(&domain.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"published_at": time.Now(),
+			"attempts":     gorm.Expr("attempts + 1"),
+		}).Error
+}
+
+func (repository *outboxRepository) MarkFailed(ctx context.Context, ids []uuid.UUID, lastError string,
+	retryAt time.Time) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"available_at": retryAt,
+			"last_error":   lastError,
+			"attempts":     gorm.Expr("attempts + 1"),
+		}).Error
+}
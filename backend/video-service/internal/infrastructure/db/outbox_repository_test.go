@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxAppendFetchAndMarkPublished(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewOutboxRepository(db)
+	transactor := NewTransactor(db)
+	videoID := uuid.New()
+
+	err := repo.Append(context.Background(), &domain.VideoEvent{
+		Type:    domain.EventVideoDeleted,
+		VideoID: videoID,
+		UserID:  uuid.New(),
+	})
+	require.NoError(t, err)
+
+	var fetched *domain.OutboxEvent
+	err = transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		pending, err := repo.FetchPending(ctx, 1000)
+		if err != nil {
+			return err
+		}
+		for _, event := range pending {
+			if event.AggregateID == videoID {
+				fetched = event
+			}
+		}
+		require.NotNil(t, fetched)
+		return repo.MarkPublished(ctx, []uuid.UUID{fetched.ID})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.EventVideoDeleted, fetched.EventType)
+	assert.NotEmpty(t, fetched.Payload)
+
+	pending, err := repo.FetchPending(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, event := range pending {
+		assert.NotEqual(t, fetched.ID, event.ID)
+	}
+}
+
+func TestOutboxMarkFailedDelaysRetry(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewOutboxRepository(db)
+	videoID := uuid.New()
+
+	err := repo.Append(context.Background(), &domain.VideoEvent{Type: domain.EventVideoDeleted, VideoID: videoID})
+	require.NoError(t, err)
+
+	var event domain.OutboxEvent
+	require.NoError(t, db.Where("aggregate_id = ?", videoID).First(&event).Error)
+
+	err = repo.MarkFailed(context.Background(), []uuid.UUID{event.ID}, "broker down", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	pending, err := repo.FetchPending(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, candidate := range pending {
+		assert.NotEqual(t, event.ID, candidate.ID)
+	}
+}
+
+func TestTransactorRollsBackOnError(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	transactor := NewTransactor(db)
+	video := createTestVideo()
+
+	err := transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		if err := videoRepo.Create(ctx, video); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+
+	_, err = videoRepo.GetByID(context.Background(), video.ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// pinVideoSQL takes the lowest free slot. The unique (user_id, slot) index
+// turns a concurrent pin of the same slot into a skipped insert instead of a
+// fourth pin.
+const pinVideoSQL = `
+INSERT INTO video_pins (video_id, user_id, slot, created_at)
+SELECT @video_id, @user_id, slot, @now
+FROM generate_series(1, @max_pins) AS slot
+WHERE slot NOT IN (SELECT slot FROM video_pins WHERE user_id = @user_id)
+ORDER BY slot
+LIMIT 1
+ON CONFLICT DO NOTHING`
+
+type pinRepository struct {
+	db *gorm.DB
+}
+
+func NewPinRepository(db *gorm.DB) domain.PinRepository {
+	return &pinRepository{db: db}
+}
+
+func (repository *pinRepository) Pin(ctx context.Context, userID, videoID uuid.UUID) error {
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(pinVideoSQL, map[string]any{
+			"video_id": videoID,
+			"user_id":  userID,
+			"now":      time.Now(),
+			"max_pins": domain.MaxPinnedVideos,
+		})
+		if result.Error != nil || result.RowsAffected > 0 {
+			return result.Error
+		}
+
+		var pinned []uuid.UUID
+		err := tx.Model(&domain.VideoPin{}).Where("user_id = ?", userID).Pluck("video_id", &pinned).Error
+		switch {
+		case err != nil:
+			return err
+		case slices.Contains(pinned, videoID):
+			return nil
+		case len(pinned) >= domain.MaxPinnedVideos:
+			return domain.ErrPinLimitReached
+		}
+		return domain.ErrPinConflict
+	})
+	return translateError(err, nil)
+}
+
+func (repository *pinRepository) Unpin(ctx context.Context, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).Where("video_id = ?", videoID).Delete(&domain.VideoPin{}).Error
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPinnableVideos(t *testing.T, repo domain.VideoRepository, userID uuid.UUID, n int) []*domain.Video {
+	videos := make([]*domain.Video, n)
+	for i := range videos {
+		videos[i] = createTestVideo()
+		videos[i].UserID = userID
+		require.NoError(t, repo.Create(context.Background(), videos[i]))
+	}
+	return videos
+}
+
+func TestPinLimitAndListing(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPinRepository(db)
+	userID := uuid.New()
+	videos := createPinnableVideos(t, videoRepo, userID, domain.MaxPinnedVideos+2)
+
+	for _, video := range videos[:domain.MaxPinnedVideos] {
+		require.NoError(t, repo.Pin(context.Background(), userID, video.ID))
+	}
+	require.NoError(t, repo.Pin(context.Background(), userID, videos[0].ID), "pinning twice is a no-op")
+	err := repo.Pin(context.Background(), userID, videos[domain.MaxPinnedVideos].ID)
+	assert.ErrorIs(t, err, domain.ErrPinLimitReached)
+
+	query := domain.UserVideosQuery{UserID: userID, Visibilities: allVisibilities, Limit: 10}
+	listed, err := videoRepo.GetByUserID(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, listed, 2, "pinned videos are left out of the grid")
+
+	query.Pinned = true
+	pinned, err := videoRepo.GetByUserID(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, pinned, domain.MaxPinnedVideos)
+
+	require.NoError(t, repo.Unpin(context.Background(), videos[0].ID))
+	require.NoError(t, repo.Pin(context.Background(), userID, videos[domain.MaxPinnedVideos].ID))
+}
+
+func TestPinRemovedWhenVideoDeletedOrPrivate(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPinRepository(db)
+	userID := uuid.New()
+	videos := createPinnableVideos(t, videoRepo, userID, 2)
+	for _, video := range videos {
+		require.NoError(t, repo.Pin(context.Background(), userID, video.ID))
+	}
+
+	require.NoError(t, videoRepo.Delete(context.Background(), videos[0].ID))
+	videos[1].Visibility = domain.VisibilityPrivate
+	require.NoError(t, videoRepo.Update(context.Background(), videos[1]))
+
+	var remaining int64
+	require.NoError(t, db.Model(&domain.VideoPin{}).Where("user_id = ?", userID).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+}
@@ -0,0 +1,236 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type playlistRepository struct {
+	db *gorm.DB
+}
+
+func NewPlaylistRepository(db *gorm.DB) domain.PlaylistRepository {
+	return &playlistRepository{db: db}
+}
+
+func (repository *playlistRepository) Create(ctx context.Context, playlist *domain.Playlist) error {
+	playlist.ID = uuid.New()
+	playlist.CreatedAt = time.Now()
+	playlist.UpdatedAt = playlist.CreatedAt
+	return translateError(withTx(ctx, repository.db).Create(playlist).Error, nil)
+}
+
+func (repository *playlistRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Playlist, error) {
+	var playlist domain.Playlist
+	err := withTx(ctx, repository.db).First(&playlist, "id = ?", id).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrPlaylistNotFound)
+	}
+	return &playlist, nil
+}
+
+func (repository *playlistRepository) Update(ctx context.Context, playlist *domain.Playlist) error {
+	playlist.UpdatedAt = time.Now()
+	err := withTx(ctx, repository.db).
+		Model(&domain.Playlist{}).
+		Where("id = ?", playlist.ID).
+		Updates(map[string]any{
+			"title":       playlist.Title,
+			"description": playlist.Description,
+			"cover_url":   playlist.CoverURL,
+			"updated_at":  playlist.UpdatedAt,
+		}).Error
+	return translateError(err, nil)
+}
+
+func (repository *playlistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("playlist_id = ?", id).Delete(&domain.PlaylistVideo{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Playlist{}, "id = ?", id).Error
+	})
+}
+
+func (repository *playlistRepository) ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) (
+	[]*domain.Playlist, error) {
+
+	var playlists []*domain.Playlist
+	err := withTx(ctx, repository.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&playlists).Error
+
+	return playlists, err
+}
+
+func (repository *playlistRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := withTx(ctx, repository.db).
+		Model(&domain.Playlist{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (repository *playlistRepository) ListByVideoID(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.Playlist, error) {
+
+	var playlists []*domain.Playlist
+	err := withTx(ctx, repository.db).
+		Select("playlists.*").
+		Joins("JOIN playlist_videos ON playlist_videos.playlist_id = playlists.id").
+		Where("playlist_videos.video_id = ?", videoID).
+		Order("playlists.created_at ASC").
+		Find(&playlists).Error
+
+	return playlists, err
+}
+
+// AddVideo locks the playlist row so that concurrent appends take distinct
+// positions.
+func (repository *playlistRepository) AddVideo(ctx context.Context, playlistID, videoID uuid.UUID) error {
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockPlaylist(tx, playlistID); err != nil {
+			return err
+		}
+
+		var position *int
+		err := tx.Model(&domain.PlaylistVideo{}).
+			Where("playlist_id = ?", playlistID).
+			Select("MAX(position)").
+			Scan(&position).Error
+		if err != nil {
+			return err
+		}
+
+		item := &domain.PlaylistVideo{PlaylistID: playlistID, VideoID: videoID, CreatedAt: time.Now()}
+		if position != nil {
+			item.Position = *position + 1
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(item).Error
+	})
+	return translateError(err, nil)
+}
+
+func (repository *playlistRepository) RemoveVideo(ctx context.Context, playlistID, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Where("playlist_id = ? AND video_id = ?", playlistID, videoID).
+		Delete(&domain.PlaylistVideo{}).Error
+}
+
+// Reorder only expects the items whose video is not in the trash. Trashed
+// items keep their relative order after the others, so positions stay
+// unique and a restored video reappears at the end.
+func (repository *playlistRepository) Reorder(ctx context.Context, playlistID uuid.UUID,
+	videoIDs []uuid.UUID) error {
+
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := lockPlaylist(tx, playlistID); err != nil {
+			return err
+		}
+
+		var existing []uuid.UUID
+		err := tx.Model(&domain.PlaylistVideo{}).
+			Joins("JOIN videos ON videos.id = playlist_videos.video_id").
+			Where("playlist_videos.playlist_id = ?", playlistID).
+			Where("videos.deleted_at IS NULL").
+			Pluck("playlist_videos.video_id", &existing).Error
+		if err != nil {
+			return err
+		}
+		if !sameIDs(existing, videoIDs) {
+			return domain.ErrInvalidPlaylistOrder
+		}
+
+		var trashed []uuid.UUID
+		err = tx.Model(&domain.PlaylistVideo{}).
+			Joins("JOIN videos ON videos.id = playlist_videos.video_id").
+			Where("playlist_videos.playlist_id = ?", playlistID).
+			Where("videos.deleted_at IS NOT NULL").
+			Order("playlist_videos.position ASC").
+			Pluck("playlist_videos.video_id", &trashed).Error
+		if err != nil {
+			return err
+		}
+
+		for position, videoID := range slices.Concat(videoIDs, trashed) {
+			err := tx.Model(&domain.PlaylistVideo{}).
+				Where("playlist_id = ? AND video_id = ?", playlistID, videoID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return translateError(err, nil)
+}
+
+func lockPlaylist(tx *gorm.DB, playlistID uuid.UUID) error {
+	var playlist domain.Playlist
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		First(&playlist, "id = ?", playlistID).Error
+	return translateError(err, domain.ErrPlaylistNotFound)
+}
+
+func (repository *playlistRepository) Position(ctx context.Context, playlistID, videoID uuid.UUID) (
+	int, error) {
+
+	var item domain.PlaylistVideo
+	err := withTx(ctx, repository.db).
+		First(&item, "playlist_id = ? AND video_id = ?", playlistID, videoID).Error
+	if err != nil {
+		return 0, translateError(err, domain.ErrVideoNotInPlaylist)
+	}
+	return item.Position, nil
+}
+
+func (repository *playlistRepository) ListVideos(ctx context.Context, query domain.PlaylistVideosQuery) (
+	[]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.playlistVideos(ctx, query).
+		Select("videos.*").
+		Order("playlist_videos.position ASC, playlist_videos.created_at ASC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *playlistRepository) CountVideos(ctx context.Context, query domain.PlaylistVideosQuery) (
+	int64, error) {
+
+	var count int64
+	err := repository.playlistVideos(ctx, query).Count(&count).Error
+	return count, err
+}
+
+func (repository *playlistRepository) playlistVideos(ctx context.Context,
+	query domain.PlaylistVideosQuery) *gorm.DB {
+
+	db := withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Joins("JOIN playlist_videos ON playlist_videos.video_id = videos.id").
+		Where("playlist_videos.playlist_id = ?", query.PlaylistID)
+	if !query.Owner {
+		db = db.Where("videos.visibility IN ?", query.Visibilities).
+			Where("videos.publication_state = ?", domain.PublicationStatePublished).
+			Where("videos.moderation_state <> ?", domain.ModerationStateTakenDown)
+	}
+	if query.AfterPosition != nil {
+		db = db.Where("playlist_videos.position > ?", *query.AfterPosition)
+	}
+	return db
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPlaylistVideos(t *testing.T, videoRepo domain.VideoRepository, userID uuid.UUID,
+	count int) []*domain.Video {
+
+	t.Helper()
+	videos := make([]*domain.Video, count)
+	for i := range videos {
+		videos[i] = createTestVideo()
+		videos[i].UserID = userID
+		require.NoError(t, videoRepo.Create(context.Background(), videos[i]))
+	}
+	return videos
+}
+
+func TestPlaylistAddVideoAppendsAndReorder(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPlaylistRepository(db)
+	userID := uuid.New()
+	videos := createTestPlaylistVideos(t, videoRepo, userID, 3)
+
+	playlist := &domain.Playlist{UserID: userID, Title: "Series"}
+	require.NoError(t, repo.Create(context.Background(), playlist))
+	for _, video := range videos {
+		require.NoError(t, repo.AddVideo(context.Background(), playlist.ID, video.ID))
+	}
+	require.NoError(t, repo.AddVideo(context.Background(), playlist.ID, videos[0].ID))
+
+	query := domain.PlaylistVideosQuery{PlaylistID: playlist.ID, Owner: true, Limit: 10}
+	listed, err := repo.ListVideos(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, videoIDs(videos), videoIDs(listed))
+
+	err = repo.Reorder(context.Background(), playlist.ID, []uuid.UUID{videos[0].ID})
+	assert.ErrorIs(t, err, domain.ErrInvalidPlaylistOrder)
+
+	reordered := []uuid.UUID{videos[2].ID, videos[0].ID, videos[1].ID}
+	require.NoError(t, repo.Reorder(context.Background(), playlist.ID, reordered))
+
+	listed, err = repo.ListVideos(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, reordered, videoIDs(listed))
+
+	position, err := repo.Position(context.Background(), playlist.ID, videos[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, position)
+}
+
+func TestPlaylistListVideosRespectsVisibility(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPlaylistRepository(db)
+	userID := uuid.New()
+
+	public := createTestVideo()
+	unlisted := createTestVideo()
+	unlisted.Visibility = domain.VisibilityUnlisted
+	private := createTestVideo()
+	private.Visibility = domain.VisibilityPrivate
+	draft := createTestVideo()
+	draft.PublicationState = domain.PublicationStateDraft
+	takenDown := createTestVideo()
+	takenDown.ModerationState = domain.ModerationStateTakenDown
+
+	playlist := &domain.Playlist{UserID: userID, Title: "Series"}
+	require.NoError(t, repo.Create(context.Background(), playlist))
+	for _, video := range []*domain.Video{public, private, draft, takenDown, unlisted} {
+		video.UserID = userID
+		require.NoError(t, videoRepo.Create(context.Background(), video))
+		require.NoError(t, repo.AddVideo(context.Background(), playlist.ID, video.ID))
+	}
+
+	query := domain.PlaylistVideosQuery{
+		PlaylistID:   playlist.ID,
+		Visibilities: []domain.Visibility{domain.VisibilityPublic, domain.VisibilityUnlisted},
+		Limit:        10,
+	}
+	listed, err := repo.ListVideos(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{public.ID, unlisted.ID}, videoIDs(listed))
+
+	count, err := repo.CountVideos(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// The next viewable item after the public video skips the hidden ones.
+	position, err := repo.Position(context.Background(), playlist.ID, public.ID)
+	require.NoError(t, err)
+	query.AfterPosition, query.Limit = &position, 1
+	next, err := repo.ListVideos(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, next, 1)
+	assert.Equal(t, unlisted.ID, next[0].ID)
+}
+
+func TestPlaylistDeleteAndListByVideoID(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPlaylistRepository(db)
+	userID := uuid.New()
+	video := createTestPlaylistVideos(t, videoRepo, userID, 1)[0]
+
+	first := &domain.Playlist{UserID: userID, Title: "First"}
+	require.NoError(t, repo.Create(context.Background(), first))
+	second := &domain.Playlist{UserID: userID, Title: "Second"}
+	require.NoError(t, repo.Create(context.Background(), second))
+	require.NoError(t, repo.AddVideo(context.Background(), second.ID, video.ID))
+	require.NoError(t, repo.AddVideo(context.Background(), first.ID, video.ID))
+
+	playlists, err := repo.ListByVideoID(context.Background(), video.ID)
+	require.NoError(t, err)
+	require.Len(t, playlists, 2)
+	assert.Equal(t, first.ID, playlists[0].ID)
+
+	require.NoError(t, repo.Delete(context.Background(), first.ID))
+
+	_, err = repo.GetByID(context.Background(), first.ID)
+	assert.ErrorIs(t, err, domain.ErrPlaylistNotFound)
+	_, err = repo.Position(context.Background(), first.ID, video.ID)
+	assert.ErrorIs(t, err, domain.ErrVideoNotInPlaylist)
+
+	err = repo.AddVideo(context.Background(), first.ID, video.ID)
+	assert.ErrorIs(t, err, domain.ErrPlaylistNotFound)
+}
@@ -35,7 +35,16 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 	}
 
 	gormDB := &GormDB{db: db}
-	return setupDatabase(gormDB)
+	db, err = setupDatabase(gormDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applySchema(db); err != nil {
+		return nil, err
+	}
+
+	return db, nil
 }
 
 func createConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
@@ -48,7 +57,7 @@ func createConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 		cfg.SSLMode,
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -70,6 +79,34 @@ func setupDatabase(database Database) (*gorm.DB, error) {
 		&domain.Video{},
 		&domain.UserVideoLike{},
 		&domain.UserVideoView{},
+		&domain.UploadSession{},
+		&domain.Job{},
+		&domain.VideoRendition{},
+		&domain.Hashtag{},
+		&domain.VideoHashtag{},
+		&domain.VideoMention{},
+		&domain.TrendingVideo{},
+		&domain.TrendingHashtagScore{},
+		&domain.VideoComment{},
+		&domain.OutboxEvent{},
+		&domain.VideoShare{},
+		&domain.ShareClick{},
+		&domain.Favorite{},
+		&domain.Collection{},
+		&domain.CollectionVideo{},
+		&domain.VideoReport{},
+		&domain.ModerationCase{},
+		&domain.ModerationAction{},
+		&domain.CreatorStrike{},
+		&domain.UserBlock{},
+		&domain.Notification{},
+		&domain.NotificationActor{},
+		&domain.VideoDailyStats{},
+		&domain.VideoRetention{},
+		&domain.IdempotencyRecord{},
+		&domain.VideoPin{},
+		&domain.Playlist{},
+		&domain.PlaylistVideo{},
 	)
 
 	if err != nil {
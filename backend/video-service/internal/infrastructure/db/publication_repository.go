@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// publishDueSQL claims due videos with SKIP LOCKED and flips them in the
+// same statement, so replicas polling at once never publish a video twice.
+const publishDueSQL = `
+UPDATE videos SET publication_state = @published, updated_at = @now
+WHERE id IN (
+	SELECT id FROM videos
+	WHERE publication_state = @scheduled AND publish_at <= @now AND deleted_at IS NULL
+	ORDER BY publish_at
+	LIMIT @limit
+	FOR UPDATE SKIP LOCKED)
+RETURNING *`
+
+type publicationRepository struct {
+	db *gorm.DB
+}
+
+func NewPublicationRepository(db *gorm.DB) domain.PublicationRepository {
+	return &publicationRepository{db: db}
+}
+
+func (repository *publicationRepository) ListDrafts(ctx context.Context, userID uuid.UUID, limit, offset int) (
+	[]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.drafts(ctx, userID).
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+	return videos, err
+}
+
+func (repository *publicationRepository) CountDrafts(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := repository.drafts(ctx, userID).Count(&count).Error
+	return count, err
+}
+
+func (repository *publicationRepository) drafts(ctx context.Context, userID uuid.UUID) *gorm.DB {
+	return withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("user_id = ?", userID).
+		Where("publication_state IN ?", []domain.PublicationState{
+			domain.PublicationStateDraft, domain.PublicationStateScheduled,
+		})
+}
+
+func (repository *publicationRepository) SetPublication(ctx context.Context, video *domain.Video,
+	from domain.PublicationState) error {
+
+	result := withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ? AND publication_state = ?", video.ID, from).
+		Updates(map[string]any{
+			"publication_state": video.PublicationState,
+			"publish_at":        video.PublishAt,
+			"updated_at":        video.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrVideoModified
+	}
+	return nil
+}
+
+func (repository *publicationRepository) PublishDue(ctx context.Context, now time.Time, limit int) (
+	[]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := withTx(ctx, repository.db).Raw(publishDueSQL, map[string]any{
+		"published": domain.PublicationStatePublished,
+		"scheduled": domain.PublicationStateScheduled,
+		"now":       now,
+		"limit":     limit,
+	}).Scan(&videos).Error
+	return videos, err
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicationDraftsHiddenFromListings(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPublicationRepository(db)
+
+	published := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), published))
+	draft := createTestVideo()
+	draft.UserID = published.UserID
+	draft.PublicationState = domain.PublicationStateDraft
+	require.NoError(t, videoRepo.Create(context.Background(), draft))
+
+	public, err := videoRepo.GetPublicVideos(context.Background(), uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, public, 1)
+	assert.Equal(t, published.ID, public[0].ID)
+
+	listed, err := videoRepo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: published.UserID, Visibilities: allVisibilities, OwnerListing: true, Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, published.ID, listed[0].ID)
+
+	drafts, err := repo.ListDrafts(context.Background(), published.UserID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, draft.ID, drafts[0].ID)
+
+	count, err := repo.CountDrafts(context.Background(), published.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestPublicationSetPublication(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPublicationRepository(db)
+
+	video := createTestVideo()
+	video.PublicationState = domain.PublicationStateDraft
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	publishAt := time.Now().Add(time.Hour)
+	video.PublicationState = domain.PublicationStateScheduled
+	video.PublishAt = &publishAt
+	require.NoError(t, repo.SetPublication(context.Background(), video, domain.PublicationStateDraft))
+
+	err := repo.SetPublication(context.Background(), video, domain.PublicationStateDraft)
+	assert.ErrorIs(t, err, domain.ErrVideoModified)
+
+	stored, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PublicationStateScheduled, stored.PublicationState)
+	require.NotNil(t, stored.PublishAt)
+	assert.WithinDuration(t, publishAt, *stored.PublishAt, time.Millisecond)
+}
+
+func TestPublicationPublishDue(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewPublicationRepository(db)
+
+	due := createTestVideo()
+	dueAt := time.Now().Add(-time.Minute)
+	due.PublicationState = domain.PublicationStateScheduled
+	due.PublishAt = &dueAt
+	require.NoError(t, videoRepo.Create(context.Background(), due))
+
+	later := createTestVideo()
+	laterAt := time.Now().Add(time.Hour)
+	later.PublicationState = domain.PublicationStateScheduled
+	later.PublishAt = &laterAt
+	require.NoError(t, videoRepo.Create(context.Background(), later))
+
+	published, err := repo.PublishDue(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	require.Len(t, published, 1)
+	assert.Equal(t, due.ID, published[0].ID)
+	assert.True(t, published[0].Published())
+
+	published, err = repo.PublishDue(context.Background(), time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, published, "a video is only published once")
+}
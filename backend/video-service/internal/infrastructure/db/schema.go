@@ -0,0 +1,85 @@
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// AutoMigrate cannot express tsvector columns, GIN or expression indexes or
+// data migrations, so they are applied here once the tables exist. Every
+// statement must be idempotent.
+var schemaStatements = []string{
+	`ALTER TABLE videos ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE INDEX IF NOT EXISTS idx_videos_search_vector ON videos USING GIN (search_vector)`,
+	`UPDATE videos SET search_vector = ` + searchVectorExpression + ` WHERE videos.search_vector IS NULL`,
+	migrateIsPublicSQL("videos"),
+	migrateIsPublicSQL("upload_sessions"),
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unread_group
+		ON notifications (user_id, type, ` + notificationVideoKey + `) WHERE read_at IS NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_videos_published_at ON videos ((COALESCE(publish_at, created_at)) DESC, id DESC)`,
+	`CREATE INDEX IF NOT EXISTS idx_videos_user_published_at
+		ON videos (user_id, (COALESCE(publish_at, created_at)) DESC, id DESC)`,
+	`CREATE INDEX IF NOT EXISTS idx_user_video_views_user_created ON user_video_views (user_id, created_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_user_video_views_ip_created ON user_video_views (client_ip, created_at)
+		WHERE client_ip <> ''`,
+	`CREATE INDEX IF NOT EXISTS idx_user_video_likes_video ON user_video_likes (video_id)`,
+	dropDuplicateLikesSQL,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_user_video_likes_user_video ON user_video_likes (user_id, video_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_user_video_views_video ON user_video_views (video_id)`,
+	backfillCounterSQL("view_count", "user_video_views", "AND flag_reason = ''"),
+	backfillCounterSQL("like_count", "user_video_likes", ""),
+}
+
+// dropDuplicateLikesSQL keeps the first of any likes that concurrent requests
+// recorded twice, so the unique index can be built. The like_count backfill
+// then corrects the counters they inflated.
+const dropDuplicateLikesSQL = `DELETE FROM user_video_likes WHERE id IN (
+	SELECT id FROM (
+		SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, video_id ORDER BY created_at, id) AS position
+		FROM user_video_likes) AS ranked
+	WHERE ranked.position > 1)`
+
+// backfillCounterSQL corrects counters written before the view and like
+// repositories maintained them. Rows that already match are left alone.
+func backfillCounterSQL(column, table, filter string) string {
+	return fmt.Sprintf(`UPDATE videos SET %[1]s = counted.total
+FROM (SELECT video_id, COUNT(*) AS total FROM %[2]s WHERE TRUE %[3]s GROUP BY video_id) AS counted
+WHERE videos.id = counted.video_id AND videos.%[1]s <> counted.total`, column, table, filter)
+}
+
+// migrateIsPublicSQL carries the old is_public flag over to the visibility
+// column, which AutoMigrate has already added with a 'public' default, and
+// then drops it.
+func migrateIsPublicSQL(table string) string {
+	return fmt.Sprintf(`DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = '%[1]s' AND column_name = 'is_public') THEN
+		UPDATE %[1]s SET visibility = 'private' WHERE is_public IS NOT TRUE;
+		ALTER TABLE %[1]s DROP COLUMN is_public;
+	END IF;
+END $$`, table)
+}
+
+// Title and hashtags rank above the description; the 'simple' configuration
+// is used because captions are written in many languages.
+const searchVectorExpression = `
+	setweight(to_tsvector('simple', coalesce(videos.title, '')), 'A') ||
+	setweight(to_tsvector('simple', coalesce((
+		SELECT string_agg(hashtags.name, ' ')
+		FROM video_hashtags
+		JOIN hashtags ON hashtags.id = video_hashtags.hashtag_id
+		WHERE video_hashtags.video_id = videos.id), '')), 'A') ||
+	setweight(to_tsvector('simple', coalesce(videos.description, '')), 'B')`
+
+const refreshSearchVectorSQL = `UPDATE videos SET search_vector = ` + searchVectorExpression + ` WHERE videos.id = ?`
+
+func applySchema(db *gorm.DB) error {
+	for _, statement := range schemaStatements {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to apply schema: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const shareStatsSelect = "COUNT(DISTINCT video_shares.id) AS shares, COUNT(share_clicks.id) AS clicks"
+
+type shareRepository struct {
+	db *gorm.DB
+}
+
+func NewShareRepository(db *gorm.DB) domain.ShareRepository {
+	return &shareRepository{db: db}
+}
+
+func (repository *shareRepository) Create(ctx context.Context, share *domain.VideoShare) (int64, error) {
+	share.ID = uuid.New()
+	share.CreatedAt = time.Now()
+
+	var shareCount int64
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(share).Error; err != nil {
+			return err
+		}
+
+		return tx.Raw("UPDATE videos SET share_count = share_count + 1 WHERE id = ? RETURNING share_count",
+			share.VideoID).Scan(&shareCount).Error
+	})
+
+	return shareCount, translateError(err, nil)
+}
+
+func (repository *shareRepository) GetByCode(ctx context.Context, code string) (*domain.VideoShare, error) {
+	var share domain.VideoShare
+	err := withTx(ctx, repository.db).Where("code = ?", code).First(&share).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrShareLinkNotFound)
+	}
+	return &share, nil
+}
+
+func (repository *shareRepository) RecordClick(ctx context.Context, click *domain.ShareClick) error {
+	click.ID = uuid.New()
+	click.CreatedAt = time.Now()
+	return withTx(ctx, repository.db).Create(click).Error
+}
+
+func (repository *shareRepository) GetAnalytics(ctx context.Context, videoID uuid.UUID,
+	topSharers int) (*domain.ShareAnalytics, error) {
+
+	analytics := &domain.ShareAnalytics{}
+	err := repository.sharesWithClicks(ctx, videoID).
+		Select("video_shares.channel, " + shareStatsSelect).
+		Group("video_shares.channel").
+		Order("shares DESC").
+		Scan(&analytics.Channels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = repository.sharesWithClicks(ctx, videoID).
+		Select("video_shares.user_id, " + shareStatsSelect).
+		Group("video_shares.user_id").
+		Order("clicks DESC, shares DESC").
+		Limit(topSharers).
+		Scan(&analytics.TopSharers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range analytics.Channels {
+		analytics.TotalShares += channel.Shares
+		analytics.TotalClicks += channel.Clicks
+	}
+
+	return analytics, nil
+}
+
+func (repository *shareRepository) sharesWithClicks(ctx context.Context, videoID uuid.UUID) *gorm.DB {
+	return withTx(ctx, repository.db).
+		Table("video_shares").
+		Joins("LEFT JOIN share_clicks ON share_clicks.share_id = video_shares.id").
+		Where("video_shares.video_id = ?", videoID)
+}
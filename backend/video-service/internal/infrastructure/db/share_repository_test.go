@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestShare(videoID uuid.UUID, channel domain.ShareChannel) *domain.VideoShare {
+	return &domain.VideoShare{
+		VideoID: videoID,
+		UserID:  uuid.New(),
+		Channel: channel,
+		Code:    fmt.Sprintf("c%d", uuid.New().ID()),
+	}
+}
+
+func TestShareCreateIncrementsShareCount(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewShareRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	shareCount, err := repo.Create(context.Background(), createTestShare(video.ID, domain.ShareChannelCopyLink))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), shareCount)
+
+	shareCount, err = repo.Create(context.Background(), createTestShare(video.ID, domain.ShareChannelExternalApp))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), shareCount)
+
+	stored, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stored.ShareCount)
+}
+
+func TestShareCreate_DuplicateCodeIsConflict(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewShareRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	share := createTestShare(video.ID, domain.ShareChannelCopyLink)
+	_, err := repo.Create(context.Background(), share)
+	require.NoError(t, err)
+
+	duplicate := createTestShare(video.ID, domain.ShareChannelCopyLink)
+	duplicate.Code = share.Code
+	_, err = repo.Create(context.Background(), duplicate)
+
+	var conflict *domain.ConflictError
+	assert.ErrorAs(t, err, &conflict)
+}
+
+func TestShareGetByCode_NotFound(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewShareRepository(db)
+
+	_, err := repo.GetByCode(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrShareLinkNotFound)
+}
+
+func TestShareGetAnalytics(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewShareRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	popular := createTestShare(video.ID, domain.ShareChannelDirectMessage)
+	_, err := repo.Create(context.Background(), popular)
+	require.NoError(t, err)
+	quiet := createTestShare(video.ID, domain.ShareChannelCopyLink)
+	_, err = repo.Create(context.Background(), quiet)
+	require.NoError(t, err)
+
+	viewerID := uuid.New()
+	require.NoError(t, repo.RecordClick(context.Background(), &domain.ShareClick{ShareID: popular.ID, ViewerID: &viewerID}))
+	require.NoError(t, repo.RecordClick(context.Background(), &domain.ShareClick{ShareID: popular.ID}))
+
+	found, err := repo.GetByCode(context.Background(), popular.Code)
+	require.NoError(t, err)
+	assert.Equal(t, popular.ID, found.ID)
+
+	analytics, err := repo.GetAnalytics(context.Background(), video.ID, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), analytics.TotalShares)
+	assert.Equal(t, int64(2), analytics.TotalClicks)
+	assert.Len(t, analytics.Channels, 2)
+	require.Len(t, analytics.TopSharers, 2)
+	assert.Equal(t, popular.UserID, analytics.TopSharers[0].UserID)
+	assert.Equal(t, int64(2), analytics.TopSharers[0].Clicks)
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) domain.TagRepository {
+	return &tagRepository{db: db}
+}
+
+func (repository *tagRepository) ReplaceVideoTags(ctx context.Context, videoID uuid.UUID,
+	hashtags []string, mentions []*domain.VideoMention) error {
+
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&domain.VideoHashtag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("video_id = ?", videoID).Delete(&domain.VideoMention{}).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if len(hashtags) > 0 {
+			tags := make([]*domain.Hashtag, len(hashtags))
+			for i, name := range hashtags {
+				tags[i] = &domain.Hashtag{ID: uuid.New(), Name: name, CreatedAt: now}
+			}
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "name"}},
+				DoNothing: true,
+			}).Create(&tags).Error
+			if err != nil {
+				return err
+			}
+
+			var ids []uuid.UUID
+			err = tx.Model(&domain.Hashtag{}).Where("name IN ?", hashtags).Pluck("id", &ids).Error
+			if err != nil {
+				return err
+			}
+
+			links := make([]*domain.VideoHashtag, len(ids))
+			for i, id := range ids {
+				links[i] = &domain.VideoHashtag{VideoID: videoID, HashtagID: id, CreatedAt: now}
+			}
+			if err := tx.Create(&links).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(mentions) > 0 {
+			for _, mention := range mentions {
+				mention.VideoID = videoID
+				mention.CreatedAt = now
+			}
+			if err := tx.Create(&mentions).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Exec(refreshSearchVectorSQL, videoID).Error
+	})
+}
+
+func (repository *tagRepository) GetVideosByHashtag(ctx context.Context, name string,
+	sort domain.HashtagSort, viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	query := repository.hashtagVideos(ctx, name, viewerID)
+
+	switch sort {
+	case domain.HashtagSortPopular:
+		query = query.Order("videos.view_count DESC").Order("videos.like_count DESC")
+	case domain.HashtagSortRecent, "":
+	default:
+		return nil, domain.ErrInvalidHashtagSort
+	}
+
+	var videos []*domain.Video
+	err := query.
+		Select("videos.*").
+		Order(publishedAtSQL + " DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *tagRepository) CountVideosByHashtag(ctx context.Context, name string,
+	viewerID uuid.UUID) (int64, error) {
+
+	var count int64
+	err := repository.hashtagVideos(ctx, name, viewerID).Count(&count).Error
+	return count, err
+}
+
+func (repository *tagRepository) GetHashtagStats(ctx context.Context, name string) (
+	*domain.HashtagStats, error) {
+
+	stats := domain.HashtagStats{Name: name}
+	err := repository.hashtagVideos(ctx, name, uuid.Nil).
+		Select("COUNT(videos.id) AS video_count, COALESCE(SUM(videos.view_count), 0) AS total_views").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (repository *tagRepository) GetVideosByMention(ctx context.Context, userID, viewerID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.mentionedVideos(ctx, userID, viewerID).
+		Select("videos.*").
+		Order(publishedAtSQL + " DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *tagRepository) CountVideosByMention(ctx context.Context, userID, viewerID uuid.UUID) (
+	int64, error) {
+
+	var count int64
+	err := repository.mentionedVideos(ctx, userID, viewerID).Count(&count).Error
+	return count, err
+}
+
+func (repository *tagRepository) GetVideoMentions(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.VideoMention, error) {
+
+	var mentions []*domain.VideoMention
+	err := withTx(ctx, repository.db).
+		Where("video_id = ?", videoID).
+		Order("username ASC").
+		Find(&mentions).Error
+	return mentions, err
+}
+
+func (repository *tagRepository) hashtagVideos(ctx context.Context, name string, viewerID uuid.UUID) *gorm.DB {
+	return repository.publicVideos(ctx, viewerID).
+		Joins("JOIN video_hashtags ON video_hashtags.video_id = videos.id").
+		Joins("JOIN hashtags ON hashtags.id = video_hashtags.hashtag_id").
+		Where("hashtags.name = ?", name)
+}
+
+func (repository *tagRepository) mentionedVideos(ctx context.Context, userID, viewerID uuid.UUID) *gorm.DB {
+	return repository.publicVideos(ctx, viewerID).
+		Joins("JOIN video_mentions ON video_mentions.video_id = videos.id").
+		Where("video_mentions.user_id = ?", userID)
+}
+
+func (repository *tagRepository) publicVideos(ctx context.Context, viewerID uuid.UUID) *gorm.DB {
+	return notBlockedWith(withTx(ctx, repository.db), viewerID).
+		Model(&domain.Video{}).
+		Where("videos.visibility = ?", domain.VisibilityPublic).
+		Where("videos.processing_status = ?", domain.ProcessingStatusReady).
+		Where("videos.moderation_state = ?", domain.ModerationStateActive).
+		Where("videos.publication_state = ?", domain.PublicationStatePublished)
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagReplaceVideoTagsAndListByHashtag(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	repo := NewTagRepository(db)
+	tag := fmt.Sprintf("tag%d", uuid.New().ID())
+
+	popular := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), popular))
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), popular.ID, []string{tag, "other" + tag}, nil))
+
+	quiet := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), quiet))
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), quiet.ID, []string{tag}, nil))
+
+	recordViews(t, viewRepo, popular.ID, 3)
+	recordViews(t, viewRepo, quiet.ID, 1)
+
+	recent, err := repo.GetVideosByHashtag(context.Background(), tag, domain.HashtagSortRecent, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{quiet.ID, popular.ID}, videoIDs(recent))
+
+	byViews, err := repo.GetVideosByHashtag(context.Background(), tag, domain.HashtagSortPopular, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{popular.ID, quiet.ID}, videoIDs(byViews))
+
+	stats, err := repo.GetHashtagStats(context.Background(), tag)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.VideoCount)
+	assert.Equal(t, int64(4), stats.TotalViews)
+
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), popular.ID, nil, nil))
+
+	stats, err = repo.GetHashtagStats(context.Background(), tag)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.VideoCount)
+}
+
+func TestTagGetVideosByMention(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewTagRepository(db)
+	mentioned := uuid.New()
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+
+	mentions := []*domain.VideoMention{{UserID: mentioned, Username: "alice"}}
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), video.ID, nil, mentions))
+
+	videos, err := repo.GetVideosByMention(context.Background(), mentioned, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, videos, 1)
+	assert.Equal(t, video.ID, videos[0].ID)
+
+	count, err := repo.CountVideosByMention(context.Background(), mentioned, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	stored, err := repo.GetVideoMentions(context.Background(), video.ID)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	assert.Equal(t, mentioned, stored[0].UserID)
+}
+
+func TestTagListingsLeaveOutBlockedOwnersBeforePaging(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	blockRepo := NewBlockRepository(db)
+	repo := NewTagRepository(db)
+	tag := fmt.Sprintf("tag%d", uuid.New().ID())
+	viewerID := uuid.New()
+	mentioned := uuid.New()
+	mentions := []*domain.VideoMention{{UserID: mentioned, Username: "alice"}}
+
+	visible := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), visible))
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), visible.ID, []string{tag}, mentions))
+	// The blocked owner's video is the newest, so it would fill the first page.
+	blocked := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), blocked))
+	require.NoError(t, repo.ReplaceVideoTags(context.Background(), blocked.ID, []string{tag}, mentions))
+	require.NoError(t, blockRepo.Block(context.Background(), viewerID, blocked.UserID))
+
+	videos, err := repo.GetVideosByHashtag(context.Background(), tag, domain.HashtagSortRecent, viewerID, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{visible.ID}, videoIDs(videos))
+
+	count, err := repo.CountVideosByHashtag(context.Background(), tag, viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	videos, err = repo.GetVideosByMention(context.Background(), mentioned, viewerID, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{visible.ID}, videoIDs(videos))
+
+	count, err = repo.CountVideosByMention(context.Background(), mentioned, viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	stats, err := repo.GetHashtagStats(context.Background(), tag)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.VideoCount)
+}
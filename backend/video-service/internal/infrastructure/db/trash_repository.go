@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type trashRepository struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) domain.TrashRepository {
+	return &trashRepository{db: db}
+}
+
+func (repository *trashRepository) trashed(ctx context.Context) *gorm.DB {
+	return withTx(ctx, repository.db).
+		Unscoped().
+		Model(&domain.Video{}).
+		Where("deleted_at IS NOT NULL")
+}
+
+func (repository *trashRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Video, error) {
+	var video domain.Video
+	err := repository.trashed(ctx).Where("id = ?", id).First(&video).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrVideoNotFound)
+	}
+	return &video, nil
+}
+
+func (repository *trashRepository) ListByUserID(ctx context.Context, userID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.trashed(ctx).
+		Where("user_id = ?", userID).
+		Limit(limit).
+		Offset(offset).
+		Order("deleted_at DESC").
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *trashRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := repository.trashed(ctx).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (repository *trashRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	result := repository.trashed(ctx).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (repository *trashRepository) ListExpired(ctx context.Context, deletedBefore time.Time, limit int) (
+	[]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.trashed(ctx).
+		Where("deleted_at < ?", deletedBefore).
+		Order("deleted_at").
+		Limit(limit).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+// Purge removes the video row first so that a video restored in the
+// meantime keeps everything that belongs to it. The moderation case stays
+// behind as part of the audit trail, next to the moderation actions.
+func (repository *trashRepository) Purge(ctx context.Context, id uuid.UUID) (bool, error) {
+	purged := false
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Delete(&domain.Video{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		purged = true
+
+		shareIDs := tx.Model(&domain.VideoShare{}).Select("id").Where("video_id = ?", id)
+		if err := tx.Where("share_id IN (?)", shareIDs).Delete(&domain.ShareClick{}).Error; err != nil {
+			return err
+		}
+		notificationIDs := tx.Model(&domain.Notification{}).Select("id").Where("video_id = ?", id)
+		err := tx.Where("notification_id IN (?)", notificationIDs).Delete(&domain.NotificationActor{}).Error
+		if err != nil {
+			return err
+		}
+
+		for _, model := range []any{
+			&domain.UserVideoLike{},
+			&domain.UserVideoView{},
+			&domain.VideoHashtag{},
+			&domain.VideoMention{},
+			&domain.VideoShare{},
+			&domain.VideoComment{},
+			&domain.Favorite{},
+			&domain.CollectionVideo{},
+			&domain.TrendingVideo{},
+			&domain.VideoRendition{},
+			&domain.Job{},
+			&domain.VideoReport{},
+			&domain.VideoPin{},
+			&domain.PlaylistVideo{},
+			&domain.Notification{},
+			&domain.VideoDailyStats{},
+			&domain.VideoRetention{},
+		} {
+			if err := tx.Where("video_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return purged, err
+}
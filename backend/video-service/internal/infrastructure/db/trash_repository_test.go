@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashListAndRestore(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewTrashRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	kept := createTestVideo()
+	kept.UserID = video.UserID
+	require.NoError(t, videoRepo.Create(context.Background(), kept))
+
+	require.NoError(t, videoRepo.Delete(context.Background(), video.ID))
+
+	listed, err := videoRepo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: video.UserID, Visibilities: allVisibilities, OwnerListing: true, Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, kept.ID, listed[0].ID)
+
+	trashed, err := repo.ListByUserID(context.Background(), video.UserID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, video.ID, trashed[0].ID)
+	assert.True(t, trashed[0].DeletedAt.Valid)
+
+	count, err := repo.CountByUserID(context.Background(), video.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = repo.GetByID(context.Background(), kept.ID)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+
+	require.NoError(t, repo.Restore(context.Background(), video.ID))
+	assert.ErrorIs(t, repo.Restore(context.Background(), video.ID), domain.ErrVideoNotFound)
+
+	restored, err := videoRepo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.False(t, restored.DeletedAt.Valid)
+}
+
+func TestTrashPurge(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	likeRepo := NewUserVideoLikeRepository(db)
+	repo := NewTrashRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	likeVideo(t, likeRepo, &domain.UserVideoLike{
+		UserID:  uuid.New(),
+		VideoID: video.ID,
+	})
+	now := time.Now()
+	day := now.Truncate(24 * time.Hour)
+	require.NoError(t, db.Create(&domain.ModerationCase{
+		VideoID: video.ID, Status: domain.CaseStatusResolved, Severity: 1, ReportCount: 1,
+		FirstReportedAt: now, LastReportedAt: now,
+	}).Error)
+	require.NoError(t, db.Create(&domain.Notification{
+		ID: uuid.New(), UserID: video.UserID, Type: domain.NotificationTypeLike, VideoID: &video.ID,
+		LastActorID: uuid.New(), ActorCount: 1,
+	}).Error)
+	require.NoError(t, db.Create(&domain.VideoDailyStats{VideoID: video.ID, Day: day, UserID: video.UserID}).Error)
+	require.NoError(t, db.Create(&domain.VideoRetention{VideoID: video.ID, Day: day, Percent: 50, Viewers: 1}).Error)
+
+	purged, err := repo.Purge(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.False(t, purged, "videos outside the trash are never purged")
+
+	require.NoError(t, videoRepo.Delete(context.Background(), video.ID))
+
+	expired, err := repo.ListExpired(context.Background(), time.Now().Add(time.Minute), 100)
+	require.NoError(t, err)
+	assert.Contains(t, videoIDs(expired), video.ID)
+
+	expired, err = repo.ListExpired(context.Background(), time.Now().Add(-time.Hour), 100)
+	require.NoError(t, err)
+	assert.NotContains(t, videoIDs(expired), video.ID)
+
+	purged, err = repo.Purge(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.True(t, purged)
+
+	_, err = repo.GetByID(context.Background(), video.ID)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+
+	likes, err := likeRepo.CountByVideoID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Zero(t, likes)
+
+	for _, model := range []any{&domain.Notification{}, &domain.VideoDailyStats{}, &domain.VideoRetention{}} {
+		var count int64
+		require.NoError(t, db.Model(model).Where("video_id = ?", video.ID).Count(&count).Error)
+		assert.Zero(t, count, "%T", model)
+	}
+	var cases int64
+	require.NoError(t, db.Model(&domain.ModerationCase{}).Where("video_id = ?", video.ID).Count(&cases).Error)
+	assert.Equal(t, int64(1), cases, "the moderation case is kept for the audit trail")
+}
+
+func videoIDs(videos []*domain.Video) []uuid.UUID {
+	ids := make([]uuid.UUID, len(videos))
+	for i, video := range videos {
+		ids[i] = video.ID
+	}
+	return ids
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const refreshTrendingVideosSQL = `
+INSERT INTO trending_videos (window_name, video_id, region, score, computed_at)
+SELECT @window, videos.id, videos.region,
+	SUM(events.weight * EXP(-LN(2) * EXTRACT(EPOCH FROM (@now - events.created_at)) / @half_life)),
+	@now
+FROM (
+	SELECT video_id, created_at, CAST(@view_weight AS double precision) AS weight
+	FROM user_video_views WHERE created_at >= @since AND created_at <= @now AND flag_reason = ''
+	UNION ALL
+	SELECT video_id, created_at, CAST(@like_weight AS double precision) AS weight
+	FROM user_video_likes WHERE created_at >= @since AND created_at <= @now
+	UNION ALL
+	SELECT video_id, created_at, CAST(@comment_weight AS double precision) AS weight
+	FROM video_comments WHERE created_at >= @since AND created_at <= @now
+	UNION ALL
+	SELECT video_id, created_at, CAST(@share_weight AS double precision) AS weight
+	FROM video_shares WHERE created_at >= @since AND created_at <= @now
+) AS events
+JOIN videos ON videos.id = events.video_id
+WHERE videos.visibility = @visibility AND videos.processing_status = @status
+	AND videos.moderation_state = @moderation_state AND videos.publication_state = @publication_state
+	AND videos.deleted_at IS NULL
+GROUP BY videos.id, videos.region`
+
+const refreshTrendingHashtagsSQL = `
+INSERT INTO trending_hashtags (window_name, region, hashtag_id, score, computed_at)
+SELECT @window, trending_videos.region, video_hashtags.hashtag_id, SUM(trending_videos.score), @now
+FROM trending_videos
+JOIN video_hashtags ON video_hashtags.video_id = trending_videos.video_id
+WHERE trending_videos.window_name = @window AND trending_videos.region <> ''
+GROUP BY trending_videos.region, video_hashtags.hashtag_id
+UNION ALL
+SELECT @window, '', video_hashtags.hashtag_id, SUM(trending_videos.score), @now
+FROM trending_videos
+JOIN video_hashtags ON video_hashtags.video_id = trending_videos.video_id
+WHERE trending_videos.window_name = @window
+GROUP BY video_hashtags.hashtag_id`
+
+type trendingRepository struct {
+	db *gorm.DB
+}
+
+func NewTrendingRepository(db *gorm.DB) domain.TrendingRepository {
+	return &trendingRepository{db: db}
+}
+
+func (repository *trendingRepository) Refresh(ctx context.Context, refresh domain.TrendingRefresh) error {
+	args := map[string]any{
+		"window":            refresh.Window,
+		"since":             refresh.Since,
+		"now":               refresh.Now,
+		"half_life":         refresh.HalfLife.Seconds(),
+		"view_weight":       refresh.Weights.View,
+		"like_weight":       refresh.Weights.Like,
+		"comment_weight":    refresh.Weights.Comment,
+		"share_weight":      refresh.Weights.Share,
+		"status":            domain.ProcessingStatusReady,
+		"visibility":        domain.VisibilityPublic,
+		"moderation_state":  domain.ModerationStateActive,
+		"publication_state": domain.PublicationStatePublished,
+	}
+
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		// Without the lock, a second refresh of the window inserts rows the
+		// first has not committed yet and fails on the primary key.
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "trending_refresh:"+string(refresh.Window)).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("window_name = ?", refresh.Window).Delete(&domain.TrendingVideo{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("window_name = ?", refresh.Window).Delete(&domain.TrendingHashtagScore{}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec(refreshTrendingVideosSQL, args).Error; err != nil {
+			return err
+		}
+		return tx.Exec(refreshTrendingHashtagsSQL, args).Error
+	})
+}
+
+func (repository *trendingRepository) RecordComment(ctx context.Context, comment *domain.VideoComment) error {
+	comment.ID = uuid.New()
+	return withTx(ctx, repository.db).Create(comment).Error
+}
+
+func (repository *trendingRepository) GetTrendingVideos(ctx context.Context, window domain.TrendingWindow,
+	region string, viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	query := withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Select("videos.*").
+		Joins("JOIN trending_videos ON trending_videos.video_id = videos.id").
+		Where("trending_videos.window_name = ?", window).
+		Where("videos.visibility = ?", domain.VisibilityPublic).
+		Where("videos.processing_status = ?", domain.ProcessingStatusReady).
+		Where("videos.moderation_state = ?", domain.ModerationStateActive).
+		Where("videos.publication_state = ?", domain.PublicationStatePublished)
+	if region != "" {
+		query = query.Where("trending_videos.region = ?", region)
+	}
+
+	var videos []*domain.Video
+	err := notBlockedWith(query, viewerID).
+		Order("trending_videos.score DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&videos).Error
+
+	return videos, err
+}
+
+func (repository *trendingRepository) GetTrendingHashtags(ctx context.Context, window domain.TrendingWindow,
+	region string, limit int) ([]*domain.TrendingHashtag, error) {
+
+	var hashtags []*domain.TrendingHashtag
+	err := withTx(ctx, repository.db).
+		Model(&domain.TrendingHashtagScore{}).
+		Select("hashtags.name, trending_hashtags.score").
+		Joins("JOIN hashtags ON hashtags.id = trending_hashtags.hashtag_id").
+		Where("trending_hashtags.window_name = ?", window).
+		Where("trending_hashtags.region = ?", region).
+		Order("trending_hashtags.score DESC").
+		Limit(limit).
+		Scan(&hashtags).Error
+
+	return hashtags, err
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingRefreshAndList(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	likeRepo := NewUserVideoLikeRepository(db)
+	tagRepo := NewTagRepository(db)
+	repo := NewTrendingRepository(db)
+
+	region := strings.ToUpper(uuid.NewString()[:6])
+	tag := "trend" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+
+	hot := createTestVideo()
+	hot.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), hot))
+	require.NoError(t, tagRepo.ReplaceVideoTags(context.Background(), hot.ID, []string{tag}, nil))
+
+	cold := createTestVideo()
+	cold.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), cold))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, viewRepo.Create(context.Background(), &domain.UserVideoView{UserID: uuid.New(), VideoID: hot.ID}))
+	}
+	likeVideo(t, likeRepo, &domain.UserVideoLike{UserID: uuid.New(), VideoID: hot.ID})
+	require.NoError(t, viewRepo.Create(context.Background(), &domain.UserVideoView{UserID: uuid.New(), VideoID: cold.ID}))
+
+	now := time.Now().Add(time.Second)
+	err := repo.Refresh(context.Background(), domain.TrendingRefresh{
+		Window:   domain.TrendingWindowDay,
+		Since:    now.Add(-24 * time.Hour),
+		Now:      now,
+		HalfLife: 6 * time.Hour,
+		Weights:  domain.TrendingWeights{View: 1, Like: 3, Share: 5},
+	})
+	require.NoError(t, err)
+
+	videos, err := repo.GetTrendingVideos(context.Background(), domain.TrendingWindowDay, region, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, videos, 2)
+	assert.Equal(t, hot.ID, videos[0].ID)
+	assert.Equal(t, cold.ID, videos[1].ID)
+
+	hashtags, err := repo.GetTrendingHashtags(context.Background(), domain.TrendingWindowDay, region, 10)
+	require.NoError(t, err)
+	require.Len(t, hashtags, 1)
+	assert.Equal(t, tag, hashtags[0].Name)
+	assert.InDelta(t, 6, hashtags[0].Score, 0.1)
+}
+
+func TestTrendingRefreshCountsShares(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	shareRepo := NewShareRepository(db)
+	repo := NewTrendingRepository(db)
+
+	region := strings.ToUpper(uuid.NewString()[:6])
+	viewed := createTestVideo()
+	viewed.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), viewed))
+	shared := createTestVideo()
+	shared.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), shared))
+
+	recordViews(t, viewRepo, viewed.ID, 3)
+	_, err := shareRepo.Create(context.Background(), createTestShare(shared.ID, domain.ShareChannelCopyLink))
+	require.NoError(t, err)
+
+	now := time.Now().Add(time.Second)
+	refresh := domain.TrendingRefresh{
+		Window:   domain.TrendingWindowDay,
+		Since:    now.Add(-24 * time.Hour),
+		Now:      now,
+		HalfLife: 6 * time.Hour,
+		Weights:  domain.TrendingWeights{View: 1, Like: 3},
+	}
+	require.NoError(t, repo.Refresh(context.Background(), refresh))
+
+	videos, err := repo.GetTrendingVideos(context.Background(), domain.TrendingWindowDay, region, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{viewed.ID, shared.ID}, videoIDs(videos))
+
+	refresh.Weights.Share = 5
+	require.NoError(t, repo.Refresh(context.Background(), refresh))
+
+	videos, err = repo.GetTrendingVideos(context.Background(), domain.TrendingWindowDay, region, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{shared.ID, viewed.ID}, videoIDs(videos))
+}
+
+func TestTrendingRefreshCountsComments(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	repo := NewTrendingRepository(db)
+
+	region := strings.ToUpper(uuid.NewString()[:6])
+	viewed := createTestVideo()
+	viewed.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), viewed))
+	commented := createTestVideo()
+	commented.Region = region
+	require.NoError(t, videoRepo.Create(context.Background(), commented))
+
+	recordViews(t, viewRepo, viewed.ID, 3)
+	require.NoError(t, repo.RecordComment(context.Background(),
+		&domain.VideoComment{VideoID: commented.ID, UserID: uuid.New()}))
+
+	now := time.Now().Add(time.Second)
+	require.NoError(t, repo.Refresh(context.Background(), domain.TrendingRefresh{
+		Window:   domain.TrendingWindowDay,
+		Since:    now.Add(-24 * time.Hour),
+		Now:      now,
+		HalfLife: 6 * time.Hour,
+		Weights:  domain.TrendingWeights{View: 1, Comment: 4},
+	}))
+
+	videos, err := repo.GetTrendingVideos(context.Background(), domain.TrendingWindowDay, region, uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{commented.ID, viewed.ID}, videoIDs(videos))
+}
+
+func TestTrendingConcurrentRefreshes(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	repo := NewTrendingRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), video))
+	recordViews(t, NewUserVideoViewRepository(db), video.ID, 1)
+
+	now := time.Now().Add(time.Second)
+	refresh := domain.TrendingRefresh{
+		Window:   domain.TrendingWindowDay,
+		Since:    now.Add(-24 * time.Hour),
+		Now:      now,
+		HalfLife: 6 * time.Hour,
+		Weights:  domain.TrendingWeights{View: 1},
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = repo.Refresh(context.Background(), refresh)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type uploadSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadSessionRepository(db *gorm.DB) domain.UploadSessionRepository {
+	return &uploadSessionRepository{db: db}
+}
+
+func (repository *uploadSessionRepository) Create(ctx context.Context, session *domain.UploadSession) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	session.CreatedAt = time.Now()
+	session.UpdatedAt = session.CreatedAt
+	return withTx(ctx, repository.db).Create(session).Error
+}
+
+func (repository *uploadSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (
+	*domain.UploadSession, error) {
+
+	var session domain.UploadSession
+	err := withTx(ctx, repository.db).Where("id = ?", id).First(&session).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrUploadSessionNotFound)
+	}
+	return &session, nil
+}
+
+func (repository *uploadSessionRepository) GetByIDForUpdate(ctx context.Context, id uuid.UUID) (
+	*domain.UploadSession, error) {
+
+	var session domain.UploadSession
+	err := withTx(ctx, repository.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&session).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrUploadSessionNotFound)
+	}
+	return &session, nil
+}
+
+func (repository *uploadSessionRepository) UpdateReceivedSize(ctx context.Context, id uuid.UUID,
+	receivedSize int64) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.UploadSession{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"received_size": receivedSize,
+			"updated_at":    time.Now(),
+		}).Error
+}
+
+func (repository *uploadSessionRepository) MarkCompleted(ctx context.Context, id, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Model(&domain.UploadSession{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":     domain.UploadStatusCompleted,
+			"video_id":   videoID,
+			"updated_at": time.Now(),
+		}).Error
+}
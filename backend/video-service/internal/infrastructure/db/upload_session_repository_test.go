@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestUploadSession() *domain.UploadSession {
+	return &domain.UploadSession{
+		UserID:    uuid.New(),
+		Title:     "Test Upload",
+		Duration:  30,
+		FileName:  "clip.mp4",
+		Container: "mp4",
+		TotalSize: 1024,
+		Checksum:  "abc",
+		ObjectKey: "uploads/test.part",
+		Status:    domain.UploadStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestUploadSessionCreateAndGet(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUploadSessionRepository(db)
+	session := createTestUploadSession()
+
+	err := repo.Create(context.Background(), session)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, session.ID)
+
+	found, err := repo.GetByID(context.Background(), session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.UserID, found.UserID)
+	assert.Equal(t, domain.UploadStatusPending, found.Status)
+}
+
+func TestUploadSessionUpdateReceivedSize(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUploadSessionRepository(db)
+	session := createTestUploadSession()
+	require.NoError(t, repo.Create(context.Background(), session))
+
+	err := repo.UpdateReceivedSize(context.Background(), session.ID, 512)
+	require.NoError(t, err)
+
+	found, err := repo.GetByID(context.Background(), session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(512), found.ReceivedSize)
+}
+
+func TestUploadSessionMarkCompleted(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUploadSessionRepository(db)
+	session := createTestUploadSession()
+	require.NoError(t, repo.Create(context.Background(), session))
+
+	videoID := uuid.New()
+	err := repo.MarkCompleted(context.Background(), session.ID, videoID)
+	require.NoError(t, err)
+
+	found, err := repo.GetByID(context.Background(), session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.UploadStatusCompleted, found.Status)
+	require.NotNil(t, found.VideoID)
+	assert.Equal(t, videoID, *found.VideoID)
+}
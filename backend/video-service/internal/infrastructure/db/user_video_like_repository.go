@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userVideoLikeRepository struct {
@@ -17,22 +18,44 @@ func NewUserVideoLikeRepository(db *gorm.DB) domain.UserVideoLikeRepository {
 	return &userVideoLikeRepository{db: db}
 }
 
-func (repository *userVideoLikeRepository) Create(ctx context.Context, like *domain.UserVideoLike) error {
+// Create and Delete keep videos.like_count in step with the like rows, so
+// that popularity sorts can read it without counting. The unique index on
+// (user_id, video_id) turns a concurrent second like into a no-op.
+func (repository *userVideoLikeRepository) Create(ctx context.Context, like *domain.UserVideoLike) (bool, error) {
 	like.ID = uuid.New()
 	like.CreatedAt = time.Now()
-	return repository.db.WithContext(ctx).Create(like).Error
+
+	var created bool
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+		if result.Error != nil || result.RowsAffected != 1 {
+			return result.Error
+		}
+		created = true
+		return adjustLikeCount(tx, like.VideoID, 1)
+	})
+	return created, err
 }
 
 func (repository *userVideoLikeRepository) Delete(ctx context.Context, userID, videoID uuid.UUID) error {
-	return repository.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Where("video_id = ?", videoID).
-		Delete(&domain.UserVideoLike{}).Error
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ?", userID).
+			Where("video_id = ?", videoID).
+			Delete(&domain.UserVideoLike{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return adjustLikeCount(tx, videoID, -result.RowsAffected)
+	})
+}
+
+func adjustLikeCount(tx *gorm.DB, videoID uuid.UUID, delta int64) error {
+	return tx.Exec("UPDATE videos SET like_count = like_count + ? WHERE id = ?", delta, videoID).Error
 }
 
 func (repository *userVideoLikeRepository) Exists(ctx context.Context, userID, videoID uuid.UUID) (bool, error) {
 	var count int64
-	err := repository.db.WithContext(ctx).
+	err := withTx(ctx, repository.db).
 		Model(&domain.UserVideoLike{}).
 		Where("user_id = ? AND video_id = ?", userID, videoID).
 		Count(&count).Error
@@ -42,10 +65,33 @@ func (repository *userVideoLikeRepository) Exists(ctx context.Context, userID, v
 
 func (repository *userVideoLikeRepository) CountByVideoID(ctx context.Context, videoID uuid.UUID) (int64, error) {
 	var count int64
-	err := repository.db.WithContext(ctx).
+	err := withTx(ctx, repository.db).
 		Model(&domain.UserVideoLike{}).
 		Where("video_id = ?", videoID).
 		Count(&count).Error
 
 	return count, err
 }
+
+func (repository *userVideoLikeRepository) LikedAmong(ctx context.Context, userID uuid.UUID,
+	videoIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	liked := make(map[uuid.UUID]bool)
+	if len(videoIDs) == 0 {
+		return liked, nil
+	}
+
+	var likedIDs []uuid.UUID
+	err := withTx(ctx, repository.db).
+		Model(&domain.UserVideoLike{}).
+		Where("user_id = ? AND video_id IN ?", userID, videoIDs).
+		Pluck("video_id", &likedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, videoID := range likedIDs {
+		liked[videoID] = true
+	}
+	return liked, nil
+}
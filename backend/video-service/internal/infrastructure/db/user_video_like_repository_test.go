@@ -17,6 +17,14 @@ func createTestLike() *domain.UserVideoLike {
 	}
 }
 
+// likeVideo records a like that the test expects to be new.
+func likeVideo(t *testing.T, repo domain.UserVideoLikeRepository, like *domain.UserVideoLike) {
+	t.Helper()
+	created, err := repo.Create(context.Background(), like)
+	require.NoError(t, err)
+	require.True(t, created)
+}
+
 func TestLikeCreate(t *testing.T) {
 	db, cleanDb := setupTestDB(t)
 	defer cleanDb()
@@ -24,11 +32,35 @@ func TestLikeCreate(t *testing.T) {
 	repo := NewUserVideoLikeRepository(db)
 	like := createTestLike()
 
-	err := repo.Create(context.Background(), like)
+	created, err := repo.Create(context.Background(), like)
 	require.NoError(t, err)
+	assert.True(t, created)
 	assert.NotEqual(t, uuid.Nil, like.ID)
 }
 
+func TestLikeCreate_SecondLikeIsNoOp(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	video := createTestVideo()
+	require.NoError(t, NewVideoRepository(db).Create(context.Background(), video))
+	repo := NewUserVideoLikeRepository(db)
+	userID := uuid.New()
+
+	likeVideo(t, repo, &domain.UserVideoLike{UserID: userID, VideoID: video.ID})
+	created, err := repo.Create(context.Background(), &domain.UserVideoLike{UserID: userID, VideoID: video.ID})
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	count, err := repo.CountByVideoID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	var stored domain.Video
+	require.NoError(t, db.First(&stored, "id = ?", video.ID).Error)
+	assert.Equal(t, int64(1), stored.LikeCount)
+}
+
 func TestLikeExists_Found(t *testing.T) {
 	db, cleanDb := setupTestDB(t)
 	defer cleanDb()
@@ -36,8 +68,7 @@ func TestLikeExists_Found(t *testing.T) {
 	repo := NewUserVideoLikeRepository(db)
 
 	like := createTestLike()
-	err := repo.Create(context.Background(), like)
-	require.NoError(t, err)
+	likeVideo(t, repo, like)
 
 	isExisted, err := repo.Exists(context.Background(), like.UserID, like.VideoID)
 	require.NoError(t, err)
@@ -64,10 +95,9 @@ func TestLikeDelete(t *testing.T) {
 	repo := NewUserVideoLikeRepository(db)
 
 	like := createTestLike()
-	err := repo.Create(context.Background(), like)
-	require.NoError(t, err)
+	likeVideo(t, repo, like)
 
-	err = repo.Delete(context.Background(), like.UserID, like.VideoID)
+	err := repo.Delete(context.Background(), like.UserID, like.VideoID)
 	require.NoError(t, err)
 
 	existed, err := repo.Exists(context.Background(), like.UserID, like.VideoID)
@@ -85,11 +115,25 @@ func TestLikeCountByVideoID(t *testing.T) {
 	for i := 1; i <= 5; i++ {
 		like := createTestLike()
 		like.VideoID = videoID
-		err := repo.Create(context.Background(), like)
-		require.NoError(t, err)
+		likeVideo(t, repo, like)
 	}
 
 	count, err := repo.CountByVideoID(context.Background(), videoID)
 	require.NoError(t, err)
 	assert.Equal(t, int64(5), count)
 }
+
+func TestLikeLikedAmong(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUserVideoLikeRepository(db)
+
+	like := createTestLike()
+	likeVideo(t, repo, like)
+	otherVideoID := uuid.New()
+
+	liked, err := repo.LikedAmong(context.Background(), like.UserID, []uuid.UUID{like.VideoID, otherVideoID})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]bool{like.VideoID: true}, liked)
+}
@@ -9,6 +9,18 @@ import (
 	"gorm.io/gorm"
 )
 
+// Views without a client IP are only judged by their user, so an empty IP
+// never matches other anonymous traffic.
+const viewActivitySQL = `
+SELECT
+	(SELECT COUNT(*) FROM user_video_views
+		WHERE user_id = @user_id AND created_at >= @rate_since) AS user_views,
+	(SELECT COUNT(*) FROM user_video_views
+		WHERE @client_ip <> '' AND client_ip = @client_ip AND created_at >= @rate_since) AS ip_views,
+	(SELECT COUNT(DISTINCT user_id) FROM user_video_views
+		WHERE @client_ip <> '' AND client_ip = @client_ip AND video_id = @video_id
+			AND created_at >= @burst_since) AS ip_video_viewers`
+
 type userVideoViewRepository struct {
 	db *gorm.DB
 }
@@ -17,22 +29,50 @@ func NewUserVideoViewRepository(db *gorm.DB) domain.UserVideoViewRepository {
 	return &userVideoViewRepository{db: db}
 }
 
+// Create and Delete keep videos.view_count equal to the number of unflagged
+// views, the same views CountByVideoID counts.
 func (repository *userVideoViewRepository) Create(ctx context.Context, view *domain.UserVideoView) error {
 	view.ID = uuid.New()
 	view.CreatedAt = time.Now()
-	return repository.db.WithContext(ctx).Create(view).Error
+
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(view).Error; err != nil {
+			return err
+		}
+		if view.FlagReason != "" {
+			return nil
+		}
+		return adjustViewCount(tx, view.VideoID, 1)
+	})
 }
 
 func (repository *userVideoViewRepository) Delete(ctx context.Context, userID, videoID uuid.UUID) error {
-	return repository.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Where("video_id = ?", videoID).
-		Delete(&domain.UserVideoView{}).Error
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		var counted int64
+		err := tx.Model(&domain.UserVideoView{}).
+			Where("user_id = ? AND video_id = ? AND flag_reason = ''", userID, videoID).
+			Count(&counted).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("user_id = ?", userID).
+			Where("video_id = ?", videoID).
+			Delete(&domain.UserVideoView{}).Error
+		if err != nil || counted == 0 {
+			return err
+		}
+		return adjustViewCount(tx, videoID, -counted)
+	})
+}
+
+func adjustViewCount(tx *gorm.DB, videoID uuid.UUID, delta int64) error {
+	return tx.Exec("UPDATE videos SET view_count = view_count + ? WHERE id = ?", delta, videoID).Error
 }
 
 func (repository *userVideoViewRepository) Exists(ctx context.Context, userID, videoID uuid.UUID) (bool, error) {
 	var count int64
-	err := repository.db.WithContext(ctx).
+	err := withTx(ctx, repository.db).
 		Model(&domain.UserVideoView{}).
 		Where("user_id = ? AND video_id = ?", userID, videoID).
 		Count(&count).Error
@@ -42,10 +82,80 @@ func (repository *userVideoViewRepository) Exists(ctx context.Context, userID, v
 
 func (repository *userVideoViewRepository) CountByVideoID(ctx context.Context, videoID uuid.UUID) (int64, error) {
 	var count int64
-	err := repository.db.WithContext(ctx).
+	err := withTx(ctx, repository.db).
 		Model(&domain.UserVideoView{}).
-		Where("video_id = ?", videoID).
+		Where("video_id = ? AND flag_reason = ''", videoID).
 		Count(&count).Error
 
 	return count, err
 }
+
+func (repository *userVideoViewRepository) FindRecent(ctx context.Context, userID, videoID uuid.UUID,
+	since time.Time) (*domain.UserVideoView, error) {
+
+	var view domain.UserVideoView
+	err := withTx(ctx, repository.db).
+		Where("user_id = ? AND video_id = ?", userID, videoID).
+		Where("created_at >= ? AND flag_reason = ''", since).
+		Order("created_at DESC").
+		First(&view).Error
+	if err != nil {
+		return nil, translateError(err, domain.ErrViewNotFound)
+	}
+	return &view, nil
+}
+
+func (repository *userVideoViewRepository) LockViewer(ctx context.Context, userID, videoID uuid.UUID) error {
+	return withTx(ctx, repository.db).
+		Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "view:"+userID.String()+":"+videoID.String()).Error
+}
+
+func (repository *userVideoViewRepository) ExtendWatchTime(ctx context.Context, viewID uuid.UUID,
+	watchTime int) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.UserVideoView{}).
+		Where("id = ?", viewID).
+		Update("watch_time", gorm.Expr("GREATEST(watch_time, ?)", watchTime)).Error
+}
+
+func (repository *userVideoViewRepository) GetActivity(ctx context.Context, userID, videoID uuid.UUID,
+	clientIP string, rateSince, burstSince time.Time) (*domain.ViewActivity, error) {
+
+	var activity domain.ViewActivity
+	err := withTx(ctx, repository.db).
+		Raw(viewActivitySQL, map[string]any{
+			"user_id":     userID,
+			"video_id":    videoID,
+			"client_ip":   clientIP,
+			"rate_since":  rateSince,
+			"burst_since": burstSince,
+		}).
+		Scan(&activity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+func (repository *userVideoViewRepository) ListFlagged(ctx context.Context,
+	limit, offset int) ([]*domain.UserVideoView, error) {
+
+	var views []*domain.UserVideoView
+	err := withTx(ctx, repository.db).
+		Where("flag_reason <> ''").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&views).Error
+	return views, err
+}
+
+func (repository *userVideoViewRepository) CountFlagged(ctx context.Context) (int64, error) {
+	var count int64
+	err := withTx(ctx, repository.db).
+		Model(&domain.UserVideoView{}).
+		Where("flag_reason <> ''").
+		Count(&count).Error
+	return count, err
+}
@@ -2,7 +2,11 @@ package db
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 	"video-service/internal/domain"
 
 	"github.com/google/uuid"
@@ -93,3 +97,97 @@ func TestViewCountByVideoID(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(5), count)
 }
+
+func TestViewFindRecentAndExtendWatchTime(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUserVideoViewRepository(db)
+	view := createTestView()
+	view.WatchTime = 10
+	require.NoError(t, repo.Create(context.Background(), view))
+
+	recent, err := repo.FindRecent(context.Background(), view.UserID, view.VideoID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, view.ID, recent.ID)
+
+	require.NoError(t, repo.ExtendWatchTime(context.Background(), view.ID, 40))
+	require.NoError(t, repo.ExtendWatchTime(context.Background(), view.ID, 5))
+	recent, err = repo.FindRecent(context.Background(), view.UserID, view.VideoID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 40, recent.WatchTime)
+
+	_, err = repo.FindRecent(context.Background(), view.UserID, view.VideoID, time.Now().Add(time.Minute))
+	assert.ErrorIs(t, err, domain.ErrViewNotFound)
+}
+
+func TestViewLockViewerSerializesConcurrentViews(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUserVideoViewRepository(db)
+	transactor := NewTransactor(db)
+	video := createTestVideo()
+	require.NoError(t, NewVideoRepository(db).Create(context.Background(), video))
+	userID := uuid.New()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+				if err := repo.LockViewer(ctx, userID, video.ID); err != nil {
+					return err
+				}
+				_, err := repo.FindRecent(ctx, userID, video.ID, time.Now().Add(-time.Hour))
+				if !errors.Is(err, domain.ErrViewNotFound) {
+					return err
+				}
+				return repo.Create(ctx, &domain.UserVideoView{UserID: userID, VideoID: video.ID})
+			})
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	count, err := repo.CountByVideoID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	stored, err := NewVideoRepository(db).GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stored.ViewCount)
+}
+
+func TestViewActivityAndFlagged(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewUserVideoViewRepository(db)
+	userID, videoID := uuid.New(), uuid.New()
+	clientIP := fmt.Sprintf("198.18.%d.%d", uuid.New().ID()%256, uuid.New().ID()%256)
+
+	require.NoError(t, repo.Create(context.Background(), &domain.UserVideoView{
+		UserID: userID, VideoID: videoID, ClientIP: clientIP}))
+	require.NoError(t, repo.Create(context.Background(), &domain.UserVideoView{
+		UserID: uuid.New(), VideoID: videoID, ClientIP: clientIP, FlagReason: domain.ViewFlagBurst}))
+	require.NoError(t, repo.Create(context.Background(), &domain.UserVideoView{
+		UserID: userID, VideoID: uuid.New()}))
+
+	since := time.Now().Add(-time.Minute)
+	activity, err := repo.GetActivity(context.Background(), userID, videoID, clientIP, since, since)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ViewActivity{UserViews: 2, IPViews: 2, IPVideoViewers: 2}, activity)
+
+	count, err := repo.CountByVideoID(context.Background(), videoID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	flagged, err := repo.ListFlagged(context.Background(), 100, 0)
+	require.NoError(t, err)
+	assert.NotEmpty(t, flagged)
+	assert.Equal(t, domain.ViewFlagBurst, flagged[0].FlagReason)
+}
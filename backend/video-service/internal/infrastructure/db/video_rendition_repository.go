@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type videoRenditionRepository struct {
+	db *gorm.DB
+}
+
+func NewVideoRenditionRepository(db *gorm.DB) domain.VideoRenditionRepository {
+	return &videoRenditionRepository{db: db}
+}
+
+func (repository *videoRenditionRepository) Upsert(ctx context.Context, rendition *domain.VideoRendition) error {
+	rendition.ID = uuid.New()
+	rendition.CreatedAt = time.Now()
+	return withTx(ctx, repository.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "video_id"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"width", "height", "bitrate", "bandwidth", "storage_key", "url", "created_at"}),
+		}).
+		Create(rendition).Error
+}
+
+func (repository *videoRenditionRepository) ListByVideoID(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.VideoRendition, error) {
+
+	var renditions []*domain.VideoRendition
+	err := withTx(ctx, repository.db).
+		Where("video_id = ?", videoID).
+		Order("height ASC").
+		Find(&renditions).Error
+
+	return renditions, err
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenditionUpsertAndList(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRenditionRepository(db)
+	videoID := uuid.New()
+
+	for _, rendition := range []*domain.VideoRendition{
+		{VideoID: videoID, Name: "720p", Height: 720, StorageKey: "a", URL: "a"},
+		{VideoID: videoID, Name: "360p", Height: 360, StorageKey: "b", URL: "b"},
+		{VideoID: videoID, Name: "720p", Height: 720, StorageKey: "c", URL: "c"},
+	} {
+		require.NoError(t, repo.Upsert(context.Background(), rendition))
+	}
+
+	renditions, err := repo.ListByVideoID(context.Background(), videoID)
+	require.NoError(t, err)
+	require.Len(t, renditions, 2)
+	assert.Equal(t, "360p", renditions[0].Name)
+	assert.Equal(t, "c", renditions[1].StorageKey)
+}
@@ -9,6 +9,11 @@ import (
 	"gorm.io/gorm"
 )
 
+// publishedAtSQL is Video.PublishedAt in SQL. Listings order by it so a
+// draft or scheduled video appears when it goes live rather than when it was
+// created; schema.go indexes the same expression.
+const publishedAtSQL = "COALESCE(videos.publish_at, videos.created_at)"
+
 type videoRepository struct {
 	db *gorm.DB
 }
@@ -20,77 +25,222 @@ func NewVideoRepository(db *gorm.DB) domain.VideoRepository {
 func (repository *videoRepository) Create(ctx context.Context, video *domain.Video) error {
 	video.ID = uuid.New()
 	video.CreatedAt = time.Now()
-	return repository.db.WithContext(ctx).Create(&video).Error
+	video.Version = 1
+	if video.ProcessingStatus == "" {
+		video.ProcessingStatus = domain.ProcessingStatusReady
+	}
+	if video.PublicationState == "" {
+		video.PublicationState = domain.PublicationStatePublished
+	}
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&video).Error; err != nil {
+			return err
+		}
+		return tx.Exec(refreshSearchVectorSQL, video.ID).Error
+	})
+	return translateError(err, nil)
 }
 
 func (repository *videoRepository) GetByID(ctx context.Context, id uuid.UUID) (
 	*domain.Video, error) {
 
 	var video domain.Video
-	err := repository.db.WithContext(ctx).Where("id = ?", id).First(&video).Error
+	err := withTx(ctx, repository.db).Where("id = ?", id).First(&video).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, domain.ErrVideoNotFound)
 	}
 	return &video, nil
 }
 
-func (repository *videoRepository) GetByUserID(ctx context.Context, userID uuid.UUID,
-	limit, offset int) ([]*domain.Video, error) {
-
+func (repository *videoRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Video, error) {
 	var videos []*domain.Video
-	err := repository.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Limit(limit).
-		Offset(offset).
-		Order("created_at DESC").
-		Find(&videos).Error
-
+	if len(ids) == 0 {
+		return videos, nil
+	}
+	err := withTx(ctx, repository.db).Where("id IN ?", ids).Find(&videos).Error
 	return videos, err
 }
 
-func (repository *videoRepository) GetPublicVideos(ctx context.Context, limit, offset int) (
+func (repository *videoRepository) GetByUserID(ctx context.Context, query domain.UserVideosQuery) (
 	[]*domain.Video, error) {
 
+	db := repository.userVideos(ctx, query.UserID, query.Visibilities, query.OwnerListing)
+	if query.Pinned {
+		db = db.Select("videos.*").
+			Joins("JOIN video_pins ON video_pins.video_id = videos.id").
+			Order("video_pins.created_at DESC")
+	} else {
+		db = sortUserVideos(db.Where("NOT EXISTS (?)", repository.db.Model(&domain.VideoPin{}).
+			Select("1").Where("video_pins.video_id = videos.id")), query.Sort, query.After)
+	}
+
 	var videos []*domain.Video
-	err := repository.db.WithContext(ctx).
-		Where("is_public = ?", true).
+	err := db.Limit(query.Limit).Offset(query.Offset).Find(&videos).Error
+	return videos, err
+}
+
+// sortUserVideos orders by the sort key with the ID as tie-breaker.
+// Publication time is fixed once a video is published, so continuing after
+// a cursor does not skip or repeat it; view counts are not, see
+// domain.VideoCursor.
+func sortUserVideos(db *gorm.DB, sort domain.VideoSort, after *domain.VideoCursor) *gorm.DB {
+	switch sort {
+	case domain.VideoSortPopular:
+		if after != nil {
+			db = db.Where("(videos.view_count, videos.id) < (?, ?)", after.ViewCount, after.ID)
+		}
+		return db.Order("videos.view_count DESC").Order("videos.id DESC")
+	case domain.VideoSortOldest:
+		if after != nil {
+			db = db.Where("("+publishedAtSQL+", videos.id) > (?, ?)", after.PublishedAt, after.ID)
+		}
+		return db.Order(publishedAtSQL + " ASC").Order("videos.id ASC")
+	default:
+		if after != nil {
+			db = db.Where("("+publishedAtSQL+", videos.id) < (?, ?)", after.PublishedAt, after.ID)
+		}
+		return db.Order(publishedAtSQL + " DESC").Order("videos.id DESC")
+	}
+}
+
+func (repository *videoRepository) GetPublicVideos(ctx context.Context, viewerID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	var videos []*domain.Video
+	err := repository.publicVideos(ctx, viewerID).
 		Limit(limit).
 		Offset(offset).
-		Order("created_at DESC").
+		Order(publishedAtSQL + " DESC").
+		Order("videos.id DESC").
 		Find(&videos).Error
 
 	return videos, err
 }
 
 func (repository *videoRepository) Update(ctx context.Context, video *domain.Video) error {
-	return repository.db.WithContext(ctx).
-		Model(&video).
+	err := withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.Video{}).
+			Where("id = ? AND version = ?", video.ID, video.Version).
+			Updates(map[string]any{
+				"title":         video.Title,
+				"description":   video.Description,
+				"thumbnail_url": video.ThumbnailURL,
+				"visibility":    video.Visibility,
+				"version":       gorm.Expr("version + 1"),
+				"updated_at":    time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrVideoModified
+		}
+		if !video.Pinnable() {
+			if err := tx.Where("video_id = ?", video.ID).Delete(&domain.VideoPin{}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Exec(refreshSearchVectorSQL, video.ID).Error
+	})
+	if err != nil {
+		return err
+	}
+	video.Version++
+	return nil
+}
+
+func (repository *videoRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return withTx(ctx, repository.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", id).Delete(&domain.VideoPin{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Video{}, id).Error
+	})
+}
+
+func (repository *videoRepository) UpdateProcessingStatus(ctx context.Context, id uuid.UUID,
+	status domain.ProcessingStatus) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ?", id).
 		Updates(map[string]any{
-			"title":       video.Title,
-			"description": video.Description,
-			"is_public":   video.IsPublic,
-			"updated_at":  time.Now(),
+			"processing_status": status,
+			"updated_at":        time.Now(),
 		}).Error
 }
 
-func (repository *videoRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return repository.db.WithContext(ctx).Delete(&domain.Video{}, id).Error
+func (repository *videoRepository) SetCoverTime(ctx context.Context, id uuid.UUID, coverTimeMs int) error {
+	return withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"cover_time_ms": coverTimeMs,
+			"updated_at":    time.Now(),
+		}).Error
 }
 
-func (repository *videoRepository) CountPublicVideos(ctx context.Context) (int64, error) {
-	var count int64
-	err := repository.db.WithContext(ctx).
+func (repository *videoRepository) UpdateThumbnails(ctx context.Context, id uuid.UUID,
+	thumbnailURL, previewURL string) error {
+
+	return withTx(ctx, repository.db).
 		Model(&domain.Video{}).
-		Where("is_public = ?", true).
-		Count(&count).Error
-	return count, err
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"thumbnail_url": thumbnailURL,
+			"preview_url":   previewURL,
+			"updated_at":    time.Now(),
+		}).Error
+}
+
+func (repository *videoRepository) UpdatePlaylistURL(ctx context.Context, id uuid.UUID,
+	playlistURL string) error {
+
+	return withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"playlist_url": playlistURL,
+			"updated_at":   time.Now(),
+		}).Error
 }
 
-func (repository *videoRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+func (repository *videoRepository) CountPublicVideos(ctx context.Context, viewerID uuid.UUID) (int64, error) {
 	var count int64
-	err := repository.db.WithContext(ctx).
+	err := repository.publicVideos(ctx, viewerID).Count(&count).Error
+	return count, err
+}
+
+func (repository *videoRepository) publicVideos(ctx context.Context, viewerID uuid.UUID) *gorm.DB {
+	query := withTx(ctx, repository.db).
 		Model(&domain.Video{}).
-		Where("user_id = ?", userID).
-		Count(&count).Error
+		Where("videos.visibility = ?", domain.VisibilityPublic).
+		Where("videos.processing_status = ?", domain.ProcessingStatusReady).
+		Where("videos.moderation_state = ?", domain.ModerationStateActive).
+		Where("videos.publication_state = ?", domain.PublicationStatePublished)
+	return notBlockedWith(query, viewerID)
+}
+
+func (repository *videoRepository) CountByUserID(ctx context.Context, userID uuid.UUID,
+	visibilities []domain.Visibility, ownerListing bool) (int64, error) {
+
+	var count int64
+	err := repository.userVideos(ctx, userID, visibilities, ownerListing).Count(&count).Error
 	return count, err
 }
+
+func (repository *videoRepository) userVideos(ctx context.Context, userID uuid.UUID,
+	visibilities []domain.Visibility, ownerListing bool) *gorm.DB {
+
+	query := withTx(ctx, repository.db).
+		Model(&domain.Video{}).
+		Where("videos.user_id = ?", userID).
+		Where("videos.visibility IN ?", visibilities).
+		Where("videos.publication_state = ?", domain.PublicationStatePublished)
+	if !ownerListing {
+		query = query.
+			Where("videos.moderation_state <> ?", domain.ModerationStateTakenDown).
+			Where("videos.processing_status = ?", domain.ProcessingStatusReady)
+	}
+	return query
+}
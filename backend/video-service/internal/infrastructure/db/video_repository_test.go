@@ -4,23 +4,33 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 	"video-service/internal/domain"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"gorm.io/gorm"
 )
 
+var allVisibilities = []domain.Visibility{
+	domain.VisibilityPublic,
+	domain.VisibilityFollowers,
+	domain.VisibilityFriends,
+	domain.VisibilityPrivate,
+	domain.VisibilityUnlisted,
+}
+
 func createTestVideo() *domain.Video {
 	return &domain.Video{
-		UserID:       uuid.New(),
-		Title:        "Test Video",
-		Description:  "Test Description",
-		VideoURL:     "https://example.com/video.mp4",
-		ThumbnailURL: "https://example.com/thumb.jpg",
-		Duration:     120,
-		IsPublic:     true,
+		UserID:           uuid.New(),
+		Title:            "Test Video",
+		Description:      "Test Description",
+		VideoURL:         "https://example.com/video.mp4",
+		ThumbnailURL:     "https://example.com/thumb.jpg",
+		Duration:         120,
+		Visibility:       domain.VisibilityPublic,
+		ModerationState:  domain.ModerationStateActive,
+		PublicationState: domain.PublicationStatePublished,
 	}
 }
 
@@ -64,7 +74,27 @@ func TestVideoGetByID_NotFound(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, found)
-	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+}
+
+func TestVideoGetByIDs(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+
+	first := createTestVideo()
+	require.NoError(t, repo.Create(context.Background(), first))
+	second := createTestVideo()
+	require.NoError(t, repo.Create(context.Background(), second))
+
+	found, err := repo.GetByIDs(context.Background(), []uuid.UUID{second.ID, uuid.New(), first.ID})
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+
+	found, err = repo.GetByIDs(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, found)
 }
 
 func TestVideoGetByUserID(t *testing.T) {
@@ -82,15 +112,244 @@ func TestVideoGetByUserID(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	videos, err := repo.GetByUserID(context.Background(), userID, 3, 0)
+	query := domain.UserVideosQuery{UserID: userID, Visibilities: allVisibilities, OwnerListing: true, Limit: 3}
+	videos, err := repo.GetByUserID(context.Background(), query)
 	require.NoError(t, err)
 	assert.Len(t, videos, 3)
 
-	videos2, err := repo.GetByUserID(context.Background(), userID, 3, 3)
+	query.Offset = 3
+	videos2, err := repo.GetByUserID(context.Background(), query)
 	require.NoError(t, err)
 	assert.Len(t, videos2, 2)
 }
 
+func TestVideoGetByUserID_FiltersVisibility(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	userID := uuid.New()
+
+	for _, visibility := range allVisibilities {
+		video := createTestVideo()
+		video.UserID = userID
+		video.Visibility = visibility
+		require.NoError(t, repo.Create(context.Background(), video))
+	}
+
+	visible := []domain.Visibility{domain.VisibilityPublic, domain.VisibilityFollowers}
+	videos, err := repo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: userID, Visibilities: visible, OwnerListing: true, Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, videos, 2)
+	for _, video := range videos {
+		assert.Contains(t, visible, video.Visibility)
+	}
+
+	count, err := repo.CountByUserID(context.Background(), userID, visible, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestVideoGetByUserID_TakenDownOnlyForOwner(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	moderationRepo := NewModerationRepository(db)
+	userID := uuid.New()
+
+	video := createTestVideo()
+	video.UserID = userID
+	require.NoError(t, repo.Create(context.Background(), video))
+	require.NoError(t, moderationRepo.SetVideoState(context.Background(), video.ID,
+		domain.ModerationStateTakenDown, "spam"))
+
+	videos, err := repo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: userID, Visibilities: allVisibilities, Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, videos)
+
+	count, err := repo.CountByUserID(context.Background(), userID, allVisibilities, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestVideoGetByUserID_ProcessingOnlyForOwner(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	userID := uuid.New()
+
+	video := createTestVideo()
+	video.UserID = userID
+	video.ProcessingStatus = domain.ProcessingStatusProcessing
+	require.NoError(t, repo.Create(context.Background(), video))
+
+	videos, err := repo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: userID, Visibilities: allVisibilities, Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, videos)
+
+	count, err := repo.CountByUserID(context.Background(), userID, allVisibilities, false)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = repo.CountByUserID(context.Background(), userID, allVisibilities, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestVideoGetByUserID_SortModes(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	userID := uuid.New()
+	videos := createPinnableVideos(t, repo, userID, 3)
+	for i, video := range videos {
+		require.NoError(t, db.Model(video).Updates(map[string]any{
+			"created_at": time.Now().Add(time.Duration(i) * time.Hour),
+			"view_count": []int64{5, 50, 5}[i],
+		}).Error)
+	}
+
+	tests := []struct {
+		sort domain.VideoSort
+		want []uuid.UUID
+	}{
+		{domain.VideoSortLatest, []uuid.UUID{videos[2].ID, videos[1].ID, videos[0].ID}},
+		{domain.VideoSortOldest, []uuid.UUID{videos[0].ID, videos[1].ID, videos[2].ID}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			query := domain.UserVideosQuery{UserID: userID, Visibilities: allVisibilities, Sort: tt.sort, Limit: 2}
+			first, err := repo.GetByUserID(context.Background(), query)
+			require.NoError(t, err)
+			require.Len(t, first, 2)
+
+			last := first[1]
+			query.After = &domain.VideoCursor{Sort: tt.sort, PublishedAt: last.PublishedAt(), ID: last.ID}
+			rest, err := repo.GetByUserID(context.Background(), query)
+			require.NoError(t, err)
+			require.Len(t, rest, 1)
+
+			assert.Equal(t, tt.want, []uuid.UUID{first[0].ID, first[1].ID, rest[0].ID})
+		})
+	}
+
+	query := domain.UserVideosQuery{
+		UserID: userID, Visibilities: allVisibilities, Sort: domain.VideoSortPopular, Limit: 10,
+		After: &domain.VideoCursor{Sort: domain.VideoSortPopular, ViewCount: 50, ID: videos[1].ID},
+	}
+	popular, err := repo.GetByUserID(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, popular, 2, "ties on view count are ordered by ID")
+}
+
+// recordViews stores count unflagged views of the video by distinct users.
+func recordViews(t *testing.T, viewRepo domain.UserVideoViewRepository, videoID uuid.UUID, count int) {
+	t.Helper()
+	for range count {
+		view := &domain.UserVideoView{UserID: uuid.New(), VideoID: videoID, WatchTime: 10}
+		require.NoError(t, viewRepo.Create(context.Background(), view))
+	}
+}
+
+func TestVideoGetByUserID_PopularFollowsRecordedViews(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	likeRepo := NewUserVideoLikeRepository(db)
+	userID := uuid.New()
+	videos := createPinnableVideos(t, repo, userID, 3)
+
+	recordViews(t, viewRepo, videos[0].ID, 2)
+	recordViews(t, viewRepo, videos[1].ID, 3)
+	for range 2 {
+		flagged := &domain.UserVideoView{UserID: uuid.New(), VideoID: videos[2].ID, FlagReason: domain.ViewFlagBurst}
+		require.NoError(t, viewRepo.Create(context.Background(), flagged))
+	}
+	like := &domain.UserVideoLike{UserID: uuid.New(), VideoID: videos[0].ID}
+	likeVideo(t, likeRepo, like)
+	require.NoError(t, likeRepo.Delete(context.Background(), like.UserID, like.VideoID))
+	likeVideo(t, likeRepo, &domain.UserVideoLike{
+		UserID: uuid.New(), VideoID: videos[1].ID,
+	})
+
+	popular, err := repo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: userID, Visibilities: allVisibilities, Sort: domain.VideoSortPopular, Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{videos[1].ID, videos[0].ID, videos[2].ID}, videoIDs(popular))
+	assert.Equal(t, []int64{3, 2, 0}, []int64{popular[0].ViewCount, popular[1].ViewCount, popular[2].ViewCount})
+	assert.Equal(t, []int64{1, 0, 0}, []int64{popular[0].LikeCount, popular[1].LikeCount, popular[2].LikeCount})
+}
+
+func TestMigrateIsPublic(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+
+	public := createTestVideo()
+	require.NoError(t, repo.Create(context.Background(), public))
+	private := createTestVideo()
+	require.NoError(t, repo.Create(context.Background(), private))
+
+	require.NoError(t, db.Exec(`ALTER TABLE videos ADD COLUMN is_public boolean`).Error)
+	require.NoError(t, db.Exec(`UPDATE videos SET is_public = (visibility = 'public' AND id <> ?)`,
+		private.ID).Error)
+	require.NoError(t, applySchema(db))
+	require.NoError(t, applySchema(db))
+
+	assert.False(t, db.Migrator().HasColumn(&domain.Video{}, "is_public"))
+
+	migrated, err := repo.GetByID(context.Background(), public.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityPublic, migrated.Visibility)
+
+	migrated, err = repo.GetByID(context.Background(), private.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityPrivate, migrated.Visibility)
+}
+
+func TestVideoListingsOrderByPublicationTime(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+	userID := uuid.New()
+	videos := createPinnableVideos(t, repo, userID, 2)
+	// The first video was drafted a day before the second and only
+	// published after it.
+	require.NoError(t, db.Model(videos[0]).Updates(map[string]any{
+		"created_at": time.Now().Add(-24 * time.Hour),
+		"publish_at": time.Now(),
+	}).Error)
+	require.NoError(t, db.Model(videos[1]).Updates(map[string]any{
+		"created_at": time.Now().Add(-time.Hour),
+		"publish_at": nil,
+	}).Error)
+	want := []uuid.UUID{videos[0].ID, videos[1].ID}
+
+	latest, err := repo.GetByUserID(context.Background(), domain.UserVideosQuery{
+		UserID: userID, Visibilities: allVisibilities, Sort: domain.VideoSortLatest, Limit: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, videoIDs(latest))
+
+	public, err := repo.GetPublicVideos(context.Background(), uuid.Nil, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, want, videoIDs(public))
+}
+
 func TestVideoGetPublicVideos(t *testing.T) {
 	db, cleanDb := setupTestDB(t)
 	defer cleanDb()
@@ -98,23 +357,49 @@ func TestVideoGetPublicVideos(t *testing.T) {
 	repo := NewVideoRepository(db)
 
 	publicVideo := createTestVideo()
-	publicVideo.IsPublic = true
+	publicVideo.Visibility = domain.VisibilityPublic
 	err := repo.Create(context.Background(), publicVideo)
 	require.NoError(t, err)
 
 	privateVideo := createTestVideo()
-	privateVideo.IsPublic = false
+	privateVideo.Visibility = domain.VisibilityPrivate
 	err = repo.Create(context.Background(), privateVideo)
 	require.NoError(t, err)
 
-	videos, err := repo.GetPublicVideos(context.Background(), 10, 0)
+	processingVideo := createTestVideo()
+	processingVideo.ProcessingStatus = domain.ProcessingStatusProcessing
+	err = repo.Create(context.Background(), processingVideo)
+	require.NoError(t, err)
+
+	videos, err := repo.GetPublicVideos(context.Background(), uuid.Nil, 10, 0)
 	require.NoError(t, err)
 
 	for _, video := range videos {
-		assert.True(t, video.IsPublic)
+		assert.Equal(t, domain.VisibilityPublic, video.Visibility)
+		assert.Equal(t, domain.ProcessingStatusReady, video.ProcessingStatus)
+		assert.NotEqual(t, processingVideo.ID, video.ID)
 	}
 }
 
+func TestVideoUpdateProcessingStatus(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+
+	video := createTestVideo()
+	video.ProcessingStatus = domain.ProcessingStatusUploaded
+	err := repo.Create(context.Background(), video)
+	require.NoError(t, err)
+
+	err = repo.UpdateProcessingStatus(context.Background(), video.ID, domain.ProcessingStatusReady)
+	require.NoError(t, err)
+
+	updated, err := repo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProcessingStatusReady, updated.ProcessingStatus)
+}
+
 func TestVideoUpdate(t *testing.T) {
 	db, cleanDb := setupTestDB(t)
 	defer cleanDb()
@@ -127,7 +412,7 @@ func TestVideoUpdate(t *testing.T) {
 
 	video.Title = "Updated Title"
 	video.Description = "Updated Description"
-	video.IsPublic = false
+	video.Visibility = domain.VisibilityPrivate
 
 	err = repo.Update(context.Background(), video)
 	require.NoError(t, err)
@@ -136,8 +421,35 @@ func TestVideoUpdate(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Title", updated.Title)
 	assert.Equal(t, "Updated Description", updated.Description)
-	assert.False(t, updated.IsPublic)
+	assert.Equal(t, domain.VisibilityPrivate, updated.Visibility)
 	assert.True(t, updated.UpdatedAt.After(updated.CreatedAt))
+	assert.Equal(t, int64(2), updated.Version)
+	assert.Equal(t, int64(2), video.Version)
+}
+
+func TestVideoUpdate_StaleVersion(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+
+	video := createTestVideo()
+	require.NoError(t, repo.Create(context.Background(), video))
+
+	first, err := repo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	second, err := repo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+
+	first.Title = "First Edit"
+	require.NoError(t, repo.Update(context.Background(), first))
+
+	second.Title = "Second Edit"
+	assert.ErrorIs(t, repo.Update(context.Background(), second), domain.ErrVideoModified)
+
+	stored, err := repo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "First Edit", stored.Title)
 }
 
 func TestVideoDelete(t *testing.T) {
@@ -155,7 +467,7 @@ func TestVideoDelete(t *testing.T) {
 
 	_, err = repo.GetByID(context.Background(), video.ID)
 	assert.Error(t, err)
-	assert.Equal(t, gorm.ErrRecordNotFound, err)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
 }
 
 func TestVideoCountPublicVideos(t *testing.T) {
@@ -176,7 +488,7 @@ func TestVideoCountPublicVideos(t *testing.T) {
 			VideoURL:     "https://example.com/video.mp4",
 			ThumbnailURL: "https://example.com/thumb.jpg",
 			Duration:     120,
-			IsPublic:     true,
+			Visibility:   domain.VisibilityPublic,
 		}
 		err := repo.Create(context.Background(), video)
 		require.NoError(t, err)
@@ -191,13 +503,13 @@ func TestVideoCountPublicVideos(t *testing.T) {
 			VideoURL:     "https://example.com/video.mp4",
 			ThumbnailURL: "https://example.com/thumb.jpg",
 			Duration:     120,
-			IsPublic:     false,
+			Visibility:   domain.VisibilityPrivate,
 		}
 		err := repo.Create(context.Background(), video)
 		require.NoError(t, err)
 	}
 
-	count, err := repo.CountPublicVideos(context.Background())
+	count, err := repo.CountPublicVideos(context.Background(), uuid.Nil)
 	require.NoError(t, err)
 	assert.Equal(t, int64(5), count)
 }
@@ -229,11 +541,34 @@ func TestVideoCountByUserID(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	count, err := repo.CountByUserID(context.Background(), userID)
+	count, err := repo.CountByUserID(context.Background(), userID, allVisibilities, true)
 	require.NoError(t, err)
 	assert.Equal(t, int64(4), count)
 
-	otherCount, err := repo.CountByUserID(context.Background(), otherUserID)
+	otherCount, err := repo.CountByUserID(context.Background(), otherUserID, allVisibilities, true)
 	require.NoError(t, err)
 	assert.Equal(t, int64(2), otherCount)
 }
+
+func TestVideoSetCoverTimeAndUpdateThumbnails(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	repo := NewVideoRepository(db)
+
+	video := createTestVideo()
+	err := repo.Create(context.Background(), video)
+	require.NoError(t, err)
+
+	err = repo.SetCoverTime(context.Background(), video.ID, 2500)
+	require.NoError(t, err)
+
+	err = repo.UpdateThumbnails(context.Background(), video.ID, "https://cdn/thumb.jpg", "https://cdn/preview.gif")
+	require.NoError(t, err)
+
+	updated, err := repo.GetByID(context.Background(), video.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2500, updated.CoverTimeMs)
+	assert.Equal(t, "https://cdn/thumb.jpg", updated.ThumbnailURL)
+	assert.Equal(t, "https://cdn/preview.gif", updated.PreviewURL)
+}
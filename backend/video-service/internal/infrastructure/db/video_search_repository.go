@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"video-service/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+const searchScoreExpression = `ts_rank_cd(videos.search_vector, to_tsquery('simple', ?)) *
+	(1 + ? * LN(1 + videos.view_count + 2 * videos.like_count))`
+
+type searchRow struct {
+	domain.Video
+	SearchScore float64
+}
+
+type videoSearchRepository struct {
+	db *gorm.DB
+}
+
+func NewVideoSearchRepository(db *gorm.DB) domain.VideoSearchRepository {
+	return &videoSearchRepository{db: db}
+}
+
+func (repository *videoSearchRepository) Search(ctx context.Context, query domain.VideoSearchQuery) (
+	[]*domain.VideoSearchResult, error) {
+
+	tsQuery := prefixTSQuery(query.Terms)
+	db := withTx(ctx, repository.db)
+
+	matches := db.Table("videos").
+		Select("videos.*, "+searchScoreExpression+" AS search_score", tsQuery, query.PopularityWeight).
+		Where("videos.search_vector @@ to_tsquery('simple', ?)", tsQuery).
+		Where("videos.deleted_at IS NULL")
+
+	if query.ViewerID != nil {
+		matches = matches.Where("(videos.visibility = ? AND videos.processing_status = ? AND "+
+			"videos.moderation_state = ? AND videos.publication_state = ?) OR videos.user_id = ?",
+			domain.VisibilityPublic, domain.ProcessingStatusReady, domain.ModerationStateActive,
+			domain.PublicationStatePublished, *query.ViewerID)
+		matches = notBlockedWith(matches, *query.ViewerID)
+	} else {
+		matches = matches.
+			Where("videos.visibility = ?", domain.VisibilityPublic).
+			Where("videos.processing_status = ?", domain.ProcessingStatusReady).
+			Where("videos.moderation_state = ?", domain.ModerationStateActive).
+			Where("videos.publication_state = ?", domain.PublicationStatePublished)
+	}
+	if query.CreatorID != nil {
+		matches = matches.Where("videos.user_id = ?", *query.CreatorID)
+	}
+	if query.CreatedAfter != nil {
+		matches = matches.Where("videos.created_at >= ?", *query.CreatedAfter)
+	}
+	if query.CreatedBefore != nil {
+		matches = matches.Where("videos.created_at < ?", *query.CreatedBefore)
+	}
+	if query.MinDuration > 0 {
+		matches = matches.Where("videos.duration >= ?", query.MinDuration)
+	}
+	if query.MaxDuration > 0 {
+		matches = matches.Where("videos.duration <= ?", query.MaxDuration)
+	}
+
+	results := db.Table("(?) AS results", matches)
+	if query.After != nil {
+		results = results.Where("(results.search_score, results.id) < (?, ?)", query.After.Score, query.After.ID)
+	}
+
+	var rows []*searchRow
+	err := results.
+		Order("results.search_score DESC").
+		Order("results.id DESC").
+		Limit(query.Limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	searchResults := make([]*domain.VideoSearchResult, len(rows))
+	for i, row := range rows {
+		video := row.Video
+		searchResults[i] = &domain.VideoSearchResult{Video: &video, Score: row.SearchScore}
+	}
+
+	return searchResults, nil
+}
+
+func prefixTSQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term + ":*"
+	}
+	return strings.Join(parts, " & ")
+}
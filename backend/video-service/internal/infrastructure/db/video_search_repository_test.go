@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixTSQuery(t *testing.T) {
+	assert.Equal(t, "dan:* & cha:*", prefixTSQuery([]string{"dan", "cha"}))
+	assert.Equal(t, "", prefixTSQuery(nil))
+}
+
+func TestVideoSearch(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	tagRepo := NewTagRepository(db)
+	repo := NewVideoSearchRepository(db)
+	word := "zq" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+
+	titled := createTestVideo()
+	titled.Title = word + " in the title"
+	require.NoError(t, videoRepo.Create(context.Background(), titled))
+	recordViews(t, NewUserVideoViewRepository(db), titled.ID, 3)
+
+	tagged := createTestVideo()
+	require.NoError(t, videoRepo.Create(context.Background(), tagged))
+	require.NoError(t, tagRepo.ReplaceVideoTags(context.Background(), tagged.ID, []string{word}, nil))
+
+	private := createTestVideo()
+	private.Description = "secret " + word
+	private.Visibility = domain.VisibilityPrivate
+	require.NoError(t, videoRepo.Create(context.Background(), private))
+
+	query := domain.VideoSearchQuery{
+		Terms:            []string{word[:6]},
+		PopularityWeight: 0.1,
+		Limit:            10,
+	}
+
+	results, err := repo.Search(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, titled.ID, results[0].Video.ID)
+	assert.Equal(t, tagged.ID, results[1].Video.ID)
+
+	query.ViewerID = &private.UserID
+	results, err = repo.Search(context.Background(), query)
+	require.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	query.Limit = 1
+	query.After = &domain.SearchCursor{Score: results[0].Score, ID: results[0].Video.ID}
+	page, err := repo.Search(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, results[1].Video.ID, page[0].Video.ID)
+}
+
+func TestVideoSearch_PopularityBreaksEqualTextMatches(t *testing.T) {
+	db, cleanDb := setupTestDB(t)
+	defer cleanDb()
+
+	videoRepo := NewVideoRepository(db)
+	viewRepo := NewUserVideoViewRepository(db)
+	likeRepo := NewUserVideoLikeRepository(db)
+	repo := NewVideoSearchRepository(db)
+	word := "zq" + strings.ReplaceAll(uuid.NewString()[:8], "-", "")
+
+	videos := make([]*domain.Video, 2)
+	for i := range videos {
+		videos[i] = createTestVideo()
+		videos[i].Title = word + " clip"
+		require.NoError(t, videoRepo.Create(context.Background(), videos[i]))
+	}
+	// Without views the ID tie-breaker decides, so make the video that would
+	// lose that tie-breaker the popular one.
+	popular := videos[0]
+	if popular.ID.String() > videos[1].ID.String() {
+		popular = videos[1]
+	}
+	recordViews(t, viewRepo, popular.ID, 5)
+	likeVideo(t, likeRepo, &domain.UserVideoLike{
+		UserID: uuid.New(), VideoID: popular.ID,
+	})
+
+	query := domain.VideoSearchQuery{Terms: []string{word}, Limit: 10}
+	results, err := repo.Search(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.NotEqual(t, popular.ID, results[0].Video.ID)
+	assert.Equal(t, results[0].Score, results[1].Score)
+
+	query.PopularityWeight = 0.1
+	results, err = repo.Search(context.Background(), query)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, popular.ID, results[0].Video.ID)
+	assert.Greater(t, results[0].Score, results[1].Score)
+}
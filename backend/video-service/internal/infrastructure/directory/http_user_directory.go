@@ -0,0 +1,172 @@
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type lookupResponse struct {
+	Success bool         `json:"success"`
+	Error   string       `json:"error"`
+	Data    []lookupUser `json:"data"`
+}
+
+type lookupUser struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+}
+
+type relationshipResponse struct {
+	Success bool                 `json:"success"`
+	Error   string               `json:"error"`
+	Data    *domain.Relationship `json:"data"`
+}
+
+type statusResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
+// httpUserDirectory calls a user service that owns accounts and the follow
+// graph. Neither auth-service nor auth-service-golang serves these endpoints
+// yet, so USER_DIRECTORY_URL must point at a service that implements:
+//
+//	GET    /api/v1/users/lookup?username=a&username=b
+//	       -> {"success": true, "data": [{"id": "...", "username": "a"}]}
+//	GET    /api/v1/users/relationship?viewer_id=...&owner_id=...
+//	       -> {"success": true, "data": {"following": true, "followed_by": false}}
+//	DELETE /api/v1/users/follows?user_id=...&other_id=...
+//	       -> {"success": true}
+//
+// Failures are reported as {"success": false, "error": "..."}.
+type httpUserDirectory struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewHTTPUserDirectory(baseURL string, timeout time.Duration) domain.UserDirectory {
+	return &httpUserDirectory{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (directory *httpUserDirectory) ResolveUsernames(ctx context.Context, usernames []string) (
+	map[string]uuid.UUID, error) {
+
+	resolved := make(map[string]uuid.UUID, len(usernames))
+	if len(usernames) == 0 {
+		return resolved, nil
+	}
+
+	query := url.Values{}
+	for _, username := range usernames {
+		query.Add("username", username)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		directory.baseURL+"/api/v1/users/lookup?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := directory.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user directory returned status %d", resp.StatusCode)
+	}
+
+	var body lookupResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if !body.Success {
+		return nil, fmt.Errorf("user directory lookup failed: %s", body.Error)
+	}
+
+	for _, user := range body.Data {
+		resolved[user.Username] = user.ID
+	}
+
+	return resolved, nil
+}
+
+func (directory *httpUserDirectory) GetRelationship(ctx context.Context, viewerID, ownerID uuid.UUID) (
+	*domain.Relationship, error) {
+
+	query := url.Values{}
+	query.Set("viewer_id", viewerID.String())
+	query.Set("owner_id", ownerID.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		directory.baseURL+"/api/v1/users/relationship?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := directory.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user directory returned status %d", resp.StatusCode)
+	}
+
+	var body relationshipResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if !body.Success {
+		return nil, fmt.Errorf("user directory relationship lookup failed: %s", body.Error)
+	}
+	if body.Data == nil {
+		return &domain.Relationship{}, nil
+	}
+
+	return body.Data, nil
+}
+
+func (directory *httpUserDirectory) RemoveFollows(ctx context.Context, userID, otherID uuid.UUID) error {
+	query := url.Values{}
+	query.Set("user_id", userID.String())
+	query.Set("other_id", otherID.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete,
+		directory.baseURL+"/api/v1/users/follows?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := directory.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("user directory returned status %d", resp.StatusCode)
+	}
+
+	var body statusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return err
+	}
+	if !body.Success {
+		return fmt.Errorf("user directory follow removal failed: %s", body.Error)
+	}
+
+	return nil
+}
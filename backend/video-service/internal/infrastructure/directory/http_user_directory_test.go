@@ -0,0 +1,120 @@
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPUserDirectory_ResolveUsernames(t *testing.T) {
+	aliceID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/users/lookup", r.URL.Path)
+		assert.ElementsMatch(t, []string{"alice", "ghost"}, r.URL.Query()["username"])
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    []map[string]any{{"id": aliceID, "username": "alice"}},
+		})
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	resolved, err := directory.ResolveUsernames(context.Background(), []string{"alice", "ghost"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uuid.UUID{"alice": aliceID}, resolved)
+}
+
+func TestHTTPUserDirectory_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	_, err := directory.ResolveUsernames(context.Background(), []string{"alice"})
+
+	assert.Error(t, err)
+}
+
+func TestHTTPUserDirectory_NoUsernames(t *testing.T) {
+	directory := NewHTTPUserDirectory("http://unused.invalid", time.Second)
+	resolved, err := directory.ResolveUsernames(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+}
+
+func TestHTTPUserDirectory_GetRelationship(t *testing.T) {
+	viewerID := uuid.New()
+	ownerID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/users/relationship", r.URL.Path)
+		assert.Equal(t, viewerID.String(), r.URL.Query().Get("viewer_id"))
+		assert.Equal(t, ownerID.String(), r.URL.Query().Get("owner_id"))
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    map[string]any{"following": true, "followed_by": false},
+		})
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	relationship, err := directory.GetRelationship(context.Background(), viewerID, ownerID)
+
+	require.NoError(t, err)
+	assert.True(t, relationship.Following)
+	assert.False(t, relationship.FollowedBy)
+}
+
+func TestHTTPUserDirectory_GetRelationshipFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"success": false, "error": "boom"})
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	_, err := directory.GetRelationship(context.Background(), uuid.New(), uuid.New())
+
+	assert.Error(t, err)
+}
+
+func TestHTTPUserDirectory_RemoveFollows(t *testing.T) {
+	userID := uuid.New()
+	otherID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/users/follows", r.URL.Path)
+		assert.Equal(t, userID.String(), r.URL.Query().Get("user_id"))
+		assert.Equal(t, otherID.String(), r.URL.Query().Get("other_id"))
+
+		json.NewEncoder(w).Encode(map[string]any{"success": true})
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	err := directory.RemoveFollows(context.Background(), userID, otherID)
+
+	assert.NoError(t, err)
+}
+
+func TestHTTPUserDirectory_RemoveFollowsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	directory := NewHTTPUserDirectory(server.URL, time.Second)
+	err := directory.RemoveFollows(context.Background(), uuid.New(), uuid.New())
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package directory
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type noopUserDirectory struct{}
+
+func NewNoopUserDirectory() domain.UserDirectory {
+	return noopUserDirectory{}
+}
+
+func (noopUserDirectory) ResolveUsernames(ctx context.Context, usernames []string) (
+	map[string]uuid.UUID, error) {
+
+	return map[string]uuid.UUID{}, nil
+}
+
+func (noopUserDirectory) GetRelationship(ctx context.Context, viewerID, ownerID uuid.UUID) (
+	*domain.Relationship, error) {
+
+	return &domain.Relationship{}, nil
+}
+
+// RemoveFollows fails rather than pretend the follows are gone.
+func (noopUserDirectory) RemoveFollows(ctx context.Context, userID, otherID uuid.UUID) error {
+	return domain.ErrFollowsNotRemoved
+}
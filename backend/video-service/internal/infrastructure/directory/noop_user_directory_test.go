@@ -0,0 +1,16 @@
+package directory
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoopUserDirectory_RemoveFollowsIsNotSilent(t *testing.T) {
+	err := NewNoopUserDirectory().RemoveFollows(context.Background(), uuid.New(), uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrFollowsNotRemoved)
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"fmt"
+	"video-service/internal/domain"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Encode(id uuid.UUID, event *domain.VideoEvent) ([]byte, error) {
+	message := &pb.VideoEvent{
+		EventId:    id.String(),
+		Type:       string(event.Type),
+		VideoId:    event.VideoID.String(),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+
+	switch event.Type {
+	case domain.EventVideoCreated:
+		message.Payload = &pb.VideoEvent_Created{Created: &pb.VideoCreated{Video: videoToProto(event.Video)}}
+	case domain.EventVideoUpdated:
+		message.Payload = &pb.VideoEvent_Updated{Updated: &pb.VideoUpdated{Video: videoToProto(event.Video)}}
+	case domain.EventVideoDeleted:
+		message.Payload = &pb.VideoEvent_Deleted{Deleted: &pb.VideoDeleted{UserId: event.UserID.String()}}
+	case domain.EventVideoRestored:
+		message.Payload = &pb.VideoEvent_Restored{Restored: &pb.VideoRestored{Video: videoToProto(event.Video)}}
+	case domain.EventVideoPurged:
+		message.Payload = &pb.VideoEvent_Purged{Purged: &pb.VideoPurged{UserId: event.UserID.String()}}
+	case domain.EventVideoModerated:
+		message.Payload = &pb.VideoEvent_Moderated{Moderated: &pb.VideoModerated{Video: videoToProto(event.Video)}}
+	case domain.EventVideoLiked:
+		message.Payload = &pb.VideoEvent_Liked{Liked: &pb.VideoLiked{
+			UserId:    event.UserID.String(),
+			LikeCount: event.LikeCount,
+		}}
+	case domain.EventVideoUnliked:
+		message.Payload = &pb.VideoEvent_Unliked{Unliked: &pb.VideoUnliked{
+			UserId:    event.UserID.String(),
+			LikeCount: event.LikeCount,
+		}}
+	case domain.EventVideoViewed:
+		message.Payload = &pb.VideoEvent_Viewed{Viewed: &pb.VideoViewed{
+			UserId:    event.UserID.String(),
+			WatchTime: int32(event.WatchTime),
+			ViewCount: event.ViewCount,
+		}}
+	default:
+		return nil, fmt.Errorf("unknown event type %q", event.Type)
+	}
+
+	return proto.Marshal(message)
+}
+
+func videoToProto(video *domain.Video) *pb.Video {
+	if video == nil {
+		return nil
+	}
+
+	return &pb.Video{
+		Id:               video.ID.String(),
+		UserId:           video.UserID.String(),
+		Title:            video.Title,
+		Description:      video.Description,
+		VideoUrl:         video.VideoURL,
+		ThumbnailUrl:     video.ThumbnailURL,
+		Duration:         int32(video.Duration),
+		ViewCount:        video.ViewCount,
+		LikeCount:        video.LikeCount,
+		ShareCount:       video.ShareCount,
+		FavoriteCount:    video.FavoriteCount,
+		Visibility:       string(video.Visibility),
+		CreatedAt:        timestamppb.New(video.CreatedAt),
+		UpdatedAt:        timestamppb.New(video.UpdatedAt),
+		ProcessingStatus: string(video.ProcessingStatus),
+		PreviewUrl:       video.PreviewURL,
+		CoverTimeMs:      int32(video.CoverTimeMs),
+		PlaylistUrl:      video.PlaylistURL,
+		Region:           video.Region,
+		ModerationState:  string(video.ModerationState),
+		ModerationReason: video.ModerationReason,
+	}
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEncode_VideoCreated(t *testing.T) {
+	id := uuid.New()
+	video := &domain.Video{ID: uuid.New(), UserID: uuid.New(), Title: "Clip", Region: "VN"}
+	occurredAt := time.Now().UTC()
+
+	payload, err := Encode(id, &domain.VideoEvent{
+		Type:       domain.EventVideoCreated,
+		VideoID:    video.ID,
+		Video:      video,
+		OccurredAt: occurredAt,
+	})
+	require.NoError(t, err)
+
+	var decoded pb.VideoEvent
+	require.NoError(t, proto.Unmarshal(payload, &decoded))
+	assert.Equal(t, id.String(), decoded.EventId)
+	assert.Equal(t, "video.created", decoded.Type)
+	assert.Equal(t, video.ID.String(), decoded.VideoId)
+	assert.True(t, occurredAt.Equal(decoded.OccurredAt.AsTime()))
+	assert.Equal(t, "Clip", decoded.GetCreated().Video.Title)
+	assert.Equal(t, "VN", decoded.GetCreated().Video.Region)
+}
+
+func TestEncode_VideoViewed(t *testing.T) {
+	userID := uuid.New()
+
+	payload, err := Encode(uuid.New(), &domain.VideoEvent{
+		Type:      domain.EventVideoViewed,
+		VideoID:   uuid.New(),
+		UserID:    userID,
+		WatchTime: 12,
+		ViewCount: 40,
+	})
+	require.NoError(t, err)
+
+	var decoded pb.VideoEvent
+	require.NoError(t, proto.Unmarshal(payload, &decoded))
+	assert.Equal(t, userID.String(), decoded.GetViewed().UserId)
+	assert.Equal(t, int32(12), decoded.GetViewed().WatchTime)
+	assert.Equal(t, int64(40), decoded.GetViewed().ViewCount)
+}
+
+func TestEncode_VideoPurged(t *testing.T) {
+	userID := uuid.New()
+
+	payload, err := Encode(uuid.New(), &domain.VideoEvent{
+		Type:    domain.EventVideoPurged,
+		VideoID: uuid.New(),
+		UserID:  userID,
+	})
+	require.NoError(t, err)
+
+	var decoded pb.VideoEvent
+	require.NoError(t, proto.Unmarshal(payload, &decoded))
+	assert.Equal(t, "video.purged", decoded.Type)
+	assert.Equal(t, userID.String(), decoded.GetPurged().UserId)
+}
+
+func TestEncode_VideoModerated(t *testing.T) {
+	video := &domain.Video{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		ModerationState:  domain.ModerationStateTakenDown,
+		ModerationReason: "graphic violence",
+	}
+
+	payload, err := Encode(uuid.New(), &domain.VideoEvent{
+		Type:    domain.EventVideoModerated,
+		VideoID: video.ID,
+		UserID:  video.UserID,
+		Video:   video,
+	})
+	require.NoError(t, err)
+
+	var decoded pb.VideoEvent
+	require.NoError(t, proto.Unmarshal(payload, &decoded))
+	assert.Equal(t, "video.moderated", decoded.Type)
+	assert.Equal(t, "taken_down", decoded.GetModerated().Video.ModerationState)
+	assert.Equal(t, "graphic violence", decoded.GetModerated().Video.ModerationReason)
+}
+
+func TestEncode_UnknownType(t *testing.T) {
+	_, err := Encode(uuid.New(), &domain.VideoEvent{Type: "video.exploded"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	headerEventID   = "event-id"
+	headerEventType = "event-type"
+)
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string, topic string) domain.Publisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (publisher *kafkaPublisher) Publish(ctx context.Context, messages ...domain.EventMessage) error {
+	return publisher.writer.WriteMessages(ctx, toKafkaMessages(messages)...)
+}
+
+func (publisher *kafkaPublisher) Close() error {
+	return publisher.writer.Close()
+}
+
+// Messages are keyed by video so a consumer sees each video's events in
+// order; the event ID header lets it drop redelivered duplicates.
+func toKafkaMessages(messages []domain.EventMessage) []kafka.Message {
+	kafkaMessages := make([]kafka.Message, len(messages))
+	for i, message := range messages {
+		kafkaMessages[i] = kafka.Message{
+			Key:   []byte(message.Key),
+			Value: message.Payload,
+			Headers: []kafka.Header{
+				{Key: headerEventID, Value: []byte(message.ID.String())},
+				{Key: headerEventType, Value: []byte(message.Type)},
+			},
+		}
+	}
+	return kafkaMessages
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToKafkaMessages(t *testing.T) {
+	message := domain.EventMessage{
+		ID:      uuid.New(),
+		Type:    domain.EventVideoLiked,
+		Key:     uuid.NewString(),
+		Payload: []byte{1, 2, 3},
+	}
+
+	kafkaMessages := toKafkaMessages([]domain.EventMessage{message})
+
+	require.Len(t, kafkaMessages, 1)
+	assert.Equal(t, []byte(message.Key), kafkaMessages[0].Key)
+	assert.Equal(t, message.Payload, kafkaMessages[0].Value)
+	assert.Equal(t, headerEventID, kafkaMessages[0].Headers[0].Key)
+	assert.Equal(t, message.ID.String(), string(kafkaMessages[0].Headers[0].Value))
+	assert.Equal(t, "video.liked", string(kafkaMessages[0].Headers[1].Value))
+}
@@ -0,0 +1,42 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"video-service/internal/domain"
+)
+
+// MemoryPublisher keeps published messages for tests to inspect. It never
+// delivers them anywhere, so it must not back the outbox relay in a running
+// service.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []domain.EventMessage
+	Err      error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (publisher *MemoryPublisher) Publish(ctx context.Context, messages ...domain.EventMessage) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if publisher.Err != nil {
+		return publisher.Err
+	}
+	publisher.messages = append(publisher.messages, messages...)
+	return nil
+}
+
+func (publisher *MemoryPublisher) Messages() []domain.EventMessage {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	return append([]domain.EventMessage(nil), publisher.messages...)
+}
+
+func (publisher *MemoryPublisher) Close() error {
+	return nil
+}
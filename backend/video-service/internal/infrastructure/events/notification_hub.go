@@ -0,0 +1,87 @@
+package events
+
+import (
+	"sync"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// NotificationHub delivers to subscribers without blocking. A subscriber
+// that falls more than the buffer behind misses notifications and has to
+// catch up from the stored list.
+type NotificationHub struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[chan *domain.Notification]struct{}
+	bufferSize  int
+	closed      bool
+}
+
+func NewNotificationHub(bufferSize int) *NotificationHub {
+	return &NotificationHub{
+		subscribers: make(map[uuid.UUID]map[chan *domain.Notification]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+func (hub *NotificationHub) Publish(notification *domain.Notification) {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	for subscriber := range hub.subscribers[notification.UserID] {
+		select {
+		case subscriber <- notification:
+		default:
+		}
+	}
+}
+
+func (hub *NotificationHub) Subscribe(userID uuid.UUID) (<-chan *domain.Notification, func()) {
+	subscriber := make(chan *domain.Notification, hub.bufferSize)
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.closed {
+		close(subscriber)
+		return subscriber, func() {}
+	}
+	if hub.subscribers[userID] == nil {
+		hub.subscribers[userID] = make(map[chan *domain.Notification]struct{})
+	}
+	hub.subscribers[userID][subscriber] = struct{}{}
+
+	var once sync.Once
+	return subscriber, func() {
+		once.Do(func() { hub.unsubscribe(userID, subscriber) })
+	}
+}
+
+func (hub *NotificationHub) unsubscribe(userID uuid.UUID, subscriber chan *domain.Notification) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if _, ok := hub.subscribers[userID][subscriber]; !ok {
+		return
+	}
+	delete(hub.subscribers[userID], subscriber)
+	if len(hub.subscribers[userID]) == 0 {
+		delete(hub.subscribers, userID)
+	}
+	close(subscriber)
+}
+
+// Close ends every subscription so open streams can finish before the
+// server stops.
+func (hub *NotificationHub) Close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.closed = true
+	for _, subscribers := range hub.subscribers {
+		for subscriber := range subscribers {
+			close(subscriber)
+		}
+	}
+	hub.subscribers = make(map[uuid.UUID]map[chan *domain.Notification]struct{})
+}
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationHub_DeliversToRecipientOnly(t *testing.T) {
+	hub := NewNotificationHub(4)
+	recipientID := uuid.New()
+
+	first, cancelFirst := hub.Subscribe(recipientID)
+	defer cancelFirst()
+	second, cancelSecond := hub.Subscribe(recipientID)
+	defer cancelSecond()
+	other, cancelOther := hub.Subscribe(uuid.New())
+	defer cancelOther()
+
+	notification := &domain.Notification{ID: uuid.New(), UserID: recipientID}
+	hub.Publish(notification)
+
+	assert.Equal(t, notification, <-first)
+	assert.Equal(t, notification, <-second)
+	assert.Empty(t, other)
+}
+
+func TestNotificationHub_DropsWhenSubscriberIsFull(t *testing.T) {
+	hub := NewNotificationHub(1)
+	recipientID := uuid.New()
+	notifications, cancel := hub.Subscribe(recipientID)
+	defer cancel()
+
+	hub.Publish(&domain.Notification{ID: uuid.New(), UserID: recipientID})
+	hub.Publish(&domain.Notification{ID: uuid.New(), UserID: recipientID})
+
+	assert.Len(t, notifications, 1)
+}
+
+func TestNotificationHub_CancelAndClose(t *testing.T) {
+	hub := NewNotificationHub(1)
+
+	notifications, cancel := hub.Subscribe(uuid.New())
+	cancel()
+	cancel()
+	_, ok := <-notifications
+	assert.False(t, ok)
+
+	open, _ := hub.Subscribe(uuid.New())
+	hub.Close()
+	_, ok = <-open
+	assert.False(t, ok)
+
+	late, _ := hub.Subscribe(uuid.New())
+	_, ok = <-late
+	require.False(t, ok)
+}
@@ -0,0 +1,110 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"video-service/internal/domain"
+)
+
+type FakeTranscoder struct {
+	Info *domain.MediaInfo
+	Err  error
+
+	mu         sync.Mutex
+	Transcoded []domain.Rendition
+	Frames     []time.Duration
+	Previews   []domain.PreviewSpec
+	Packaged   []string
+}
+
+func NewFakeTranscoder(info *domain.MediaInfo) *FakeTranscoder {
+	return &FakeTranscoder{Info: info}
+}
+
+func (transcoder *FakeTranscoder) Probe(ctx context.Context, inputPath string) (*domain.MediaInfo, error) {
+	if transcoder.Err != nil {
+		return nil, transcoder.Err
+	}
+	return transcoder.Info, nil
+}
+
+func (transcoder *FakeTranscoder) Transcode(ctx context.Context, inputPath, outputPath string,
+	rendition domain.Rendition) error {
+
+	if transcoder.Err != nil {
+		return transcoder.Err
+	}
+
+	input, err := os.ReadFile(inputPath)
+	if err != nil {
+		return err
+	}
+
+	output := append([]byte(fmt.Sprintf("%s:", rendition.Name)), input...)
+	if err := os.WriteFile(outputPath, output, 0o644); err != nil {
+		return err
+	}
+
+	transcoder.mu.Lock()
+	transcoder.Transcoded = append(transcoder.Transcoded, rendition)
+	transcoder.mu.Unlock()
+	return nil
+}
+
+func (transcoder *FakeTranscoder) ExtractFrame(ctx context.Context, inputPath, outputPath string,
+	at time.Duration) error {
+
+	if transcoder.Err != nil {
+		return transcoder.Err
+	}
+	if err := os.WriteFile(outputPath, []byte(fmt.Sprintf("frame@%s", at)), 0o644); err != nil {
+		return err
+	}
+
+	transcoder.mu.Lock()
+	transcoder.Frames = append(transcoder.Frames, at)
+	transcoder.mu.Unlock()
+	return nil
+}
+
+func (transcoder *FakeTranscoder) CreatePreview(ctx context.Context, inputPath, outputPath string,
+	spec domain.PreviewSpec) error {
+
+	if transcoder.Err != nil {
+		return transcoder.Err
+	}
+	if err := os.WriteFile(outputPath, []byte("GIF89a"), 0o644); err != nil {
+		return err
+	}
+
+	transcoder.mu.Lock()
+	transcoder.Previews = append(transcoder.Previews, spec)
+	transcoder.mu.Unlock()
+	return nil
+}
+
+func (transcoder *FakeTranscoder) PackageHLS(ctx context.Context, inputPath, outputDir string,
+	segmentDuration time.Duration) error {
+
+	if transcoder.Err != nil {
+		return transcoder.Err
+	}
+
+	playlist := fmt.Sprintf("#EXTM3U\n#EXT-X-TARGETDURATION:%d\n#EXTINF:%.3f,\nsegment_000.ts\n#EXT-X-ENDLIST\n",
+		int(segmentDuration.Seconds()), segmentDuration.Seconds())
+	if err := os.WriteFile(filepath.Join(outputDir, "index.m3u8"), []byte(playlist), 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "segment_000.ts"), []byte("segment"), 0o644); err != nil {
+		return err
+	}
+
+	transcoder.mu.Lock()
+	transcoder.Packaged = append(transcoder.Packaged, inputPath)
+	transcoder.mu.Unlock()
+	return nil
+}
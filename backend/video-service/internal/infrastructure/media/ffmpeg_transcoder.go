@@ -0,0 +1,166 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+	"video-service/internal/domain"
+)
+
+// localInputOnly keeps ffmpeg and ffprobe from following references inside a
+// media file, such as playlist or concat entries, to network protocols.
+var localInputOnly = []string{"-protocol_whitelist", "file"}
+
+type ffmpegTranscoder struct {
+	ffmpegPath  string
+	ffprobePath string
+}
+
+func NewFFmpegTranscoder(ffmpegPath, ffprobePath string) domain.Transcoder {
+	return &ffmpegTranscoder{
+		ffmpegPath:  ffmpegPath,
+		ffprobePath: ffprobePath,
+	}
+}
+
+type ffprobeOutput struct {
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		Width     int    `json:"width"`
+		Height    int    `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+func (transcoder *ffmpegTranscoder) Probe(ctx context.Context, inputPath string) (*domain.MediaInfo, error) {
+	output, err := run(ctx, transcoder.ffprobePath, slices.Concat(localInputOnly, []string{
+		"-v", "error",
+		"-print_format", "json",
+		"-show_streams",
+		"-show_format",
+		inputPath,
+	})...)
+	if err != nil {
+		return nil, err
+	}
+
+	var probe ffprobeOutput
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return nil, fmt.Errorf("failed to parse ffprobe output: %w", err)
+	}
+
+	info := &domain.MediaInfo{}
+	for _, stream := range probe.Streams {
+		if stream.CodecType == "video" {
+			info.Width = stream.Width
+			info.Height = stream.Height
+			break
+		}
+	}
+	if info.Height == 0 {
+		return nil, fmt.Errorf("no video stream found in %s", inputPath)
+	}
+
+	if seconds, err := strconv.ParseFloat(probe.Format.Duration, 64); err == nil {
+		info.Duration = time.Duration(seconds * float64(time.Second))
+	}
+
+	return info, nil
+}
+
+func (transcoder *ffmpegTranscoder) Transcode(ctx context.Context, inputPath, outputPath string,
+	rendition domain.Rendition) error {
+
+	_, err := run(ctx, transcoder.ffmpegPath, slices.Concat(localInputOnly, []string{
+		"-y",
+		"-i", inputPath,
+		"-vf", fmt.Sprintf("scale=-2:%d", rendition.Height),
+		"-c:v", "libx264",
+		"-preset", "veryfast",
+		"-profile:v", "main",
+		"-b:v", rendition.VideoBitrate,
+		"-c:a", "aac",
+		"-b:a", rendition.AudioBitrate,
+		"-movflags", "+faststart",
+		outputPath,
+	})...)
+	return err
+}
+
+func (transcoder *ffmpegTranscoder) ExtractFrame(ctx context.Context, inputPath, outputPath string,
+	at time.Duration) error {
+
+	_, err := run(ctx, transcoder.ffmpegPath, slices.Concat(localInputOnly, []string{
+		"-y",
+		"-ss", formatSeconds(at),
+		"-i", inputPath,
+		"-frames:v", "1",
+		"-q:v", "2",
+		outputPath,
+	})...)
+	return err
+}
+
+func (transcoder *ffmpegTranscoder) CreatePreview(ctx context.Context, inputPath, outputPath string,
+	spec domain.PreviewSpec) error {
+
+	filter := fmt.Sprintf(
+		"fps=%d,scale=%d:-1:flags=lanczos,split[s0][s1];[s0]palettegen[p];[s1][p]paletteuse",
+		spec.FPS, spec.Width)
+
+	_, err := run(ctx, transcoder.ffmpegPath, slices.Concat(localInputOnly, []string{
+		"-y",
+		"-ss", formatSeconds(spec.Start),
+		"-t", formatSeconds(spec.Length),
+		"-i", inputPath,
+		"-vf", filter,
+		"-loop", "0",
+		outputPath,
+	})...)
+	return err
+}
+
+func (transcoder *ffmpegTranscoder) PackageHLS(ctx context.Context, inputPath, outputDir string,
+	segmentDuration time.Duration) error {
+
+	_, err := run(ctx, transcoder.ffmpegPath, slices.Concat(localInputOnly, []string{
+		"-y",
+		"-i", inputPath,
+		"-c", "copy",
+		"-f", "hls",
+		"-hls_time", formatSeconds(segmentDuration),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(outputDir, "segment_%03d.ts"),
+		filepath.Join(outputDir, "index.m3u8"),
+	})...)
+	return err
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
+
+func run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", name, err, lastLine(stderr.Bytes()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func lastLine(output []byte) string {
+	lines := bytes.Split(bytes.TrimSpace(output), []byte("\n"))
+	return string(lines[len(lines)-1])
+}
@@ -0,0 +1,120 @@
+package media
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFakeBinary(t *testing.T, name, script string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755))
+	return path
+}
+
+func TestFFmpegProbe(t *testing.T) {
+	ffprobe := writeFakeBinary(t, "ffprobe", `cat <<'JSON'
+{"streams":[{"codec_type":"audio"},{"codec_type":"video","width":1920,"height":1080}],"format":{"duration":"12.5"}}
+JSON
+`)
+	transcoder := NewFFmpegTranscoder("ffmpeg", ffprobe)
+
+	info, err := transcoder.Probe(context.Background(), "input.mp4")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1920, info.Width)
+	assert.Equal(t, 1080, info.Height)
+	assert.Equal(t, 12.5, info.Duration.Seconds())
+}
+
+func TestFFmpegProbe_NoVideoStream(t *testing.T) {
+	ffprobe := writeFakeBinary(t, "ffprobe", `echo '{"streams":[{"codec_type":"audio"}],"format":{}}'`)
+	transcoder := NewFFmpegTranscoder("ffmpeg", ffprobe)
+
+	_, err := transcoder.Probe(context.Background(), "input.mp4")
+
+	assert.Error(t, err)
+}
+
+func TestFFmpegTranscode_PassesRenditionSettings(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "args")
+	ffmpeg := writeFakeBinary(t, "ffmpeg", `echo "$@" > `+argsFile+"\n")
+	transcoder := NewFFmpegTranscoder(ffmpeg, "ffprobe")
+
+	err := transcoder.Transcode(context.Background(), "in.mp4", "out.mp4", domain.Rendition{
+		Name: "720p", Height: 720, VideoBitrate: "2500k", AudioBitrate: "128k",
+	})
+	require.NoError(t, err)
+
+	args, err := os.ReadFile(argsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "scale=-2:720")
+	assert.Contains(t, string(args), "-c:v libx264")
+	assert.Contains(t, string(args), "-b:v 2500k")
+	assert.Contains(t, string(args), "-protocol_whitelist file")
+	assert.Contains(t, string(args), "out.mp4")
+}
+
+func TestFFmpegTranscode_ReportsStderr(t *testing.T) {
+	ffmpeg := writeFakeBinary(t, "ffmpeg", "echo 'invalid data found' >&2\nexit 1\n")
+	transcoder := NewFFmpegTranscoder(ffmpeg, "ffprobe")
+
+	err := transcoder.Transcode(context.Background(), "in.mp4", "out.mp4", domain.Rendition{Height: 360})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid data found")
+}
+
+func TestFFmpegExtractFrame(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "args")
+	ffmpeg := writeFakeBinary(t, "ffmpeg", `echo "$@" > `+argsFile+"\n")
+	transcoder := NewFFmpegTranscoder(ffmpeg, "ffprobe")
+
+	err := transcoder.ExtractFrame(context.Background(), "in.mp4", "thumb.jpg", 1500*time.Millisecond)
+	require.NoError(t, err)
+
+	args, err := os.ReadFile(argsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "-ss 1.500")
+	assert.Contains(t, string(args), "-frames:v 1")
+	assert.Contains(t, string(args), "thumb.jpg")
+}
+
+func TestFFmpegCreatePreview(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "args")
+	ffmpeg := writeFakeBinary(t, "ffmpeg", `echo "$@" > `+argsFile+"\n")
+	transcoder := NewFFmpegTranscoder(ffmpeg, "ffprobe")
+
+	err := transcoder.CreatePreview(context.Background(), "in.mp4", "preview.gif", domain.PreviewSpec{
+		Start: 2 * time.Second, Length: 3 * time.Second, Width: 320, FPS: 10,
+	})
+	require.NoError(t, err)
+
+	args, err := os.ReadFile(argsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "-ss 2.000 -t 3.000")
+	assert.Contains(t, string(args), "fps=10,scale=320:-1")
+	assert.Contains(t, string(args), "preview.gif")
+}
+
+func TestFFmpegPackageHLS(t *testing.T) {
+	argsFile := filepath.Join(t.TempDir(), "args")
+	ffmpeg := writeFakeBinary(t, "ffmpeg", `echo "$@" > `+argsFile+"\n")
+	transcoder := NewFFmpegTranscoder(ffmpeg, "ffprobe")
+
+	err := transcoder.PackageHLS(context.Background(), "720p.mp4", "/tmp/hls/720p", 4*time.Second)
+	require.NoError(t, err)
+
+	args, err := os.ReadFile(argsFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(args), "-c copy -f hls -hls_time 4.000 -hls_playlist_type vod")
+	assert.Contains(t, string(args), "/tmp/hls/720p/segment_%03d.ts")
+	assert.Contains(t, string(args), "/tmp/hls/720p/index.m3u8")
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"video-service/internal/domain"
+)
+
+type localStorage struct {
+	root    string
+	baseURL string
+}
+
+func NewLocalStorage(root, baseURL string) (domain.ObjectStorage, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage root: %w", err)
+	}
+	if err := os.MkdirAll(absRoot, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage root: %w", err)
+	}
+
+	return &localStorage{
+		root:    absRoot,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+func (storage *localStorage) resolve(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(storage.root, filepath.FromSlash(cleaned)), nil
+}
+
+func (storage *localStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return storage.write(ctx, key, r, os.O_CREATE|os.O_WRONLY|os.O_TRUNC)
+}
+
+func (storage *localStorage) Append(ctx context.Context, key string, r io.Reader) (int64, error) {
+	return storage.write(ctx, key, r, os.O_CREATE|os.O_WRONLY|os.O_APPEND)
+}
+
+func (storage *localStorage) write(ctx context.Context, key string, r io.Reader, flag int) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	filePath, err := storage.resolve(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return 0, err
+	}
+
+	file, err := os.OpenFile(filePath, flag, 0o644)
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return written, err
+}
+
+func (storage *localStorage) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	filePath, err := storage.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, domain.ErrObjectNotFound
+	}
+	return file, err
+}
+
+func (storage *localStorage) Stat(ctx context.Context, key string) (*domain.ObjectInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	filePath, err := storage.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, domain.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ObjectInfo{
+		Key:     key,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}, nil
+}
+
+func (storage *localStorage) Move(ctx context.Context, srcKey, dstKey string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	srcPath, err := storage.resolve(srcKey)
+	if err != nil {
+		return err
+	}
+	dstPath, err := storage.resolve(dstKey)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dstPath), 0o755); err != nil {
+		return err
+	}
+
+	err = os.Rename(srcPath, dstPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return domain.ErrObjectNotFound
+	}
+	return err
+}
+
+func (storage *localStorage) Delete(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	filePath, err := storage.resolve(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (storage *localStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	dirPath, err := storage.resolve(prefix)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(dirPath)
+}
+
+func (storage *localStorage) URL(key string) string {
+	return storage.baseURL + path.Clean("/"+key)
+}
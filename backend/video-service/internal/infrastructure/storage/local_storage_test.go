@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestStorage(t *testing.T) (domain.ObjectStorage, string) {
+	t.Helper()
+	root := t.TempDir()
+	storage, err := NewLocalStorage(root, "https://cdn.example.com/media/")
+	require.NoError(t, err)
+	return storage, root
+}
+
+func readObject(t *testing.T, storage domain.ObjectStorage, key string) string {
+	t.Helper()
+	reader, err := storage.Open(context.Background(), key)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestLocalStoragePutAndOpen(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	written, err := storage.Put(context.Background(), "videos/a.mp4", strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+	assert.Equal(t, "hello", readObject(t, storage, "videos/a.mp4"))
+
+	_, err = storage.Put(context.Background(), "videos/a.mp4", strings.NewReader("hi"))
+	require.NoError(t, err)
+	assert.Equal(t, "hi", readObject(t, storage, "videos/a.mp4"))
+}
+
+func TestLocalStorageAppend(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	_, err := storage.Append(context.Background(), "uploads/x.part", strings.NewReader("abc"))
+	require.NoError(t, err)
+	_, err = storage.Append(context.Background(), "uploads/x.part", strings.NewReader("def"))
+	require.NoError(t, err)
+
+	info, err := storage.Stat(context.Background(), "uploads/x.part")
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), info.Size)
+	assert.Equal(t, "abcdef", readObject(t, storage, "uploads/x.part"))
+}
+
+func TestLocalStorageMove(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	_, err := storage.Put(context.Background(), "uploads/x.part", strings.NewReader("data"))
+	require.NoError(t, err)
+
+	err = storage.Move(context.Background(), "uploads/x.part", "videos/u/x.mp4")
+	require.NoError(t, err)
+
+	_, err = storage.Stat(context.Background(), "uploads/x.part")
+	assert.ErrorIs(t, err, domain.ErrObjectNotFound)
+	assert.Equal(t, "data", readObject(t, storage, "videos/u/x.mp4"))
+}
+
+func TestLocalStorageDelete(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	_, err := storage.Put(context.Background(), "videos/a.mp4", strings.NewReader("hello"))
+	require.NoError(t, err)
+
+	require.NoError(t, storage.Delete(context.Background(), "videos/a.mp4"))
+	require.NoError(t, storage.Delete(context.Background(), "videos/a.mp4"))
+
+	_, err = storage.Open(context.Background(), "videos/a.mp4")
+	assert.ErrorIs(t, err, domain.ErrObjectNotFound)
+}
+
+func TestLocalStorageDeletePrefix(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	for _, key := range []string{"videos/u/v/720p.mp4", "videos/u/v/hls/master.m3u8", "videos/u/w/720p.mp4"} {
+		_, err := storage.Put(context.Background(), key, strings.NewReader("x"))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, storage.DeletePrefix(context.Background(), "videos/u/v"))
+	require.NoError(t, storage.DeletePrefix(context.Background(), "videos/u/v"))
+
+	_, err := storage.Stat(context.Background(), "videos/u/v/hls/master.m3u8")
+	assert.ErrorIs(t, err, domain.ErrObjectNotFound)
+	assert.Equal(t, "x", readObject(t, storage, "videos/u/w/720p.mp4"))
+}
+
+func TestLocalStorageKeysStayInsideRoot(t *testing.T) {
+	storage, root := createTestStorage(t)
+
+	_, err := storage.Put(context.Background(), "../../escape.txt", strings.NewReader("x"))
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(root, "escape.txt"))
+	assert.NoError(t, err)
+}
+
+func TestLocalStorageURL(t *testing.T) {
+	storage, _ := createTestStorage(t)
+
+	assert.Equal(t, "https://cdn.example.com/media/videos/a.mp4", storage.URL("videos/a.mp4"))
+}
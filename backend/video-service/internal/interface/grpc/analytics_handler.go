@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AnalyticsHandler struct {
+	analyticsUseCase usecase.AnalyticsUseCase
+}
+
+func NewAnalyticsHandler(analyticsUseCase usecase.AnalyticsUseCase) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsUseCase: analyticsUseCase,
+	}
+}
+
+func engagementStatsToProto(stats domain.EngagementStats) *pb.EngagementStats {
+	return &pb.EngagementStats{
+		Views:            stats.Views,
+		UniqueViewers:    stats.UniqueViewers,
+		TotalWatchTime:   stats.TotalWatchTime,
+		AverageWatchTime: stats.AverageWatchTime(),
+		CompletedViews:   stats.CompletedViews,
+		CompletionRate:   stats.CompletionRate(),
+		Likes:            stats.Likes,
+		Shares:           stats.Shares,
+	}
+}
+
+func (h *AnalyticsHandler) GetVideoAnalytics(ctx context.Context, req *pb.GetVideoAnalyticsRequest) (
+	*pb.GetVideoAnalyticsResponse, error) {
+
+	logger.Info("GetVideoAnalytics request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid GetVideoAnalytics request", zap.Error(err))
+		return nil, err
+	}
+
+	analytics, err := h.analyticsUseCase.GetVideoAnalytics(ctx, req.UserId, req.VideoId,
+		protoTimeOrZero(req.StartDate), protoTimeOrZero(req.EndDate))
+	if err != nil {
+		logger.Error("Failed to get video analytics", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	daily := make([]*pb.DailyStats, len(analytics.Daily))
+	for i, day := range analytics.Daily {
+		daily[i] = &pb.DailyStats{
+			Day:   timestamppb.New(day.Day),
+			Stats: engagementStatsToProto(day.EngagementStats),
+		}
+	}
+	retention := make([]*pb.RetentionPoint, len(analytics.Retention))
+	for i, point := range analytics.Retention {
+		retention[i] = &pb.RetentionPoint{
+			Percent: int32(point.Percent),
+			Viewers: point.Viewers,
+			Rate:    point.Rate,
+		}
+	}
+
+	logger.Info("GetVideoAnalytics request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int("day_count", len(daily)),
+		zap.Int64("views", analytics.Totals.Views))
+
+	return &pb.GetVideoAnalyticsResponse{
+		StartDate: timestamppb.New(analytics.From),
+		EndDate:   timestamppb.New(analytics.To),
+		Totals:    engagementStatsToProto(analytics.Totals),
+		Daily:     daily,
+		Retention: retention,
+	}, nil
+}
+
+func (h *AnalyticsHandler) GetCreatorAnalytics(ctx context.Context, req *pb.GetCreatorAnalyticsRequest) (
+	*pb.GetCreatorAnalyticsResponse, error) {
+
+	logger.Info("GetCreatorAnalytics request received",
+		zap.String("user_id", req.UserId))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid GetCreatorAnalytics request", zap.Error(err))
+		return nil, err
+	}
+
+	analytics, err := h.analyticsUseCase.GetCreatorAnalytics(ctx, req.UserId,
+		protoTimeOrZero(req.StartDate), protoTimeOrZero(req.EndDate))
+	if err != nil {
+		logger.Error("Failed to get creator analytics", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	daily := make([]*pb.DailyStats, len(analytics.Daily))
+	for i, day := range analytics.Daily {
+		daily[i] = &pb.DailyStats{
+			Day:   timestamppb.New(day.Day),
+			Stats: engagementStatsToProto(day.EngagementStats),
+		}
+	}
+	topVideos := make([]*pb.VideoStats, len(analytics.TopVideos))
+	for i, video := range analytics.TopVideos {
+		topVideos[i] = &pb.VideoStats{
+			VideoId: video.VideoID.String(),
+			Stats:   engagementStatsToProto(video.EngagementStats),
+		}
+	}
+
+	logger.Info("GetCreatorAnalytics request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("day_count", len(daily)),
+		zap.Int64("views", analytics.Totals.Views))
+
+	return &pb.GetCreatorAnalyticsResponse{
+		StartDate: timestamppb.New(analytics.From),
+		EndDate:   timestamppb.New(analytics.To),
+		Totals:    engagementStatsToProto(analytics.Totals),
+		Daily:     daily,
+		TopVideos: topVideos,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MockAnalyticsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsUseCase) RollupDailyStats(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsUseCase) GetVideoAnalytics(ctx context.Context, userID, videoID string,
+	from, to time.Time) (*domain.VideoAnalytics, error) {
+
+	args := m.Called(ctx, userID, videoID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VideoAnalytics), args.Error(1)
+}
+
+func (m *MockAnalyticsUseCase) GetCreatorAnalytics(ctx context.Context, userID string,
+	from, to time.Time) (*domain.CreatorAnalytics, error) {
+
+	args := m.Called(ctx, userID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CreatorAnalytics), args.Error(1)
+}
+
+func createTestAnalyticsHandler() (*AnalyticsHandler, *MockAnalyticsUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockAnalyticsUseCase{}
+	handler := NewAnalyticsHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestGetVideoAnalytics_Success(t *testing.T) {
+	handler, mockUseCase := createTestAnalyticsHandler()
+	userID := uuid.New().String()
+	videoID := uuid.New().String()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)
+	totals := domain.EngagementStats{Views: 4, TotalWatchTime: 100, CompletedViews: 1}
+
+	mockUseCase.On("GetVideoAnalytics", mock.Anything, userID, videoID, from, to).
+		Return(&domain.VideoAnalytics{
+			From:      from,
+			To:        to,
+			Totals:    totals,
+			Daily:     []*domain.VideoDailyStats{{Day: from, EngagementStats: totals}},
+			Retention: []*domain.RetentionPoint{{Percent: 0, Viewers: 4, Rate: 1}},
+		}, nil)
+
+	resp, err := handler.GetVideoAnalytics(context.Background(), &pb.GetVideoAnalyticsRequest{
+		UserId:    userID,
+		VideoId:   videoID,
+		StartDate: timestamppb.New(from),
+		EndDate:   timestamppb.New(to),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), resp.Totals.Views)
+	assert.Equal(t, 25.0, resp.Totals.AverageWatchTime)
+	assert.Equal(t, 0.25, resp.Totals.CompletionRate)
+	require.Len(t, resp.Daily, 1)
+	assert.True(t, resp.Daily[0].Day.AsTime().Equal(from))
+	require.Len(t, resp.Retention, 1)
+	assert.Equal(t, 1.0, resp.Retention[0].Rate)
+}
+
+func TestGetVideoAnalytics_NotOwner(t *testing.T) {
+	handler, mockUseCase := createTestAnalyticsHandler()
+	userID := uuid.New().String()
+	videoID := uuid.New().String()
+
+	mockUseCase.On("GetVideoAnalytics", mock.Anything, userID, videoID, time.Time{}, time.Time{}).
+		Return(nil, domain.ErrNotVideoOwner)
+
+	_, err := handler.GetVideoAnalytics(context.Background(), &pb.GetVideoAnalyticsRequest{
+		UserId:  userID,
+		VideoId: videoID,
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientError(err)))
+}
+
+func TestGetCreatorAnalytics_InvalidRange(t *testing.T) {
+	handler, mockUseCase := createTestAnalyticsHandler()
+	userID := uuid.New().String()
+	from := time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mockUseCase.On("GetCreatorAnalytics", mock.Anything, userID, from, to).
+		Return(nil, domain.ErrInvalidDateRange)
+
+	_, err := handler.GetCreatorAnalytics(context.Background(), &pb.GetCreatorAnalyticsRequest{
+		UserId:    userID,
+		StartDate: timestamppb.New(from),
+		EndDate:   timestamppb.New(to),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestGetCreatorAnalytics_InvalidUserID(t *testing.T) {
+	handler, mockUseCase := createTestAnalyticsHandler()
+
+	_, err := handler.GetCreatorAnalytics(context.Background(), &pb.GetCreatorAnalyticsRequest{UserId: "bad"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "GetCreatorAnalytics", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
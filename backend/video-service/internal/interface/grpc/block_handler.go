@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type BlockHandler struct {
+	blockUseCase usecase.BlockUseCase
+}
+
+func NewBlockHandler(blockUseCase usecase.BlockUseCase) *BlockHandler {
+	return &BlockHandler{
+		blockUseCase: blockUseCase,
+	}
+}
+
+func validateBlockRequest(userID, blockedUserID string) error {
+	if err := validateUUID(userID, "user_id"); err != nil {
+		return err
+	}
+	return validateUUID(blockedUserID, "blocked_user_id")
+}
+
+func (h *BlockHandler) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	logger.Info("BlockUser request received",
+		zap.String("user_id", req.UserId),
+		zap.String("blocked_user_id", req.BlockedUserId))
+
+	if err := validateBlockRequest(req.UserId, req.BlockedUserId); err != nil {
+		logger.Error("Invalid BlockUser request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := h.blockUseCase.BlockUser(ctx, req.UserId, req.BlockedUserId); err != nil {
+		logger.Error("Failed to block user", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("blocked_user_id", req.BlockedUserId))
+		return nil, err
+	}
+
+	logger.Info("BlockUser request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.String("blocked_user_id", req.BlockedUserId))
+
+	return &pb.BlockUserResponse{Success: true}, nil
+}
+
+func (h *BlockHandler) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (
+	*pb.UnblockUserResponse, error) {
+
+	logger.Info("UnblockUser request received",
+		zap.String("user_id", req.UserId),
+		zap.String("blocked_user_id", req.BlockedUserId))
+
+	if err := validateBlockRequest(req.UserId, req.BlockedUserId); err != nil {
+		logger.Error("Invalid UnblockUser request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := h.blockUseCase.UnblockUser(ctx, req.UserId, req.BlockedUserId); err != nil {
+		logger.Error("Failed to unblock user", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("blocked_user_id", req.BlockedUserId))
+		return nil, err
+	}
+
+	logger.Info("UnblockUser request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.String("blocked_user_id", req.BlockedUserId))
+
+	return &pb.UnblockUserResponse{Success: true}, nil
+}
+
+func (h *BlockHandler) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (
+	*pb.ListBlockedUsersResponse, error) {
+
+	logger.Info("ListBlockedUsers request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListBlockedUsers request", zap.Error(err))
+		return nil, err
+	}
+
+	blocks, total, err := h.blockUseCase.ListBlockedUsers(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list blocked users", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	users := make([]*pb.BlockedUser, len(blocks))
+	for i, block := range blocks {
+		users[i] = &pb.BlockedUser{
+			UserId:    block.BlockedID.String(),
+			BlockedAt: timestamppb.New(block.CreatedAt),
+		}
+	}
+
+	logger.Info("ListBlockedUsers request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("user_count", len(users)),
+		zap.Int64("total", total))
+
+	return &pb.ListBlockedUsersResponse{Users: users, Total: total}, nil
+}
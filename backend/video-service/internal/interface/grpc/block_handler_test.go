@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockBlockUseCase struct {
+	mock.Mock
+}
+
+func (m *MockBlockUseCase) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *MockBlockUseCase) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	args := m.Called(ctx, userID, blockedUserID)
+	return args.Error(0)
+}
+
+func (m *MockBlockUseCase) ListBlockedUsers(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.UserBlock, int64, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.UserBlock), args.Get(1).(int64), args.Error(2)
+}
+
+func createTestBlockHandler() (*BlockHandler, *MockBlockUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockBlockUseCase{}
+	handler := NewBlockHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestBlockUser_Success(t *testing.T) {
+	handler, mockUseCase := createTestBlockHandler()
+	userID := uuid.NewString()
+	blockedUserID := uuid.NewString()
+
+	mockUseCase.On("BlockUser", mock.Anything, userID, blockedUserID).Return(nil)
+
+	resp, err := handler.BlockUser(context.Background(), &pb.BlockUserRequest{
+		UserId:        userID,
+		BlockedUserId: blockedUserID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestBlockUser_Self(t *testing.T) {
+	handler, mockUseCase := createTestBlockHandler()
+	userID := uuid.NewString()
+
+	mockUseCase.On("BlockUser", mock.Anything, userID, userID).Return(domain.ErrCannotBlockSelf)
+
+	_, err := handler.BlockUser(context.Background(), &pb.BlockUserRequest{
+		UserId:        userID,
+		BlockedUserId: userID,
+	})
+
+	st, ok := status.FromError(clientError(err))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestBlockUser_InvalidBlockedUserID(t *testing.T) {
+	handler, _ := createTestBlockHandler()
+
+	_, err := handler.BlockUser(context.Background(), &pb.BlockUserRequest{
+		UserId:        uuid.NewString(),
+		BlockedUserId: "bad",
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestListBlockedUsers_Success(t *testing.T) {
+	handler, mockUseCase := createTestBlockHandler()
+	userID := uuid.New()
+	block := &domain.UserBlock{BlockerID: userID, BlockedID: uuid.New(), CreatedAt: time.Now()}
+
+	mockUseCase.On("ListBlockedUsers", mock.Anything, userID.String(), 10, 0).
+		Return([]*domain.UserBlock{block}, int64(1), nil)
+
+	resp, err := handler.ListBlockedUsers(context.Background(), &pb.ListBlockedUsersRequest{
+		UserId: userID.String(),
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, block.BlockedID.String(), resp.Users[0].UserId)
+	assert.True(t, resp.Users[0].BlockedAt.AsTime().Equal(block.CreatedAt))
+	mockUseCase.AssertExpectations(t)
+}
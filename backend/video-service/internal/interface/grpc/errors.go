@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryErrorInterceptor reports the errors handlers return as gRPC statuses.
+// Handlers return domain errors as they are and leave the mapping to it.
+func UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, errorToStatus(info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+func StreamErrorInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if err := handler(srv, stream); err != nil {
+		return errorToStatus(info.FullMethod, err)
+	}
+	return nil
+}
+
+// errorToStatus leaves errors that already carry a status alone and turns
+// anything it does not recognise into a bare Internal, so raw causes such
+// as SQL errors never reach the caller.
+func errorToStatus(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var (
+		notFound  *domain.NotFoundError
+		invalid   *domain.InvalidArgumentError
+		conflict  *domain.ConflictError
+		denied    *domain.PermissionDeniedError
+		aborted   *domain.AbortedError
+		failed    *domain.FailedPreconditionError
+		dataLoss  *domain.DataLossError
+		exhausted *domain.ResourceExhaustedError
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return status.Error(codes.NotFound, notFound.Error())
+	case errors.As(err, &invalid):
+		return invalidArgument(invalid.Field, invalid.Reason)
+	case errors.As(err, &conflict):
+		return status.Error(codes.AlreadyExists, conflict.Error())
+	case errors.As(err, &denied):
+		return status.Error(codes.PermissionDenied, denied.Error())
+	case errors.As(err, &aborted):
+		return status.Error(codes.Aborted, aborted.Error())
+	case errors.As(err, &failed):
+		return status.Error(codes.FailedPrecondition, failed.Error())
+	case errors.As(err, &dataLoss):
+		return status.Error(codes.DataLoss, dataLoss.Error())
+	case errors.As(err, &exhausted):
+		return status.Error(codes.ResourceExhausted, exhausted.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	logger.Error("Unhandled error", zap.String("method", method), zap.Error(err))
+	return status.Error(codes.Internal, "internal error")
+}
+
+// invalidArgument attaches the offending field as a BadRequest detail so
+// clients can point at it without parsing the message.
+func invalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, description)
+	if field == "" {
+		return st.Err()
+	}
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
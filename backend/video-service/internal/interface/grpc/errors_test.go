@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// clientError returns err as the interceptor would send it to the client.
+func clientError(err error) error {
+	return errorToStatus("/test", err)
+}
+
+func TestErrorToStatus_DomainErrors(t *testing.T) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"not found", domain.ErrVideoNotFound, codes.NotFound, "video not found"},
+		{"wrapped not found", fmt.Errorf("load: %w", domain.ErrCollectionNotFound), codes.NotFound,
+			"collection not found"},
+		{"invalid argument", domain.ErrInvalidTrendingWindow, codes.InvalidArgument,
+			"window must be one of: day, week, month"},
+		{"conflict", &domain.ConflictError{Reason: "resource already exists", Err: errors.New("duplicate key")},
+			codes.AlreadyExists, "resource already exists"},
+		{"permission denied", domain.ErrNotVideoOwner, codes.PermissionDenied, domain.ErrNotVideoOwner.Error()},
+		{"failed precondition", domain.ErrVideoNotScheduled, codes.FailedPrecondition, "video is not scheduled"},
+		{"data loss", domain.ErrUploadChecksumMismatch, codes.DataLoss, "upload checksum mismatch"},
+		{"resource exhausted", domain.ErrViewRateLimited, codes.ResourceExhausted, "too many views in a short period"},
+		{"canceled", context.Canceled, codes.Canceled, "request canceled"},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded, "deadline exceeded"},
+		{"unknown", errors.New("pq: connection refused"), codes.Internal, "internal error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(clientError(tt.err))
+
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+		})
+	}
+}
+
+func TestErrorToStatus_InvalidArgumentCarriesFieldViolation(t *testing.T) {
+	st := status.Convert(clientError(domain.NewInvalidArgumentError("video_id", "video_id must be a valid UUID")))
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "video_id", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "video_id must be a valid UUID", badRequest.FieldViolations[0].Description)
+}
+
+func TestErrorToStatus_StatusPassesThrough(t *testing.T) {
+	err := status.Error(codes.ResourceExhausted, "too many views")
+
+	assert.Equal(t, err, clientError(err))
+}
+
+func TestUnaryErrorInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/video.VideoService/GetVideo"}
+
+	resp, err := UnaryErrorInterceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, domain.ErrVideoNotFound
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err = UnaryErrorInterceptor(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestStreamErrorInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/video.VideoService/SubscribeNotifications"}
+
+	err := StreamErrorInterceptor(nil, nil, info, func(any, grpc.ServerStream) error {
+		return domain.NewInvalidArgumentError("user_id", "user_id is required")
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
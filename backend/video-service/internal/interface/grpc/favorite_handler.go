@@ -0,0 +1,321 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FavoriteHandler struct {
+	favoriteUseCase usecase.FavoriteUseCase
+}
+
+func NewFavoriteHandler(favoriteUseCase usecase.FavoriteUseCase) *FavoriteHandler {
+	return &FavoriteHandler{
+		favoriteUseCase: favoriteUseCase,
+	}
+}
+
+func domainCollectionToProto(collection *domain.Collection) *pb.Collection {
+	return &pb.Collection{
+		Id:        collection.ID.String(),
+		UserId:    collection.UserID.String(),
+		Name:      collection.Name,
+		IsPublic:  collection.IsPublic,
+		Position:  int32(collection.Position),
+		CreatedAt: timestamppb.New(collection.CreatedAt),
+		UpdatedAt: timestamppb.New(collection.UpdatedAt),
+	}
+}
+
+func (h *FavoriteHandler) AddFavorite(ctx context.Context, req *pb.AddFavoriteRequest) (
+	*pb.AddFavoriteResponse, error) {
+
+	logger.Info("AddFavorite request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId),
+		zap.String("collection_id", req.CollectionId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid AddFavorite request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.CollectionId, "collection_id"); err != nil {
+		logger.Error("Invalid AddFavorite request", zap.Error(err))
+		return nil, err
+	}
+
+	favoriteCount, err := h.favoriteUseCase.AddFavorite(ctx, req.UserId, req.VideoId, req.CollectionId)
+	if err != nil {
+		logger.Error("Failed to add favorite", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("AddFavorite request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int64("favorite_count", favoriteCount))
+
+	return &pb.AddFavoriteResponse{Success: true, FavoriteCount: favoriteCount}, nil
+}
+
+func (h *FavoriteHandler) RemoveFavorite(ctx context.Context, req *pb.RemoveFavoriteRequest) (
+	*pb.RemoveFavoriteResponse, error) {
+
+	logger.Info("RemoveFavorite request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId),
+		zap.String("collection_id", req.CollectionId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid RemoveFavorite request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.CollectionId, "collection_id"); err != nil {
+		logger.Error("Invalid RemoveFavorite request", zap.Error(err))
+		return nil, err
+	}
+
+	favoriteCount, err := h.favoriteUseCase.RemoveFavorite(ctx, req.UserId, req.VideoId, req.CollectionId)
+	if err != nil {
+		logger.Error("Failed to remove favorite", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("RemoveFavorite request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int64("favorite_count", favoriteCount))
+
+	return &pb.RemoveFavoriteResponse{Success: true, FavoriteCount: favoriteCount}, nil
+}
+
+func (h *FavoriteHandler) ListFavorites(ctx context.Context, req *pb.ListFavoritesRequest) (
+	*pb.ListFavoritesResponse, error) {
+
+	logger.Info("ListFavorites request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListFavorites request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.favoriteUseCase.ListFavorites(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list favorites", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("ListFavorites request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("video_count", len(videos)),
+		zap.Int64("total", total))
+
+	return &pb.ListFavoritesResponse{Videos: listVideosToProto(videos), Total: total}, nil
+}
+
+func (h *FavoriteHandler) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (
+	*pb.CreateCollectionResponse, error) {
+
+	logger.Info("CreateCollection request received",
+		zap.String("user_id", req.UserId),
+		zap.String("name", req.Name),
+		zap.Bool("is_public", req.IsPublic))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid CreateCollection request", zap.Error(err))
+		return nil, err
+	}
+
+	collection, err := h.favoriteUseCase.CreateCollection(ctx, &usecase.CollectionRequest{
+		UserID:   req.UserId,
+		Name:     req.Name,
+		IsPublic: req.IsPublic,
+	})
+	if err != nil {
+		logger.Error("Failed to create collection", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("CreateCollection request completed successfully",
+		zap.String("collection_id", collection.ID.String()))
+
+	return &pb.CreateCollectionResponse{Collection: domainCollectionToProto(collection)}, nil
+}
+
+func (h *FavoriteHandler) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (
+	*pb.UpdateCollectionResponse, error) {
+
+	logger.Info("UpdateCollection request received",
+		zap.String("user_id", req.UserId),
+		zap.String("collection_id", req.CollectionId),
+		zap.String("name", req.Name),
+		zap.Bool("is_public", req.IsPublic))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid UpdateCollection request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.CollectionId, "collection_id"); err != nil {
+		logger.Error("Invalid UpdateCollection request", zap.Error(err))
+		return nil, err
+	}
+
+	collection, err := h.favoriteUseCase.UpdateCollection(ctx, &usecase.CollectionRequest{
+		ID:       req.CollectionId,
+		UserID:   req.UserId,
+		Name:     req.Name,
+		IsPublic: req.IsPublic,
+	})
+	if err != nil {
+		logger.Error("Failed to update collection", zap.Error(err),
+			zap.String("collection_id", req.CollectionId))
+		return nil, err
+	}
+
+	logger.Info("UpdateCollection request completed successfully",
+		zap.String("collection_id", req.CollectionId))
+
+	return &pb.UpdateCollectionResponse{Collection: domainCollectionToProto(collection)}, nil
+}
+
+func (h *FavoriteHandler) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (
+	*pb.DeleteCollectionResponse, error) {
+
+	logger.Info("DeleteCollection request received",
+		zap.String("user_id", req.UserId),
+		zap.String("collection_id", req.CollectionId))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid DeleteCollection request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.CollectionId, "collection_id"); err != nil {
+		logger.Error("Invalid DeleteCollection request", zap.Error(err))
+		return nil, err
+	}
+
+	err := h.favoriteUseCase.DeleteCollection(ctx, req.UserId, req.CollectionId)
+	if err != nil {
+		logger.Error("Failed to delete collection", zap.Error(err),
+			zap.String("collection_id", req.CollectionId))
+		return nil, err
+	}
+
+	logger.Info("DeleteCollection request completed successfully",
+		zap.String("collection_id", req.CollectionId))
+
+	return &pb.DeleteCollectionResponse{Success: true}, nil
+}
+
+func (h *FavoriteHandler) ReorderCollections(ctx context.Context, req *pb.ReorderCollectionsRequest) (
+	*pb.ReorderCollectionsResponse, error) {
+
+	logger.Info("ReorderCollections request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("collection_count", len(req.CollectionIds)))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ReorderCollections request", zap.Error(err))
+		return nil, err
+	}
+	for _, collectionID := range req.CollectionIds {
+		if err := validateUUID(collectionID, "collection_ids"); err != nil {
+			logger.Error("Invalid ReorderCollections request", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	err := h.favoriteUseCase.ReorderCollections(ctx, req.UserId, req.CollectionIds)
+	if err != nil {
+		logger.Error("Failed to reorder collections", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("ReorderCollections request completed successfully",
+		zap.String("user_id", req.UserId))
+
+	return &pb.ReorderCollectionsResponse{Success: true}, nil
+}
+
+func (h *FavoriteHandler) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (
+	*pb.ListCollectionsResponse, error) {
+
+	logger.Info("ListCollections request received",
+		zap.String("user_id", req.UserId),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListCollections request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid ListCollections request", zap.Error(err))
+		return nil, err
+	}
+
+	collections, total, err := h.favoriteUseCase.ListCollections(ctx, req.UserId, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list collections", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	protoCollections := make([]*pb.Collection, len(collections))
+	for i, collection := range collections {
+		protoCollections[i] = domainCollectionToProto(collection)
+	}
+
+	logger.Info("ListCollections request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("collection_count", len(protoCollections)),
+		zap.Int64("total", total))
+
+	return &pb.ListCollectionsResponse{Collections: protoCollections, Total: total}, nil
+}
+
+func (h *FavoriteHandler) ListCollectionVideos(ctx context.Context, req *pb.ListCollectionVideosRequest) (
+	*pb.ListCollectionVideosResponse, error) {
+
+	logger.Info("ListCollectionVideos request received",
+		zap.String("collection_id", req.CollectionId),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.CollectionId, "collection_id"); err != nil {
+		logger.Error("Invalid ListCollectionVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid ListCollectionVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.favoriteUseCase.ListCollectionVideos(ctx, req.CollectionId, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list collection videos", zap.Error(err),
+			zap.String("collection_id", req.CollectionId))
+		return nil, err
+	}
+
+	logger.Info("ListCollectionVideos request completed successfully",
+		zap.String("collection_id", req.CollectionId),
+		zap.Int("video_count", len(videos)),
+		zap.Int64("total", total))
+
+	return &pb.ListCollectionVideosResponse{Videos: listVideosToProto(videos), Total: total}, nil
+}
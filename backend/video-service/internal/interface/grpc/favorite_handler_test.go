@@ -0,0 +1,307 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockFavoriteUseCase struct {
+	mock.Mock
+}
+
+func (m *MockFavoriteUseCase) AddFavorite(ctx context.Context, userID, videoID, collectionID string) (
+	int64, error) {
+
+	args := m.Called(ctx, userID, videoID, collectionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteUseCase) RemoveFavorite(ctx context.Context, userID, videoID, collectionID string) (
+	int64, error) {
+
+	args := m.Called(ctx, userID, videoID, collectionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteUseCase) ListFavorites(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFavoriteUseCase) CreateCollection(ctx context.Context, req *usecase.CollectionRequest) (
+	*domain.Collection, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Collection), args.Error(1)
+}
+
+func (m *MockFavoriteUseCase) UpdateCollection(ctx context.Context, req *usecase.CollectionRequest) (
+	*domain.Collection, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Collection), args.Error(1)
+}
+
+func (m *MockFavoriteUseCase) DeleteCollection(ctx context.Context, userID, collectionID string) error {
+	args := m.Called(ctx, userID, collectionID)
+	return args.Error(0)
+}
+
+func (m *MockFavoriteUseCase) ReorderCollections(ctx context.Context, userID string,
+	collectionIDs []string) error {
+
+	args := m.Called(ctx, userID, collectionIDs)
+	return args.Error(0)
+}
+
+func (m *MockFavoriteUseCase) ListCollections(ctx context.Context, ownerID, viewerID string,
+	limit, offset int) ([]*domain.Collection, int64, error) {
+
+	args := m.Called(ctx, ownerID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Collection), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFavoriteUseCase) ListCollectionVideos(ctx context.Context, collectionID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, collectionID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func createTestFavoriteHandler() (*FavoriteHandler, *MockFavoriteUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockFavoriteUseCase{}
+	handler := NewFavoriteHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func createTestDomainCollection() *domain.Collection {
+	return &domain.Collection{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Name:      "Recipes",
+		IsPublic:  true,
+		Position:  2,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestAddFavorite_Success(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+	userID := uuid.New().String()
+	videoID := uuid.New().String()
+
+	mockUseCase.On("AddFavorite", mock.Anything, userID, videoID, "").Return(int64(9), nil)
+
+	resp, err := handler.AddFavorite(context.Background(), &pb.AddFavoriteRequest{UserId: userID, VideoId: videoID})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(9), resp.FavoriteCount)
+}
+
+func TestAddFavorite_InvalidCollectionID(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	_, err := handler.AddFavorite(context.Background(), &pb.AddFavoriteRequest{
+		UserId:       uuid.New().String(),
+		VideoId:      uuid.New().String(),
+		CollectionId: "invalid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "AddFavorite", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddFavorite_VideoNotFound(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("AddFavorite", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), domain.ErrVideoNotFound)
+
+	_, err := handler.AddFavorite(context.Background(), &pb.AddFavoriteRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(clientError(err)))
+}
+
+func TestRemoveFavorite_NotCollectionOwner(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("RemoveFavorite", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), domain.ErrNotCollectionOwner)
+
+	_, err := handler.RemoveFavorite(context.Background(), &pb.RemoveFavoriteRequest{
+		UserId:       uuid.New().String(),
+		VideoId:      uuid.New().String(),
+		CollectionId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientError(err)))
+}
+
+func TestListFavorites_Success(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+	userID := uuid.New().String()
+
+	mockUseCase.On("ListFavorites", mock.Anything, userID, 10, 0).
+		Return([]*domain.Video{createTestDomainVideo()}, int64(1), nil)
+
+	resp, err := handler.ListFavorites(context.Background(), &pb.ListFavoritesRequest{UserId: userID, Limit: 10})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Videos, 1)
+	assert.Equal(t, int64(1), resp.Total)
+}
+
+func TestCreateCollection_Success(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+	collection := createTestDomainCollection()
+
+	mockUseCase.On("CreateCollection", mock.Anything, &usecase.CollectionRequest{
+		UserID:   collection.UserID.String(),
+		Name:     "Recipes",
+		IsPublic: true,
+	}).Return(collection, nil)
+
+	resp, err := handler.CreateCollection(context.Background(), &pb.CreateCollectionRequest{
+		UserId:   collection.UserID.String(),
+		Name:     "Recipes",
+		IsPublic: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, collection.ID.String(), resp.Collection.Id)
+	assert.Equal(t, int32(2), resp.Collection.Position)
+}
+
+func TestCreateCollection_InvalidName(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("CreateCollection", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidCollectionName)
+
+	_, err := handler.CreateCollection(context.Background(), &pb.CreateCollectionRequest{UserId: uuid.New().String()})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestUpdateCollection_NotFound(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("UpdateCollection", mock.Anything, mock.Anything).Return(nil, domain.ErrCollectionNotFound)
+
+	_, err := handler.UpdateCollection(context.Background(), &pb.UpdateCollectionRequest{
+		UserId:       uuid.New().String(),
+		CollectionId: uuid.New().String(),
+		Name:         "Renamed",
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(clientError(err)))
+}
+
+func TestDeleteCollection_Success(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+	userID := uuid.New().String()
+	collectionID := uuid.New().String()
+
+	mockUseCase.On("DeleteCollection", mock.Anything, userID, collectionID).Return(nil)
+
+	resp, err := handler.DeleteCollection(context.Background(), &pb.DeleteCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestReorderCollections_InvalidOrder(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("ReorderCollections", mock.Anything, mock.Anything, mock.Anything).
+		Return(domain.ErrInvalidCollectionsOrder)
+
+	_, err := handler.ReorderCollections(context.Background(), &pb.ReorderCollectionsRequest{
+		UserId:        uuid.New().String(),
+		CollectionIds: []string{uuid.New().String()},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestReorderCollections_InvalidCollectionID(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	_, err := handler.ReorderCollections(context.Background(), &pb.ReorderCollectionsRequest{
+		UserId:        uuid.New().String(),
+		CollectionIds: []string{"invalid"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ReorderCollections", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListCollections_Success(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+	collection := createTestDomainCollection()
+	ownerID := collection.UserID.String()
+
+	mockUseCase.On("ListCollections", mock.Anything, ownerID, "", 20, 0).
+		Return([]*domain.Collection{collection}, int64(1), nil)
+
+	resp, err := handler.ListCollections(context.Background(), &pb.ListCollectionsRequest{UserId: ownerID, Limit: 20})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Collections, 1)
+	assert.Equal(t, "Recipes", resp.Collections[0].Name)
+	assert.Equal(t, int64(1), resp.Total)
+}
+
+func TestListCollectionVideos_InternalError(t *testing.T) {
+	handler, mockUseCase := createTestFavoriteHandler()
+
+	mockUseCase.On("ListCollectionVideos", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, int64(0), errors.New("database error"))
+
+	_, err := handler.ListCollectionVideos(context.Background(), &pb.ListCollectionVideosRequest{
+		CollectionId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(clientError(err)))
+}
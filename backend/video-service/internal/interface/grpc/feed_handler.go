@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+)
+
+// maxBatchSize bounds the IN lists the batch RPCs turn into.
+const maxBatchSize = 100
+
+type FeedHandler struct {
+	feedUseCase usecase.FeedUseCase
+}
+
+func NewFeedHandler(feedUseCase usecase.FeedUseCase) *FeedHandler {
+	return &FeedHandler{
+		feedUseCase: feedUseCase,
+	}
+}
+
+func validateVideoIDs(videoIDs []string) error {
+	if len(videoIDs) == 0 {
+		return invalidArgument("video_ids", "video_ids is required")
+	}
+	if len(videoIDs) > maxBatchSize {
+		return invalidArgument("video_ids", fmt.Sprintf("video_ids must not contain more than %d IDs", maxBatchSize))
+	}
+	for i, videoID := range videoIDs {
+		if err := validateUUID(videoID, fmt.Sprintf("video_ids[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *FeedHandler) BatchGetVideos(ctx context.Context, req *pb.BatchGetVideosRequest) (
+	*pb.BatchGetVideosResponse, error) {
+
+	logger.Info("BatchGetVideos request received",
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int("video_id_count", len(req.VideoIds)))
+
+	if err := validateVideoIDs(req.VideoIds); err != nil {
+		logger.Error("Invalid BatchGetVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in BatchGetVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, missing, err := h.feedUseCase.BatchGetVideos(ctx, req.VideoIds, req.ViewerId)
+	if err != nil {
+		logger.Error("Failed to batch get videos", zap.Error(err), zap.String("viewer_id", req.ViewerId))
+		return nil, err
+	}
+
+	logger.Info("BatchGetVideos request completed successfully",
+		zap.Int("video_count", len(videos)),
+		zap.Int("missing_count", len(missing)))
+
+	return &pb.BatchGetVideosResponse{Videos: listVideosToProto(videos), MissingIds: missing}, nil
+}
+
+func (h *FeedHandler) BatchGetViewerState(ctx context.Context, req *pb.BatchGetViewerStateRequest) (
+	*pb.BatchGetViewerStateResponse, error) {
+
+	logger.Info("BatchGetViewerState request received",
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int("video_id_count", len(req.VideoIds)))
+
+	if err := validateUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in BatchGetViewerState request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateVideoIDs(req.VideoIds); err != nil {
+		logger.Error("Invalid BatchGetViewerState request", zap.Error(err))
+		return nil, err
+	}
+
+	states, err := h.feedUseCase.BatchGetViewerState(ctx, req.ViewerId, req.VideoIds)
+	if err != nil {
+		logger.Error("Failed to batch get viewer state", zap.Error(err), zap.String("viewer_id", req.ViewerId))
+		return nil, err
+	}
+
+	protoStates := make([]*pb.ViewerVideoState, len(states))
+	for i, state := range states {
+		protoStates[i] = &pb.ViewerVideoState{
+			VideoId:          state.VideoID.String(),
+			Liked:            state.Liked,
+			Bookmarked:       state.Bookmarked,
+			FollowingCreator: state.FollowingCreator,
+		}
+	}
+
+	logger.Info("BatchGetViewerState request completed successfully",
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int("state_count", len(protoStates)))
+
+	return &pb.BatchGetViewerStateResponse{States: protoStates}, nil
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockFeedUseCase struct {
+	mock.Mock
+}
+
+func (m *MockFeedUseCase) BatchGetVideos(ctx context.Context, videoIDs []string, viewerID string) (
+	[]*domain.Video, []string, error) {
+
+	args := m.Called(ctx, videoIDs, viewerID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *MockFeedUseCase) BatchGetViewerState(ctx context.Context, viewerID string, videoIDs []string) (
+	[]*usecase.ViewerVideoState, error) {
+
+	args := m.Called(ctx, viewerID, videoIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*usecase.ViewerVideoState), args.Error(1)
+}
+
+func createTestFeedHandler() (*FeedHandler, *MockFeedUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockFeedUseCase{}
+	handler := NewFeedHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestBatchGetVideos_Success(t *testing.T) {
+	handler, mockUseCase := createTestFeedHandler()
+	video := &domain.Video{ID: uuid.New(), UserID: uuid.New(), Title: "Test Video"}
+	missingID := uuid.NewString()
+	videoIDs := []string{video.ID.String(), missingID}
+
+	mockUseCase.On("BatchGetVideos", mock.Anything, videoIDs, "").
+		Return([]*domain.Video{video}, []string{missingID}, nil)
+
+	resp, err := handler.BatchGetVideos(context.Background(), &pb.BatchGetVideosRequest{VideoIds: videoIDs})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Videos, 1)
+	assert.Equal(t, video.ID.String(), resp.Videos[0].Id)
+	assert.Equal(t, []string{missingID}, resp.MissingIds)
+}
+
+func TestBatchGetVideos_TooManyIDs(t *testing.T) {
+	handler, mockUseCase := createTestFeedHandler()
+	videoIDs := make([]string, maxBatchSize+1)
+	for i := range videoIDs {
+		videoIDs[i] = uuid.NewString()
+	}
+
+	_, err := handler.BatchGetVideos(context.Background(), &pb.BatchGetVideosRequest{VideoIds: videoIDs})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "BatchGetVideos", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBatchGetVideos_InvalidVideoID(t *testing.T) {
+	handler, _ := createTestFeedHandler()
+
+	_, err := handler.BatchGetVideos(context.Background(), &pb.BatchGetVideosRequest{
+		VideoIds: []string{uuid.NewString(), "not-a-uuid"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "video_ids[1]")
+}
+
+func TestBatchGetViewerState_Success(t *testing.T) {
+	handler, mockUseCase := createTestFeedHandler()
+	viewerID := uuid.NewString()
+	videoID := uuid.New()
+
+	mockUseCase.On("BatchGetViewerState", mock.Anything, viewerID, []string{videoID.String()}).
+		Return([]*usecase.ViewerVideoState{{VideoID: videoID, Liked: true, FollowingCreator: true}}, nil)
+
+	resp, err := handler.BatchGetViewerState(context.Background(), &pb.BatchGetViewerStateRequest{
+		ViewerId: viewerID,
+		VideoIds: []string{videoID.String()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.States, 1)
+	assert.Equal(t, videoID.String(), resp.States[0].VideoId)
+	assert.True(t, resp.States[0].Liked)
+	assert.False(t, resp.States[0].Bookmarked)
+	assert.True(t, resp.States[0].FollowingCreator)
+}
+
+func TestBatchGetViewerState_ViewerRequired(t *testing.T) {
+	handler, _ := createTestFeedHandler()
+
+	_, err := handler.BatchGetViewerState(context.Background(), &pb.BatchGetViewerStateRequest{
+		VideoIds: []string{uuid.NewString()},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// IdempotencyKeyMetadataKey carries the client-chosen key that makes a retry
+// of a mutating RPC replay the original response.
+const IdempotencyKeyMetadataKey = "idempotency-key"
+
+// idempotentMethods are the unary RPCs that write. Reads are safe to retry
+// and are never cached.
+var idempotentMethods = map[string]bool{
+	pb.VideoService_CreateVideo_FullMethodName:          true,
+	pb.VideoService_UpdateVideo_FullMethodName:          true,
+	pb.VideoService_DeleteVideo_FullMethodName:          true,
+	pb.VideoService_LikeVideo_FullMethodName:            true,
+	pb.VideoService_UnlikeVideo_FullMethodName:          true,
+	pb.VideoService_CreateView_FullMethodName:           true,
+	pb.VideoService_SetVideoCover_FullMethodName:        true,
+	pb.VideoService_CreateUploadSession_FullMethodName:  true,
+	pb.VideoService_ShareVideo_FullMethodName:           true,
+	pb.VideoService_AddFavorite_FullMethodName:          true,
+	pb.VideoService_RemoveFavorite_FullMethodName:       true,
+	pb.VideoService_CreateCollection_FullMethodName:     true,
+	pb.VideoService_UpdateCollection_FullMethodName:     true,
+	pb.VideoService_DeleteCollection_FullMethodName:     true,
+	pb.VideoService_ReorderCollections_FullMethodName:   true,
+	pb.VideoService_RestoreVideo_FullMethodName:         true,
+	pb.VideoService_ReportVideo_FullMethodName:          true,
+	pb.VideoService_ModerateVideo_FullMethodName:        true,
+	pb.VideoService_BlockUser_FullMethodName:            true,
+	pb.VideoService_UnblockUser_FullMethodName:          true,
+	pb.VideoService_MarkRead_FullMethodName:             true,
+	pb.VideoService_RecordActivity_FullMethodName:       true,
+	pb.VideoService_PublishVideo_FullMethodName:         true,
+	pb.VideoService_ScheduleVideo_FullMethodName:        true,
+	pb.VideoService_CancelScheduledVideo_FullMethodName: true,
+	pb.VideoService_PinVideo_FullMethodName:             true,
+	pb.VideoService_UnpinVideo_FullMethodName:           true,
+	pb.VideoService_CreatePlaylist_FullMethodName:       true,
+	pb.VideoService_UpdatePlaylist_FullMethodName:       true,
+	pb.VideoService_DeletePlaylist_FullMethodName:       true,
+	pb.VideoService_ReorderPlaylist_FullMethodName:      true,
+	pb.VideoService_AddPlaylistVideo_FullMethodName:     true,
+	pb.VideoService_RemovePlaylistVideo_FullMethodName:  true,
+}
+
+// NewIdempotencyInterceptor replays the stored response when a mutating RPC
+// arrives again with an idempotency key it has already completed. Requests
+// without a key run as usual. It must sit inside UnaryErrorInterceptor so
+// that its own errors are mapped too.
+func NewIdempotencyInterceptor(idempotency usecase.IdempotencyUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := idempotencyKey(ctx)
+		message, ok := req.(proto.Message)
+		if key == "" || !ok || !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		request, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		userID := requestUserID(message)
+
+		stored, err := idempotency.Begin(ctx, userID, info.FullMethod, key, request)
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			logger.Info("Replaying response for repeated request",
+				zap.String("method", info.FullMethod),
+				zap.String("user_id", userID),
+				zap.String("idempotency_key", key))
+			return replayResponse(stored)
+		}
+
+		// The outcome is recorded even if the client has gone away, since
+		// that is exactly when it is going to retry.
+		recordCtx := context.WithoutCancel(ctx)
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := idempotency.Release(recordCtx, userID, info.FullMethod, key); releaseErr != nil {
+				logger.Error("Failed to release idempotency key", zap.Error(releaseErr),
+					zap.String("method", info.FullMethod),
+					zap.String("idempotency_key", key))
+			}
+			return nil, err
+		}
+
+		if err := completeRequest(recordCtx, idempotency, userID, info.FullMethod, key, resp); err != nil {
+			logger.Error("Failed to store response for idempotency key", zap.Error(err),
+				zap.String("method", info.FullMethod),
+				zap.String("idempotency_key", key))
+		}
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(IdempotencyKeyMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// actingUserFields name the request field that identifies the caller, in
+// the order they are looked for.
+var actingUserFields = []protoreflect.Name{"user_id", "moderator_id", "actor_id"}
+
+// requestUserID scopes keys to the acting user so that two users who pick
+// the same key never see each other's responses. Requests that carry no
+// user are still told apart by the request hash.
+func requestUserID(message proto.Message) string {
+	reflected := message.ProtoReflect()
+	for _, name := range actingUserFields {
+		if field := reflected.Descriptor().Fields().ByName(name); field != nil {
+			return reflected.Get(field).String()
+		}
+	}
+	return ""
+}
+
+func completeRequest(ctx context.Context, idempotency usecase.IdempotencyUseCase, userID, method, key string,
+	resp any) error {
+
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return idempotency.Release(ctx, userID, method, key)
+	}
+	wrapped, err := anypb.New(message)
+	if err != nil {
+		return err
+	}
+	response, err := proto.Marshal(wrapped)
+	if err != nil {
+		return err
+	}
+	return idempotency.Complete(ctx, userID, method, key, response)
+}
+
+func replayResponse(stored []byte) (any, error) {
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(stored, &wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type MockIdempotencyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyUseCase) Begin(ctx context.Context, userID, method, key string, request []byte) (
+	[]byte, error) {
+
+	args := m.Called(ctx, userID, method, key, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockIdempotencyUseCase) Complete(ctx context.Context, userID, method, key string, response []byte) error {
+	args := m.Called(ctx, userID, method, key, response)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyUseCase) Release(ctx context.Context, userID, method, key string) error {
+	args := m.Called(ctx, userID, method, key)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyUseCase) PurgeExpired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+var createVideoInfo = &grpc.UnaryServerInfo{FullMethod: pb.VideoService_CreateVideo_FullMethodName}
+
+func createTestIdempotencyInterceptor() (grpc.UnaryServerInterceptor, *MockIdempotencyUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockIdempotencyUseCase{}
+	return NewIdempotencyInterceptor(mockUseCase), mockUseCase
+}
+
+func withIdempotencyKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMetadataKey, key))
+}
+
+// countingHandler returns resp and err and records how often it ran.
+func countingHandler(calls *int, resp any, err error) grpc.UnaryHandler {
+	return func(context.Context, any) (any, error) {
+		*calls++
+		return resp, err
+	}
+}
+
+func TestIdempotencyInterceptor_FirstRequestIsStored(t *testing.T) {
+	interceptor, mockUseCase := createTestIdempotencyInterceptor()
+	req := &pb.CreateVideoRequest{UserId: "user-1", Title: "Title"}
+	resp := &pb.CreateVideoResponse{Video: &pb.Video{Id: "video-1"}}
+
+	mockUseCase.On("Begin", mock.Anything, "user-1", createVideoInfo.FullMethod, "key-1", mock.Anything).
+		Return(nil, nil)
+	mockUseCase.On("Complete", mock.Anything, "user-1", createVideoInfo.FullMethod, "key-1",
+		mock.MatchedBy(func(stored []byte) bool {
+			var wrapped anypb.Any
+			return proto.Unmarshal(stored, &wrapped) == nil && wrapped.MessageIs(&pb.CreateVideoResponse{})
+		})).Return(nil)
+
+	calls := 0
+	got, err := interceptor(withIdempotencyKey("key-1"), req, createVideoInfo, countingHandler(&calls, resp, nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, resp, got)
+	assert.Equal(t, 1, calls)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestIdempotencyInterceptor_RetryReplaysStoredResponse(t *testing.T) {
+	interceptor, mockUseCase := createTestIdempotencyInterceptor()
+	original := &pb.CreateVideoResponse{Video: &pb.Video{Id: "video-1", Title: "Title"}}
+	wrapped, err := anypb.New(original)
+	require.NoError(t, err)
+	stored, err := proto.Marshal(wrapped)
+	require.NoError(t, err)
+
+	mockUseCase.On("Begin", mock.Anything, "user-1", createVideoInfo.FullMethod, "key-1", mock.Anything).
+		Return(stored, nil)
+
+	calls := 0
+	got, err := interceptor(withIdempotencyKey("key-1"), &pb.CreateVideoRequest{UserId: "user-1"}, createVideoInfo,
+		countingHandler(&calls, nil, nil))
+
+	require.NoError(t, err)
+	assert.Zero(t, calls, "a replayed request must not run again")
+	assert.True(t, proto.Equal(original, got.(proto.Message)))
+	mockUseCase.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func TestIdempotencyInterceptor_FailureReleasesKey(t *testing.T) {
+	interceptor, mockUseCase := createTestIdempotencyInterceptor()
+
+	mockUseCase.On("Begin", mock.Anything, "user-1", createVideoInfo.FullMethod, "key-1", mock.Anything).
+		Return(nil, nil)
+	mockUseCase.On("Release", mock.Anything, "user-1", createVideoInfo.FullMethod, "key-1").Return(nil)
+
+	calls := 0
+	_, err := interceptor(withIdempotencyKey("key-1"), &pb.CreateVideoRequest{UserId: "user-1"}, createVideoInfo,
+		countingHandler(&calls, nil, domain.ErrVideoNotFound))
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestIdempotencyInterceptor_KeyInUse(t *testing.T) {
+	interceptor, mockUseCase := createTestIdempotencyInterceptor()
+
+	mockUseCase.On("Begin", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrIdempotencyKeyInUse)
+
+	calls := 0
+	_, err := interceptor(withIdempotencyKey("key-1"), &pb.CreateVideoRequest{}, createVideoInfo,
+		countingHandler(&calls, nil, nil))
+
+	assert.ErrorIs(t, err, domain.ErrIdempotencyKeyInUse)
+	assert.Zero(t, calls)
+}
+
+func TestIdempotencyInterceptor_Bypassed(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		info *grpc.UnaryServerInfo
+	}{
+		{"no key", context.Background(), createVideoInfo},
+		{"read-only method", withIdempotencyKey("key-1"),
+			&grpc.UnaryServerInfo{FullMethod: pb.VideoService_GetVideo_FullMethodName}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor, mockUseCase := createTestIdempotencyInterceptor()
+
+			calls := 0
+			_, err := interceptor(tt.ctx, &pb.GetVideoRequest{}, tt.info,
+				countingHandler(&calls, &pb.GetVideoResponse{}, errors.New("handler ran")))
+
+			assert.EqualError(t, err, "handler ran")
+			assert.Equal(t, 1, calls)
+			mockUseCase.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything)
+		})
+	}
+}
+
+func TestRequestUserID(t *testing.T) {
+	assert.Equal(t, "user-1", requestUserID(&pb.CreateViewRequest{UserId: "user-1"}))
+	assert.Equal(t, "moderator-1", requestUserID(&pb.ModerateVideoRequest{ModeratorId: "moderator-1"}))
+	assert.Equal(t, "actor-1", requestUserID(&pb.RecordActivityRequest{ActorId: "actor-1"}))
+	assert.Empty(t, requestUserID(&pb.DeleteVideoRequest{Id: "video-1"}))
+}
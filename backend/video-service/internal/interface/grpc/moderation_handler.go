@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ModerationHandler struct {
+	moderationUseCase usecase.ModerationUseCase
+}
+
+func NewModerationHandler(moderationUseCase usecase.ModerationUseCase) *ModerationHandler {
+	return &ModerationHandler{
+		moderationUseCase: moderationUseCase,
+	}
+}
+
+func moderationCaseToProto(moderationCase *domain.ModerationCase) *pb.ModerationCase {
+	reasonCounts := make(map[string]int64, len(moderationCase.ReasonCounts))
+	for reason, count := range moderationCase.ReasonCounts {
+		reasonCounts[string(reason)] = count
+	}
+
+	protoCase := &pb.ModerationCase{
+		Status:          string(moderationCase.Status),
+		Severity:        int32(moderationCase.Severity),
+		ReportCount:     moderationCase.ReportCount,
+		ReasonCounts:    reasonCounts,
+		FirstReportedAt: timestamppb.New(moderationCase.FirstReportedAt),
+		LastReportedAt:  timestamppb.New(moderationCase.LastReportedAt),
+	}
+	if moderationCase.Video != nil {
+		protoCase.Video = domainVideoToProto(moderationCase.Video)
+	}
+	return protoCase
+}
+
+func moderationActionToProto(action *domain.ModerationAction) *pb.ModerationAction {
+	return &pb.ModerationAction{
+		Id:          action.ID.String(),
+		VideoId:     action.VideoID.String(),
+		ModeratorId: action.ModeratorID.String(),
+		Action:      string(action.Action),
+		Reason:      action.Reason,
+		CreatedAt:   timestamppb.New(action.CreatedAt),
+	}
+}
+
+func (h *ModerationHandler) ReportVideo(ctx context.Context, req *pb.ReportVideoRequest) (
+	*pb.ReportVideoResponse, error) {
+
+	logger.Info("ReportVideo request received",
+		zap.String("reporter_id", req.ReporterId),
+		zap.String("video_id", req.VideoId),
+		zap.String("reason", req.Reason))
+
+	if err := validateUUID(req.ReporterId, "reporter_id"); err != nil {
+		logger.Error("Invalid ReportVideo request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid ReportVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	created, err := h.moderationUseCase.ReportVideo(ctx, &usecase.ReportVideoRequest{
+		ReporterID: req.ReporterId,
+		VideoID:    req.VideoId,
+		Reason:     req.Reason,
+		Details:    req.Details,
+	})
+	if err != nil {
+		logger.Error("Failed to report video", zap.Error(err),
+			zap.String("reporter_id", req.ReporterId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("ReportVideo request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Bool("duplicate", !created))
+
+	return &pb.ReportVideoResponse{Duplicate: !created}, nil
+}
+
+func (h *ModerationHandler) GetModerationQueue(ctx context.Context, req *pb.GetModerationQueueRequest) (
+	*pb.GetModerationQueueResponse, error) {
+
+	logger.Info("GetModerationQueue request received",
+		zap.String("moderator_id", req.ModeratorId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.ModeratorId, "moderator_id"); err != nil {
+		logger.Error("Invalid GetModerationQueue request", zap.Error(err))
+		return nil, err
+	}
+
+	cases, total, err := h.moderationUseCase.GetModerationQueue(ctx, req.ModeratorId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to get moderation queue", zap.Error(err),
+			zap.String("moderator_id", req.ModeratorId))
+		return nil, err
+	}
+
+	protoCases := make([]*pb.ModerationCase, len(cases))
+	for i, moderationCase := range cases {
+		protoCases[i] = moderationCaseToProto(moderationCase)
+	}
+
+	logger.Info("GetModerationQueue request completed successfully",
+		zap.Int("case_count", len(cases)),
+		zap.Int64("total", total))
+
+	return &pb.GetModerationQueueResponse{Cases: protoCases, Total: total}, nil
+}
+
+func (h *ModerationHandler) ModerateVideo(ctx context.Context, req *pb.ModerateVideoRequest) (
+	*pb.ModerateVideoResponse, error) {
+
+	logger.Info("ModerateVideo request received",
+		zap.String("moderator_id", req.ModeratorId),
+		zap.String("video_id", req.VideoId),
+		zap.String("action", req.Action))
+
+	if err := validateUUID(req.ModeratorId, "moderator_id"); err != nil {
+		logger.Error("Invalid ModerateVideo request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid ModerateVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	outcome, err := h.moderationUseCase.ModerateVideo(ctx, &usecase.ModerateVideoRequest{
+		ModeratorID: req.ModeratorId,
+		VideoID:     req.VideoId,
+		Action:      req.Action,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		logger.Error("Failed to moderate video", zap.Error(err),
+			zap.String("moderator_id", req.ModeratorId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("ModerateVideo request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.String("moderation_state", string(outcome.Video.ModerationState)))
+
+	return &pb.ModerateVideoResponse{
+		Video:              domainVideoToProto(outcome.Video),
+		CreatorStrikeCount: outcome.StrikeCount,
+	}, nil
+}
+
+func (h *ModerationHandler) ListModerationActions(ctx context.Context, req *pb.ListModerationActionsRequest) (
+	*pb.ListModerationActionsResponse, error) {
+
+	logger.Info("ListModerationActions request received",
+		zap.String("moderator_id", req.ModeratorId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUUID(req.ModeratorId, "moderator_id"); err != nil {
+		logger.Error("Invalid ListModerationActions request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid ListModerationActions request", zap.Error(err))
+		return nil, err
+	}
+
+	actions, err := h.moderationUseCase.ListModerationActions(ctx, req.ModeratorId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to list moderation actions", zap.Error(err),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	protoActions := make([]*pb.ModerationAction, len(actions))
+	for i, action := range actions {
+		protoActions[i] = moderationActionToProto(action)
+	}
+
+	logger.Info("ListModerationActions request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int("action_count", len(actions)))
+
+	return &pb.ListModerationActionsResponse{Actions: protoActions}, nil
+}
+
+func (h *ModerationHandler) ListFlaggedViews(ctx context.Context, req *pb.ListFlaggedViewsRequest) (
+	*pb.ListFlaggedViewsResponse, error) {
+
+	logger.Info("ListFlaggedViews request received",
+		zap.String("moderator_id", req.ModeratorId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.ModeratorId, "moderator_id"); err != nil {
+		logger.Error("Invalid ListFlaggedViews request", zap.Error(err))
+		return nil, err
+	}
+
+	views, total, err := h.moderationUseCase.ListFlaggedViews(ctx, req.ModeratorId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list flagged views", zap.Error(err),
+			zap.String("moderator_id", req.ModeratorId))
+		return nil, err
+	}
+
+	protoViews := make([]*pb.FlaggedView, len(views))
+	for i, view := range views {
+		protoViews[i] = &pb.FlaggedView{
+			Id:        view.ID.String(),
+			UserId:    view.UserID.String(),
+			VideoId:   view.VideoID.String(),
+			WatchTime: int32(view.WatchTime),
+			ClientIp:  view.ClientIP,
+			Reason:    string(view.FlagReason),
+			CreatedAt: timestamppb.New(view.CreatedAt),
+		}
+	}
+
+	logger.Info("ListFlaggedViews request completed successfully",
+		zap.Int("view_count", len(views)),
+		zap.Int64("total", total))
+
+	return &pb.ListFlaggedViewsResponse{Views: protoViews, Total: total}, nil
+}
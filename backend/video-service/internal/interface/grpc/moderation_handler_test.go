@@ -0,0 +1,262 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockModerationUseCase struct {
+	mock.Mock
+}
+
+func (m *MockModerationUseCase) ReportVideo(ctx context.Context, req *usecase.ReportVideoRequest) (bool, error) {
+	args := m.Called(ctx, req)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockModerationUseCase) GetModerationQueue(ctx context.Context, moderatorID string,
+	limit, offset int) ([]*domain.ModerationCase, int64, error) {
+
+	args := m.Called(ctx, moderatorID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.ModerationCase), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockModerationUseCase) ModerateVideo(ctx context.Context, req *usecase.ModerateVideoRequest) (
+	*usecase.ModerationOutcome, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ModerationOutcome), args.Error(1)
+}
+
+func (m *MockModerationUseCase) ListModerationActions(ctx context.Context, moderatorID, videoID string) (
+	[]*domain.ModerationAction, error) {
+
+	args := m.Called(ctx, moderatorID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ModerationAction), args.Error(1)
+}
+
+func (m *MockModerationUseCase) ListFlaggedViews(ctx context.Context, moderatorID string,
+	limit, offset int) ([]*domain.UserVideoView, int64, error) {
+
+	args := m.Called(ctx, moderatorID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.UserVideoView), args.Get(1).(int64), args.Error(2)
+}
+
+func createTestModerationHandler() (*ModerationHandler, *MockModerationUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockModerationUseCase{}
+	handler := NewModerationHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestReportVideo_Duplicate(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+	reporterID := uuid.NewString()
+	videoID := uuid.NewString()
+
+	mockUseCase.On("ReportVideo", mock.Anything, &usecase.ReportVideoRequest{
+		ReporterID: reporterID,
+		VideoID:    videoID,
+		Reason:     "spam",
+	}).Return(false, nil)
+
+	resp, err := handler.ReportVideo(context.Background(), &pb.ReportVideoRequest{
+		ReporterId: reporterID,
+		VideoId:    videoID,
+		Reason:     "spam",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Duplicate)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestReportVideo_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"video not found", domain.ErrVideoNotFound, codes.NotFound},
+		{"invalid reason", domain.ErrInvalidReportReason, codes.InvalidArgument},
+		{"own video", domain.ErrCannotReportOwnVideo, codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestModerationHandler()
+			mockUseCase.On("ReportVideo", mock.Anything, mock.Anything).Return(false, test.err)
+
+			_, err := handler.ReportVideo(context.Background(), &pb.ReportVideoRequest{
+				ReporterId: uuid.NewString(),
+				VideoId:    uuid.NewString(),
+				Reason:     "spam",
+			})
+
+			assert.Equal(t, test.code, status.Code(clientError(err)))
+		})
+	}
+}
+
+func TestGetModerationQueue_Success(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+	moderatorID := uuid.NewString()
+	video := createTestDomainVideo()
+
+	mockUseCase.On("GetModerationQueue", mock.Anything, moderatorID, 20, 0).Return([]*domain.ModerationCase{{
+		VideoID:         video.ID,
+		Status:          domain.CaseStatusOpen,
+		Severity:        4,
+		ReportCount:     3,
+		FirstReportedAt: time.Now(),
+		LastReportedAt:  time.Now(),
+		Video:           video,
+		ReasonCounts:    map[domain.ReportReason]int64{domain.ReportReasonViolence: 3},
+	}}, int64(1), nil)
+
+	resp, err := handler.GetModerationQueue(context.Background(), &pb.GetModerationQueueRequest{
+		ModeratorId: moderatorID,
+		Limit:       20,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Cases, 1)
+	assert.Equal(t, video.ID.String(), resp.Cases[0].Video.Id)
+	assert.Equal(t, int32(4), resp.Cases[0].Severity)
+	assert.Equal(t, int64(3), resp.Cases[0].ReasonCounts["violence"])
+}
+
+func TestGetModerationQueue_NotModerator(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+
+	mockUseCase.On("GetModerationQueue", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, int64(0), domain.ErrNotModerator)
+
+	_, err := handler.GetModerationQueue(context.Background(), &pb.GetModerationQueueRequest{
+		ModeratorId: uuid.NewString(),
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientError(err)))
+}
+
+func TestModerateVideo_Success(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+	moderatorID := uuid.NewString()
+	video := createTestDomainVideo()
+	video.ModerationState = domain.ModerationStateTakenDown
+	video.ModerationReason = "repeated copyright claims"
+
+	mockUseCase.On("ModerateVideo", mock.Anything, &usecase.ModerateVideoRequest{
+		ModeratorID: moderatorID,
+		VideoID:     video.ID.String(),
+		Action:      "strike",
+		Reason:      "repeated copyright claims",
+	}).Return(&usecase.ModerationOutcome{Video: video, StrikeCount: 2}, nil)
+
+	resp, err := handler.ModerateVideo(context.Background(), &pb.ModerateVideoRequest{
+		ModeratorId: moderatorID,
+		VideoId:     video.ID.String(),
+		Action:      "strike",
+		Reason:      "repeated copyright claims",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "taken_down", resp.Video.ModerationState)
+	assert.Equal(t, "repeated copyright claims", resp.Video.ModerationReason)
+	assert.Equal(t, int64(2), resp.CreatorStrikeCount)
+}
+
+func TestModerateVideo_InvalidVideoID(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+
+	_, err := handler.ModerateVideo(context.Background(), &pb.ModerateVideoRequest{
+		ModeratorId: uuid.NewString(),
+		VideoId:     "invalid",
+		Action:      "dismiss",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ModerateVideo", mock.Anything, mock.Anything)
+}
+
+func TestListModerationActions_Success(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+	moderatorID := uuid.New()
+	videoID := uuid.New()
+
+	mockUseCase.On("ListModerationActions", mock.Anything, moderatorID.String(), videoID.String()).
+		Return([]*domain.ModerationAction{{
+			ID:          uuid.New(),
+			VideoID:     videoID,
+			ModeratorID: moderatorID,
+			Action:      domain.ModerationActionRestrict,
+			Reason:      "misleading thumbnail",
+			CreatedAt:   time.Now(),
+		}}, nil)
+
+	resp, err := handler.ListModerationActions(context.Background(), &pb.ListModerationActionsRequest{
+		ModeratorId: moderatorID.String(),
+		VideoId:     videoID.String(),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Actions, 1)
+	assert.Equal(t, "restrict", resp.Actions[0].Action)
+	assert.Equal(t, "misleading thumbnail", resp.Actions[0].Reason)
+}
+
+func TestListFlaggedViews_Success(t *testing.T) {
+	handler, mockUseCase := createTestModerationHandler()
+	moderatorID := uuid.NewString()
+	view := &domain.UserVideoView{
+		ID:         uuid.New(),
+		UserID:     uuid.New(),
+		VideoID:    uuid.New(),
+		WatchTime:  12,
+		ClientIP:   "203.0.113.7",
+		FlagReason: domain.ViewFlagBurst,
+		CreatedAt:  time.Now(),
+	}
+
+	mockUseCase.On("ListFlaggedViews", mock.Anything, moderatorID, 20, 0).
+		Return([]*domain.UserVideoView{view}, int64(1), nil)
+
+	resp, err := handler.ListFlaggedViews(context.Background(), &pb.ListFlaggedViewsRequest{
+		ModeratorId: moderatorID,
+		Limit:       20,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Views, 1)
+	assert.Equal(t, "burst", resp.Views[0].Reason)
+	assert.Equal(t, "203.0.113.7", resp.Views[0].ClientIp)
+}
@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NotificationHandler struct {
+	notificationUseCase usecase.NotificationUseCase
+}
+
+func NewNotificationHandler(notificationUseCase usecase.NotificationUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUseCase: notificationUseCase,
+	}
+}
+
+func notificationToProto(notification *domain.Notification) *pb.Notification {
+	protoNotification := &pb.Notification{
+		Id:          notification.ID.String(),
+		Type:        string(notification.Type),
+		LastActorId: notification.LastActorID.String(),
+		ActorCount:  notification.ActorCount,
+		Read:        notification.ReadAt != nil,
+		CreatedAt:   timestamppb.New(notification.CreatedAt),
+		UpdatedAt:   timestamppb.New(notification.UpdatedAt),
+	}
+	if notification.VideoID != nil {
+		protoNotification.VideoId = notification.VideoID.String()
+	}
+	return protoNotification
+}
+
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (
+	*pb.ListNotificationsResponse, error) {
+
+	logger.Info("ListNotifications request received",
+		zap.String("user_id", req.UserId),
+		zap.Bool("unread_only", req.UnreadOnly),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListNotifications request", zap.Error(err))
+		return nil, err
+	}
+
+	notifications, unread, err := h.notificationUseCase.ListNotifications(ctx, req.UserId, req.UnreadOnly,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list notifications", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	protoNotifications := make([]*pb.Notification, len(notifications))
+	for i, notification := range notifications {
+		protoNotifications[i] = notificationToProto(notification)
+	}
+
+	logger.Info("ListNotifications request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("notification_count", len(protoNotifications)),
+		zap.Int64("unread_count", unread))
+
+	return &pb.ListNotificationsResponse{Notifications: protoNotifications, UnreadCount: unread}, nil
+}
+
+func (h *NotificationHandler) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	logger.Info("MarkRead request received",
+		zap.String("user_id", req.UserId),
+		zap.Int("notification_count", len(req.NotificationIds)))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid MarkRead request", zap.Error(err))
+		return nil, err
+	}
+	for _, notificationID := range req.NotificationIds {
+		if err := validateUUID(notificationID, "notification_ids"); err != nil {
+			logger.Error("Invalid notification_ids in MarkRead request", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	unread, err := h.notificationUseCase.MarkRead(ctx, req.UserId, req.NotificationIds)
+	if err != nil {
+		logger.Error("Failed to mark notifications read", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("MarkRead request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int64("unread_count", unread))
+
+	return &pb.MarkReadResponse{UnreadCount: unread}, nil
+}
+
+// SubscribeNotifications streams notifications created after the call
+// starts. Clients catch up on anything older with ListNotifications.
+func (h *NotificationHandler) SubscribeNotifications(req *pb.SubscribeNotificationsRequest,
+	stream pb.VideoService_SubscribeNotificationsServer) error {
+
+	ctx := stream.Context()
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid SubscribeNotifications request", zap.Error(err))
+		return err
+	}
+
+	notifications, cancel, err := h.notificationUseCase.SubscribeNotifications(ctx, req.UserId)
+	if err != nil {
+		logger.Error("Failed to subscribe to notifications", zap.Error(err), zap.String("user_id", req.UserId))
+		return err
+	}
+	defer cancel()
+
+	logger.Info("SubscribeNotifications stream started", zap.String("user_id", req.UserId))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("SubscribeNotifications stream closed by client", zap.String("user_id", req.UserId))
+			return nil
+		case notification, ok := <-notifications:
+			if !ok {
+				logger.Info("SubscribeNotifications stream ended by server", zap.String("user_id", req.UserId))
+				return status.Error(codes.Unavailable, "notification stream closed, please reconnect")
+			}
+			if err := stream.Send(notificationToProto(notification)); err != nil {
+				logger.Error("Failed to send notification", zap.Error(err), zap.String("user_id", req.UserId))
+				return err
+			}
+		}
+	}
+}
+
+func (h *NotificationHandler) RecordActivity(ctx context.Context, req *pb.RecordActivityRequest) (
+	*pb.RecordActivityResponse, error) {
+
+	logger.Info("RecordActivity request received",
+		zap.String("type", req.Type),
+		zap.String("recipient_id", req.RecipientId),
+		zap.String("actor_id", req.ActorId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUUID(req.RecipientId, "recipient_id"); err != nil {
+		logger.Error("Invalid RecordActivity request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.ActorId, "actor_id"); err != nil {
+		logger.Error("Invalid RecordActivity request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid RecordActivity request", zap.Error(err))
+		return nil, err
+	}
+
+	err := h.notificationUseCase.RecordActivity(ctx, &usecase.RecordActivityRequest{
+		Type:        req.Type,
+		RecipientID: req.RecipientId,
+		ActorID:     req.ActorId,
+		VideoID:     req.VideoId,
+	})
+	if err != nil {
+		logger.Error("Failed to record activity", zap.Error(err), zap.String("type", req.Type))
+		return nil, err
+	}
+
+	logger.Info("RecordActivity request completed successfully",
+		zap.String("type", req.Type),
+		zap.String("recipient_id", req.RecipientId))
+
+	return &pb.RecordActivityResponse{Success: true}, nil
+}
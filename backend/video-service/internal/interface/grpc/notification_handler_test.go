@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockNotificationUseCase struct {
+	mock.Mock
+}
+
+func (m *MockNotificationUseCase) ListNotifications(ctx context.Context, userID string, unreadOnly bool,
+	limit, offset int) ([]*domain.Notification, int64, error) {
+
+	args := m.Called(ctx, userID, unreadOnly, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationUseCase) MarkRead(ctx context.Context, userID string,
+	notificationIDs []string) (int64, error) {
+
+	args := m.Called(ctx, userID, notificationIDs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationUseCase) SubscribeNotifications(ctx context.Context, userID string) (
+	<-chan *domain.Notification, func(), error) {
+
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(<-chan *domain.Notification), args.Get(1).(func()), args.Error(2)
+}
+
+func (m *MockNotificationUseCase) RecordActivity(ctx context.Context, req *usecase.RecordActivityRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+type fakeNotificationStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.Notification
+}
+
+func (s *fakeNotificationStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeNotificationStream) Send(notification *pb.Notification) error {
+	s.sent = append(s.sent, notification)
+	return nil
+}
+
+func createTestNotificationHandler() (*NotificationHandler, *MockNotificationUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockNotificationUseCase{}
+	handler := NewNotificationHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestListNotifications_Success(t *testing.T) {
+	handler, mockUseCase := createTestNotificationHandler()
+	userID := uuid.New()
+	videoID := uuid.New()
+	notification := &domain.Notification{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Type:        domain.NotificationTypeLike,
+		VideoID:     &videoID,
+		LastActorID: uuid.New(),
+		ActorCount:  13,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	mockUseCase.On("ListNotifications", mock.Anything, userID.String(), false, 20, 0).
+		Return([]*domain.Notification{notification}, int64(1), nil)
+
+	resp, err := handler.ListNotifications(context.Background(), &pb.ListNotificationsRequest{
+		UserId: userID.String(),
+		Limit:  20,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.UnreadCount)
+	require.Len(t, resp.Notifications, 1)
+	assert.Equal(t, videoID.String(), resp.Notifications[0].VideoId)
+	assert.Equal(t, int64(13), resp.Notifications[0].ActorCount)
+	assert.False(t, resp.Notifications[0].Read)
+}
+
+func TestMarkRead_InvalidNotificationID(t *testing.T) {
+	handler, _ := createTestNotificationHandler()
+
+	_, err := handler.MarkRead(context.Background(), &pb.MarkReadRequest{
+		UserId:          uuid.NewString(),
+		NotificationIds: []string{"bad"},
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestSubscribeNotifications_StreamsUntilHubCloses(t *testing.T) {
+	handler, mockUseCase := createTestNotificationHandler()
+	userID := uuid.NewString()
+	notifications := make(chan *domain.Notification, 2)
+	cancelled := false
+
+	mockUseCase.On("SubscribeNotifications", mock.Anything, userID).
+		Return((<-chan *domain.Notification)(notifications), func() { cancelled = true }, nil)
+
+	notifications <- &domain.Notification{ID: uuid.New(), Type: domain.NotificationTypeFollow}
+	close(notifications)
+
+	stream := &fakeNotificationStream{ctx: context.Background()}
+	err := handler.SubscribeNotifications(&pb.SubscribeNotificationsRequest{UserId: userID}, stream)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, "follow", stream.sent[0].Type)
+	assert.Empty(t, stream.sent[0].VideoId)
+	assert.True(t, cancelled)
+}
+
+func TestSubscribeNotifications_ClientDisconnect(t *testing.T) {
+	handler, mockUseCase := createTestNotificationHandler()
+	userID := uuid.NewString()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockUseCase.On("SubscribeNotifications", mock.Anything, userID).
+		Return((<-chan *domain.Notification)(make(chan *domain.Notification)), func() {}, nil)
+
+	err := handler.SubscribeNotifications(&pb.SubscribeNotificationsRequest{UserId: userID},
+		&fakeNotificationStream{ctx: ctx})
+
+	assert.NoError(t, err)
+}
+
+func TestRecordActivity_InvalidType(t *testing.T) {
+	handler, mockUseCase := createTestNotificationHandler()
+	req := &pb.RecordActivityRequest{
+		Type:        "like",
+		RecipientId: uuid.NewString(),
+		ActorId:     uuid.NewString(),
+	}
+
+	mockUseCase.On("RecordActivity", mock.Anything, mock.Anything).Return(domain.ErrInvalidNotificationType)
+
+	_, err := handler.RecordActivity(context.Background(), req)
+
+	st, ok := status.FromError(clientError(err))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
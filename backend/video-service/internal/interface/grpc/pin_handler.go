@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+)
+
+type PinHandler struct {
+	pinUseCase usecase.PinUseCase
+}
+
+func NewPinHandler(pinUseCase usecase.PinUseCase) *PinHandler {
+	return &PinHandler{
+		pinUseCase: pinUseCase,
+	}
+}
+
+func (h *PinHandler) PinVideo(ctx context.Context, req *pb.PinVideoRequest) (*pb.PinVideoResponse, error) {
+	logger.Info("PinVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid PinVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := h.pinUseCase.PinVideo(ctx, req.UserId, req.VideoId); err != nil {
+		logger.Error("Failed to pin video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("PinVideo request completed successfully", zap.String("video_id", req.VideoId))
+
+	return &pb.PinVideoResponse{Success: true}, nil
+}
+
+func (h *PinHandler) UnpinVideo(ctx context.Context, req *pb.UnpinVideoRequest) (*pb.UnpinVideoResponse, error) {
+	logger.Info("UnpinVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid UnpinVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := h.pinUseCase.UnpinVideo(ctx, req.UserId, req.VideoId); err != nil {
+		logger.Error("Failed to unpin video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("UnpinVideo request completed successfully", zap.String("video_id", req.VideoId))
+
+	return &pb.UnpinVideoResponse{Success: true}, nil
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockPinUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPinUseCase) PinVideo(ctx context.Context, userID, videoID string) error {
+	args := m.Called(ctx, userID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPinUseCase) UnpinVideo(ctx context.Context, userID, videoID string) error {
+	args := m.Called(ctx, userID, videoID)
+	return args.Error(0)
+}
+
+func createTestPinHandler() (*PinHandler, *MockPinUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockPinUseCase{}
+	handler := NewPinHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestPinVideo_Success(t *testing.T) {
+	handler, mockUseCase := createTestPinHandler()
+	userID, videoID := uuid.NewString(), uuid.NewString()
+
+	mockUseCase.On("PinVideo", mock.Anything, userID, videoID).Return(nil)
+
+	resp, err := handler.PinVideo(context.Background(), &pb.PinVideoRequest{UserId: userID, VideoId: videoID})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestPinVideo_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"limit reached", domain.ErrPinLimitReached, codes.FailedPrecondition},
+		{"not pinnable", domain.ErrVideoNotPinnable, codes.FailedPrecondition},
+		{"concurrent pin", domain.ErrPinConflict, codes.Aborted},
+		{"not owner", domain.ErrNotVideoOwner, codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestPinHandler()
+			mockUseCase.On("PinVideo", mock.Anything, mock.Anything, mock.Anything).Return(test.err)
+
+			_, err := handler.PinVideo(context.Background(), &pb.PinVideoRequest{
+				UserId:  uuid.NewString(),
+				VideoId: uuid.NewString(),
+			})
+
+			assert.Equal(t, test.code, status.Code(clientError(err)))
+		})
+	}
+}
+
+func TestUnpinVideo_InvalidVideoID(t *testing.T) {
+	handler, mockUseCase := createTestPinHandler()
+
+	_, err := handler.UnpinVideo(context.Background(), &pb.UnpinVideoRequest{
+		UserId:  uuid.NewString(),
+		VideoId: "invalid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "UnpinVideo", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,337 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PlaylistHandler struct {
+	playlistUseCase usecase.PlaylistUseCase
+}
+
+func NewPlaylistHandler(playlistUseCase usecase.PlaylistUseCase) *PlaylistHandler {
+	return &PlaylistHandler{
+		playlistUseCase: playlistUseCase,
+	}
+}
+
+func domainPlaylistToProto(playlist *domain.Playlist) *pb.Playlist {
+	return &pb.Playlist{
+		Id:          playlist.ID.String(),
+		UserId:      playlist.UserID.String(),
+		Title:       playlist.Title,
+		Description: playlist.Description,
+		CoverUrl:    playlist.CoverURL,
+		CreatedAt:   timestamppb.New(playlist.CreatedAt),
+		UpdatedAt:   timestamppb.New(playlist.UpdatedAt),
+	}
+}
+
+func validateUserPlaylistRequest(userID, playlistID string) error {
+	if err := validateUUID(userID, "user_id"); err != nil {
+		return err
+	}
+	return validateUUID(playlistID, "playlist_id")
+}
+
+func (h *PlaylistHandler) CreatePlaylist(ctx context.Context, req *pb.CreatePlaylistRequest) (
+	*pb.CreatePlaylistResponse, error) {
+
+	logger.Info("CreatePlaylist request received",
+		zap.String("user_id", req.UserId),
+		zap.String("title", req.Title))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid CreatePlaylist request", zap.Error(err))
+		return nil, err
+	}
+
+	playlist, err := h.playlistUseCase.CreatePlaylist(ctx, &usecase.PlaylistRequest{
+		UserID:      req.UserId,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverURL:    req.CoverUrl,
+	})
+	if err != nil {
+		logger.Error("Failed to create playlist", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("CreatePlaylist request completed successfully",
+		zap.String("playlist_id", playlist.ID.String()))
+
+	return &pb.CreatePlaylistResponse{Playlist: domainPlaylistToProto(playlist)}, nil
+}
+
+func (h *PlaylistHandler) UpdatePlaylist(ctx context.Context, req *pb.UpdatePlaylistRequest) (
+	*pb.UpdatePlaylistResponse, error) {
+
+	logger.Info("UpdatePlaylist request received",
+		zap.String("user_id", req.UserId),
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("title", req.Title))
+
+	if err := validateUserPlaylistRequest(req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Invalid UpdatePlaylist request", zap.Error(err))
+		return nil, err
+	}
+
+	playlist, err := h.playlistUseCase.UpdatePlaylist(ctx, &usecase.PlaylistRequest{
+		ID:          req.PlaylistId,
+		UserID:      req.UserId,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverURL:    req.CoverUrl,
+	})
+	if err != nil {
+		logger.Error("Failed to update playlist", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId))
+		return nil, err
+	}
+
+	logger.Info("UpdatePlaylist request completed successfully",
+		zap.String("playlist_id", req.PlaylistId))
+
+	return &pb.UpdatePlaylistResponse{Playlist: domainPlaylistToProto(playlist)}, nil
+}
+
+func (h *PlaylistHandler) DeletePlaylist(ctx context.Context, req *pb.DeletePlaylistRequest) (
+	*pb.DeletePlaylistResponse, error) {
+
+	logger.Info("DeletePlaylist request received",
+		zap.String("user_id", req.UserId),
+		zap.String("playlist_id", req.PlaylistId))
+
+	if err := validateUserPlaylistRequest(req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Invalid DeletePlaylist request", zap.Error(err))
+		return nil, err
+	}
+
+	if err := h.playlistUseCase.DeletePlaylist(ctx, req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Failed to delete playlist", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId))
+		return nil, err
+	}
+
+	logger.Info("DeletePlaylist request completed successfully",
+		zap.String("playlist_id", req.PlaylistId))
+
+	return &pb.DeletePlaylistResponse{Success: true}, nil
+}
+
+func (h *PlaylistHandler) ReorderPlaylist(ctx context.Context, req *pb.ReorderPlaylistRequest) (
+	*pb.ReorderPlaylistResponse, error) {
+
+	logger.Info("ReorderPlaylist request received",
+		zap.String("user_id", req.UserId),
+		zap.String("playlist_id", req.PlaylistId),
+		zap.Int("video_count", len(req.VideoIds)))
+
+	if err := validateUserPlaylistRequest(req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Invalid ReorderPlaylist request", zap.Error(err))
+		return nil, err
+	}
+	for _, videoID := range req.VideoIds {
+		if err := validateUUID(videoID, "video_ids"); err != nil {
+			logger.Error("Invalid ReorderPlaylist request", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	err := h.playlistUseCase.ReorderPlaylist(ctx, req.UserId, req.PlaylistId, req.VideoIds)
+	if err != nil {
+		logger.Error("Failed to reorder playlist", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId))
+		return nil, err
+	}
+
+	logger.Info("ReorderPlaylist request completed successfully",
+		zap.String("playlist_id", req.PlaylistId))
+
+	return &pb.ReorderPlaylistResponse{Success: true}, nil
+}
+
+func (h *PlaylistHandler) AddPlaylistVideo(ctx context.Context, req *pb.AddPlaylistVideoRequest) (
+	*pb.AddPlaylistVideoResponse, error) {
+
+	logger.Info("AddPlaylistVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserPlaylistRequest(req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Invalid AddPlaylistVideo request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid AddPlaylistVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	err := h.playlistUseCase.AddVideo(ctx, req.UserId, req.PlaylistId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to add video to playlist", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("AddPlaylistVideo request completed successfully",
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("video_id", req.VideoId))
+
+	return &pb.AddPlaylistVideoResponse{Success: true}, nil
+}
+
+func (h *PlaylistHandler) RemovePlaylistVideo(ctx context.Context, req *pb.RemovePlaylistVideoRequest) (
+	*pb.RemovePlaylistVideoResponse, error) {
+
+	logger.Info("RemovePlaylistVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserPlaylistRequest(req.UserId, req.PlaylistId); err != nil {
+		logger.Error("Invalid RemovePlaylistVideo request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid RemovePlaylistVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	err := h.playlistUseCase.RemoveVideo(ctx, req.UserId, req.PlaylistId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to remove video from playlist", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("RemovePlaylistVideo request completed successfully",
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("video_id", req.VideoId))
+
+	return &pb.RemovePlaylistVideoResponse{Success: true}, nil
+}
+
+func (h *PlaylistHandler) ListPlaylists(ctx context.Context, req *pb.ListPlaylistsRequest) (
+	*pb.ListPlaylistsResponse, error) {
+
+	logger.Info("ListPlaylists request received",
+		zap.String("user_id", req.UserId),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListPlaylists request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid ListPlaylists request", zap.Error(err))
+		return nil, err
+	}
+
+	playlists, total, err := h.playlistUseCase.ListPlaylists(ctx, req.UserId, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list playlists", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	protoPlaylists := make([]*pb.Playlist, len(playlists))
+	for i, playlist := range playlists {
+		protoPlaylists[i] = domainPlaylistToProto(playlist)
+	}
+
+	logger.Info("ListPlaylists request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("playlist_count", len(protoPlaylists)),
+		zap.Int64("total", total))
+
+	return &pb.ListPlaylistsResponse{Playlists: protoPlaylists, Total: total}, nil
+}
+
+func (h *PlaylistHandler) ListPlaylistVideos(ctx context.Context, req *pb.ListPlaylistVideosRequest) (
+	*pb.ListPlaylistVideosResponse, error) {
+
+	logger.Info("ListPlaylistVideos request received",
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.PlaylistId, "playlist_id"); err != nil {
+		logger.Error("Invalid ListPlaylistVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid ListPlaylistVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.playlistUseCase.ListPlaylistVideos(ctx, req.PlaylistId, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list playlist videos", zap.Error(err),
+			zap.String("playlist_id", req.PlaylistId))
+		return nil, err
+	}
+
+	logger.Info("ListPlaylistVideos request completed successfully",
+		zap.String("playlist_id", req.PlaylistId),
+		zap.Int("video_count", len(videos)),
+		zap.Int64("total", total))
+
+	return &pb.ListPlaylistVideosResponse{Videos: listVideosToProto(videos), Total: total}, nil
+}
+
+func (h *PlaylistHandler) GetNextInSeries(ctx context.Context, req *pb.GetNextInSeriesRequest) (
+	*pb.GetNextInSeriesResponse, error) {
+
+	logger.Info("GetNextInSeries request received",
+		zap.String("video_id", req.VideoId),
+		zap.String("playlist_id", req.PlaylistId),
+		zap.String("viewer_id", req.ViewerId))
+
+	if err := validateUUID(req.VideoId, "video_id"); err != nil {
+		logger.Error("Invalid GetNextInSeries request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.PlaylistId, "playlist_id"); err != nil {
+		logger.Error("Invalid GetNextInSeries request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid GetNextInSeries request", zap.Error(err))
+		return nil, err
+	}
+
+	series, err := h.playlistUseCase.GetNextInSeries(ctx, req.VideoId, req.PlaylistId, req.ViewerId)
+	if err != nil {
+		logger.Error("Failed to get next video in series", zap.Error(err),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	response := &pb.GetNextInSeriesResponse{}
+	if series.Playlist != nil {
+		response.Playlist = domainPlaylistToProto(series.Playlist)
+	}
+	if series.Next != nil {
+		response.NextVideo = domainVideoToProto(series.Next)
+	}
+
+	logger.Info("GetNextInSeries request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Bool("has_next", series.Next != nil))
+
+	return response, nil
+}
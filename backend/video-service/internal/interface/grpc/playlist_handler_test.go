@@ -0,0 +1,208 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockPlaylistUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPlaylistUseCase) CreatePlaylist(ctx context.Context, req *usecase.PlaylistRequest) (
+	*domain.Playlist, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistUseCase) UpdatePlaylist(ctx context.Context, req *usecase.PlaylistRequest) (
+	*domain.Playlist, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistUseCase) DeletePlaylist(ctx context.Context, userID, playlistID string) error {
+	args := m.Called(ctx, userID, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistUseCase) AddVideo(ctx context.Context, userID, playlistID, videoID string) error {
+	args := m.Called(ctx, userID, playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistUseCase) RemoveVideo(ctx context.Context, userID, playlistID, videoID string) error {
+	args := m.Called(ctx, userID, playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistUseCase) ReorderPlaylist(ctx context.Context, userID, playlistID string,
+	videoIDs []string) error {
+
+	args := m.Called(ctx, userID, playlistID, videoIDs)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistUseCase) ListPlaylists(ctx context.Context, ownerID, viewerID string, limit, offset int) (
+	[]*domain.Playlist, int64, error) {
+
+	args := m.Called(ctx, ownerID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Playlist), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPlaylistUseCase) ListPlaylistVideos(ctx context.Context, playlistID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, playlistID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPlaylistUseCase) GetNextInSeries(ctx context.Context, videoID, playlistID, viewerID string) (
+	*usecase.SeriesNext, error) {
+
+	args := m.Called(ctx, videoID, playlistID, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.SeriesNext), args.Error(1)
+}
+
+func createTestPlaylistHandler() (*PlaylistHandler, *MockPlaylistUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockPlaylistUseCase{}
+	handler := NewPlaylistHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestCreatePlaylist_Success(t *testing.T) {
+	handler, mockUseCase := createTestPlaylistHandler()
+	userID := uuid.New()
+	playlist := &domain.Playlist{ID: uuid.New(), UserID: userID, Title: "Season one",
+		CoverURL: "https://example.com/cover.jpg"}
+
+	mockUseCase.On("CreatePlaylist", mock.Anything, &usecase.PlaylistRequest{
+		UserID:   userID.String(),
+		Title:    "Season one",
+		CoverURL: "https://example.com/cover.jpg",
+	}).Return(playlist, nil)
+
+	resp, err := handler.CreatePlaylist(context.Background(), &pb.CreatePlaylistRequest{
+		UserId:   userID.String(),
+		Title:    "Season one",
+		CoverUrl: "https://example.com/cover.jpg",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, playlist.ID.String(), resp.Playlist.Id)
+	assert.Equal(t, "https://example.com/cover.jpg", resp.Playlist.CoverUrl)
+}
+
+func TestReorderPlaylist_InvalidVideoID(t *testing.T) {
+	handler, mockUseCase := createTestPlaylistHandler()
+
+	_, err := handler.ReorderPlaylist(context.Background(), &pb.ReorderPlaylistRequest{
+		UserId:     uuid.NewString(),
+		PlaylistId: uuid.NewString(),
+		VideoIds:   []string{uuid.NewString(), "invalid"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ReorderPlaylist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddPlaylistVideo_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"playlist missing", domain.ErrPlaylistNotFound, codes.NotFound},
+		{"not playlist owner", domain.ErrNotPlaylistOwner, codes.PermissionDenied},
+		{"not video owner", domain.ErrNotVideoOwner, codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestPlaylistHandler()
+			mockUseCase.On("AddVideo", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(test.err)
+
+			_, err := handler.AddPlaylistVideo(context.Background(), &pb.AddPlaylistVideoRequest{
+				UserId:     uuid.NewString(),
+				PlaylistId: uuid.NewString(),
+				VideoId:    uuid.NewString(),
+			})
+
+			assert.Equal(t, test.code, status.Code(clientError(err)))
+		})
+	}
+}
+
+func TestGetNextInSeries_Success(t *testing.T) {
+	handler, mockUseCase := createTestPlaylistHandler()
+	next := createTestDomainVideo()
+	playlist := &domain.Playlist{ID: uuid.New(), UserID: next.UserID, Title: "Season one"}
+	videoID := uuid.NewString()
+
+	mockUseCase.On("GetNextInSeries", mock.Anything, videoID, "", "").
+		Return(&usecase.SeriesNext{Playlist: playlist, Next: next}, nil)
+
+	resp, err := handler.GetNextInSeries(context.Background(), &pb.GetNextInSeriesRequest{VideoId: videoID})
+
+	require.NoError(t, err)
+	assert.Equal(t, playlist.ID.String(), resp.Playlist.Id)
+	assert.Equal(t, next.ID.String(), resp.NextVideo.Id)
+}
+
+func TestGetNextInSeries_NoSeries(t *testing.T) {
+	handler, mockUseCase := createTestPlaylistHandler()
+	videoID := uuid.NewString()
+
+	mockUseCase.On("GetNextInSeries", mock.Anything, videoID, "", "").Return(&usecase.SeriesNext{}, nil)
+
+	resp, err := handler.GetNextInSeries(context.Background(), &pb.GetNextInSeriesRequest{VideoId: videoID})
+
+	require.NoError(t, err)
+	assert.Nil(t, resp.Playlist)
+	assert.Nil(t, resp.NextVideo)
+}
+
+func TestGetNextInSeries_InvalidPlaylistID(t *testing.T) {
+	handler, mockUseCase := createTestPlaylistHandler()
+
+	_, err := handler.GetNextInSeries(context.Background(), &pb.GetNextInSeriesRequest{
+		VideoId:    uuid.NewString(),
+		PlaylistId: "invalid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "GetNextInSeries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+	"time"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PublishingHandler struct {
+	publishingUseCase usecase.PublishingUseCase
+}
+
+func NewPublishingHandler(publishingUseCase usecase.PublishingUseCase) *PublishingHandler {
+	return &PublishingHandler{
+		publishingUseCase: publishingUseCase,
+	}
+}
+
+// optionalTimeToProto leaves unset times out of the response instead of
+// sending the zero time.
+func optionalTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func (h *PublishingHandler) ListDrafts(ctx context.Context, req *pb.ListDraftsRequest) (
+	*pb.ListDraftsResponse, error) {
+
+	logger.Info("ListDrafts request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListDrafts request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.publishingUseCase.ListDrafts(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list drafts", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("ListDrafts request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("video_count", len(videos)),
+		zap.Int64("total", total))
+
+	return &pb.ListDraftsResponse{Videos: listVideosToProto(videos), Total: total}, nil
+}
+
+func (h *PublishingHandler) PublishVideo(ctx context.Context, req *pb.PublishVideoRequest) (
+	*pb.PublishVideoResponse, error) {
+
+	logger.Info("PublishVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid PublishVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	video, err := h.publishingUseCase.PublishVideo(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to publish video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("PublishVideo request completed successfully", zap.String("video_id", req.VideoId))
+
+	return &pb.PublishVideoResponse{Video: domainVideoToProto(video)}, nil
+}
+
+func (h *PublishingHandler) ScheduleVideo(ctx context.Context, req *pb.ScheduleVideoRequest) (
+	*pb.ScheduleVideoResponse, error) {
+
+	logger.Info("ScheduleVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid ScheduleVideo request", zap.Error(err))
+		return nil, err
+	}
+	if req.PublishAt == nil {
+		logger.Error("Invalid ScheduleVideo request: missing publish_at")
+		return nil, invalidArgument("publish_at", "publish_at is required")
+	}
+
+	video, err := h.publishingUseCase.ScheduleVideo(ctx, req.UserId, req.VideoId, req.PublishAt.AsTime())
+	if err != nil {
+		logger.Error("Failed to schedule video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("ScheduleVideo request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Time("publish_at", *video.PublishAt))
+
+	return &pb.ScheduleVideoResponse{Video: domainVideoToProto(video)}, nil
+}
+
+func (h *PublishingHandler) CancelScheduledVideo(ctx context.Context, req *pb.CancelScheduledVideoRequest) (
+	*pb.CancelScheduledVideoResponse, error) {
+
+	logger.Info("CancelScheduledVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid CancelScheduledVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	video, err := h.publishingUseCase.CancelSchedule(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to cancel scheduled video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("CancelScheduledVideo request completed successfully", zap.String("video_id", req.VideoId))
+
+	return &pb.CancelScheduledVideoResponse{Video: domainVideoToProto(video)}, nil
+}
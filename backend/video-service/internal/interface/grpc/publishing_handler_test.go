@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MockPublishingUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPublishingUseCase) ListDrafts(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPublishingUseCase) PublishVideo(ctx context.Context, userID, videoID string) (*domain.Video, error) {
+	args := m.Called(ctx, userID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+func (m *MockPublishingUseCase) ScheduleVideo(ctx context.Context, userID, videoID string,
+	publishAt time.Time) (*domain.Video, error) {
+
+	args := m.Called(ctx, userID, videoID, publishAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+func (m *MockPublishingUseCase) CancelSchedule(ctx context.Context, userID, videoID string) (
+	*domain.Video, error) {
+
+	args := m.Called(ctx, userID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+func (m *MockPublishingUseCase) PublishDue(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func createTestPublishingHandler() (*PublishingHandler, *MockPublishingUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockPublishingUseCase{}
+	handler := NewPublishingHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestListDrafts_Success(t *testing.T) {
+	handler, mockUseCase := createTestPublishingHandler()
+	video := createTestDomainVideo()
+	video.PublicationState = domain.PublicationStateDraft
+
+	mockUseCase.On("ListDrafts", mock.Anything, video.UserID.String(), 10, 0).
+		Return([]*domain.Video{video}, int64(1), nil)
+
+	resp, err := handler.ListDrafts(context.Background(), &pb.ListDraftsRequest{
+		UserId: video.UserID.String(),
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Videos, 1)
+	assert.Equal(t, "draft", resp.Videos[0].PublicationState)
+	assert.Nil(t, resp.Videos[0].PublishAt)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestScheduleVideo_Success(t *testing.T) {
+	handler, mockUseCase := createTestPublishingHandler()
+	video := createTestDomainVideo()
+	publishAt := time.Now().Add(time.Hour).UTC()
+	video.PublicationState = domain.PublicationStateScheduled
+	video.PublishAt = &publishAt
+
+	mockUseCase.On("ScheduleVideo", mock.Anything, video.UserID.String(), video.ID.String(),
+		mock.MatchedBy(publishAt.Equal)).Return(video, nil)
+
+	resp, err := handler.ScheduleVideo(context.Background(), &pb.ScheduleVideoRequest{
+		UserId:    video.UserID.String(),
+		VideoId:   video.ID.String(),
+		PublishAt: timestamppb.New(publishAt),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "scheduled", resp.Video.PublicationState)
+	assert.True(t, resp.Video.PublishAt.AsTime().Equal(publishAt))
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestScheduleVideo_MissingPublishAt(t *testing.T) {
+	handler, mockUseCase := createTestPublishingHandler()
+
+	_, err := handler.ScheduleVideo(context.Background(), &pb.ScheduleVideoRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ScheduleVideo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPublishVideo_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"not found", domain.ErrVideoNotFound, codes.NotFound},
+		{"not owner", domain.ErrNotVideoOwner, codes.PermissionDenied},
+		{"already published", domain.ErrVideoAlreadyPublished, codes.FailedPrecondition},
+		{"changed concurrently", domain.ErrVideoModified, codes.Aborted},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestPublishingHandler()
+			mockUseCase.On("PublishVideo", mock.Anything, mock.Anything, mock.Anything).Return(nil, test.err)
+
+			_, err := handler.PublishVideo(context.Background(), &pb.PublishVideoRequest{
+				UserId:  uuid.New().String(),
+				VideoId: uuid.New().String(),
+			})
+
+			assert.Equal(t, test.code, status.Code(clientError(err)))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"time"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SearchHandler struct {
+	searchUseCase usecase.SearchUseCase
+}
+
+func NewSearchHandler(searchUseCase usecase.SearchUseCase) *SearchHandler {
+	return &SearchHandler{
+		searchUseCase: searchUseCase,
+	}
+}
+
+func validateSearchVideosRequest(req *pb.SearchVideosRequest) error {
+	if req.Query == "" {
+		return invalidArgument("query", "query is required")
+	}
+	if req.ViewerId != "" {
+		if err := validateUUID(req.ViewerId, "viewer_id"); err != nil {
+			return err
+		}
+	}
+	if req.CreatorId != "" {
+		if err := validateUUID(req.CreatorId, "creator_id"); err != nil {
+			return err
+		}
+	}
+	if req.MinDuration < 0 || req.MaxDuration < 0 {
+		return invalidArgument("duration", "duration filters must not be negative")
+	}
+	if req.MaxDuration > 0 && req.MinDuration > req.MaxDuration {
+		return invalidArgument("min_duration", "min_duration must not exceed max_duration")
+	}
+	if req.CreatedAfter != nil && req.CreatedBefore != nil &&
+		!req.CreatedAfter.AsTime().Before(req.CreatedBefore.AsTime()) {
+		return invalidArgument("created_after", "created_after must be before created_before")
+	}
+	return nil
+}
+
+func protoTimeOrZero(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+	return timestamp.AsTime()
+}
+
+func (h *SearchHandler) SearchVideos(ctx context.Context, req *pb.SearchVideosRequest) (
+	*pb.SearchVideosResponse, error) {
+
+	logger.Info("SearchVideos request received",
+		zap.String("query", req.Query),
+		zap.String("viewer_id", req.ViewerId),
+		zap.String("creator_id", req.CreatorId),
+		zap.Int32("limit", req.Limit))
+
+	if err := validateSearchVideosRequest(req); err != nil {
+		logger.Error("Invalid SearchVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, nextCursor, err := h.searchUseCase.SearchVideos(ctx, &usecase.SearchVideosRequest{
+		Query:         req.Query,
+		ViewerID:      req.ViewerId,
+		CreatorID:     req.CreatorId,
+		CreatedAfter:  protoTimeOrZero(req.CreatedAfter),
+		CreatedBefore: protoTimeOrZero(req.CreatedBefore),
+		MinDuration:   int(req.MinDuration),
+		MaxDuration:   int(req.MaxDuration),
+		Limit:         int(req.Limit),
+		Cursor:        req.Cursor,
+	})
+	if err != nil {
+		logger.Error("Failed to search videos", zap.Error(err), zap.String("query", req.Query))
+		return nil, err
+	}
+
+	protoVideos := listVideosToProto(videos)
+	logger.Info("SearchVideos request completed successfully",
+		zap.String("query", req.Query),
+		zap.Int("video_count", len(protoVideos)),
+		zap.Bool("has_more", nextCursor != ""))
+
+	return &pb.SearchVideosResponse{Videos: protoVideos, NextCursor: nextCursor}, nil
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MockSearchUseCase struct {
+	mock.Mock
+}
+
+func (m *MockSearchUseCase) SearchVideos(ctx context.Context, req *usecase.SearchVideosRequest) (
+	[]*domain.Video, string, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.String(1), args.Error(2)
+}
+
+func createTestSearchHandler() (*SearchHandler, *MockSearchUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockSearchUseCase{}
+	handler := NewSearchHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestSearchVideos_Success(t *testing.T) {
+	handler, mockUseCase := createTestSearchHandler()
+	viewerID := uuid.NewString()
+	after := time.Now().Add(-24 * time.Hour).UTC()
+
+	mockUseCase.On("SearchVideos", mock.Anything, mock.MatchedBy(func(req *usecase.SearchVideosRequest) bool {
+		return req.Query == "dance" &&
+			req.ViewerID == viewerID &&
+			req.CreatedAfter.Equal(after) &&
+			req.CreatedBefore.IsZero() &&
+			req.MaxDuration == 30
+	})).Return([]*domain.Video{createTestDomainVideo()}, "next", nil)
+
+	resp, err := handler.SearchVideos(context.Background(), &pb.SearchVideosRequest{
+		Query:        "dance",
+		ViewerId:     viewerID,
+		CreatedAfter: timestamppb.New(after),
+		MaxDuration:  30,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Videos, 1)
+	assert.Equal(t, "next", resp.NextCursor)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestSearchVideos_ValidationErrors(t *testing.T) {
+	handler, _ := createTestSearchHandler()
+	now := time.Now()
+
+	requests := map[string]*pb.SearchVideosRequest{
+		"missing query":     {},
+		"invalid viewer":    {Query: "dance", ViewerId: "bad"},
+		"invalid creator":   {Query: "dance", CreatorId: "bad"},
+		"duration range":    {Query: "dance", MinDuration: 60, MaxDuration: 10},
+		"negative duration": {Query: "dance", MinDuration: -1},
+		"date range": {
+			Query:         "dance",
+			CreatedAfter:  timestamppb.New(now),
+			CreatedBefore: timestamppb.New(now.Add(-time.Hour)),
+		},
+	}
+
+	for name, req := range requests {
+		t.Run(name, func(t *testing.T) {
+			_, err := handler.SearchVideos(context.Background(), req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSearchVideos_InvalidCursor(t *testing.T) {
+	handler, mockUseCase := createTestSearchHandler()
+
+	mockUseCase.On("SearchVideos", mock.Anything, mock.Anything).
+		Return(nil, "", domain.ErrInvalidSearchCursor)
+
+	_, err := handler.SearchVideos(context.Background(), &pb.SearchVideosRequest{Query: "dance", Cursor: "x"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestSearchVideos_UseCaseError(t *testing.T) {
+	handler, mockUseCase := createTestSearchHandler()
+
+	mockUseCase.On("SearchVideos", mock.Anything, mock.Anything).
+		Return(nil, "", errors.New("database error"))
+
+	_, err := handler.SearchVideos(context.Background(), &pb.SearchVideosRequest{Query: "dance"})
+
+	assert.Equal(t, codes.Internal, status.Code(clientError(err)))
+}
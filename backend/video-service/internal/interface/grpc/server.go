@@ -0,0 +1,24 @@
+package grpc
+
+import pb "video-service/proto"
+
+var _ pb.VideoServiceServer = (*VideoServer)(nil)
+
+type VideoServer struct {
+	*VideoHandler
+	*UploadHandler
+	*TagHandler
+	*TrendingHandler
+	*SearchHandler
+	*ShareHandler
+	*FavoriteHandler
+	*TrashHandler
+	*ModerationHandler
+	*BlockHandler
+	*NotificationHandler
+	*AnalyticsHandler
+	*FeedHandler
+	*PublishingHandler
+	*PinHandler
+	*PlaylistHandler
+}
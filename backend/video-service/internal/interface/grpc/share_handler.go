@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ShareHandler struct {
+	shareUseCase usecase.ShareUseCase
+}
+
+func NewShareHandler(shareUseCase usecase.ShareUseCase) *ShareHandler {
+	return &ShareHandler{
+		shareUseCase: shareUseCase,
+	}
+}
+
+func (h *ShareHandler) ShareVideo(ctx context.Context, req *pb.ShareVideoRequest) (*pb.ShareVideoResponse, error) {
+	logger.Info("ShareVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId),
+		zap.String("channel", req.Channel))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid ShareVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	shared, err := h.shareUseCase.ShareVideo(ctx, req.UserId, req.VideoId, req.Channel)
+	if err != nil {
+		logger.Error("Failed to share video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("ShareVideo request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.String("code", shared.Share.Code),
+		zap.Int64("share_count", shared.ShareCount))
+
+	return &pb.ShareVideoResponse{
+		ShareLink: &pb.ShareLink{
+			Id:        shared.Share.ID.String(),
+			VideoId:   shared.Share.VideoID.String(),
+			UserId:    shared.Share.UserID.String(),
+			Channel:   string(shared.Share.Channel),
+			Code:      shared.Share.Code,
+			Url:       shared.URL,
+			CreatedAt: timestamppb.New(shared.Share.CreatedAt),
+		},
+		ShareCount: shared.ShareCount,
+	}, nil
+}
+
+func (h *ShareHandler) ResolveShareLink(ctx context.Context, req *pb.ResolveShareLinkRequest) (
+	*pb.ResolveShareLinkResponse, error) {
+
+	logger.Info("ResolveShareLink request received",
+		zap.String("code", req.Code),
+		zap.String("viewer_id", req.ViewerId))
+
+	if req.Code == "" {
+		logger.Error("Invalid ResolveShareLink request: code is required")
+		return nil, invalidArgument("code", "code is required")
+	}
+	if req.ViewerId != "" {
+		if err := validateUUID(req.ViewerId, "viewer_id"); err != nil {
+			logger.Error("Invalid ResolveShareLink request", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	resolved, err := h.shareUseCase.ResolveShareLink(ctx, req.Code, req.ViewerId)
+	if err != nil {
+		logger.Error("Failed to resolve share link", zap.Error(err), zap.String("code", req.Code))
+		return nil, err
+	}
+
+	logger.Info("ResolveShareLink request completed successfully",
+		zap.String("code", req.Code),
+		zap.String("video_id", resolved.Video.ID.String()))
+
+	return &pb.ResolveShareLinkResponse{
+		Video:    domainVideoToProto(resolved.Video),
+		SharerId: resolved.Share.UserID.String(),
+		Channel:  string(resolved.Share.Channel),
+	}, nil
+}
+
+func (h *ShareHandler) GetShareAnalytics(ctx context.Context, req *pb.GetShareAnalyticsRequest) (
+	*pb.GetShareAnalyticsResponse, error) {
+
+	logger.Info("GetShareAnalytics request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid GetShareAnalytics request", zap.Error(err))
+		return nil, err
+	}
+
+	analytics, err := h.shareUseCase.GetShareAnalytics(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to get share analytics", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	channels := make([]*pb.ShareChannelStats, len(analytics.Channels))
+	for i, channel := range analytics.Channels {
+		channels[i] = &pb.ShareChannelStats{
+			Channel: string(channel.Channel),
+			Shares:  channel.Shares,
+			Clicks:  channel.Clicks,
+		}
+	}
+	sharers := make([]*pb.SharerStats, len(analytics.TopSharers))
+	for i, sharer := range analytics.TopSharers {
+		sharers[i] = &pb.SharerStats{
+			UserId: sharer.UserID.String(),
+			Shares: sharer.Shares,
+			Clicks: sharer.Clicks,
+		}
+	}
+
+	logger.Info("GetShareAnalytics request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int64("total_shares", analytics.TotalShares),
+		zap.Int64("total_clicks", analytics.TotalClicks))
+
+	return &pb.GetShareAnalyticsResponse{
+		TotalShares: analytics.TotalShares,
+		TotalClicks: analytics.TotalClicks,
+		Channels:    channels,
+		TopSharers:  sharers,
+	}, nil
+}
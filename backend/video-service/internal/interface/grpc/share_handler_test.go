@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockShareUseCase struct {
+	mock.Mock
+}
+
+func (m *MockShareUseCase) ShareVideo(ctx context.Context, userID, videoID, channel string) (
+	*usecase.SharedVideo, error) {
+
+	args := m.Called(ctx, userID, videoID, channel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.SharedVideo), args.Error(1)
+}
+
+func (m *MockShareUseCase) ResolveShareLink(ctx context.Context, code, viewerID string) (
+	*usecase.ResolvedShareLink, error) {
+
+	args := m.Called(ctx, code, viewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ResolvedShareLink), args.Error(1)
+}
+
+func (m *MockShareUseCase) GetShareAnalytics(ctx context.Context, userID, videoID string) (
+	*domain.ShareAnalytics, error) {
+
+	args := m.Called(ctx, userID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShareAnalytics), args.Error(1)
+}
+
+func createTestShareHandler() (*ShareHandler, *MockShareUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockShareUseCase{}
+	handler := NewShareHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestShareVideo_Success(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+	userID := uuid.New()
+	videoID := uuid.New()
+
+	mockUseCase.On("ShareVideo", mock.Anything, userID.String(), videoID.String(), "copy_link").
+		Return(&usecase.SharedVideo{
+			Share: &domain.VideoShare{
+				ID:        uuid.New(),
+				VideoID:   videoID,
+				UserID:    userID,
+				Channel:   domain.ShareChannelCopyLink,
+				Code:      "abcd2345",
+				CreatedAt: time.Now(),
+			},
+			URL:        "https://vid.example/s/abcd2345",
+			ShareCount: 3,
+		}, nil)
+
+	resp, err := handler.ShareVideo(context.Background(), &pb.ShareVideoRequest{
+		UserId:  userID.String(),
+		VideoId: videoID.String(),
+		Channel: "copy_link",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://vid.example/s/abcd2345", resp.ShareLink.Url)
+	assert.Equal(t, "copy_link", resp.ShareLink.Channel)
+	assert.Equal(t, int64(3), resp.ShareCount)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestShareVideo_InvalidChannel(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	mockUseCase.On("ShareVideo", mock.Anything, mock.Anything, mock.Anything, "fax").
+		Return(nil, domain.ErrInvalidShareChannel)
+
+	_, err := handler.ShareVideo(context.Background(), &pb.ShareVideoRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+		Channel: "fax",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestShareVideo_VideoNotFound(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	mockUseCase.On("ShareVideo", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrVideoNotFound)
+
+	_, err := handler.ShareVideo(context.Background(), &pb.ShareVideoRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+		Channel: "copy_link",
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(clientError(err)))
+}
+
+func TestShareVideo_InvalidUserID(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	_, err := handler.ShareVideo(context.Background(), &pb.ShareVideoRequest{
+		UserId:  "invalid",
+		VideoId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ShareVideo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResolveShareLink_Success(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+	video := createTestDomainVideo()
+	sharerID := uuid.New()
+
+	mockUseCase.On("ResolveShareLink", mock.Anything, "abcd2345", "").
+		Return(&usecase.ResolvedShareLink{
+			Share: &domain.VideoShare{UserID: sharerID, Channel: domain.ShareChannelExternalApp},
+			Video: video,
+		}, nil)
+
+	resp, err := handler.ResolveShareLink(context.Background(), &pb.ResolveShareLinkRequest{Code: "abcd2345"})
+
+	require.NoError(t, err)
+	assert.Equal(t, video.ID.String(), resp.Video.Id)
+	assert.Equal(t, sharerID.String(), resp.SharerId)
+	assert.Equal(t, "external_app", resp.Channel)
+}
+
+func TestResolveShareLink_MissingCode(t *testing.T) {
+	handler, _ := createTestShareHandler()
+
+	_, err := handler.ResolveShareLink(context.Background(), &pb.ResolveShareLinkRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResolveShareLink_NotFound(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	mockUseCase.On("ResolveShareLink", mock.Anything, "missing", "").
+		Return(nil, domain.ErrShareLinkNotFound)
+
+	_, err := handler.ResolveShareLink(context.Background(), &pb.ResolveShareLinkRequest{Code: "missing"})
+
+	assert.Equal(t, codes.NotFound, status.Code(clientError(err)))
+}
+
+func TestGetShareAnalytics_Success(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+	userID := uuid.New().String()
+	videoID := uuid.New().String()
+	sharerID := uuid.New()
+
+	mockUseCase.On("GetShareAnalytics", mock.Anything, userID, videoID).
+		Return(&domain.ShareAnalytics{
+			TotalShares: 2,
+			TotalClicks: 5,
+			Channels: []*domain.ShareChannelStats{
+				{Channel: domain.ShareChannelDirectMessage, Shares: 2, Clicks: 5},
+			},
+			TopSharers: []*domain.SharerStats{{UserID: sharerID, Shares: 2, Clicks: 5}},
+		}, nil)
+
+	resp, err := handler.GetShareAnalytics(context.Background(), &pb.GetShareAnalyticsRequest{
+		UserId:  userID,
+		VideoId: videoID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.TotalShares)
+	assert.Equal(t, int64(5), resp.TotalClicks)
+	require.Len(t, resp.Channels, 1)
+	assert.Equal(t, "direct_message", resp.Channels[0].Channel)
+	require.Len(t, resp.TopSharers, 1)
+	assert.Equal(t, sharerID.String(), resp.TopSharers[0].UserId)
+}
+
+func TestGetShareAnalytics_NotOwner(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	mockUseCase.On("GetShareAnalytics", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrNotVideoOwner)
+
+	_, err := handler.GetShareAnalytics(context.Background(), &pb.GetShareAnalyticsRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientError(err)))
+}
+
+func TestGetShareAnalytics_InternalError(t *testing.T) {
+	handler, mockUseCase := createTestShareHandler()
+
+	mockUseCase.On("GetShareAnalytics", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("database error"))
+
+	_, err := handler.GetShareAnalytics(context.Background(), &pb.GetShareAnalyticsRequest{
+		UserId:  uuid.New().String(),
+		VideoId: uuid.New().String(),
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(clientError(err)))
+}
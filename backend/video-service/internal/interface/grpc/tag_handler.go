@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+)
+
+type TagHandler struct {
+	tagUseCase usecase.TagUseCase
+}
+
+func NewTagHandler(tagUseCase usecase.TagUseCase) *TagHandler {
+	return &TagHandler{
+		tagUseCase: tagUseCase,
+	}
+}
+
+func validateHashtag(hashtag string) error {
+	if strings.TrimPrefix(strings.TrimSpace(hashtag), "#") == "" {
+		return invalidArgument("hashtag", "hashtag is required")
+	}
+	return nil
+}
+
+func (h *TagHandler) ListHashtagVideos(ctx context.Context, req *pb.ListHashtagVideosRequest) (
+	*pb.ListHashtagVideosResponse, error) {
+
+	logger.Info("ListHashtagVideos request received",
+		zap.String("hashtag", req.Hashtag),
+		zap.String("sort", req.Sort),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateHashtag(req.Hashtag); err != nil {
+		logger.Error("Invalid ListHashtagVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in ListHashtagVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.tagUseCase.ListHashtagVideos(ctx, req.Hashtag, req.ViewerId,
+		domain.HashtagSort(req.Sort), int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list hashtag videos", zap.Error(err), zap.String("hashtag", req.Hashtag))
+		return nil, err
+	}
+
+	protoVideos := listVideosToProto(videos)
+	logger.Info("ListHashtagVideos request completed successfully",
+		zap.String("hashtag", req.Hashtag),
+		zap.Int("video_count", len(protoVideos)),
+		zap.Int64("total", total))
+
+	return &pb.ListHashtagVideosResponse{Videos: protoVideos, Total: total}, nil
+}
+
+func (h *TagHandler) GetHashtagStats(ctx context.Context, req *pb.GetHashtagStatsRequest) (
+	*pb.GetHashtagStatsResponse, error) {
+
+	logger.Info("GetHashtagStats request received", zap.String("hashtag", req.Hashtag))
+
+	if err := validateHashtag(req.Hashtag); err != nil {
+		logger.Error("Invalid GetHashtagStats request", zap.Error(err))
+		return nil, err
+	}
+
+	stats, err := h.tagUseCase.GetHashtagStats(ctx, req.Hashtag)
+	if err != nil {
+		logger.Error("Failed to get hashtag stats", zap.Error(err), zap.String("hashtag", req.Hashtag))
+		return nil, err
+	}
+
+	logger.Info("GetHashtagStats request completed successfully",
+		zap.String("hashtag", stats.Name),
+		zap.Int64("video_count", stats.VideoCount))
+
+	return &pb.GetHashtagStatsResponse{
+		Stats: &pb.HashtagStats{
+			Name:       stats.Name,
+			VideoCount: stats.VideoCount,
+			TotalViews: stats.TotalViews,
+		},
+	}, nil
+}
+
+func (h *TagHandler) ListMentionedVideos(ctx context.Context, req *pb.ListMentionedVideosRequest) (
+	*pb.ListMentionedVideosResponse, error) {
+
+	logger.Info("ListMentionedVideos request received",
+		zap.String("user_id", req.UserId),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid user_id in ListMentionedVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in ListMentionedVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.tagUseCase.ListMentionedVideos(ctx, req.UserId, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list mentioned videos", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	protoVideos := listVideosToProto(videos)
+	logger.Info("ListMentionedVideos request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("video_count", len(protoVideos)),
+		zap.Int64("total", total))
+
+	return &pb.ListMentionedVideosResponse{Videos: protoVideos, Total: total}, nil
+}
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockTagUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTagUseCase) ListHashtagVideos(ctx context.Context, tag, viewerID string, sort domain.HashtagSort,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, tag, viewerID, sort, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTagUseCase) GetHashtagStats(ctx context.Context, tag string) (*domain.HashtagStats, error) {
+	args := m.Called(ctx, tag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.HashtagStats), args.Error(1)
+}
+
+func (m *MockTagUseCase) ListMentionedVideos(ctx context.Context, userID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, userID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+}
+
+func createTestTagHandler() (*TagHandler, *MockTagUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockTagUseCase{}
+	handler := NewTagHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestListHashtagVideos_Success(t *testing.T) {
+	handler, mockUseCase := createTestTagHandler()
+	videos := []*domain.Video{createTestDomainVideo()}
+
+	mockUseCase.On("ListHashtagVideos", mock.Anything, "#dance", "", domain.HashtagSortPopular, 10, 0).
+		Return(videos, int64(3), nil)
+
+	resp, err := handler.ListHashtagVideos(context.Background(), &pb.ListHashtagVideosRequest{
+		Hashtag: "#dance",
+		Sort:    "popular",
+		Limit:   10,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Videos, 1)
+	assert.Equal(t, int64(3), resp.Total)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestListHashtagVideos_MissingHashtag(t *testing.T) {
+	handler, _ := createTestTagHandler()
+
+	_, err := handler.ListHashtagVideos(context.Background(), &pb.ListHashtagVideosRequest{Hashtag: "#"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListHashtagVideos_InvalidSort(t *testing.T) {
+	handler, mockUseCase := createTestTagHandler()
+
+	mockUseCase.On("ListHashtagVideos", mock.Anything, "dance", "", domain.HashtagSort("oldest"), 10, 0).
+		Return(nil, int64(0), domain.ErrInvalidHashtagSort)
+
+	_, err := handler.ListHashtagVideos(context.Background(), &pb.ListHashtagVideosRequest{
+		Hashtag: "dance",
+		Sort:    "oldest",
+		Limit:   10,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestGetHashtagStats_Success(t *testing.T) {
+	handler, mockUseCase := createTestTagHandler()
+
+	mockUseCase.On("GetHashtagStats", mock.Anything, "dance").
+		Return(&domain.HashtagStats{Name: "dance", VideoCount: 4, TotalViews: 250}, nil)
+
+	resp, err := handler.GetHashtagStats(context.Background(), &pb.GetHashtagStatsRequest{Hashtag: "dance"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "dance", resp.Stats.Name)
+	assert.Equal(t, int64(4), resp.Stats.VideoCount)
+	assert.Equal(t, int64(250), resp.Stats.TotalViews)
+}
+
+func TestGetHashtagStats_UseCaseError(t *testing.T) {
+	handler, mockUseCase := createTestTagHandler()
+
+	mockUseCase.On("GetHashtagStats", mock.Anything, "dance").Return(nil, errors.New("database error"))
+
+	_, err := handler.GetHashtagStats(context.Background(), &pb.GetHashtagStatsRequest{Hashtag: "dance"})
+
+	assert.Equal(t, codes.Internal, status.Code(clientError(err)))
+}
+
+func TestListMentionedVideos_Success(t *testing.T) {
+	handler, mockUseCase := createTestTagHandler()
+	userID := uuid.NewString()
+
+	viewerID := uuid.NewString()
+
+	mockUseCase.On("ListMentionedVideos", mock.Anything, userID, viewerID, 20, 0).
+		Return([]*domain.Video{createTestDomainVideo()}, int64(1), nil)
+
+	resp, err := handler.ListMentionedVideos(context.Background(), &pb.ListMentionedVideosRequest{
+		UserId:   userID,
+		ViewerId: viewerID,
+		Limit:    20,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Videos, 1)
+	assert.Equal(t, int64(1), resp.Total)
+}
+
+func TestListMentionedVideos_InvalidUserID(t *testing.T) {
+	handler, _ := createTestTagHandler()
+
+	_, err := handler.ListMentionedVideos(context.Background(), &pb.ListMentionedVideosRequest{UserId: "bad"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
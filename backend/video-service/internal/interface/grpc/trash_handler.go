@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TrashHandler struct {
+	trashUseCase usecase.TrashUseCase
+}
+
+func NewTrashHandler(trashUseCase usecase.TrashUseCase) *TrashHandler {
+	return &TrashHandler{
+		trashUseCase: trashUseCase,
+	}
+}
+
+func trashedVideoToProto(trashed *usecase.TrashedVideo) *pb.TrashedVideo {
+	return &pb.TrashedVideo{
+		Video:     domainVideoToProto(trashed.Video),
+		DeletedAt: timestamppb.New(trashed.Video.DeletedAt.Time),
+		PurgeAt:   timestamppb.New(trashed.PurgeAt),
+	}
+}
+
+func (h *TrashHandler) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	logger.Info("ListTrash request received",
+		zap.String("user_id", req.UserId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		logger.Error("Invalid ListTrash request", zap.Error(err))
+		return nil, err
+	}
+
+	trashed, total, err := h.trashUseCase.ListTrash(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to list trash", zap.Error(err), zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	protoVideos := make([]*pb.TrashedVideo, len(trashed))
+	for i, video := range trashed {
+		protoVideos[i] = trashedVideoToProto(video)
+	}
+
+	logger.Info("ListTrash request completed successfully",
+		zap.String("user_id", req.UserId),
+		zap.Int("video_count", len(trashed)),
+		zap.Int64("total", total))
+
+	return &pb.ListTrashResponse{Videos: protoVideos, Total: total}, nil
+}
+
+func (h *TrashHandler) RestoreVideo(ctx context.Context, req *pb.RestoreVideoRequest) (
+	*pb.RestoreVideoResponse, error) {
+
+	logger.Info("RestoreVideo request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid RestoreVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	video, err := h.trashUseCase.RestoreVideo(ctx, req.UserId, req.VideoId)
+	if err != nil {
+		logger.Error("Failed to restore video", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("RestoreVideo request completed successfully", zap.String("video_id", req.VideoId))
+
+	return &pb.RestoreVideoResponse{Video: domainVideoToProto(video)}, nil
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type MockTrashUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTrashUseCase) ListTrash(ctx context.Context, userID string, limit, offset int) (
+	[]*usecase.TrashedVideo, int64, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*usecase.TrashedVideo), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTrashUseCase) RestoreVideo(ctx context.Context, userID, videoID string) (*domain.Video, error) {
+	args := m.Called(ctx, userID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+func (m *MockTrashUseCase) PurgeExpired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func createTestTrashHandler() (*TrashHandler, *MockTrashUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockTrashUseCase{}
+	handler := NewTrashHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestListTrash_Success(t *testing.T) {
+	handler, mockUseCase := createTestTrashHandler()
+	video := createTestDomainVideo()
+	video.DeletedAt = gorm.DeletedAt{Time: time.Now().Add(-time.Hour), Valid: true}
+	purgeAt := video.DeletedAt.Time.Add(30 * 24 * time.Hour)
+
+	mockUseCase.On("ListTrash", mock.Anything, video.UserID.String(), 10, 0).
+		Return([]*usecase.TrashedVideo{{Video: video, PurgeAt: purgeAt}}, int64(1), nil)
+
+	resp, err := handler.ListTrash(context.Background(), &pb.ListTrashRequest{
+		UserId: video.UserID.String(),
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Total)
+	require.Len(t, resp.Videos, 1)
+	assert.Equal(t, video.ID.String(), resp.Videos[0].Video.Id)
+	assert.True(t, resp.Videos[0].DeletedAt.AsTime().Equal(video.DeletedAt.Time))
+	assert.True(t, resp.Videos[0].PurgeAt.AsTime().Equal(purgeAt))
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestListTrash_InvalidUserID(t *testing.T) {
+	handler, mockUseCase := createTestTrashHandler()
+
+	_, err := handler.ListTrash(context.Background(), &pb.ListTrashRequest{UserId: "invalid"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "ListTrash", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRestoreVideo_Success(t *testing.T) {
+	handler, mockUseCase := createTestTrashHandler()
+	video := createTestDomainVideo()
+
+	mockUseCase.On("RestoreVideo", mock.Anything, video.UserID.String(), video.ID.String()).Return(video, nil)
+
+	resp, err := handler.RestoreVideo(context.Background(), &pb.RestoreVideoRequest{
+		UserId:  video.UserID.String(),
+		VideoId: video.ID.String(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, video.ID.String(), resp.Video.Id)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRestoreVideo_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"not in trash", domain.ErrVideoNotFound, codes.NotFound},
+		{"not owner", domain.ErrNotVideoOwner, codes.PermissionDenied},
+		{"window expired", domain.ErrRestoreWindowExpired, codes.FailedPrecondition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestTrashHandler()
+			mockUseCase.On("RestoreVideo", mock.Anything, mock.Anything, mock.Anything).Return(nil, test.err)
+
+			_, err := handler.RestoreVideo(context.Background(), &pb.RestoreVideoRequest{
+				UserId:  uuid.New().String(),
+				VideoId: uuid.New().String(),
+			})
+
+			assert.Equal(t, test.code, status.Code(clientError(err)))
+		})
+	}
+}
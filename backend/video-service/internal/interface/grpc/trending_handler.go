@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+)
+
+type TrendingHandler struct {
+	trendingUseCase usecase.TrendingUseCase
+}
+
+func NewTrendingHandler(trendingUseCase usecase.TrendingUseCase) *TrendingHandler {
+	return &TrendingHandler{
+		trendingUseCase: trendingUseCase,
+	}
+}
+
+func (h *TrendingHandler) GetTrendingVideos(ctx context.Context, req *pb.GetTrendingVideosRequest) (
+	*pb.GetTrendingVideosResponse, error) {
+
+	logger.Info("GetTrendingVideos request received",
+		zap.String("window", req.Window),
+		zap.String("region", req.Region),
+		zap.String("viewer_id", req.ViewerId),
+		zap.Int32("limit", req.Limit),
+		zap.Int32("offset", req.Offset))
+
+	if err := validateRegion(req.Region); err != nil {
+		logger.Error("Invalid GetTrendingVideos request", zap.Error(err))
+		return nil, err
+	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in GetTrendingVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, err := h.trendingUseCase.GetTrendingVideos(ctx, req.Window, req.Region, req.ViewerId,
+		int(req.Limit), int(req.Offset))
+	if err != nil {
+		logger.Error("Failed to get trending videos", zap.Error(err), zap.String("window", req.Window))
+		return nil, err
+	}
+
+	protoVideos := listVideosToProto(videos)
+	logger.Info("GetTrendingVideos request completed successfully",
+		zap.String("window", req.Window),
+		zap.Int("video_count", len(protoVideos)))
+
+	return &pb.GetTrendingVideosResponse{Videos: protoVideos}, nil
+}
+
+func (h *TrendingHandler) GetTrendingHashtags(ctx context.Context, req *pb.GetTrendingHashtagsRequest) (
+	*pb.GetTrendingHashtagsResponse, error) {
+
+	logger.Info("GetTrendingHashtags request received",
+		zap.String("window", req.Window),
+		zap.String("region", req.Region),
+		zap.Int32("limit", req.Limit))
+
+	if err := validateRegion(req.Region); err != nil {
+		logger.Error("Invalid GetTrendingHashtags request", zap.Error(err))
+		return nil, err
+	}
+
+	hashtags, err := h.trendingUseCase.GetTrendingHashtags(ctx, req.Window, req.Region, int(req.Limit))
+	if err != nil {
+		logger.Error("Failed to get trending hashtags", zap.Error(err), zap.String("window", req.Window))
+		return nil, err
+	}
+
+	protoHashtags := make([]*pb.TrendingHashtag, len(hashtags))
+	for i, hashtag := range hashtags {
+		protoHashtags[i] = &pb.TrendingHashtag{Name: hashtag.Name, Score: hashtag.Score}
+	}
+
+	logger.Info("GetTrendingHashtags request completed successfully",
+		zap.String("window", req.Window),
+		zap.Int("hashtag_count", len(protoHashtags)))
+
+	return &pb.GetTrendingHashtagsResponse{Hashtags: protoHashtags}, nil
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockTrendingUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTrendingUseCase) RefreshTrending(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockTrendingUseCase) GetTrendingVideos(ctx context.Context, window, region, viewerID string,
+	limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, window, region, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTrendingUseCase) GetTrendingHashtags(ctx context.Context, window, region string,
+	limit int) ([]*domain.TrendingHashtag, error) {
+
+	args := m.Called(ctx, window, region, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TrendingHashtag), args.Error(1)
+}
+
+func createTestTrendingHandler() (*TrendingHandler, *MockTrendingUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockTrendingUseCase{}
+	handler := NewTrendingHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func TestGetTrendingVideos_Success(t *testing.T) {
+	handler, mockUseCase := createTestTrendingHandler()
+
+	mockUseCase.On("GetTrendingVideos", mock.Anything, "week", "VN", "", 10, 0).
+		Return([]*domain.Video{createTestDomainVideo()}, nil)
+
+	resp, err := handler.GetTrendingVideos(context.Background(), &pb.GetTrendingVideosRequest{
+		Window: "week",
+		Region: "VN",
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Videos, 1)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestGetTrendingVideos_InvalidWindow(t *testing.T) {
+	handler, mockUseCase := createTestTrendingHandler()
+
+	mockUseCase.On("GetTrendingVideos", mock.Anything, "year", "", "", 10, 0).
+		Return(nil, domain.ErrInvalidTrendingWindow)
+
+	_, err := handler.GetTrendingVideos(context.Background(), &pb.GetTrendingVideosRequest{Window: "year", Limit: 10})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestGetTrendingVideos_InvalidRegion(t *testing.T) {
+	handler, _ := createTestTrendingHandler()
+
+	_, err := handler.GetTrendingVideos(context.Background(), &pb.GetTrendingVideosRequest{Region: "NOT-A-REGION"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetTrendingHashtags_Success(t *testing.T) {
+	handler, mockUseCase := createTestTrendingHandler()
+
+	mockUseCase.On("GetTrendingHashtags", mock.Anything, "day", "", 5).
+		Return([]*domain.TrendingHashtag{{Name: "dance", Score: 4.5}}, nil)
+
+	resp, err := handler.GetTrendingHashtags(context.Background(), &pb.GetTrendingHashtagsRequest{
+		Window: "day",
+		Limit:  5,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Hashtags, 1)
+	assert.Equal(t, "dance", resp.Hashtags[0].Name)
+	assert.Equal(t, 4.5, resp.Hashtags[0].Score)
+}
+
+func TestGetTrendingHashtags_UseCaseError(t *testing.T) {
+	handler, mockUseCase := createTestTrendingHandler()
+
+	mockUseCase.On("GetTrendingHashtags", mock.Anything, "day", "", 5).
+		Return(nil, errors.New("database error"))
+
+	_, err := handler.GetTrendingHashtags(context.Background(), &pb.GetTrendingHashtagsRequest{
+		Window: "day",
+		Limit:  5,
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(clientError(err)))
+}
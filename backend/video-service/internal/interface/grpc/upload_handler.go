@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type UploadHandler struct {
+	uploadUseCase usecase.UploadUseCase
+}
+
+func NewUploadHandler(uploadUseCase usecase.UploadUseCase) *UploadHandler {
+	return &UploadHandler{
+		uploadUseCase: uploadUseCase,
+	}
+}
+
+func domainUploadSessionToProto(session *domain.UploadSession) *pb.UploadSession {
+	protoSession := &pb.UploadSession{
+		UploadId:  session.ID.String(),
+		Offset:    session.ReceivedSize,
+		TotalSize: session.TotalSize,
+		Status:    string(session.Status),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+	if session.VideoID != nil {
+		protoSession.VideoId = session.VideoID.String()
+	}
+
+	return protoSession
+}
+
+func validateCreateUploadSessionRequest(req *pb.CreateUploadSessionRequest) error {
+	if err := validateUUID(req.UserId, "user_id"); err != nil {
+		return err
+	}
+	if req.Title == "" {
+		return invalidArgument("title", "title is required")
+	}
+	if req.FileName == "" {
+		return invalidArgument("file_name", "file_name is required")
+	}
+	if req.TotalSize <= 0 {
+		return invalidArgument("total_size", "total_size must be greater than 0")
+	}
+	if req.Duration <= 0 {
+		return invalidArgument("duration", "duration must be greater than 0")
+	}
+	if digest, err := hex.DecodeString(req.Sha256); err != nil || len(digest) != sha256.Size {
+		return invalidArgument("sha256", "sha256 must be a hex-encoded SHA-256 digest")
+	}
+	if err := validateVisibility(req.Visibility); err != nil {
+		return err
+	}
+	return validateRegion(req.Region)
+}
+
+func validateUploadOwner(uploadID, userID string) error {
+	if err := validateUUID(uploadID, "upload_id"); err != nil {
+		return err
+	}
+	return validateUUID(userID, "user_id")
+}
+
+func (h *UploadHandler) CreateUploadSession(ctx context.Context, req *pb.CreateUploadSessionRequest) (
+	*pb.CreateUploadSessionResponse, error) {
+
+	logger.Info("CreateUploadSession request received",
+		zap.String("user_id", req.UserId),
+		zap.String("file_name", req.FileName),
+		zap.Int64("total_size", req.TotalSize))
+
+	if err := validateCreateUploadSessionRequest(req); err != nil {
+		logger.Error("Invalid CreateUploadSession request", zap.Error(err))
+		return nil, err
+	}
+
+	session, err := h.uploadUseCase.CreateUploadSession(ctx, &usecase.CreateUploadSessionRequest{
+		UserID:      req.UserId,
+		Title:       req.Title,
+		Description: req.Description,
+		Duration:    int(req.Duration),
+		Visibility:  req.Visibility,
+		Region:      req.Region,
+		Draft:       req.Draft,
+		FileName:    req.FileName,
+		TotalSize:   req.TotalSize,
+		Checksum:    req.Sha256,
+	})
+	if err != nil {
+		logger.Error("Failed to create upload session", zap.Error(err),
+			zap.String("user_id", req.UserId))
+		return nil, err
+	}
+
+	logger.Info("CreateUploadSession request completed successfully",
+		zap.String("upload_id", session.ID.String()))
+
+	return &pb.CreateUploadSessionResponse{Session: domainUploadSessionToProto(session)}, nil
+}
+
+func (h *UploadHandler) GetUploadSession(ctx context.Context, req *pb.GetUploadSessionRequest) (
+	*pb.GetUploadSessionResponse, error) {
+
+	logger.Info("GetUploadSession request received", zap.String("upload_id", req.UploadId))
+
+	if err := validateUploadOwner(req.UploadId, req.UserId); err != nil {
+		logger.Error("Invalid GetUploadSession request", zap.Error(err))
+		return nil, err
+	}
+
+	session, err := h.uploadUseCase.GetUploadSession(ctx, req.UploadId, req.UserId)
+	if err != nil {
+		logger.Error("Failed to get upload session", zap.Error(err),
+			zap.String("upload_id", req.UploadId))
+		return nil, err
+	}
+
+	return &pb.GetUploadSessionResponse{Session: domainUploadSessionToProto(session)}, nil
+}
+
+func (h *UploadHandler) UploadVideo(stream pb.VideoService_UploadVideoServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		logger.Error("Failed to receive UploadVideo start message", zap.Error(err))
+		return status.Error(codes.InvalidArgument, "upload stream must start with a start message")
+	}
+
+	start := first.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "upload stream must start with a start message")
+	}
+	if err := validateUploadOwner(start.UploadId, start.UserId); err != nil {
+		return err
+	}
+
+	logger.Info("UploadVideo stream started",
+		zap.String("upload_id", start.UploadId),
+		zap.Int64("offset", start.Offset))
+
+	session, err := h.uploadUseCase.ResumeUpload(ctx, start.UploadId, start.UserId, start.Offset)
+	if err != nil {
+		logger.Error("Failed to resume upload", zap.Error(err),
+			zap.String("upload_id", start.UploadId))
+		if errors.Is(err, domain.ErrUploadOffsetMismatch) {
+			return &domain.FailedPreconditionError{
+				Reason: fmt.Sprintf("offset %d does not match received size %d", start.Offset, session.ReceivedSize),
+			}
+		}
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.Error("UploadVideo stream interrupted", zap.Error(err),
+				zap.String("upload_id", start.UploadId),
+				zap.Int64("offset", session.ReceivedSize))
+			return err
+		}
+
+		chunk := req.GetChunk()
+		if chunk == nil {
+			return status.Error(codes.InvalidArgument, "only chunk messages may follow the start message")
+		}
+
+		if err := h.uploadUseCase.WriteChunk(ctx, session, chunk); err != nil {
+			logger.Error("Failed to write upload chunk", zap.Error(err),
+				zap.String("upload_id", start.UploadId))
+			return err
+		}
+	}
+
+	if session.Remaining() > 0 {
+		logger.Info("UploadVideo stream closed before completion",
+			zap.String("upload_id", start.UploadId),
+			zap.Int64("offset", session.ReceivedSize))
+		return stream.SendAndClose(&pb.UploadVideoResponse{Session: domainUploadSessionToProto(session)})
+	}
+
+	video, err := h.uploadUseCase.CompleteUpload(ctx, session)
+	if err != nil {
+		logger.Error("Failed to complete upload", zap.Error(err),
+			zap.String("upload_id", start.UploadId))
+		return err
+	}
+
+	logger.Info("UploadVideo completed successfully",
+		zap.String("upload_id", start.UploadId),
+		zap.String("video_id", video.ID.String()))
+
+	return stream.SendAndClose(&pb.UploadVideoResponse{
+		Session: domainUploadSessionToProto(session),
+		Video:   domainVideoToProto(video),
+	})
+}
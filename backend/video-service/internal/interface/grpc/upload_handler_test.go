@@ -0,0 +1,281 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+	pb "video-service/proto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MockUploadUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUploadUseCase) CreateUploadSession(ctx context.Context, req *usecase.CreateUploadSessionRequest) (*domain.UploadSession, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadSession), args.Error(1)
+}
+
+func (m *MockUploadUseCase) GetUploadSession(ctx context.Context, id, userID string) (*domain.UploadSession, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadSession), args.Error(1)
+}
+
+func (m *MockUploadUseCase) ResumeUpload(ctx context.Context, id, userID string, offset int64) (*domain.UploadSession, error) {
+	args := m.Called(ctx, id, userID, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadSession), args.Error(1)
+}
+
+func (m *MockUploadUseCase) WriteChunk(ctx context.Context, session *domain.UploadSession, chunk []byte) error {
+	args := m.Called(ctx, session, chunk)
+	if args.Error(0) == nil {
+		session.ReceivedSize += int64(len(chunk))
+	}
+	return args.Error(0)
+}
+
+func (m *MockUploadUseCase) CompleteUpload(ctx context.Context, session *domain.UploadSession) (*domain.Video, error) {
+	args := m.Called(ctx, session)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+type fakeUploadStream struct {
+	grpc.ServerStream
+	requests []*pb.UploadVideoRequest
+	response *pb.UploadVideoResponse
+}
+
+func (s *fakeUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeUploadStream) Recv() (*pb.UploadVideoRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeUploadStream) SendAndClose(resp *pb.UploadVideoResponse) error {
+	s.response = resp
+	return nil
+}
+
+func createTestUploadHandler() (*UploadHandler, *MockUploadUseCase) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	mockUseCase := &MockUploadUseCase{}
+	handler := NewUploadHandler(mockUseCase)
+
+	return handler, mockUseCase
+}
+
+func createTestDomainUploadSession(totalSize int64) *domain.UploadSession {
+	return &domain.UploadSession{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		TotalSize: totalSize,
+		Status:    domain.UploadStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func newStartMessage(session *domain.UploadSession, offset int64) *pb.UploadVideoRequest {
+	return &pb.UploadVideoRequest{Payload: &pb.UploadVideoRequest_Start{
+		Start: &pb.UploadStart{UploadId: session.ID.String(), UserId: session.UserID.String(), Offset: offset},
+	}}
+}
+
+func newChunkMessage(chunk []byte) *pb.UploadVideoRequest {
+	return &pb.UploadVideoRequest{Payload: &pb.UploadVideoRequest_Chunk{Chunk: chunk}}
+}
+
+func TestCreateUploadSession_Success(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+	session := createTestDomainUploadSession(1024)
+
+	mockUseCase.On("CreateUploadSession", mock.Anything, mock.AnythingOfType("*usecase.CreateUploadSessionRequest")).
+		Return(session, nil)
+
+	resp, err := handler.CreateUploadSession(context.Background(), &pb.CreateUploadSessionRequest{
+		UserId:    session.UserID.String(),
+		Title:     "Clip",
+		Duration:  10,
+		FileName:  "clip.mp4",
+		TotalSize: 1024,
+		Sha256:    "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, session.ID.String(), resp.Session.UploadId)
+	assert.Equal(t, int64(0), resp.Session.Offset)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestCreateUploadSession_ValidationError(t *testing.T) {
+	handler, _ := createTestUploadHandler()
+
+	resp, err := handler.CreateUploadSession(context.Background(), &pb.CreateUploadSessionRequest{
+		UserId:    uuid.NewString(),
+		Title:     "Clip",
+		Duration:  10,
+		FileName:  "clip.mp4",
+		TotalSize: 1024,
+		Sha256:    "short",
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateUploadSession_ChecksumMustBeHex(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+
+	_, err := handler.CreateUploadSession(context.Background(), &pb.CreateUploadSessionRequest{
+		UserId:    uuid.NewString(),
+		Title:     "Clip",
+		Duration:  10,
+		FileName:  "clip.mp4",
+		TotalSize: 1024,
+		Sha256:    "zz23456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "CreateUploadSession", mock.Anything, mock.Anything)
+}
+
+func TestCreateUploadSession_TooLarge(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+
+	mockUseCase.On("CreateUploadSession", mock.Anything, mock.Anything).
+		Return(nil, domain.ErrUploadTooLarge)
+
+	_, err := handler.CreateUploadSession(context.Background(), &pb.CreateUploadSessionRequest{
+		UserId:    uuid.NewString(),
+		Title:     "Clip",
+		Duration:  10,
+		FileName:  "clip.mp4",
+		TotalSize: 1 << 40,
+		Sha256:    "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestUploadVideo_CompletesUpload(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+	session := createTestDomainUploadSession(8)
+	video := createTestDomainVideo()
+
+	mockUseCase.On("ResumeUpload", mock.Anything, session.ID.String(), session.UserID.String(), int64(0)).Return(session, nil)
+	mockUseCase.On("WriteChunk", mock.Anything, session, mock.Anything).Return(nil).Twice()
+	mockUseCase.On("CompleteUpload", mock.Anything, session).Return(video, nil)
+
+	stream := &fakeUploadStream{requests: []*pb.UploadVideoRequest{
+		newStartMessage(session, 0),
+		newChunkMessage([]byte("abcd")),
+		newChunkMessage([]byte("efgh")),
+	}}
+
+	err := handler.UploadVideo(stream)
+
+	require.NoError(t, err)
+	require.NotNil(t, stream.response)
+	assert.Equal(t, video.ID.String(), stream.response.Video.Id)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestUploadVideo_PartialUploadReturnsOffset(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+	session := createTestDomainUploadSession(8)
+
+	mockUseCase.On("ResumeUpload", mock.Anything, session.ID.String(), session.UserID.String(), int64(0)).Return(session, nil)
+	mockUseCase.On("WriteChunk", mock.Anything, session, mock.Anything).Return(nil).Once()
+
+	stream := &fakeUploadStream{requests: []*pb.UploadVideoRequest{
+		newStartMessage(session, 0),
+		newChunkMessage([]byte("abcd")),
+	}}
+
+	err := handler.UploadVideo(stream)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), stream.response.Session.Offset)
+	assert.Nil(t, stream.response.Video)
+	mockUseCase.AssertNotCalled(t, "CompleteUpload", mock.Anything, mock.Anything)
+}
+
+func TestUploadVideo_OffsetMismatch(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+	session := createTestDomainUploadSession(8)
+	session.ReceivedSize = 4
+
+	mockUseCase.On("ResumeUpload", mock.Anything, session.ID.String(), session.UserID.String(), int64(0)).
+		Return(session, domain.ErrUploadOffsetMismatch)
+
+	stream := &fakeUploadStream{requests: []*pb.UploadVideoRequest{
+		newStartMessage(session, 0),
+	}}
+
+	err := handler.UploadVideo(stream)
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(clientError(err)))
+	assert.Contains(t, clientError(err).Error(), "received size 4")
+}
+
+func TestUploadVideo_MissingStartMessage(t *testing.T) {
+	handler, _ := createTestUploadHandler()
+
+	stream := &fakeUploadStream{requests: []*pb.UploadVideoRequest{
+		newChunkMessage([]byte("abcd")),
+	}}
+
+	err := handler.UploadVideo(stream)
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUploadVideo_ChecksumMismatch(t *testing.T) {
+	handler, mockUseCase := createTestUploadHandler()
+	session := createTestDomainUploadSession(4)
+
+	mockUseCase.On("ResumeUpload", mock.Anything, session.ID.String(), session.UserID.String(), int64(0)).Return(session, nil)
+	mockUseCase.On("WriteChunk", mock.Anything, session, mock.Anything).Return(nil)
+	mockUseCase.On("CompleteUpload", mock.Anything, session).Return(nil, domain.ErrUploadChecksumMismatch)
+
+	stream := &fakeUploadStream{requests: []*pb.UploadVideoRequest{
+		newStartMessage(session, 0),
+		newChunkMessage([]byte("abcd")),
+	}}
+
+	err := handler.UploadVideo(stream)
+
+	assert.Equal(t, codes.DataLoss, status.Code(clientError(err)))
+}
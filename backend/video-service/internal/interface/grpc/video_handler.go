@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"net"
+	"slices"
 	"video-service/internal/domain"
 	"video-service/internal/pkg/logger"
 	"video-service/internal/usecase"
@@ -9,10 +11,9 @@ import (
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"gorm.io/gorm"
 )
 
 type VideoHandler struct {
@@ -28,19 +29,30 @@ func NewVideoHandler(videoUseCase usecase.VideoUseCase) *VideoHandler {
 
 func domainVideoToProto(video *domain.Video) *pb.Video {
 	return &pb.Video{
-		Id:           video.ID.String(),
-		UserId:       video.UserID.String(),
-		Title:        video.Title,
-		Description:  video.Description,
-		VideoUrl:     video.VideoURL,
-		ThumbnailUrl: video.ThumbnailURL,
-		Duration:     int32(video.Duration),
-		ViewCount:    video.ViewCount,
-		LikeCount:    video.LikeCount,
-		ShareCount:   video.ShareCount,
-		IsPublic:     video.IsPublic,
-		CreatedAt:    timestamppb.New(video.CreatedAt),
-		UpdatedAt:    timestamppb.New(video.UpdatedAt),
+		Id:               video.ID.String(),
+		UserId:           video.UserID.String(),
+		Title:            video.Title,
+		Description:      video.Description,
+		VideoUrl:         video.VideoURL,
+		ThumbnailUrl:     video.ThumbnailURL,
+		Duration:         int32(video.Duration),
+		ViewCount:        video.ViewCount,
+		LikeCount:        video.LikeCount,
+		ShareCount:       video.ShareCount,
+		FavoriteCount:    video.FavoriteCount,
+		Visibility:       string(video.Visibility),
+		CreatedAt:        timestamppb.New(video.CreatedAt),
+		UpdatedAt:        timestamppb.New(video.UpdatedAt),
+		ProcessingStatus: string(video.ProcessingStatus),
+		PreviewUrl:       video.PreviewURL,
+		CoverTimeMs:      int32(video.CoverTimeMs),
+		PlaylistUrl:      video.PlaylistURL,
+		Region:           video.Region,
+		ModerationState:  string(video.ModerationState),
+		ModerationReason: video.ModerationReason,
+		Etag:             video.ETag(),
+		PublicationState: string(video.PublicationState),
+		PublishAt:        optionalTimeToProto(video.PublishAt),
 	}
 }
 
@@ -61,39 +73,67 @@ func protoCreateRequestToUseCase(req *pb.CreateVideoRequest) *usecase.CreateVide
 		VideoURL:     req.VideoUrl,
 		ThumbnailURL: req.ThumbnailUrl,
 		Duration:     int(req.Duration),
-		IsPublic:     req.IsPublic,
+		Visibility:   req.Visibility,
+		Region:       req.Region,
+		Draft:        req.Draft,
 	}
 }
 
 func validateCreateVideoRequest(req *pb.CreateVideoRequest) error {
 	if req.UserId == "" {
-		return status.Error(codes.InvalidArgument, "user_id is required")
+		return invalidArgument("user_id", "user_id is required")
 	}
 	if _, err := uuid.Parse(req.UserId); err != nil {
-		return status.Error(codes.InvalidArgument, "user_id must be a valid UUID")
+		return invalidArgument("user_id", "user_id must be a valid UUID")
 	}
 	if req.Title == "" {
-		return status.Error(codes.InvalidArgument, "title is required")
+		return invalidArgument("title", "title is required")
 	}
 	if req.VideoUrl == "" {
-		return status.Error(codes.InvalidArgument, "video_url is required")
+		return invalidArgument("video_url", "video_url is required")
 	}
 	if req.Duration <= 0 {
-		return status.Error(codes.InvalidArgument, "duration must be greater than 0")
+		return invalidArgument("duration", "duration must be greater than 0")
+	}
+	if err := validateVisibility(req.Visibility); err != nil {
+		return err
+	}
+	return validateRegion(req.Region)
+}
+
+func validateRegion(region string) error {
+	if len(region) > 8 {
+		return invalidArgument("region", "region must be at most 8 characters")
 	}
 	return nil
 }
 
+func validateVisibility(visibility string) error {
+	switch domain.Visibility(visibility) {
+	case "", domain.VisibilityPublic, domain.VisibilityFollowers, domain.VisibilityFriends,
+		domain.VisibilityPrivate, domain.VisibilityUnlisted:
+		return nil
+	}
+	return invalidArgument("visibility", "visibility must be one of: public, followers, friends, private, unlisted")
+}
+
 func validateUUID(id, fieldName string) error {
 	if id == "" {
-		return status.Errorf(codes.InvalidArgument, "%s is required", fieldName)
+		return invalidArgument(fieldName, fieldName+" is required")
 	}
 	if _, err := uuid.Parse(id); err != nil {
-		return status.Errorf(codes.InvalidArgument, "%s must be a valid UUID", fieldName)
+		return invalidArgument(fieldName, fieldName+" must be a valid UUID")
 	}
 	return nil
 }
 
+func validateOptionalUUID(id, fieldName string) error {
+	if id == "" {
+		return nil
+	}
+	return validateUUID(id, fieldName)
+}
+
 func validateUserVideoRequest(userID, videoID string) error {
 	if err := validateUUID(userID, "user_id"); err != nil {
 		return err
@@ -110,7 +150,9 @@ func protoUpdateRequestToUseCase(req *pb.UpdateVideoRequest) *usecase.UpdateVide
 		Title:        req.Title,
 		Description:  req.Description,
 		ThumbnailURL: req.ThumbnailUrl,
-		IsPublic:     req.IsPublic,
+		Visibility:   req.Visibility,
+		UpdateMask:   req.UpdateMask.GetPaths(),
+		ETag:         req.Etag,
 	}
 }
 
@@ -118,10 +160,14 @@ func validateUpdateVideoRequest(req *pb.UpdateVideoRequest) error {
 	if err := validateUUID(req.Id, "id"); err != nil {
 		return err
 	}
-	if req.Title == "" {
-		return status.Error(codes.InvalidArgument, "title is required")
+	paths := req.UpdateMask.GetPaths()
+	if slices.Contains(paths, usecase.VideoFieldTitle) && req.Title == "" {
+		return invalidArgument("title", "title is required")
 	}
-	return nil
+	if slices.Contains(paths, usecase.VideoFieldVisibility) && req.Visibility == "" {
+		return invalidArgument("visibility", "visibility is required")
+	}
+	return validateVisibility(req.Visibility)
 }
 
 func (h *VideoHandler) CreateVideo(ctx context.Context, req *pb.CreateVideoRequest) (*pb.CreateVideoResponse, error) {
@@ -130,7 +176,7 @@ func (h *VideoHandler) CreateVideo(ctx context.Context, req *pb.CreateVideoReque
 		zap.String("title", req.Title),
 		zap.String("video_url", req.VideoUrl),
 		zap.Int32("duration", req.Duration),
-		zap.Bool("is_public", req.IsPublic),
+		zap.String("visibility", req.Visibility),
 	)
 
 	if err := validateCreateVideoRequest(req); err != nil {
@@ -150,7 +196,7 @@ func (h *VideoHandler) CreateVideo(ctx context.Context, req *pb.CreateVideoReque
 			zap.String("title", req.Title),
 			zap.Error(err),
 		)
-		return nil, status.Errorf(codes.Internal, "failed to create video: %v", err)
+		return nil, err
 	}
 
 	logger.Info("Video created successfully",
@@ -167,19 +213,21 @@ func (h *VideoHandler) CreateVideo(ctx context.Context, req *pb.CreateVideoReque
 func (h *VideoHandler) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*pb.GetVideoResponse, error) {
 	logger.Info("GetVideo request received",
 		zap.String("video_id", req.Id),
+		zap.String("viewer_id", req.ViewerId),
 	)
 
-	video, err := h.videoUseCase.GetVideo(ctx, req.Id)
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in GetVideo request", zap.Error(err))
+		return nil, err
+	}
+
+	video, err := h.videoUseCase.GetVideo(ctx, req.Id, req.ViewerId)
 	if err != nil {
 		logger.Error("Failed to get video",
 			zap.String("video_id", req.Id),
 			zap.Error(err),
 		)
-
-		if err == gorm.ErrRecordNotFound {
-			return nil, status.Errorf(codes.NotFound, "video not found")
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get video: %v", err)
+		return nil, err
 	}
 
 	logger.Info("Video retrieved successfully",
@@ -193,17 +241,23 @@ func (h *VideoHandler) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*
 
 func (h *VideoHandler) ListVideos(ctx context.Context, req *pb.ListVideosRequest) (*pb.ListVideosResponse, error) {
 	logger.Info("ListVideos request received",
+		zap.String("viewer_id", req.ViewerId),
 		zap.Int32("limit", req.Limit),
 		zap.Int32("offset", req.Offset),
 	)
 
-	videos, total, err := h.videoUseCase.ListVideos(ctx, int(req.Limit), int(req.Offset))
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in ListVideos request", zap.Error(err))
+		return nil, err
+	}
+
+	videos, total, err := h.videoUseCase.ListVideos(ctx, req.ViewerId, int(req.Limit), int(req.Offset))
 	if err != nil {
 		logger.Error("Failed to list videos",
 			zap.Error(err),
 		)
 
-		return nil, status.Errorf(codes.Internal, "failed to list videos: %v", err)
+		return nil, err
 	}
 
 	logger.Info("Videos retrieved successfully",
@@ -220,6 +274,7 @@ func (h *VideoHandler) ListVideos(ctx context.Context, req *pb.ListVideosRequest
 func (h *VideoHandler) GetVideosByUser(ctx context.Context, req *pb.GetVideosByUserRequest) (*pb.GetVideosByUserResponse, error) {
 	logger.Info("GetVideosByUser request received",
 		zap.String("user_id", req.UserId),
+		zap.String("sort", req.Sort),
 		zap.Int32("limit", req.Limit),
 		zap.Int32("offset", req.Offset))
 
@@ -227,20 +282,38 @@ func (h *VideoHandler) GetVideosByUser(ctx context.Context, req *pb.GetVideosByU
 		logger.Error("Invalid user_id in GetVideosByUser request", zap.Error(err))
 		return nil, err
 	}
+	if err := validateOptionalUUID(req.ViewerId, "viewer_id"); err != nil {
+		logger.Error("Invalid viewer_id in GetVideosByUser request", zap.Error(err))
+		return nil, err
+	}
 
-	videos, total, err := h.videoUseCase.GetVideosByUser(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	page, err := h.videoUseCase.GetVideosByUser(ctx, &usecase.GetVideosByUserRequest{
+		UserID:   req.UserId,
+		ViewerID: req.ViewerId,
+		Sort:     req.Sort,
+		Cursor:   req.Cursor,
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+	})
 	if err != nil {
 		logger.Error("Failed to get videos by user", zap.Error(err), zap.String("user_id", req.UserId))
-		return nil, status.Error(codes.Internal, "Failed to get videos")
+		return nil, err
 	}
 
-	protoVideos := listVideosToProto(videos)
+	protoVideos := listVideosToProto(page.Videos)
 	logger.Info("GetVideosByUser request completed successfully",
 		zap.String("user_id", req.UserId),
 		zap.Int("video_count", len(protoVideos)),
-		zap.Int64("total", total))
-
-	return &pb.GetVideosByUserResponse{Videos: protoVideos, Total: total}, nil
+		zap.Int("pinned_count", len(page.Pinned)),
+		zap.Int64("total", page.Total),
+		zap.Bool("has_more", page.NextCursor != ""))
+
+	return &pb.GetVideosByUserResponse{
+		Videos:       protoVideos,
+		Total:        page.Total,
+		PinnedVideos: listVideosToProto(page.Pinned),
+		NextCursor:   page.NextCursor,
+	}, nil
 }
 
 func (h *VideoHandler) UpdateVideo(ctx context.Context, req *pb.UpdateVideoRequest) (*pb.UpdateVideoResponse, error) {
@@ -255,7 +328,7 @@ func (h *VideoHandler) UpdateVideo(ctx context.Context, req *pb.UpdateVideoReque
 	video, err := h.videoUseCase.UpdateVideo(ctx, updateReq)
 	if err != nil {
 		logger.Error("Failed to update video", zap.Error(err), zap.String("video_id", req.Id))
-		return nil, status.Error(codes.Internal, "Failed to update video")
+		return nil, err
 	}
 
 	protoVideo := domainVideoToProto(video)
@@ -275,7 +348,7 @@ func (h *VideoHandler) DeleteVideo(ctx context.Context, req *pb.DeleteVideoReque
 	err := h.videoUseCase.DeleteVideo(ctx, req.Id)
 	if err != nil {
 		logger.Error("Failed to delete video", zap.Error(err), zap.String("video_id", req.Id))
-		return nil, status.Error(codes.Internal, "Failed to delete video")
+		return nil, err
 	}
 
 	logger.Info("DeleteVideo request completed successfully", zap.String("video_id", req.Id))
@@ -297,7 +370,7 @@ func (h *VideoHandler) LikeVideo(ctx context.Context, req *pb.LikeVideoRequest)
 		logger.Error("Failed to like video", zap.Error(err),
 			zap.String("user_id", req.UserId),
 			zap.String("video_id", req.VideoId))
-		return nil, status.Error(codes.Internal, "Failed to like video")
+		return nil, err
 	}
 
 	logger.Info("LikeVideo request completed successfully",
@@ -323,7 +396,7 @@ func (h *VideoHandler) UnlikeVideo(ctx context.Context, req *pb.UnlikeVideoReque
 		logger.Error("Failed to unlike video", zap.Error(err),
 			zap.String("user_id", req.UserId),
 			zap.String("video_id", req.VideoId))
-		return nil, status.Error(codes.Internal, "Failed to unlike video")
+		return nil, err
 	}
 
 	logger.Info("UnlikeVideo request completed successfully",
@@ -347,23 +420,73 @@ func (h *VideoHandler) CreateView(ctx context.Context, req *pb.CreateViewRequest
 
 	if req.WatchTime < 0 {
 		logger.Error("Invalid watch_time in CreateView request", zap.Int32("watch_time", req.WatchTime))
-		return nil, status.Error(codes.InvalidArgument, "watch_time must be non-negative")
+		return nil, invalidArgument("watch_time", "watch_time must be non-negative")
 	}
 
-	totalViews, err := h.videoUseCase.CreateView(ctx, req.UserId, req.VideoId, int(req.WatchTime))
+	if req.ClientIp != "" && net.ParseIP(req.ClientIp) == nil {
+		logger.Error("Invalid client_ip in CreateView request", zap.String("client_ip", req.ClientIp))
+		return nil, invalidArgument("client_ip", "client_ip must be an IP address")
+	}
+
+	outcome, err := h.videoUseCase.CreateView(ctx, &usecase.CreateViewRequest{
+		UserID:    req.UserId,
+		VideoID:   req.VideoId,
+		WatchTime: int(req.WatchTime),
+		ClientIP:  callerIP(ctx, req.ClientIp),
+	})
 	if err != nil {
 		logger.Error("Failed to create view", zap.Error(err),
 			zap.String("user_id", req.UserId),
 			zap.String("video_id", req.VideoId))
-		return nil, status.Error(codes.Internal, "Failed to create view")
+		return nil, err
 	}
 
 	logger.Info("CreateView request completed successfully",
 		zap.String("user_id", req.UserId),
 		zap.String("video_id", req.VideoId),
-		zap.Int64("total_views", totalViews))
+		zap.Int64("total_views", outcome.TotalViews),
+		zap.Bool("counted", outcome.Counted))
 
-	return &pb.CreateViewResponse{Success: true, TotalViews: totalViews}, nil
+	return &pb.CreateViewResponse{Success: true, TotalViews: outcome.TotalViews, Counted: outcome.Counted}, nil
+}
+
+// ClientIPMetadataKey carries the caller's address from the REST gateway,
+// whose own connection is the peer of every call it proxies. It is only
+// trusted on loopback connections, which is how the gateway dials in.
+const ClientIPMetadataKey = "x-client-ip"
+
+// callerIP is the address views are rate limited by. Only loopback
+// connections, the gateway and edge proxies on the same host, may name the
+// client. The gateway appends its value after any metadata taken from the
+// REST request, so only the last value is used, and it wins over claimed
+// because the gateway copies client_ip from the REST body as sent.
+func callerIP(ctx context.Context, claimed string) string {
+	host := peerIP(ctx)
+	if host == "" || !net.ParseIP(host).IsLoopback() {
+		return host
+	}
+
+	forwarded := metadata.ValueFromIncomingContext(ctx, ClientIPMetadataKey)
+	if len(forwarded) > 0 && net.ParseIP(forwarded[len(forwarded)-1]) != nil {
+		return forwarded[len(forwarded)-1]
+	}
+	if claimed != "" {
+		return claimed
+	}
+	return host
+}
+
+// peerIP is empty when the caller's address is not an IP, e.g. a unix socket.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil || net.ParseIP(host) == nil {
+		return ""
+	}
+	return host
 }
 
 func (h *VideoHandler) CheckUserLikedVideo(ctx context.Context, req *pb.CheckUserLikedVideoRequest) (*pb.CheckUserLikedVideoResponse, error) {
@@ -381,7 +504,7 @@ func (h *VideoHandler) CheckUserLikedVideo(ctx context.Context, req *pb.CheckUse
 		logger.Error("Failed to check if user liked video", zap.Error(err),
 			zap.String("user_id", req.UserId),
 			zap.String("video_id", req.VideoId))
-		return nil, status.Error(codes.Internal, "Failed to check user liked video")
+		return nil, err
 	}
 
 	logger.Info("CheckUserLikedVideo request completed successfully",
@@ -398,7 +521,7 @@ func (h *VideoHandler) GetVideoLikeCount(ctx context.Context, req *pb.GetVideoLi
 
 	if req.VideoId == "" {
 		logger.Error("Empty video_id in GetVideoLikeCount request")
-		return nil, status.Error(codes.InvalidArgument, "video_id is required")
+		return nil, invalidArgument("video_id", "video_id is required")
 	}
 
 	if err := validateUUID(req.VideoId, "video_id"); err != nil {
@@ -410,7 +533,7 @@ func (h *VideoHandler) GetVideoLikeCount(ctx context.Context, req *pb.GetVideoLi
 	if err != nil {
 		logger.Error("Failed to get video like count", zap.Error(err),
 			zap.String("video_id", req.VideoId))
-		return nil, status.Error(codes.Internal, "Failed to get video like count")
+		return nil, err
 	}
 
 	logger.Info("GetVideoLikeCount request completed successfully",
@@ -419,3 +542,29 @@ func (h *VideoHandler) GetVideoLikeCount(ctx context.Context, req *pb.GetVideoLi
 
 	return &pb.GetVideoLikeCountResponse{LikeCount: likeCount}, nil
 }
+
+func (h *VideoHandler) SetVideoCover(ctx context.Context, req *pb.SetVideoCoverRequest) (*pb.SetVideoCoverResponse, error) {
+	logger.Info("SetVideoCover request received",
+		zap.String("user_id", req.UserId),
+		zap.String("video_id", req.VideoId),
+		zap.Int32("cover_time_ms", req.CoverTimeMs))
+
+	if err := validateUserVideoRequest(req.UserId, req.VideoId); err != nil {
+		logger.Error("Invalid SetVideoCover request", zap.Error(err))
+		return nil, err
+	}
+
+	video, err := h.videoUseCase.SetVideoCover(ctx, req.UserId, req.VideoId, int(req.CoverTimeMs))
+	if err != nil {
+		logger.Error("Failed to set video cover", zap.Error(err),
+			zap.String("user_id", req.UserId),
+			zap.String("video_id", req.VideoId))
+		return nil, err
+	}
+
+	logger.Info("SetVideoCover request completed successfully",
+		zap.String("video_id", req.VideoId),
+		zap.Int32("cover_time_ms", req.CoverTimeMs))
+
+	return &pb.SetVideoCoverResponse{Video: domainVideoToProto(video)}, nil
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
 	"time"
 	"video-service/internal/domain"
@@ -15,8 +16,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
-	"gorm.io/gorm"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type MockVideoUseCase struct {
@@ -31,28 +34,32 @@ func (m *MockVideoUseCase) CreateVideo(ctx context.Context, req *usecase.CreateV
 	return args.Get(0).(*domain.Video), args.Error(1)
 }
 
-func (m *MockVideoUseCase) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
-	args := m.Called(ctx, id)
+func (m *MockVideoUseCase) GetVideo(ctx context.Context, id, viewerID string) (*domain.Video, error) {
+	args := m.Called(ctx, id, viewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.Video), args.Error(1)
 }
 
-func (m *MockVideoUseCase) ListVideos(ctx context.Context, limit, offset int) ([]*domain.Video, int64, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockVideoUseCase) ListVideos(ctx context.Context, viewerID string, limit, offset int) (
+	[]*domain.Video, int64, error) {
+
+	args := m.Called(ctx, viewerID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
 	}
 	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockVideoUseCase) GetVideosByUser(ctx context.Context, userID string, limit, offset int) ([]*domain.Video, int64, error) {
-	args := m.Called(ctx, userID, limit, offset)
+func (m *MockVideoUseCase) GetVideosByUser(ctx context.Context, req *usecase.GetVideosByUserRequest) (
+	*usecase.UserVideosPage, error) {
+
+	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
-		return nil, 0, args.Error(2)
+		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*domain.Video), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).(*usecase.UserVideosPage), args.Error(1)
 }
 
 func (m *MockVideoUseCase) UpdateVideo(ctx context.Context, req *usecase.UpdateVideoRequest) (*domain.Video, error) {
@@ -78,9 +85,14 @@ func (m *MockVideoUseCase) UnlikeVideo(ctx context.Context, userID, videoID stri
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockVideoUseCase) CreateView(ctx context.Context, userID, videoID string, watchTime int) (int64, error) {
-	args := m.Called(ctx, userID, videoID, watchTime)
-	return args.Get(0).(int64), args.Error(1)
+func (m *MockVideoUseCase) CreateView(ctx context.Context, req *usecase.CreateViewRequest) (
+	*usecase.ViewOutcome, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.ViewOutcome), args.Error(1)
 }
 
 func (m *MockVideoUseCase) CheckUserLikedVideo(ctx context.Context, userID, videoID string) (bool, error) {
@@ -93,6 +105,14 @@ func (m *MockVideoUseCase) GetVideoLikeCount(ctx context.Context, videoID string
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoUseCase) SetVideoCover(ctx context.Context, userID, videoID string, coverTimeMs int) (*domain.Video, error) {
+	args := m.Called(ctx, userID, videoID, coverTimeMs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
 func createTestVideoHandler() (*VideoHandler, *MockVideoUseCase) {
 	logConfig := logger.NewDevelopmentConfig()
 	logger.Init(*logConfig)
@@ -105,19 +125,22 @@ func createTestVideoHandler() (*VideoHandler, *MockVideoUseCase) {
 
 func createTestDomainVideo() *domain.Video {
 	return &domain.Video{
-		ID:           uuid.New(),
-		UserID:       uuid.New(),
-		Title:        "Test Video",
-		Description:  "Test Description",
-		VideoURL:     "https://example.com/video.mp4",
-		ThumbnailURL: "https://example.com/thumb.jpg",
-		Duration:     120,
-		ViewCount:    0,
-		LikeCount:    0,
-		ShareCount:   0,
-		IsPublic:     true,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Title:            "Test Video",
+		Description:      "Test Description",
+		VideoURL:         "https://example.com/video.mp4",
+		ThumbnailURL:     "https://example.com/thumb.jpg",
+		Duration:         120,
+		ViewCount:        0,
+		LikeCount:        0,
+		ShareCount:       0,
+		Visibility:       domain.VisibilityPublic,
+		ProcessingStatus: domain.ProcessingStatusReady,
+		PlaylistURL:      "https://example.com/hls/master.m3u8",
+		PublicationState: domain.PublicationStatePublished,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
 	}
 }
 
@@ -129,7 +152,7 @@ func createTestCreateVideoRequest() *pb.CreateVideoRequest {
 		VideoUrl:     "https://example.com/video.mp4",
 		ThumbnailUrl: "https://example.com/thumb.jpg",
 		Duration:     120,
-		IsPublic:     true,
+		Visibility:   string(domain.VisibilityPublic),
 	}
 }
 
@@ -240,6 +263,19 @@ func TestCreateVideo_InvalidDuration(t *testing.T) {
 	assert.Equal(t, "duration must be greater than 0", st.Message())
 }
 
+func TestCreateVideo_InvalidVisibility(t *testing.T) {
+	handler, _ := createTestVideoHandler()
+	req := createTestCreateVideoRequest()
+	req.Visibility = "everyone"
+
+	resp, err := handler.CreateVideo(context.Background(), req)
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestCreateVideo_UseCaseError(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	req := createTestCreateVideoRequest()
@@ -269,7 +305,7 @@ func TestGetVideo_Success(t *testing.T) {
 	expectedVideo := createTestDomainVideo()
 	videoID := expectedVideo.ID.String()
 
-	mockUseCase.On("GetVideo", mock.Anything, videoID).Return(expectedVideo, nil)
+	mockUseCase.On("GetVideo", mock.Anything, videoID, "").Return(expectedVideo, nil)
 
 	req := &pb.GetVideoRequest{Id: videoID}
 	resp, err := handler.GetVideo(context.Background(), req)
@@ -283,7 +319,9 @@ func TestGetVideo_Success(t *testing.T) {
 	assert.Equal(t, expectedVideo.VideoURL, resp.Video.VideoUrl)
 	assert.Equal(t, expectedVideo.ThumbnailURL, resp.Video.ThumbnailUrl)
 	assert.Equal(t, int32(expectedVideo.Duration), resp.Video.Duration)
-	assert.Equal(t, expectedVideo.IsPublic, resp.Video.IsPublic)
+	assert.Equal(t, string(expectedVideo.Visibility), resp.Video.Visibility)
+	assert.Equal(t, "ready", resp.Video.ProcessingStatus)
+	assert.Equal(t, expectedVideo.PlaylistURL, resp.Video.PlaylistUrl)
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -292,7 +330,7 @@ func TestGetVideo_NotFound(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	videoID := uuid.New().String()
 
-	mockUseCase.On("GetVideo", mock.Anything, videoID).Return(nil, gorm.ErrRecordNotFound)
+	mockUseCase.On("GetVideo", mock.Anything, videoID, "").Return(nil, domain.ErrVideoNotFound)
 
 	req := &pb.GetVideoRequest{Id: videoID}
 	resp, err := handler.GetVideo(context.Background(), req)
@@ -300,7 +338,7 @@ func TestGetVideo_NotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.NotFound, st.Code())
 	assert.Equal(t, "video not found", st.Message())
@@ -308,11 +346,26 @@ func TestGetVideo_NotFound(t *testing.T) {
 	mockUseCase.AssertExpectations(t)
 }
 
+func TestGetVideo_InvalidViewerID(t *testing.T) {
+	handler, _ := createTestVideoHandler()
+
+	resp, err := handler.GetVideo(context.Background(), &pb.GetVideoRequest{
+		Id:       uuid.New().String(),
+		ViewerId: "invalid-uuid",
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "viewer_id must be a valid UUID", st.Message())
+}
+
 func TestGetVideo_UseCaseError(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	videoID := uuid.New().String()
 
-	mockUseCase.On("GetVideo", mock.Anything, videoID).Return(nil, errors.New("database connection error"))
+	mockUseCase.On("GetVideo", mock.Anything, videoID, "").Return(nil, errors.New("database connection error"))
 
 	req := &pb.GetVideoRequest{Id: videoID}
 	resp, err := handler.GetVideo(context.Background(), req)
@@ -320,10 +373,10 @@ func TestGetVideo_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Contains(t, st.Message(), "database connection error")
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -338,7 +391,7 @@ func TestListVideos_Success(t *testing.T) {
 		createTestDomainVideo(),
 		createTestDomainVideo(),
 	}
-	mockUseCase.On("ListVideos", mock.Anything, limit, offset).Return(
+	mockUseCase.On("ListVideos", mock.Anything, "", limit, offset).Return(
 		domainVideos, int64(10), nil)
 
 	req := &pb.ListVideosRequest{Limit: int32(limit), Offset: int32(offset)}
@@ -355,7 +408,7 @@ func TestListVideos_Success(t *testing.T) {
 		assert.Equal(t, domainVideos[i].VideoURL, video.VideoUrl)
 		assert.Equal(t, domainVideos[i].ThumbnailURL, video.ThumbnailUrl)
 		assert.Equal(t, int32(domainVideos[i].Duration), video.Duration)
-		assert.Equal(t, domainVideos[i].IsPublic, video.IsPublic)
+		assert.Equal(t, string(domainVideos[i].Visibility), video.Visibility)
 	}
 	assert.Equal(t, int64(10), resp.Total)
 
@@ -367,7 +420,7 @@ func TestListVideos_UseCaseError(t *testing.T) {
 
 	limit := 5
 	offset := 5
-	mockUseCase.On("ListVideos", mock.Anything, limit, offset).Return(
+	mockUseCase.On("ListVideos", mock.Anything, "", limit, offset).Return(
 		nil, int64(0), errors.New("database connection error"))
 
 	req := &pb.ListVideosRequest{Limit: int32(limit), Offset: int32(offset)}
@@ -376,10 +429,10 @@ func TestListVideos_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Contains(t, st.Message(), "database connection error")
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -394,12 +447,24 @@ func TestGetVideosByUser_Success(t *testing.T) {
 		createTestDomainVideo(),
 		createTestDomainVideo(),
 	}
+	pinned := createTestDomainVideo()
 	total := int64(15)
 
-	mockUseCase.On("GetVideosByUser", mock.Anything, userID, limit, offset).Return(domainVideos, total, nil)
+	mockUseCase.On("GetVideosByUser", mock.Anything, &usecase.GetVideosByUserRequest{
+		UserID: userID,
+		Sort:   "popular",
+		Limit:  limit,
+		Offset: offset,
+	}).Return(&usecase.UserVideosPage{
+		Pinned:     []*domain.Video{pinned},
+		Videos:     domainVideos,
+		Total:      total,
+		NextCursor: "next",
+	}, nil)
 
 	req := &pb.GetVideosByUserRequest{
 		UserId: userID,
+		Sort:   "popular",
 		Limit:  int32(limit),
 		Offset: int32(offset),
 	}
@@ -409,6 +474,9 @@ func TestGetVideosByUser_Success(t *testing.T) {
 	require.NotNil(t, resp)
 	assert.Len(t, resp.Videos, len(domainVideos))
 	assert.Equal(t, total, resp.Total)
+	assert.Equal(t, "next", resp.NextCursor)
+	require.Len(t, resp.PinnedVideos, 1)
+	assert.Equal(t, pinned.ID.String(), resp.PinnedVideos[0].Id)
 
 	for i, video := range resp.Videos {
 		assert.Equal(t, domainVideos[i].ID.String(), video.Id)
@@ -463,7 +531,7 @@ func TestGetVideosByUser_UseCaseError(t *testing.T) {
 	limit := 10
 	offset := 0
 
-	mockUseCase.On("GetVideosByUser", mock.Anything, userID, limit, offset).Return(nil, int64(0), errors.New("database error"))
+	mockUseCase.On("GetVideosByUser", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 
 	req := &pb.GetVideosByUserRequest{
 		UserId: userID,
@@ -475,10 +543,10 @@ func TestGetVideosByUser_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to get videos", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -493,7 +561,7 @@ func TestUpdateVideo_Success(t *testing.T) {
 			req.Title == "Updated Title" &&
 			req.Description == "Updated Description" &&
 			req.ThumbnailURL == "https://example.com/new-thumb.jpg" &&
-			req.IsPublic == false
+			req.Visibility == string(domain.VisibilityPrivate)
 	})).Return(expectedVideo, nil)
 
 	req := &pb.UpdateVideoRequest{
@@ -501,7 +569,7 @@ func TestUpdateVideo_Success(t *testing.T) {
 		Title:        "Updated Title",
 		Description:  "Updated Description",
 		ThumbnailUrl: "https://example.com/new-thumb.jpg",
-		IsPublic:     false,
+		Visibility:   string(domain.VisibilityPrivate),
 	}
 	resp, err := handler.UpdateVideo(context.Background(), req)
 
@@ -520,7 +588,6 @@ func TestUpdateVideo_InvalidVideoID(t *testing.T) {
 		Id:          "invalid-uuid",
 		Title:       "Updated Title",
 		Description: "Updated Description",
-		IsPublic:    true,
 	}
 	resp, err := handler.UpdateVideo(context.Background(), req)
 
@@ -540,7 +607,6 @@ func TestUpdateVideo_EmptyVideoID(t *testing.T) {
 		Id:          "",
 		Title:       "Updated Title",
 		Description: "Updated Description",
-		IsPublic:    true,
 	}
 	resp, err := handler.UpdateVideo(context.Background(), req)
 
@@ -561,7 +627,7 @@ func TestUpdateVideo_EmptyTitle(t *testing.T) {
 		Id:          videoID,
 		Title:       "",
 		Description: "Updated Description",
-		IsPublic:    true,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"title", "description"}},
 	}
 	resp, err := handler.UpdateVideo(context.Background(), req)
 
@@ -574,6 +640,55 @@ func TestUpdateVideo_EmptyTitle(t *testing.T) {
 	assert.Equal(t, "title is required", st.Message())
 }
 
+func TestUpdateVideo_PassesMaskAndETag(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	videoID := uuid.New().String()
+	expectedVideo := createTestDomainVideo()
+	expectedVideo.Version = 4
+
+	mockUseCase.On("UpdateVideo", mock.Anything, mock.MatchedBy(func(req *usecase.UpdateVideoRequest) bool {
+		return req.ID == videoID &&
+			assert.ObjectsAreEqual([]string{"visibility"}, req.UpdateMask) &&
+			req.ETag == "3"
+	})).Return(expectedVideo, nil)
+
+	resp, err := handler.UpdateVideo(context.Background(), &pb.UpdateVideoRequest{
+		Id:         videoID,
+		Visibility: string(domain.VisibilityPrivate),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		Etag:       "3",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "4", resp.Video.Etag)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestUpdateVideo_MaskedVisibilityRequired(t *testing.T) {
+	handler, _ := createTestVideoHandler()
+
+	_, err := handler.UpdateVideo(context.Background(), &pb.UpdateVideoRequest{
+		Id:         uuid.New().String(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateVideo_ConcurrentEditIsAborted(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+
+	mockUseCase.On("UpdateVideo", mock.Anything, mock.Anything).Return(nil, domain.ErrVideoModified)
+
+	_, err := handler.UpdateVideo(context.Background(), &pb.UpdateVideoRequest{
+		Id:    uuid.New().String(),
+		Title: "Updated Title",
+		Etag:  "1",
+	})
+
+	assert.Equal(t, codes.Aborted, status.Code(clientError(err)))
+}
+
 func TestUpdateVideo_UseCaseError(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	videoID := uuid.New().String()
@@ -584,17 +699,16 @@ func TestUpdateVideo_UseCaseError(t *testing.T) {
 		Id:          videoID,
 		Title:       "Updated Title",
 		Description: "Updated Description",
-		IsPublic:    true,
 	}
 	resp, err := handler.UpdateVideo(context.Background(), req)
 
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to update video", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -657,10 +771,10 @@ func TestDeleteVideo_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to delete video", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -779,14 +893,30 @@ func TestLikeVideo_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to like video", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
 
+func TestLikeVideo_HiddenVideo(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	userID := uuid.New().String()
+	videoID := uuid.New().String()
+
+	mockUseCase.On("LikeVideo", mock.Anything, userID, videoID).Return(int64(0), domain.ErrVideoNotFound)
+
+	resp, err := handler.LikeVideo(context.Background(), &pb.LikeVideoRequest{UserId: userID, VideoId: videoID})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(clientError(err))
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	mockUseCase.AssertExpectations(t)
+}
+
 func TestUnlikeVideo_Success(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	userID := uuid.New().String()
@@ -901,10 +1031,10 @@ func TestUnlikeVideo_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to unlike video", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -916,18 +1046,28 @@ func TestCreateView_Success(t *testing.T) {
 	watchTime := int32(120)
 	expectedTotalViews := int64(50)
 
-	mockUseCase.On("CreateView", mock.Anything, userID, videoID, int(watchTime)).Return(expectedTotalViews, nil)
+	mockUseCase.On("CreateView", mock.Anything, &usecase.CreateViewRequest{
+		UserID:    userID,
+		VideoID:   videoID,
+		WatchTime: int(watchTime),
+		ClientIP:  "198.51.100.4",
+	}).Return(&usecase.ViewOutcome{TotalViews: expectedTotalViews, Counted: true}, nil)
 
 	req := &pb.CreateViewRequest{
 		UserId:    userID,
 		VideoId:   videoID,
 		WatchTime: watchTime,
+		ClientIp:  "198.51.100.4",
 	}
-	resp, err := handler.CreateView(context.Background(), req)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+	})
+	resp, err := handler.CreateView(ctx, req)
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.True(t, resp.Success)
+	assert.True(t, resp.Counted)
 	assert.Equal(t, expectedTotalViews, resp.TotalViews)
 
 	mockUseCase.AssertExpectations(t)
@@ -1040,7 +1180,8 @@ func TestCreateView_ZeroWatchTime(t *testing.T) {
 	videoID := uuid.New().String()
 	expectedTotalViews := int64(25)
 
-	mockUseCase.On("CreateView", mock.Anything, userID, videoID, 0).Return(expectedTotalViews, nil)
+	mockUseCase.On("CreateView", mock.Anything, &usecase.CreateViewRequest{UserID: userID, VideoID: videoID}).
+		Return(&usecase.ViewOutcome{TotalViews: expectedTotalViews}, nil)
 
 	req := &pb.CreateViewRequest{
 		UserId:    userID,
@@ -1063,7 +1204,8 @@ func TestCreateView_UseCaseError(t *testing.T) {
 	videoID := uuid.New().String()
 	watchTime := int32(120)
 
-	mockUseCase.On("CreateView", mock.Anything, userID, videoID, int(watchTime)).Return(int64(0), errors.New("database error"))
+	mockUseCase.On("CreateView", mock.Anything, &usecase.CreateViewRequest{
+		UserID: userID, VideoID: videoID, WatchTime: int(watchTime)}).Return(nil, errors.New("database error"))
 
 	req := &pb.CreateViewRequest{
 		UserId:    userID,
@@ -1075,14 +1217,164 @@ func TestCreateView_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to create view", st.Message())
+	assert.Equal(t, "internal error", st.Message())
+
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestCreateView_RateLimited(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+
+	mockUseCase.On("CreateView", mock.Anything, mock.Anything).Return(nil, domain.ErrViewRateLimited)
+
+	_, err := handler.CreateView(context.Background(), &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+	})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(clientError(err)))
+}
 
+func TestCreateView_InvalidClientIP(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+
+	_, err := handler.CreateView(context.Background(), &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+		ClientIp:  "not-an-ip",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockUseCase.AssertNotCalled(t, "CreateView", mock.Anything, mock.Anything)
+}
+
+func TestCreateView_FallsBackToPeerIP(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 50000},
+	})
+
+	mockUseCase.On("CreateView", mock.Anything, mock.MatchedBy(func(req *usecase.CreateViewRequest) bool {
+		return req.ClientIP == "192.0.2.10"
+	})).Return(&usecase.ViewOutcome{TotalViews: 1, Counted: true}, nil)
+
+	_, err := handler.CreateView(ctx, &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+	})
+
+	require.NoError(t, err)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestCreateView_PrefersGatewayClientIP(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+	})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ClientIPMetadataKey, "198.51.100.4"))
+
+	mockUseCase.On("CreateView", mock.Anything, mock.MatchedBy(func(req *usecase.CreateViewRequest) bool {
+		return req.ClientIP == "198.51.100.4"
+	})).Return(&usecase.ViewOutcome{TotalViews: 1, Counted: true}, nil)
+
+	_, err := handler.CreateView(ctx, &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+	})
+
+	require.NoError(t, err)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestCreateView_UsesLastForwardedClientIP(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+	})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		ClientIPMetadataKey, "203.0.113.9",
+		ClientIPMetadataKey, "198.51.100.4",
+	))
+
+	mockUseCase.On("CreateView", mock.Anything, mock.MatchedBy(func(req *usecase.CreateViewRequest) bool {
+		return req.ClientIP == "198.51.100.4"
+	})).Return(&usecase.ViewOutcome{TotalViews: 1, Counted: true}, nil)
+
+	_, err := handler.CreateView(ctx, &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+	})
+
+	require.NoError(t, err)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestCreateView_IgnoresForwardedClientIPFromRemotePeer(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 50000},
+	})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ClientIPMetadataKey, "198.51.100.4"))
+
+	mockUseCase.On("CreateView", mock.Anything, mock.MatchedBy(func(req *usecase.CreateViewRequest) bool {
+		return req.ClientIP == "192.0.2.10"
+	})).Return(&usecase.ViewOutcome{TotalViews: 1, Counted: true}, nil)
+
+	_, err := handler.CreateView(ctx, &pb.CreateViewRequest{
+		UserId:    uuid.New().String(),
+		VideoId:   uuid.New().String(),
+		WatchTime: 30,
+	})
+
+	require.NoError(t, err)
 	mockUseCase.AssertExpectations(t)
 }
 
+func TestCreateView_IgnoresClaimedClientIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		peerIP   string
+		metadata metadata.MD
+		expected string
+	}{
+		{"remote peer", "192.0.2.10", nil, "192.0.2.10"},
+		{"rest request", "127.0.0.1", metadata.Pairs(ClientIPMetadataKey, "192.0.2.10"), "192.0.2.10"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, mockUseCase := createTestVideoHandler()
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(test.peerIP), Port: 50000},
+			})
+			ctx = metadata.NewIncomingContext(ctx, test.metadata)
+
+			mockUseCase.On("CreateView", mock.Anything, mock.MatchedBy(func(req *usecase.CreateViewRequest) bool {
+				return req.ClientIP == test.expected
+			})).Return(&usecase.ViewOutcome{TotalViews: 1, Counted: true}, nil)
+
+			_, err := handler.CreateView(ctx, &pb.CreateViewRequest{
+				UserId:    uuid.New().String(),
+				VideoId:   uuid.New().String(),
+				WatchTime: 30,
+				ClientIp:  "198.51.100.4",
+			})
+
+			require.NoError(t, err)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 func TestCheckUserLikedVideo_Success(t *testing.T) {
 	handler, mockUseCase := createTestVideoHandler()
 	userID := uuid.New().String()
@@ -1157,10 +1449,10 @@ func TestCheckUserLikedVideo_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to check user liked video", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
@@ -1232,10 +1524,80 @@ func TestGetVideoLikeCount_UseCaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	st, ok := status.FromError(err)
+	st, ok := status.FromError(clientError(err))
 	require.True(t, ok)
 	assert.Equal(t, codes.Internal, st.Code())
-	assert.Equal(t, "Failed to get video like count", st.Message())
+	assert.Equal(t, "internal error", st.Message())
 
 	mockUseCase.AssertExpectations(t)
 }
+
+func TestSetVideoCover_Success(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+	expectedVideo := createTestDomainVideo()
+	expectedVideo.CoverTimeMs = 1500
+
+	mockUseCase.On("SetVideoCover", mock.Anything, expectedVideo.UserID.String(), expectedVideo.ID.String(), 1500).
+		Return(expectedVideo, nil)
+
+	resp, err := handler.SetVideoCover(context.Background(), &pb.SetVideoCoverRequest{
+		UserId:      expectedVideo.UserID.String(),
+		VideoId:     expectedVideo.ID.String(),
+		CoverTimeMs: 1500,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1500), resp.Video.CoverTimeMs)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestSetVideoCover_NotOwner(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+
+	mockUseCase.On("SetVideoCover", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrNotVideoOwner)
+
+	resp, err := handler.SetVideoCover(context.Background(), &pb.SetVideoCoverRequest{
+		UserId:  uuid.NewString(),
+		VideoId: uuid.NewString(),
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(clientError(err)))
+}
+
+func TestSetVideoCover_InvalidCoverTime(t *testing.T) {
+	handler, mockUseCase := createTestVideoHandler()
+
+	mockUseCase.On("SetVideoCover", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrInvalidCoverTime)
+
+	_, err := handler.SetVideoCover(context.Background(), &pb.SetVideoCoverRequest{
+		UserId:      uuid.NewString(),
+		VideoId:     uuid.NewString(),
+		CoverTimeMs: 999999,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(clientError(err)))
+}
+
+func TestSetVideoCover_InvalidVideoID(t *testing.T) {
+	handler, _ := createTestVideoHandler()
+
+	_, err := handler.SetVideoCover(context.Background(), &pb.SetVideoCoverRequest{
+		UserId:  uuid.NewString(),
+		VideoId: "not-a-uuid",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListVideos_InvalidViewerID(t *testing.T) {
+	handler, _ := createTestVideoHandler()
+
+	_, err := handler.ListVideos(context.Background(), &pb.ListVideosRequest{ViewerId: "bad"})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	grpcHandler "video-service/internal/interface/grpc"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	corsAllowMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+	corsAllowHeaders = "Authorization, Content-Type, Idempotency-Key"
+	corsMaxAge       = "600"
+)
+
+type errorBody struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Code    int    `json:"code"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// NewGateway serves VideoService as REST/JSON by proxying to the gRPC server
+// at grpcEndpoint, which keeps the streaming RPCs available over HTTP too.
+// The connection is closed when ctx is done.
+func NewGateway(ctx context.Context, grpcEndpoint string) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithErrorHandler(writeGatewayError),
+		runtime.WithMetadata(forwardClientIP),
+		runtime.WithIncomingHeaderMatcher(matchIncomingHeader),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterVideoServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// forwardClientIP keeps view counting per client rather than per gateway.
+func forwardClientIP(ctx context.Context, r *http.Request) metadata.MD {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil || net.ParseIP(host) == nil {
+		return nil
+	}
+	return metadata.Pairs(grpcHandler.ClientIPMetadataKey, host)
+}
+
+// matchIncomingHeader passes the Idempotency-Key header through as gRPC
+// metadata so that REST retries are deduplicated like gRPC ones. The client
+// address is only ever set by forwardClientIP, so a client cannot pick the
+// address its views are rate limited by.
+func matchIncomingHeader(header string) (string, bool) {
+	if strings.EqualFold(header, grpcHandler.IdempotencyKeyMetadataKey) {
+		return grpcHandler.IdempotencyKeyMetadataKey, true
+	}
+	key, ok := runtime.DefaultHeaderMatcher(header)
+	if ok && strings.EqualFold(key, grpcHandler.ClientIPMetadataKey) {
+		return "", false
+	}
+	return key, ok
+}
+
+// writeGatewayError renders every failure, including unknown routes, as
+// {"error": {"code", "status", "message"}} with the HTTP status grpc-gateway
+// maps the gRPC code to.
+func writeGatewayError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler,
+	w http.ResponseWriter, r *http.Request, err error) {
+
+	var routingErr *runtime.HTTPStatusError
+	httpStatus := 0
+	if errors.As(err, &routingErr) {
+		httpStatus = routingErr.HTTPStatus
+		err = routingErr.Err
+	}
+
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = runtime.HTTPStatusFromCode(st.Code())
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	body := errorBody{Error: errorDetail{
+		Code:    httpStatus,
+		Status:  code.Code_name[int32(st.Code())],
+		Message: st.Message(),
+	}}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error("Failed to write gateway error", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(pb.OpenAPI)
+}
+
+// withCORS lets browsers on allowedOrigins call the gateway; "*" allows any
+// origin.
+func withCORS(next http.Handler, allowedOrigins []string) http.Handler {
+	allowAll := false
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			allowAll = true
+		}
+		allowed[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		if !allowAll && !allowed[origin] {
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", corsAllowMethods)
+			w.Header().Set("Access-Control-Allow-Headers", corsAllowHeaders)
+			w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
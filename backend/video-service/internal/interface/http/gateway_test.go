@@ -0,0 +1,255 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"video-service/internal/pkg/logger"
+	pb "video-service/proto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeVideoServer struct {
+	pb.UnimplementedVideoServiceServer
+}
+
+func (fakeVideoServer) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*pb.GetVideoResponse, error) {
+	if req.Id == "missing" {
+		return nil, status.Error(codes.NotFound, "Video not found")
+	}
+	return &pb.GetVideoResponse{Video: &pb.Video{Id: req.Id, Title: "Test Video " + req.ViewerId}}, nil
+}
+
+func (fakeVideoServer) LikeVideo(ctx context.Context, req *pb.LikeVideoRequest) (*pb.LikeVideoResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return &pb.LikeVideoResponse{Success: true, LikeCount: 1}, nil
+}
+
+func (fakeVideoServer) ListHashtagVideos(ctx context.Context, req *pb.ListHashtagVideosRequest) (
+	*pb.ListHashtagVideosResponse, error) {
+	return &pb.ListHashtagVideosResponse{Videos: []*pb.Video{{Title: "#" + req.Hashtag}}, Total: 1}, nil
+}
+
+func (fakeVideoServer) GetNextInSeries(ctx context.Context, req *pb.GetNextInSeriesRequest) (
+	*pb.GetNextInSeriesResponse, error) {
+	return &pb.GetNextInSeriesResponse{NextVideo: &pb.Video{Id: "after-" + req.VideoId}}, nil
+}
+
+func (fakeVideoServer) RecordActivity(ctx context.Context, req *pb.RecordActivityRequest) (
+	*pb.RecordActivityResponse, error) {
+	return &pb.RecordActivityResponse{Success: true}, nil
+}
+
+func createTestGatewayServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	pb.RegisterVideoServiceServer(grpcServer, fakeVideoServer{})
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	gateway, err := NewGateway(ctx, lis.Addr().String())
+	require.NoError(t, err)
+
+	server := httptest.NewServer(NewGatewayServer("", gateway, []string{"http://localhost:3000"}).Handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func decodeErrorBody(t *testing.T, resp *http.Response) errorDetail {
+	t.Helper()
+	var body errorBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Error
+}
+
+func TestGateway_GetVideo(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/videos/abc?viewer_id=v1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "abc", body["video"]["id"])
+	assert.Equal(t, "Test Video v1", body["video"]["title"])
+	assert.Contains(t, body["video"], "user_id")
+}
+
+func TestGateway_PostMergesPathAndBody(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Post(server.URL+"/api/v1/videos/abc/likes", "application/json",
+		strings.NewReader(`{"user_id": "u1"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGateway_MapsStatusToErrorBody(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/videos/missing")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, errorDetail{Code: http.StatusNotFound, Status: "NOT_FOUND", Message: "Video not found"},
+		decodeErrorBody(t, resp))
+}
+
+func TestGateway_InvalidArgumentIsBadRequest(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Post(server.URL+"/api/v1/videos/abc/likes", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "INVALID_ARGUMENT", decodeErrorBody(t, resp).Status)
+}
+
+func TestGateway_UnknownRoute(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/nothing-here")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, http.StatusNotFound, decodeErrorBody(t, resp).Code)
+}
+
+func TestGateway_ServesOpenAPI(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var document map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&document))
+	assert.Contains(t, document["paths"], "/api/v1/videos/{id}")
+}
+
+func TestGateway_CORSPreflight(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	req, err := http.NewRequest(http.MethodOptions, server.URL+"/api/v1/videos/abc/likes", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "http://localhost:3000", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Methods"), http.MethodPost)
+}
+
+func TestGateway_CORSRejectsUnknownOrigin(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/videos/abc", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://evil.example")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+}
+
+func TestGateway_LiteralRoutesTakePrecedenceOverVideoID(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	for _, path := range []string{"/api/v1/videos/search?query=cats", "/api/v1/videos/trending", "/api/v1/videos/batch"} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		// The fake server only implements GetVideo, so reaching it would succeed.
+		assert.Equal(t, http.StatusNotImplemented, resp.StatusCode, path)
+	}
+}
+
+func TestGateway_HashtagPagesAreNotTakenByVideoRoutes(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	for _, hashtag := range []string{"next", "analytics"} {
+		resp, err := http.Get(server.URL + "/api/v1/videos/hashtags/" + hashtag)
+		require.NoError(t, err)
+
+		var body struct {
+			Videos []map[string]any `json:"videos"`
+		}
+		require.Equal(t, http.StatusOK, resp.StatusCode, hashtag)
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		resp.Body.Close()
+		require.Len(t, body.Videos, 1, hashtag)
+		assert.Equal(t, "#"+hashtag, body.Videos[0]["title"])
+	}
+}
+
+func TestGateway_GetNextInSeries(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Get(server.URL + "/api/v1/videos/abc/series/next")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "after-abc", body["next_video"]["id"])
+}
+
+func TestMatchIncomingHeader_ForwardsIdempotencyKey(t *testing.T) {
+	key, ok := matchIncomingHeader("Idempotency-Key")
+	assert.True(t, ok)
+	assert.Equal(t, "idempotency-key", key)
+
+	_, ok = matchIncomingHeader("X-Unrelated")
+	assert.False(t, ok)
+}
+
+func TestMatchIncomingHeader_DropsClientIP(t *testing.T) {
+	for _, header := range []string{"Grpc-Metadata-X-Client-Ip", "grpc-metadata-x-client-ip"} {
+		_, ok := matchIncomingHeader(header)
+		assert.False(t, ok, header)
+	}
+}
+
+func TestGateway_RecordActivityIsNotPublished(t *testing.T) {
+	server := createTestGatewayServer(t)
+
+	resp, err := http.Post(server.URL+"/api/v1/videos/activities", "application/json",
+		strings.NewReader(`{"type": "follow", "recipient_id": "r1", "actor_id": "a1"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"video-service/internal/domain"
+	"video-service/internal/pkg/logger"
+	"video-service/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// A video can be taken down, trashed or made private at any time, so its
+// media is never kept by shared caches and only briefly by the browser.
+const (
+	playlistCacheControl = "private, max-age=60"
+	segmentCacheControl  = "private, max-age=3600"
+)
+
+var contentTypes = map[string]string{
+	".m3u8": "application/vnd.apple.mpegurl",
+	".ts":   "video/mp2t",
+	".mp4":  "video/mp4",
+	".mov":  "video/quicktime",
+	".webm": "video/webm",
+	".jpg":  "image/jpeg",
+	".gif":  "image/gif",
+}
+
+type MediaHandler struct {
+	storage domain.ObjectStorage
+	media   usecase.MediaUseCase
+	prefix  string
+}
+
+func NewMediaHandler(storage domain.ObjectStorage, media usecase.MediaUseCase, prefix string) *MediaHandler {
+	return &MediaHandler{
+		storage: storage,
+		media:   media,
+		prefix:  "/" + strings.Trim(prefix, "/") + "/",
+	}
+}
+
+func (h *MediaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key, ok := h.objectKey(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	servable, err := h.media.Servable(r.Context(), key)
+	if err != nil {
+		logger.Error("Failed to check media access", zap.String("key", key), zap.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !servable {
+		http.NotFound(w, r)
+		return
+	}
+
+	info, err := h.storage.Stat(r.Context(), key)
+	if errors.Is(err, domain.ErrObjectNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		logger.Error("Failed to stat media object", zap.String("key", key), zap.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	object, err := h.storage.Open(r.Context(), key)
+	if errors.Is(err, domain.ErrObjectNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		logger.Error("Failed to open media object", zap.String("key", key), zap.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer object.Close()
+
+	ext := path.Ext(key)
+	if contentType, ok := contentTypes[ext]; ok {
+		w.Header().Set("Content-Type", contentType)
+	}
+	if ext == ".m3u8" {
+		w.Header().Set("Cache-Control", playlistCacheControl)
+	} else {
+		w.Header().Set("Cache-Control", segmentCacheControl)
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, info.ModTime.UnixNano(), info.Size))
+
+	http.ServeContent(w, r, path.Base(key), info.ModTime, object)
+}
+
+// objectKey maps the URL onto a storage key; the media usecase then decides
+// whether the object may be served.
+func (h *MediaHandler) objectKey(urlPath string) (string, bool) {
+	if !strings.HasPrefix(urlPath, h.prefix) {
+		return "", false
+	}
+
+	key := strings.TrimPrefix(path.Clean(urlPath), h.prefix)
+	if !strings.HasPrefix(key, "videos/") {
+		return "", false
+	}
+	return key, true
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"video-service/internal/infrastructure/storage"
+	"video-service/internal/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMediaUseCase serves every key under videos/u/v/ and nothing else.
+type fakeMediaUseCase struct{}
+
+func (fakeMediaUseCase) Servable(ctx context.Context, key string) (bool, error) {
+	return strings.HasPrefix(key, "videos/u/v/"), nil
+}
+
+func createTestMediaServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	objectStorage, err := storage.NewLocalStorage(t.TempDir(), "/media")
+	require.NoError(t, err)
+
+	objects := map[string]string{
+		"videos/u/v/hls/master.m3u8":         "#EXTM3U\n",
+		"videos/u/v/hls/720p/segment_000.ts": "0123456789",
+		"uploads/secret.part":                "private",
+		"videos/u/original.mp4":              "original",
+		"videos/u/hidden/hls/master.m3u8":    "#EXTM3U\n",
+	}
+	for key, content := range objects {
+		_, err := objectStorage.Put(context.Background(), key, strings.NewReader(content))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(NewServer("", NewMediaHandler(objectStorage, fakeMediaUseCase{}, "/media")).Handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestMediaHandler_ServesPlaylist(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	resp, err := http.Get(server.URL + "/media/videos/u/v/hls/master.m3u8")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/vnd.apple.mpegurl", resp.Header.Get("Content-Type"))
+	assert.Equal(t, playlistCacheControl, resp.Header.Get("Cache-Control"))
+	assert.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.NotEmpty(t, resp.Header.Get("ETag"))
+}
+
+func TestMediaHandler_ServesByteRange(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/media/videos/u/v/hls/720p/segment_000.ts", nil)
+	require.NoError(t, err)
+	req.Header.Set("Range", "bytes=2-5")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body := make([]byte, 16)
+	n, _ := resp.Body.Read(body)
+
+	assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	assert.Equal(t, "bytes 2-5/10", resp.Header.Get("Content-Range"))
+	assert.Equal(t, "2345", string(body[:n]))
+	assert.Equal(t, "video/mp2t", resp.Header.Get("Content-Type"))
+	assert.Equal(t, segmentCacheControl, resp.Header.Get("Cache-Control"))
+	assert.NotContains(t, resp.Header.Get("Cache-Control"), "public")
+	assert.NotContains(t, resp.Header.Get("Cache-Control"), "immutable")
+}
+
+func TestMediaHandler_ConditionalRequest(t *testing.T) {
+	server := createTestMediaServer(t)
+	url := server.URL + "/media/videos/u/v/hls/master.m3u8"
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", resp.Header.Get("ETag"))
+
+	cached, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer cached.Body.Close()
+
+	assert.Equal(t, http.StatusNotModified, cached.StatusCode)
+}
+
+func TestMediaHandler_HidesStagedUploads(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	for _, path := range []string{
+		"/media/uploads/secret.part",
+		"/media/videos/../uploads/secret.part",
+	} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+}
+
+func TestMediaHandler_HidesMediaTheUseCaseRejects(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	for _, path := range []string{
+		"/media/videos/u/original.mp4",
+		"/media/videos/u/hidden/hls/master.m3u8",
+	} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+}
+
+func TestMediaHandler_MissingObject(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	resp, err := http.Get(server.URL + "/media/videos/u/v/hls/missing.m3u8")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestMediaHandler_RejectsWrites(t *testing.T) {
+	server := createTestMediaServer(t)
+
+	resp, err := http.Post(server.URL+"/media/videos/u/v/hls/master.m3u8", "text/plain", strings.NewReader("x"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+func NewServer(addr string, mediaHandler *MediaHandler) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(mediaHandler.prefix, mediaHandler)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// NewGatewayServer serves the REST gateway under /api/v1/ and its OpenAPI
+// document. It sets no write timeout so notification streams stay open.
+func NewGatewayServer(addr string, gateway http.Handler, allowedOrigins []string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/api/", gateway)
+	mux.HandleFunc("GET /api/v1/openapi.json", serveOpenAPI)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           withCORS(mux, allowedOrigins),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
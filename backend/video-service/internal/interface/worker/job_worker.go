@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+	"video-service/internal/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type JobProcessor interface {
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+type JobWorker struct {
+	name         string
+	processor    JobProcessor
+	concurrency  int
+	pollInterval time.Duration
+}
+
+func NewJobWorker(name string, processor JobProcessor, concurrency int, pollInterval time.Duration) *JobWorker {
+	return &JobWorker{
+		name:         name,
+		processor:    processor,
+		concurrency:  max(concurrency, 1),
+		pollInterval: pollInterval,
+	}
+}
+
+func (w *JobWorker) Run(ctx context.Context) {
+	logger.Info("Job worker starting",
+		zap.String("worker", w.name),
+		zap.Int("concurrency", w.concurrency))
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	wg.Wait()
+
+	logger.Info("Job worker stopped", zap.String("worker", w.name))
+}
+
+func (w *JobWorker) loop(ctx context.Context) {
+	for {
+		processed, err := w.processor.ProcessNext(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("Job worker failed to process job",
+				zap.String("worker", w.name),
+				zap.Error(err))
+		}
+
+		if processed && err == nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+	"video-service/internal/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingProcessor struct {
+	calls   atomic.Int32
+	pending atomic.Int32
+	err     error
+}
+
+func (p *countingProcessor) ProcessNext(ctx context.Context) (bool, error) {
+	p.calls.Add(1)
+	if p.err != nil {
+		return false, p.err
+	}
+	if p.pending.Load() > 0 {
+		p.pending.Add(-1)
+		return true, nil
+	}
+	return false, nil
+}
+
+func runWorker(processor JobProcessor, duration time.Duration) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+
+	NewJobWorker("test", processor, 2, 20*time.Millisecond).Run(ctx)
+}
+
+func TestJobWorker_DrainsQueueWithoutWaiting(t *testing.T) {
+	processor := &countingProcessor{}
+	processor.pending.Store(50)
+
+	runWorker(processor, 50*time.Millisecond)
+
+	assert.Equal(t, int32(0), processor.pending.Load())
+}
+
+func TestJobWorker_PollsWhenIdle(t *testing.T) {
+	processor := &countingProcessor{}
+
+	runWorker(processor, 70*time.Millisecond)
+
+	calls := processor.calls.Load()
+	assert.GreaterOrEqual(t, calls, int32(2))
+	assert.LessOrEqual(t, calls, int32(10))
+}
+
+func TestJobWorker_BacksOffOnError(t *testing.T) {
+	processor := &countingProcessor{err: errors.New("database unavailable")}
+
+	runWorker(processor, 70*time.Millisecond)
+
+	assert.LessOrEqual(t, processor.calls.Load(), int32(10))
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"time"
+	"video-service/internal/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type PeriodicTask func(ctx context.Context) error
+
+type PeriodicWorker struct {
+	name     string
+	task     PeriodicTask
+	interval time.Duration
+}
+
+func NewPeriodicWorker(name string, task PeriodicTask, interval time.Duration) *PeriodicWorker {
+	return &PeriodicWorker{
+		name:     name,
+		task:     task,
+		interval: interval,
+	}
+}
+
+func (w *PeriodicWorker) Run(ctx context.Context) {
+	logger.Info("Periodic worker starting",
+		zap.String("worker", w.name),
+		zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		started := time.Now()
+		if err := w.task(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("Periodic worker task failed",
+				zap.String("worker", w.name),
+				zap.Error(err))
+		} else if err == nil {
+			logger.Info("Periodic worker task completed",
+				zap.String("worker", w.name),
+				zap.Duration("elapsed", time.Since(started)))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Periodic worker stopped", zap.String("worker", w.name))
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+	"video-service/internal/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func runPeriodicWorker(task PeriodicTask, duration time.Duration) {
+	logConfig := logger.NewDevelopmentConfig()
+	logger.Init(*logConfig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+
+	NewPeriodicWorker("test", task, 20*time.Millisecond).Run(ctx)
+}
+
+func TestPeriodicWorker_RunsImmediatelyAndOnInterval(t *testing.T) {
+	var calls atomic.Int32
+
+	runPeriodicWorker(func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	}, 70*time.Millisecond)
+
+	assert.GreaterOrEqual(t, calls.Load(), int32(3))
+}
+
+func TestPeriodicWorker_KeepsRunningAfterFailure(t *testing.T) {
+	var calls atomic.Int32
+
+	runPeriodicWorker(func(ctx context.Context) error {
+		calls.Add(1)
+		return errors.New("refresh failed")
+	}, 70*time.Millisecond)
+
+	assert.GreaterOrEqual(t, calls.Load(), int32(2))
+}
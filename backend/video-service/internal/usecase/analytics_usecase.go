@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+)
+
+const (
+	defaultAnalyticsDays = 28
+	maxAnalyticsDays     = 366
+	topVideosLimit       = 10
+)
+
+type AnalyticsPolicy struct {
+	// RetentionStep is the distance between retention points, in percent of
+	// the video's duration.
+	RetentionStep int
+}
+
+type AnalyticsUseCase interface {
+	RollupDailyStats(ctx context.Context) error
+	GetVideoAnalytics(ctx context.Context, userID, videoID string, from, to time.Time) (
+		*domain.VideoAnalytics, error)
+	GetCreatorAnalytics(ctx context.Context, userID string, from, to time.Time) (*domain.CreatorAnalytics, error)
+}
+
+type analyticsUseCase struct {
+	videoRepo     domain.VideoRepository
+	analyticsRepo domain.AnalyticsRepository
+	policy        AnalyticsPolicy
+}
+
+func NewAnalyticsUseCase(videoRepo domain.VideoRepository, analyticsRepo domain.AnalyticsRepository,
+	policy AnalyticsPolicy) AnalyticsUseCase {
+
+	return &analyticsUseCase{
+		videoRepo:     videoRepo,
+		analyticsRepo: analyticsRepo,
+		policy:        policy,
+	}
+}
+
+// RollupDailyStats recomputes yesterday as well as today so that activity
+// written just before midnight is not lost to the previous run.
+func (usecase *analyticsUseCase) RollupDailyStats(ctx context.Context) error {
+	today := analyticsDay(time.Now())
+	for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+		if err := usecase.analyticsRepo.Rollup(ctx, day, usecase.policy.RetentionStep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (usecase *analyticsUseCase) GetVideoAnalytics(ctx context.Context, userID, videoID string,
+	from, to time.Time) (*domain.VideoAnalytics, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+	from, to, err = resolveAnalyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+
+	daily, err := usecase.analyticsRepo.ListVideoDailyStats(ctx, videoUUID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	retention, err := usecase.analyticsRepo.GetRetention(ctx, videoUUID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(retention) > 0 && retention[0].Viewers > 0 {
+		for _, point := range retention {
+			point.Rate = float64(point.Viewers) / float64(retention[0].Viewers)
+		}
+	}
+
+	analytics := &domain.VideoAnalytics{From: from, To: to, Daily: daily, Retention: retention}
+	for _, day := range daily {
+		analytics.Totals.Add(day.EngagementStats)
+	}
+	return analytics, nil
+}
+
+func (usecase *analyticsUseCase) GetCreatorAnalytics(ctx context.Context, userID string,
+	from, to time.Time) (*domain.CreatorAnalytics, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	from, to, err = resolveAnalyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := usecase.analyticsRepo.ListCreatorDailyStats(ctx, userUUID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	topVideos, err := usecase.analyticsRepo.ListTopVideos(ctx, userUUID, from, to, topVideosLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics := &domain.CreatorAnalytics{From: from, To: to, Daily: daily, TopVideos: topVideos}
+	for _, day := range daily {
+		analytics.Totals.Add(day.EngagementStats)
+	}
+	return analytics, nil
+}
+
+// resolveAnalyticsRange truncates both ends to UTC days. A missing end means
+// today and a missing start means the default window before the end.
+func resolveAnalyticsRange(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	to = analyticsDay(to)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	}
+	from = analyticsDay(from)
+
+	if from.After(to) || to.Sub(from) >= maxAnalyticsDays*24*time.Hour {
+		return time.Time{}, time.Time{}, domain.ErrInvalidDateRange
+	}
+	return from, to, nil
+}
+
+func analyticsDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
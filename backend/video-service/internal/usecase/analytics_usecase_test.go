@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAnalyticsRepository struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsRepository) Rollup(ctx context.Context, day time.Time, retentionStep int) error {
+	args := m.Called(ctx, day, retentionStep)
+	return args.Error(0)
+}
+
+func (m *MockAnalyticsRepository) ListVideoDailyStats(ctx context.Context, videoID uuid.UUID,
+	from, to time.Time) ([]*domain.VideoDailyStats, error) {
+
+	args := m.Called(ctx, videoID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.VideoDailyStats), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) GetRetention(ctx context.Context, videoID uuid.UUID,
+	from, to time.Time) ([]*domain.RetentionPoint, error) {
+
+	args := m.Called(ctx, videoID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RetentionPoint), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) ListCreatorDailyStats(ctx context.Context, userID uuid.UUID,
+	from, to time.Time) ([]*domain.DailyStats, error) {
+
+	args := m.Called(ctx, userID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DailyStats), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) ListTopVideos(ctx context.Context, userID uuid.UUID,
+	from, to time.Time, limit int) ([]*domain.VideoStats, error) {
+
+	args := m.Called(ctx, userID, from, to, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.VideoStats), args.Error(1)
+}
+
+func createTestAnalyticsUseCase() (*analyticsUseCase, *MockVideoRepository, *MockAnalyticsRepository) {
+	mockVideoRepo := &MockVideoRepository{}
+	mockAnalyticsRepo := &MockAnalyticsRepository{}
+	usecase := NewAnalyticsUseCase(mockVideoRepo, mockAnalyticsRepo, AnalyticsPolicy{RetentionStep: 10})
+	return usecase.(*analyticsUseCase), mockVideoRepo, mockAnalyticsRepo
+}
+
+func TestRollupDailyStats_RollsUpYesterdayAndToday(t *testing.T) {
+	usecase, _, mockAnalyticsRepo := createTestAnalyticsUseCase()
+	today := analyticsDay(time.Now())
+
+	mockAnalyticsRepo.On("Rollup", mock.Anything, today.AddDate(0, 0, -1), 10).Return(nil).Once()
+	mockAnalyticsRepo.On("Rollup", mock.Anything, today, 10).Return(nil).Once()
+
+	require.NoError(t, usecase.RollupDailyStats(context.Background()))
+	mockAnalyticsRepo.AssertExpectations(t)
+}
+
+func TestGetVideoAnalytics_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockAnalyticsRepo := createTestAnalyticsUseCase()
+	video := createTestVideo()
+	from := time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	fromDay, toDay := analyticsDay(from), analyticsDay(to)
+	daily := []*domain.VideoDailyStats{
+		{VideoID: video.ID, Day: fromDay, EngagementStats: domain.EngagementStats{Views: 3, TotalWatchTime: 90}},
+		{VideoID: video.ID, Day: toDay, EngagementStats: domain.EngagementStats{Views: 1, CompletedViews: 1}},
+	}
+	retention := []*domain.RetentionPoint{{Percent: 0, Viewers: 4}, {Percent: 50, Viewers: 2}}
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockAnalyticsRepo.On("ListVideoDailyStats", mock.Anything, video.ID, fromDay, toDay).Return(daily, nil)
+	mockAnalyticsRepo.On("GetRetention", mock.Anything, video.ID, fromDay, toDay).Return(retention, nil)
+
+	analytics, err := usecase.GetVideoAnalytics(context.Background(), video.UserID.String(), video.ID.String(),
+		from, to)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), analytics.Totals.Views)
+	assert.Equal(t, 22.5, analytics.Totals.AverageWatchTime())
+	assert.Equal(t, 0.25, analytics.Totals.CompletionRate())
+	assert.Equal(t, 1.0, analytics.Retention[0].Rate)
+	assert.Equal(t, 0.5, analytics.Retention[1].Rate)
+}
+
+func TestGetVideoAnalytics_NotOwner(t *testing.T) {
+	usecase, mockVideoRepo, mockAnalyticsRepo := createTestAnalyticsUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.GetVideoAnalytics(context.Background(), uuid.New().String(), video.ID.String(),
+		time.Time{}, time.Time{})
+
+	assert.ErrorIs(t, err, domain.ErrNotVideoOwner)
+	mockAnalyticsRepo.AssertNotCalled(t, "ListVideoDailyStats", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func TestGetCreatorAnalytics_DefaultsToRecentWindow(t *testing.T) {
+	usecase, _, mockAnalyticsRepo := createTestAnalyticsUseCase()
+	userID := uuid.New()
+	toDay := analyticsDay(time.Now())
+	fromDay := toDay.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	top := []*domain.VideoStats{{VideoID: uuid.New(), EngagementStats: domain.EngagementStats{Views: 7}}}
+
+	mockAnalyticsRepo.On("ListCreatorDailyStats", mock.Anything, userID, fromDay, toDay).
+		Return([]*domain.DailyStats{{Day: toDay, EngagementStats: domain.EngagementStats{Views: 7, Likes: 2}}}, nil)
+	mockAnalyticsRepo.On("ListTopVideos", mock.Anything, userID, fromDay, toDay, topVideosLimit).Return(top, nil)
+
+	analytics, err := usecase.GetCreatorAnalytics(context.Background(), userID.String(), time.Time{}, time.Time{})
+
+	require.NoError(t, err)
+	assert.Equal(t, fromDay, analytics.From)
+	assert.Equal(t, toDay, analytics.To)
+	assert.Equal(t, domain.EngagementStats{Views: 7, Likes: 2}, analytics.Totals)
+	assert.Equal(t, top, analytics.TopVideos)
+}
+
+func TestResolveAnalyticsRange_Invalid(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, _, err := resolveAnalyticsRange(now, now.AddDate(0, 0, -1))
+	assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+
+	_, _, err = resolveAnalyticsRange(now.AddDate(0, 0, -maxAnalyticsDays), now)
+	assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+
+	from, to, err := resolveAnalyticsRange(now.AddDate(0, 0, -(maxAnalyticsDays-1)), now)
+	require.NoError(t, err)
+	assert.Equal(t, maxAnalyticsDays-1, int(to.Sub(from).Hours()/24))
+}
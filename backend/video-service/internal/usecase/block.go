@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// isBlocked reports whether either user has blocked the other. Signed-out
+// viewers are never blocked.
+func isBlocked(ctx context.Context, blocks domain.BlockRepository, userID, otherID uuid.UUID) (bool, error) {
+	if userID == uuid.Nil || otherID == uuid.Nil || userID == otherID {
+		return false, nil
+	}
+
+	blocked, err := blocks.BlockedAmong(ctx, userID, []uuid.UUID{otherID})
+	if err != nil {
+		return false, err
+	}
+	return blocked[otherID], nil
+}
+
+// withoutBlocked drops videos whose owner is blocked with the viewer. The
+// whole page is checked with one lookup.
+func withoutBlocked(ctx context.Context, blocks domain.BlockRepository, viewerID uuid.UUID,
+	videos []*domain.Video) ([]*domain.Video, error) {
+
+	if viewerID == uuid.Nil || len(videos) == 0 {
+		return videos, nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(videos))
+	ownerIDs := make([]uuid.UUID, 0, len(videos))
+	for _, video := range videos {
+		if video.UserID != viewerID && !seen[video.UserID] {
+			seen[video.UserID] = true
+			ownerIDs = append(ownerIDs, video.UserID)
+		}
+	}
+	if len(ownerIDs) == 0 {
+		return videos, nil
+	}
+
+	blocked, err := blocks.BlockedAmong(ctx, viewerID, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocked) == 0 {
+		return videos, nil
+	}
+
+	visible := make([]*domain.Video, 0, len(videos))
+	for _, video := range videos {
+		if !blocked[video.UserID] {
+			visible = append(visible, video)
+		}
+	}
+	return visible, nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type BlockUseCase interface {
+	BlockUser(ctx context.Context, userID, blockedUserID string) error
+	UnblockUser(ctx context.Context, userID, blockedUserID string) error
+	ListBlockedUsers(ctx context.Context, userID string, limit, offset int) ([]*domain.UserBlock, int64, error)
+}
+
+type blockUseCase struct {
+	blockRepo domain.BlockRepository
+	directory domain.UserDirectory
+}
+
+func NewBlockUseCase(blockRepo domain.BlockRepository, directory domain.UserDirectory) BlockUseCase {
+	return &blockUseCase{
+		blockRepo: blockRepo,
+		directory: directory,
+	}
+}
+
+// BlockUser also removes any follows between the two users. Unblocking
+// does not restore them. The block is stored before the follows are removed,
+// so a failed call can be retried.
+func (usecase *blockUseCase) BlockUser(ctx context.Context, userID, blockedUserID string) error {
+	userUUID, blockedUUID, err := parseBlockPair(userID, blockedUserID)
+	if err != nil {
+		return err
+	}
+
+	err = usecase.blockRepo.Block(ctx, userUUID, blockedUUID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.directory.RemoveFollows(ctx, userUUID, blockedUUID)
+}
+
+func (usecase *blockUseCase) UnblockUser(ctx context.Context, userID, blockedUserID string) error {
+	userUUID, blockedUUID, err := parseBlockPair(userID, blockedUserID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.blockRepo.Unblock(ctx, userUUID, blockedUUID)
+}
+
+func (usecase *blockUseCase) ListBlockedUsers(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.UserBlock, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blocks, err := usecase.blockRepo.ListBlocked(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.blockRepo.CountBlocked(ctx, userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return blocks, total, nil
+}
+
+func parseBlockPair(userID, blockedUserID string) (uuid.UUID, uuid.UUID, error) {
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	blockedUUID, err := parseID(blockedUserID, "blocked_user_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if userUUID == blockedUUID {
+		return uuid.Nil, uuid.Nil, domain.ErrCannotBlockSelf
+	}
+	return userUUID, blockedUUID, nil
+}
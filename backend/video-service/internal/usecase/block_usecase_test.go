@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockBlockRepository) Block(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Error(0)
+}
+
+func (m *MockBlockRepository) Unblock(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Error(0)
+}
+
+func (m *MockBlockRepository) ListBlocked(ctx context.Context, blockerID uuid.UUID,
+	limit, offset int) ([]*domain.UserBlock, error) {
+
+	args := m.Called(ctx, blockerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UserBlock), args.Error(1)
+}
+
+func (m *MockBlockRepository) CountBlocked(ctx context.Context, blockerID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, blockerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBlockRepository) BlockedAmong(ctx context.Context, userID uuid.UUID,
+	userIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	args := m.Called(ctx, userID, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]bool), args.Error(1)
+}
+
+// noBlocks returns a block repository in which nobody has blocked anyone.
+func noBlocks() *MockBlockRepository {
+	blocks := &MockBlockRepository{}
+	blocks.On("BlockedAmong", mock.Anything, mock.Anything, mock.Anything).
+		Return(map[uuid.UUID]bool{}, nil).Maybe()
+	return blocks
+}
+
+func TestBlockUser_RemovesFollows(t *testing.T) {
+	mockBlockRepo := &MockBlockRepository{}
+	mockDirectory := &MockUserDirectory{}
+	usecase := NewBlockUseCase(mockBlockRepo, mockDirectory)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mockBlockRepo.On("Block", mock.Anything, userID, blockedID).Return(nil)
+	mockDirectory.On("RemoveFollows", mock.Anything, userID, blockedID).Return(nil)
+
+	err := usecase.BlockUser(context.Background(), userID.String(), blockedID.String())
+
+	require.NoError(t, err)
+	mockBlockRepo.AssertExpectations(t)
+	mockDirectory.AssertExpectations(t)
+}
+
+func TestBlockUser_ReportsFollowsNotRemoved(t *testing.T) {
+	mockBlockRepo := &MockBlockRepository{}
+	mockDirectory := &MockUserDirectory{}
+	usecase := NewBlockUseCase(mockBlockRepo, mockDirectory)
+	userID, blockedID := uuid.New(), uuid.New()
+
+	mockBlockRepo.On("Block", mock.Anything, userID, blockedID).Return(nil)
+	mockDirectory.On("RemoveFollows", mock.Anything, userID, blockedID).Return(domain.ErrFollowsNotRemoved)
+
+	err := usecase.BlockUser(context.Background(), userID.String(), blockedID.String())
+
+	assert.ErrorIs(t, err, domain.ErrFollowsNotRemoved)
+	mockBlockRepo.AssertExpectations(t)
+}
+
+func TestBlockUser_Self(t *testing.T) {
+	usecase := NewBlockUseCase(&MockBlockRepository{}, &MockUserDirectory{})
+	userID := uuid.NewString()
+
+	err := usecase.BlockUser(context.Background(), userID, userID)
+
+	assert.ErrorIs(t, err, domain.ErrCannotBlockSelf)
+}
+
+func TestListBlockedUsers(t *testing.T) {
+	mockBlockRepo := &MockBlockRepository{}
+	usecase := NewBlockUseCase(mockBlockRepo, &MockUserDirectory{})
+	userID := uuid.New()
+	blocks := []*domain.UserBlock{{BlockerID: userID, BlockedID: uuid.New()}}
+
+	mockBlockRepo.On("ListBlocked", mock.Anything, userID, 20, 0).Return(blocks, nil)
+	mockBlockRepo.On("CountBlocked", mock.Anything, userID).Return(int64(1), nil)
+
+	result, total, err := usecase.ListBlockedUsers(context.Background(), userID.String(), 20, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, blocks, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestWithoutBlocked_OneLookupPerPage(t *testing.T) {
+	viewerID := uuid.New()
+	blockedOwner, otherOwner := uuid.New(), uuid.New()
+	videos := []*domain.Video{
+		{ID: uuid.New(), UserID: blockedOwner},
+		{ID: uuid.New(), UserID: otherOwner},
+		{ID: uuid.New(), UserID: blockedOwner},
+		{ID: uuid.New(), UserID: viewerID},
+	}
+
+	mockBlockRepo := &MockBlockRepository{}
+	mockBlockRepo.On("BlockedAmong", mock.Anything, viewerID, []uuid.UUID{blockedOwner, otherOwner}).
+		Return(map[uuid.UUID]bool{blockedOwner: true}, nil).Once()
+
+	visible, err := withoutBlocked(context.Background(), mockBlockRepo, viewerID, videos)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.Video{videos[1], videos[3]}, visible)
+	mockBlockRepo.AssertExpectations(t)
+}
+
+func TestWithoutBlocked_SignedOutViewer(t *testing.T) {
+	videos := []*domain.Video{{ID: uuid.New(), UserID: uuid.New()}}
+
+	visible, err := withoutBlocked(context.Background(), &MockBlockRepository{}, uuid.Nil, videos)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, visible)
+}
+
+func TestIsBlocked_RepositoryError(t *testing.T) {
+	mockBlockRepo := &MockBlockRepository{}
+	mockBlockRepo.On("BlockedAmong", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("database error"))
+
+	_, err := isBlocked(context.Background(), mockBlockRepo, uuid.New(), uuid.New())
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,349 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const maxCollectionNameLength = 100
+
+type FavoriteUseCase interface {
+	AddFavorite(ctx context.Context, userID, videoID, collectionID string) (int64, error)
+	RemoveFavorite(ctx context.Context, userID, videoID, collectionID string) (int64, error)
+	ListFavorites(ctx context.Context, userID string, limit, offset int) ([]*domain.Video, int64, error)
+	CreateCollection(ctx context.Context, req *CollectionRequest) (*domain.Collection, error)
+	UpdateCollection(ctx context.Context, req *CollectionRequest) (*domain.Collection, error)
+	DeleteCollection(ctx context.Context, userID, collectionID string) error
+	ReorderCollections(ctx context.Context, userID string, collectionIDs []string) error
+	ListCollections(ctx context.Context, ownerID, viewerID string, limit, offset int) (
+		[]*domain.Collection, int64, error)
+	ListCollectionVideos(ctx context.Context, collectionID, viewerID string, limit, offset int) (
+		[]*domain.Video, int64, error)
+}
+
+type CollectionRequest struct {
+	ID       string `json:"id"`
+	UserID   string `json:"user_id"`
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
+
+type favoriteUseCase struct {
+	videoRepo      domain.VideoRepository
+	favoriteRepo   domain.FavoriteRepository
+	collectionRepo domain.CollectionRepository
+	blockRepo      domain.BlockRepository
+	transactor     domain.Transactor
+}
+
+func NewFavoriteUseCase(
+	videoRepo domain.VideoRepository,
+	favoriteRepo domain.FavoriteRepository,
+	collectionRepo domain.CollectionRepository,
+	blockRepo domain.BlockRepository,
+	transactor domain.Transactor,
+) FavoriteUseCase {
+	return &favoriteUseCase{
+		videoRepo:      videoRepo,
+		favoriteRepo:   favoriteRepo,
+		collectionRepo: collectionRepo,
+		blockRepo:      blockRepo,
+		transactor:     transactor,
+	}
+}
+
+// AddFavorite saves the video for the user and, when collectionID is set,
+// also files it into that collection.
+func (usecase *favoriteUseCase) AddFavorite(ctx context.Context, userID, videoID, collectionID string) (
+	int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return 0, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return 0, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return 0, err
+	}
+	if video.UserID != userUUID &&
+		(video.Visibility != domain.VisibilityPublic || video.ProcessingStatus != domain.ProcessingStatusReady) {
+		return 0, domain.ErrVideoNotFound
+	}
+	blocked, err := isBlocked(ctx, usecase.blockRepo, userUUID, video.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if blocked {
+		return 0, domain.ErrVideoNotFound
+	}
+
+	var collection *domain.Collection
+	if collectionID != "" {
+		collection, err = usecase.ownedCollection(ctx, userUUID, collectionID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var favoriteCount int64
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		favoriteCount, err = usecase.favoriteRepo.Add(ctx, userUUID, videoUUID)
+		if err != nil {
+			return err
+		}
+		if collection == nil {
+			return nil
+		}
+		return usecase.collectionRepo.AddVideo(ctx, collection.ID, videoUUID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return favoriteCount, nil
+}
+
+// RemoveFavorite takes the video out of a single collection when
+// collectionID is set, and otherwise unfavorites it entirely.
+func (usecase *favoriteUseCase) RemoveFavorite(ctx context.Context, userID, videoID, collectionID string) (
+	int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return 0, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return 0, err
+	}
+
+	if collectionID == "" {
+		return usecase.favoriteRepo.Remove(ctx, userUUID, videoUUID)
+	}
+
+	collection, err := usecase.ownedCollection(ctx, userUUID, collectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	err = usecase.collectionRepo.RemoveVideo(ctx, collection.ID, videoUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	return usecase.favoriteRepo.GetFavoriteCount(ctx, videoUUID)
+}
+
+func (usecase *favoriteUseCase) ListFavorites(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.Video, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	videos, err := usecase.favoriteRepo.ListVideos(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.favoriteRepo.CountVideos(ctx, userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return videos, total, nil
+}
+
+func (usecase *favoriteUseCase) CreateCollection(ctx context.Context, req *CollectionRequest) (
+	*domain.Collection, error) {
+
+	userUUID, err := parseID(req.UserID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := normalizeCollectionName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &domain.Collection{
+		UserID:   userUUID,
+		Name:     name,
+		IsPublic: req.IsPublic,
+	}
+	err = usecase.collectionRepo.Create(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (usecase *favoriteUseCase) UpdateCollection(ctx context.Context, req *CollectionRequest) (
+	*domain.Collection, error) {
+
+	userUUID, err := parseID(req.UserID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := normalizeCollectionName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := usecase.ownedCollection(ctx, userUUID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Name = name
+	collection.IsPublic = req.IsPublic
+	err = usecase.collectionRepo.Update(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (usecase *favoriteUseCase) DeleteCollection(ctx context.Context, userID, collectionID string) error {
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return err
+	}
+
+	collection, err := usecase.ownedCollection(ctx, userUUID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.collectionRepo.Delete(ctx, collection.ID)
+}
+
+func (usecase *favoriteUseCase) ReorderCollections(ctx context.Context, userID string,
+	collectionIDs []string) error {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(collectionIDs))
+	for i, collectionID := range collectionIDs {
+		ids[i], err = parseID(collectionID, "collection_id")
+		if err != nil {
+			return err
+		}
+	}
+
+	return usecase.collectionRepo.Reorder(ctx, userUUID, ids)
+}
+
+func (usecase *favoriteUseCase) ListCollections(ctx context.Context, ownerID, viewerID string,
+	limit, offset int) ([]*domain.Collection, int64, error) {
+
+	ownerUUID, err := parseID(ownerID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+	publicOnly := !isSameUser(viewerID, ownerUUID)
+
+	collections, err := usecase.collectionRepo.ListByUserID(ctx, ownerUUID, publicOnly, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.collectionRepo.CountByUserID(ctx, ownerUUID, publicOnly)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return collections, total, nil
+}
+
+func (usecase *favoriteUseCase) ListCollectionVideos(ctx context.Context, collectionID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	collectionUUID, err := parseID(collectionID, "collection_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	collection, err := usecase.collectionRepo.GetByID(ctx, collectionUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !collection.IsPublic && !isSameUser(viewerID, collection.UserID) {
+		return nil, 0, domain.ErrCollectionNotFound
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	blocked, err := isBlocked(ctx, usecase.blockRepo, viewerUUID, collection.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if blocked {
+		return nil, 0, domain.ErrCollectionNotFound
+	}
+
+	videos, err := usecase.collectionRepo.ListVideos(ctx, collection, viewerUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.collectionRepo.CountVideos(ctx, collection, viewerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, video := range videos {
+		hideModerationReason(video, viewerUUID)
+	}
+
+	return videos, total, nil
+}
+
+func (usecase *favoriteUseCase) ownedCollection(ctx context.Context, userID uuid.UUID,
+	collectionID string) (*domain.Collection, error) {
+
+	collectionUUID, err := parseID(collectionID, "collection_id")
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := usecase.collectionRepo.GetByID(ctx, collectionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.UserID != userID {
+		return nil, domain.ErrNotCollectionOwner
+	}
+
+	return collection, nil
+}
+
+func isSameUser(viewerID string, userID uuid.UUID) bool {
+	viewerUUID, err := parseID(viewerID, "viewer_id")
+	return err == nil && viewerUUID == userID
+}
+
+func normalizeCollectionName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCollectionNameLength {
+		return "", domain.ErrInvalidCollectionName
+	}
+	return name, nil
+}
@@ -0,0 +1,397 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockFavoriteRepository struct {
+	mock.Mock
+}
+
+func (m *MockFavoriteRepository) Add(ctx context.Context, userID, videoID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID, videoID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) Remove(ctx context.Context, userID, videoID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID, videoID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) GetFavoriteCount(ctx context.Context, videoID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, videoID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) ListVideos(ctx context.Context, userID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) CountVideos(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFavoriteRepository) FavoritedAmong(ctx context.Context, userID uuid.UUID,
+	videoIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	args := m.Called(ctx, userID, videoIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]bool), args.Error(1)
+}
+
+type MockCollectionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCollectionRepository) Create(ctx context.Context, collection *domain.Collection) error {
+	args := m.Called(ctx, collection)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Collection, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Update(ctx context.Context, collection *domain.Collection) error {
+	args := m.Called(ctx, collection)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) ListByUserID(ctx context.Context, userID uuid.UUID, publicOnly bool,
+	limit, offset int) ([]*domain.Collection, error) {
+
+	args := m.Called(ctx, userID, publicOnly, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) CountByUserID(ctx context.Context, userID uuid.UUID,
+	publicOnly bool) (int64, error) {
+
+	args := m.Called(ctx, userID, publicOnly)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Reorder(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	args := m.Called(ctx, userID, ids)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) AddVideo(ctx context.Context, collectionID, videoID uuid.UUID) error {
+	args := m.Called(ctx, collectionID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) RemoveVideo(ctx context.Context, collectionID, videoID uuid.UUID) error {
+	args := m.Called(ctx, collectionID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) ListVideos(ctx context.Context, collection *domain.Collection,
+	viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, collection, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockCollectionRepository) CountVideos(ctx context.Context, collection *domain.Collection,
+	viewerID uuid.UUID) (int64, error) {
+
+	args := m.Called(ctx, collection, viewerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func createTestFavoriteUseCase() (FavoriteUseCase, *MockVideoRepository, *MockFavoriteRepository,
+	*MockCollectionRepository) {
+
+	mockVideoRepo := &MockVideoRepository{}
+	mockFavoriteRepo := &MockFavoriteRepository{}
+	mockCollectionRepo := &MockCollectionRepository{}
+	usecase := NewFavoriteUseCase(mockVideoRepo, mockFavoriteRepo, mockCollectionRepo, noBlocks(),
+		fakeTransactor{})
+
+	return usecase, mockVideoRepo, mockFavoriteRepo, mockCollectionRepo
+}
+
+func createTestCollection(userID uuid.UUID, isPublic bool) *domain.Collection {
+	return &domain.Collection{ID: uuid.New(), UserID: userID, Name: "Saved", IsPublic: isPublic}
+}
+
+func TestAddFavorite_WithCollection(t *testing.T) {
+	usecase, mockVideoRepo, mockFavoriteRepo, mockCollectionRepo := createTestFavoriteUseCase()
+	video := createTestVideo()
+	video.ProcessingStatus = domain.ProcessingStatusReady
+	userID := uuid.New()
+	collection := createTestCollection(userID, false)
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+	mockFavoriteRepo.On("Add", mock.Anything, userID, video.ID).Return(int64(4), nil)
+	mockCollectionRepo.On("AddVideo", mock.Anything, collection.ID, video.ID).Return(nil)
+
+	count, err := usecase.AddFavorite(context.Background(), userID.String(), video.ID.String(), collection.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	mockFavoriteRepo.AssertExpectations(t)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestAddFavorite_PrivateVideoOfOtherUser(t *testing.T) {
+	usecase, mockVideoRepo, mockFavoriteRepo, _ := createTestFavoriteUseCase()
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityPrivate
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.AddFavorite(context.Background(), uuid.New().String(), video.ID.String(), "")
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	mockFavoriteRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddFavorite_CollectionOfOtherUser(t *testing.T) {
+	usecase, mockVideoRepo, mockFavoriteRepo, mockCollectionRepo := createTestFavoriteUseCase()
+	video := createTestVideo()
+	video.ProcessingStatus = domain.ProcessingStatusReady
+	collection := createTestCollection(uuid.New(), true)
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+
+	_, err := usecase.AddFavorite(context.Background(), uuid.New().String(), video.ID.String(),
+		collection.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrNotCollectionOwner)
+	mockFavoriteRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemoveFavorite_Entirely(t *testing.T) {
+	usecase, _, mockFavoriteRepo, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+	videoID := uuid.New()
+
+	mockFavoriteRepo.On("Remove", mock.Anything, userID, videoID).Return(int64(2), nil)
+
+	count, err := usecase.RemoveFavorite(context.Background(), userID.String(), videoID.String(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	mockCollectionRepo.AssertNotCalled(t, "RemoveVideo", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemoveFavorite_FromCollection(t *testing.T) {
+	usecase, _, mockFavoriteRepo, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+	videoID := uuid.New()
+	collection := createTestCollection(userID, false)
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+	mockCollectionRepo.On("RemoveVideo", mock.Anything, collection.ID, videoID).Return(nil)
+	mockFavoriteRepo.On("GetFavoriteCount", mock.Anything, videoID).Return(int64(3), nil)
+
+	count, err := usecase.RemoveFavorite(context.Background(), userID.String(), videoID.String(),
+		collection.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	mockFavoriteRepo.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateCollection_TrimsName(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+
+	mockCollectionRepo.On("Create", mock.Anything, mock.MatchedBy(func(collection *domain.Collection) bool {
+		return collection.Name == "Recipes" && collection.UserID == userID && collection.IsPublic
+	})).Return(nil)
+
+	collection, err := usecase.CreateCollection(context.Background(), &CollectionRequest{
+		UserID:   userID.String(),
+		Name:     "  Recipes ",
+		IsPublic: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Recipes", collection.Name)
+}
+
+func TestCreateCollection_InvalidName(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+
+	for _, name := range []string{"   ", strings.Repeat("a", maxCollectionNameLength+1)} {
+		_, err := usecase.CreateCollection(context.Background(), &CollectionRequest{
+			UserID: uuid.New().String(),
+			Name:   name,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidCollectionName)
+	}
+	mockCollectionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCollection_NotOwner(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	collection := createTestCollection(uuid.New(), false)
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+
+	_, err := usecase.UpdateCollection(context.Background(), &CollectionRequest{
+		ID:     collection.ID.String(),
+		UserID: uuid.New().String(),
+		Name:   "Renamed",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotCollectionOwner)
+	mockCollectionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateCollection_Success(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+	collection := createTestCollection(userID, false)
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+	mockCollectionRepo.On("Update", mock.Anything, collection).Return(nil)
+
+	updated, err := usecase.UpdateCollection(context.Background(), &CollectionRequest{
+		ID:       collection.ID.String(),
+		UserID:   userID.String(),
+		Name:     "Renamed",
+		IsPublic: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Name)
+	assert.True(t, updated.IsPublic)
+}
+
+func TestDeleteCollection_Success(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+	collection := createTestCollection(userID, false)
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+	mockCollectionRepo.On("Delete", mock.Anything, collection.ID).Return(nil)
+
+	err := usecase.DeleteCollection(context.Background(), userID.String(), collection.ID.String())
+
+	require.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestReorderCollections_ParsesIDs(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	userID := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	mockCollectionRepo.On("Reorder", mock.Anything, userID, ids).Return(nil)
+
+	err := usecase.ReorderCollections(context.Background(), userID.String(),
+		[]string{ids[0].String(), ids[1].String()})
+
+	require.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestListCollections_OtherViewerSeesPublicOnly(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	ownerID := uuid.New()
+	collections := []*domain.Collection{createTestCollection(ownerID, true)}
+
+	mockCollectionRepo.On("ListByUserID", mock.Anything, ownerID, true, 10, 0).Return(collections, nil)
+	mockCollectionRepo.On("CountByUserID", mock.Anything, ownerID, true).Return(int64(1), nil)
+
+	result, total, err := usecase.ListCollections(context.Background(), ownerID.String(), uuid.New().String(), 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, collections, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestListCollections_OwnerSeesAll(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	ownerID := uuid.New()
+
+	mockCollectionRepo.On("ListByUserID", mock.Anything, ownerID, false, 10, 0).Return([]*domain.Collection{}, nil)
+	mockCollectionRepo.On("CountByUserID", mock.Anything, ownerID, false).Return(int64(0), nil)
+
+	_, _, err := usecase.ListCollections(context.Background(), ownerID.String(), ownerID.String(), 10, 0)
+
+	require.NoError(t, err)
+	mockCollectionRepo.AssertExpectations(t)
+}
+
+func TestListCollectionVideos_PrivateCollectionHidden(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	collection := createTestCollection(uuid.New(), false)
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+
+	_, _, err := usecase.ListCollectionVideos(context.Background(), collection.ID.String(), "", 10, 0)
+
+	assert.ErrorIs(t, err, domain.ErrCollectionNotFound)
+	mockCollectionRepo.AssertNotCalled(t, "ListVideos", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func TestListCollectionVideos_PublicCollection(t *testing.T) {
+	usecase, _, _, mockCollectionRepo := createTestFavoriteUseCase()
+	collection := createTestCollection(uuid.New(), true)
+	videos := []*domain.Video{createTestVideo()}
+
+	mockCollectionRepo.On("GetByID", mock.Anything, collection.ID).Return(collection, nil)
+	mockCollectionRepo.On("ListVideos", mock.Anything, collection, uuid.Nil, 10, 0).Return(videos, nil)
+	mockCollectionRepo.On("CountVideos", mock.Anything, collection, uuid.Nil).Return(int64(1), nil)
+
+	result, total, err := usecase.ListCollectionVideos(context.Background(), collection.ID.String(), "", 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestListFavorites_Success(t *testing.T) {
+	usecase, _, mockFavoriteRepo, _ := createTestFavoriteUseCase()
+	userID := uuid.New()
+	videos := []*domain.Video{createTestVideo()}
+
+	mockFavoriteRepo.On("ListVideos", mock.Anything, userID, 20, 0).Return(videos, nil)
+	mockFavoriteRepo.On("CountVideos", mock.Anything, userID).Return(int64(1), nil)
+
+	result, total, err := usecase.ListFavorites(context.Background(), userID.String(), 20, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, result)
+	assert.Equal(t, int64(1), total)
+}
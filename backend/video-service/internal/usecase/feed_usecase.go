@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ViewerVideoState struct {
+	VideoID          uuid.UUID
+	Liked            bool
+	Bookmarked       bool
+	FollowingCreator bool
+}
+
+// FeedUseCase serves the per-video lookups a feed page needs in one call per
+// page rather than one per video.
+type FeedUseCase interface {
+	// BatchGetVideos returns the videos in the requested order, followed by
+	// the requested IDs that do not exist or are hidden from the viewer.
+	BatchGetVideos(ctx context.Context, videoIDs []string, viewerID string) ([]*domain.Video, []string, error)
+	// BatchGetViewerState leaves out videos the viewer may not see.
+	BatchGetViewerState(ctx context.Context, viewerID string, videoIDs []string) ([]*ViewerVideoState, error)
+}
+
+type feedUseCase struct {
+	videoRepo    domain.VideoRepository
+	likeRepo     domain.UserVideoLikeRepository
+	favoriteRepo domain.FavoriteRepository
+	directory    domain.UserDirectory
+	blockRepo    domain.BlockRepository
+}
+
+func NewFeedUseCase(
+	videoRepo domain.VideoRepository,
+	likeRepo domain.UserVideoLikeRepository,
+	favoriteRepo domain.FavoriteRepository,
+	directory domain.UserDirectory,
+	blockRepo domain.BlockRepository,
+) FeedUseCase {
+	return &feedUseCase{
+		videoRepo:    videoRepo,
+		likeRepo:     likeRepo,
+		favoriteRepo: favoriteRepo,
+		directory:    directory,
+		blockRepo:    blockRepo,
+	}
+}
+
+func (usecase *feedUseCase) BatchGetVideos(ctx context.Context, videoIDs []string, viewerID string) (
+	[]*domain.Video, []string, error) {
+
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, err := parseVideoIDs(videoIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	videos, err := usecase.viewableVideos(ctx, ids, viewerUUID, cachedRelationships(usecase.directory))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ordered := make([]*domain.Video, 0, len(videos))
+	var missing []string
+	for _, id := range ids {
+		if video, ok := videos[id]; ok {
+			ordered = append(ordered, video)
+		} else {
+			missing = append(missing, id.String())
+		}
+	}
+	return ordered, missing, nil
+}
+
+func (usecase *feedUseCase) BatchGetViewerState(ctx context.Context, viewerID string, videoIDs []string) (
+	[]*ViewerVideoState, error) {
+
+	viewerUUID, err := parseID(viewerID, "viewer_id")
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parseVideoIDs(videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	relationshipOf := cachedRelationships(usecase.directory)
+	videos, err := usecase.viewableVideos(ctx, ids, viewerUUID, relationshipOf)
+	if err != nil {
+		return nil, err
+	}
+	if len(videos) == 0 {
+		return []*ViewerVideoState{}, nil
+	}
+
+	viewableIDs := make([]uuid.UUID, 0, len(videos))
+	for _, id := range ids {
+		if _, ok := videos[id]; ok {
+			viewableIDs = append(viewableIDs, id)
+		}
+	}
+
+	liked, err := usecase.likeRepo.LikedAmong(ctx, viewerUUID, viewableIDs)
+	if err != nil {
+		return nil, err
+	}
+	bookmarked, err := usecase.favoriteRepo.FavoritedAmong(ctx, viewerUUID, viewableIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*ViewerVideoState, len(viewableIDs))
+	for i, id := range viewableIDs {
+		state := &ViewerVideoState{VideoID: id, Liked: liked[id], Bookmarked: bookmarked[id]}
+		if ownerID := videos[id].UserID; ownerID != viewerUUID {
+			relationship, err := relationshipOf(ctx, viewerUUID, ownerID)
+			if err != nil {
+				return nil, err
+			}
+			state.FollowingCreator = relationship.Following
+		}
+		states[i] = state
+	}
+	return states, nil
+}
+
+func (usecase *feedUseCase) viewableVideos(ctx context.Context, ids []uuid.UUID, viewerID uuid.UUID,
+	relationshipOf relationshipLookup) (map[uuid.UUID]*domain.Video, error) {
+
+	videos, err := usecase.videoRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	videos, err = viewableAmong(ctx, relationshipOf, usecase.blockRepo, videos, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*domain.Video, len(videos))
+	for _, video := range videos {
+		byID[video.ID] = video
+	}
+	return byID, nil
+}
+
+// parseVideoIDs drops repeated IDs, keeping the first occurrence.
+func parseVideoIDs(videoIDs []string) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(videoIDs))
+	ids := make([]uuid.UUID, 0, len(videoIDs))
+	for _, videoID := range videoIDs {
+		id, err := parseID(videoID, "video_ids")
+		if err != nil {
+			return nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
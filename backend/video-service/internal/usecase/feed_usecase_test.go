@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestFeedUseCase(blocks *MockBlockRepository) (FeedUseCase, *MockVideoRepository,
+	*MockUserVideoLikeRepository, *MockFavoriteRepository, *MockUserDirectory) {
+
+	mockVideoRepo := &MockVideoRepository{}
+	mockLikeRepo := &MockUserVideoLikeRepository{}
+	mockFavoriteRepo := &MockFavoriteRepository{}
+	mockDirectory := &MockUserDirectory{}
+	usecase := NewFeedUseCase(mockVideoRepo, mockLikeRepo, mockFavoriteRepo, mockDirectory, blocks)
+	return usecase, mockVideoRepo, mockLikeRepo, mockFavoriteRepo, mockDirectory
+}
+
+func TestBatchGetVideos_KeepsOrderAndReportsMissing(t *testing.T) {
+	usecase, mockVideoRepo, _, _, mockDirectory := createTestFeedUseCase(noBlocks())
+	viewerID := uuid.New()
+	first, second, hidden := createTestVideo(), createTestVideo(), createTestVideo()
+	hidden.Visibility = domain.VisibilityFollowers
+	unknownID := uuid.New()
+
+	mockVideoRepo.On("GetByIDs", mock.Anything, []uuid.UUID{second.ID, unknownID, hidden.ID, first.ID}).
+		Return([]*domain.Video{first, second, hidden}, nil)
+	mockDirectory.On("GetRelationship", mock.Anything, viewerID, hidden.UserID).
+		Return(&domain.Relationship{}, nil).Once()
+
+	videos, missing, err := usecase.BatchGetVideos(context.Background(), []string{
+		second.ID.String(), unknownID.String(), hidden.ID.String(), first.ID.String(), second.ID.String(),
+	}, viewerID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.Video{second, first}, videos)
+	assert.Equal(t, []string{unknownID.String(), hidden.ID.String()}, missing)
+	mockDirectory.AssertExpectations(t)
+}
+
+func TestBatchGetVideos_OmitsBlockedOwners(t *testing.T) {
+	blocks := &MockBlockRepository{}
+	usecase, mockVideoRepo, _, _, _ := createTestFeedUseCase(blocks)
+	viewerID := uuid.New()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByIDs", mock.Anything, []uuid.UUID{video.ID}).Return([]*domain.Video{video}, nil)
+	blocks.On("BlockedAmong", mock.Anything, viewerID, mock.Anything).
+		Return(map[uuid.UUID]bool{video.UserID: true}, nil)
+
+	videos, missing, err := usecase.BatchGetVideos(context.Background(), []string{video.ID.String()},
+		viewerID.String())
+
+	require.NoError(t, err)
+	assert.Empty(t, videos)
+	assert.Equal(t, []string{video.ID.String()}, missing)
+}
+
+func TestBatchGetViewerState_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockLikeRepo, mockFavoriteRepo, mockDirectory := createTestFeedUseCase(noBlocks())
+	viewerID := uuid.New()
+	liked, own := createTestVideo(), createTestVideo()
+	own.UserID = viewerID
+	ids := []uuid.UUID{liked.ID, own.ID}
+
+	mockVideoRepo.On("GetByIDs", mock.Anything, ids).Return([]*domain.Video{own, liked}, nil)
+	mockLikeRepo.On("LikedAmong", mock.Anything, viewerID, ids).Return(map[uuid.UUID]bool{liked.ID: true}, nil)
+	mockFavoriteRepo.On("FavoritedAmong", mock.Anything, viewerID, ids).
+		Return(map[uuid.UUID]bool{own.ID: true}, nil)
+	mockDirectory.On("GetRelationship", mock.Anything, viewerID, liked.UserID).
+		Return(&domain.Relationship{Following: true}, nil)
+
+	states, err := usecase.BatchGetViewerState(context.Background(), viewerID.String(),
+		[]string{liked.ID.String(), own.ID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*ViewerVideoState{
+		{VideoID: liked.ID, Liked: true, FollowingCreator: true},
+		{VideoID: own.ID, Bookmarked: true},
+	}, states)
+}
+
+func TestBatchGetViewerState_NothingViewable(t *testing.T) {
+	usecase, mockVideoRepo, mockLikeRepo, _, _ := createTestFeedUseCase(noBlocks())
+	videoID := uuid.New()
+
+	mockVideoRepo.On("GetByIDs", mock.Anything, []uuid.UUID{videoID}).Return([]*domain.Video{}, nil)
+
+	states, err := usecase.BatchGetViewerState(context.Background(), uuid.NewString(), []string{videoID.String()})
+
+	require.NoError(t, err)
+	assert.Empty(t, states)
+	mockLikeRepo.AssertNotCalled(t, "LikedAmong", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+	"video-service/internal/domain"
+)
+
+const maxIdempotencyKeyLength = 128
+
+type IdempotencyPolicy struct {
+	// TTL is how long a completed response is replayed for retries.
+	TTL time.Duration
+	// PendingTimeout is how long a request that never finished, for
+	// example because the server crashed, keeps its key locked.
+	PendingTimeout time.Duration
+}
+
+// IdempotencyUseCase lets a client retry a mutating request under the same
+// key without the write happening twice.
+type IdempotencyUseCase interface {
+	// Begin claims key for request. It returns the stored response when the
+	// same request already completed under key; otherwise it returns nil and
+	// the caller must run the request and then call Complete or Release.
+	Begin(ctx context.Context, userID, method, key string, request []byte) ([]byte, error)
+	Complete(ctx context.Context, userID, method, key string, response []byte) error
+	// Release frees key after a failed request so that a retry runs again.
+	Release(ctx context.Context, userID, method, key string) error
+	PurgeExpired(ctx context.Context) error
+}
+
+type idempotencyUseCase struct {
+	idempotencyRepo domain.IdempotencyRepository
+	policy          IdempotencyPolicy
+}
+
+func NewIdempotencyUseCase(idempotencyRepo domain.IdempotencyRepository, policy IdempotencyPolicy) IdempotencyUseCase {
+	return &idempotencyUseCase{
+		idempotencyRepo: idempotencyRepo,
+		policy:          policy,
+	}
+}
+
+func (usecase *idempotencyUseCase) Begin(ctx context.Context, userID, method, key string, request []byte) (
+	[]byte, error) {
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, domain.NewInvalidArgumentError("idempotency-key",
+			"idempotency-key must not be longer than 128 characters")
+	}
+
+	now := time.Now()
+	digest := sha256.Sum256(request)
+	record := &domain.IdempotencyRecord{
+		UserID:      userID,
+		Method:      method,
+		Key:         key,
+		RequestHash: hex.EncodeToString(digest[:]),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(usecase.policy.PendingTimeout),
+	}
+	existing, reserved, err := usecase.idempotencyRepo.Reserve(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	switch {
+	case existing.RequestHash != record.RequestHash:
+		return nil, domain.ErrIdempotencyKeyReused
+	case existing.Response == nil:
+		return nil, domain.ErrIdempotencyKeyInUse
+	}
+	return existing.Response, nil
+}
+
+func (usecase *idempotencyUseCase) Complete(ctx context.Context, userID, method, key string,
+	response []byte) error {
+
+	return usecase.idempotencyRepo.Complete(ctx, userID, method, key, response, time.Now().Add(usecase.policy.TTL))
+}
+
+func (usecase *idempotencyUseCase) Release(ctx context.Context, userID, method, key string) error {
+	return usecase.idempotencyRepo.Release(ctx, userID, method, key)
+}
+
+func (usecase *idempotencyUseCase) PurgeExpired(ctx context.Context) error {
+	_, err := usecase.idempotencyRepo.DeleteExpired(ctx, time.Now())
+	return err
+}
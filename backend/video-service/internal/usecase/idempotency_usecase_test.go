@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (
+	*domain.IdempotencyRecord, bool, error) {
+
+	args := m.Called(ctx, record)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*domain.IdempotencyRecord), args.Bool(1), args.Error(2)
+}
+
+func (m *MockIdempotencyRepository) Complete(ctx context.Context, userID, method, key string,
+	response []byte, expiresAt time.Time) error {
+
+	args := m.Called(ctx, userID, method, key, response, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) Release(ctx context.Context, userID, method, key string) error {
+	args := m.Called(ctx, userID, method, key)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+var testIdempotencyPolicy = IdempotencyPolicy{TTL: 24 * time.Hour, PendingTimeout: time.Minute}
+
+func hashOf(request []byte) string {
+	digest := sha256.Sum256(request)
+	return hex.EncodeToString(digest[:])
+}
+
+func TestIdempotencyBegin_NewKeyIsReserved(t *testing.T) {
+	mockRepo := &MockIdempotencyRepository{}
+	usecase := NewIdempotencyUseCase(mockRepo, testIdempotencyPolicy)
+
+	mockRepo.On("Reserve", mock.Anything, mock.MatchedBy(func(record *domain.IdempotencyRecord) bool {
+		return record.Key == "key-1" && record.RequestHash == hashOf([]byte("request")) &&
+			record.ExpiresAt.Sub(record.CreatedAt) == time.Minute
+	})).Return(nil, true, nil)
+
+	response, err := usecase.Begin(context.Background(), "user", "/video.VideoService/CreateVideo", "key-1",
+		[]byte("request"))
+
+	require.NoError(t, err)
+	assert.Nil(t, response)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIdempotencyBegin_ExistingKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing *domain.IdempotencyRecord
+		response []byte
+		err      error
+	}{
+		{"completed", &domain.IdempotencyRecord{RequestHash: hashOf([]byte("request")), Response: []byte("stored")},
+			[]byte("stored"), nil},
+		{"in progress", &domain.IdempotencyRecord{RequestHash: hashOf([]byte("request"))},
+			nil, domain.ErrIdempotencyKeyInUse},
+		{"different request", &domain.IdempotencyRecord{RequestHash: hashOf([]byte("other")), Response: []byte("x")},
+			nil, domain.ErrIdempotencyKeyReused},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockIdempotencyRepository{}
+			usecase := NewIdempotencyUseCase(mockRepo, testIdempotencyPolicy)
+			mockRepo.On("Reserve", mock.Anything, mock.Anything).Return(tt.existing, false, nil)
+
+			response, err := usecase.Begin(context.Background(), "user", "/method", "key", []byte("request"))
+
+			assert.Equal(t, tt.response, response)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIdempotencyBegin_KeyTooLong(t *testing.T) {
+	mockRepo := &MockIdempotencyRepository{}
+	usecase := NewIdempotencyUseCase(mockRepo, testIdempotencyPolicy)
+
+	_, err := usecase.Begin(context.Background(), "user", "/method", strings.Repeat("k", 129), nil)
+
+	var invalid *domain.InvalidArgumentError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "idempotency-key", invalid.Field)
+	mockRepo.AssertNotCalled(t, "Reserve", mock.Anything, mock.Anything)
+}
+
+func TestIdempotencyComplete_KeepsResponseForTTL(t *testing.T) {
+	mockRepo := &MockIdempotencyRepository{}
+	usecase := NewIdempotencyUseCase(mockRepo, testIdempotencyPolicy)
+
+	mockRepo.On("Complete", mock.Anything, "user", "/method", "key", []byte("response"),
+		mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.After(time.Now().Add(23 * time.Hour))
+		})).Return(nil)
+
+	require.NoError(t, usecase.Complete(context.Background(), "user", "/method", "key", []byte("response")))
+	mockRepo.AssertExpectations(t)
+}
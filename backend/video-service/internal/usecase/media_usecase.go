@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// MediaUseCase decides which stored objects the public media server may
+// hand out. Its requests carry no viewer, so only media of videos that a
+// signed-out viewer could open is served.
+type MediaUseCase interface {
+	// Servable reports false for originals, staged uploads and any media of
+	// a video that is not ready, published, active and public or unlisted.
+	Servable(ctx context.Context, key string) (bool, error)
+}
+
+type mediaUseCase struct {
+	videoRepo domain.VideoRepository
+}
+
+func NewMediaUseCase(videoRepo domain.VideoRepository) MediaUseCase {
+	return &mediaUseCase{videoRepo: videoRepo}
+}
+
+func (usecase *mediaUseCase) Servable(ctx context.Context, key string) (bool, error) {
+	videoID, ok := derivedMediaVideoID(key)
+	if !ok {
+		return false, nil
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoID)
+	if errors.Is(err, domain.ErrVideoNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return video.ProcessingStatus == domain.ProcessingStatusReady &&
+		video.ModerationState != domain.ModerationStateTakenDown &&
+		video.Published() &&
+		(video.Visibility == domain.VisibilityPublic || video.Visibility == domain.VisibilityUnlisted), nil
+}
+
+// derivedMediaVideoID accepts the HLS output, posters and previews that
+// processing writes under videoMediaPrefix, and nothing else.
+func derivedMediaVideoID(key string) (uuid.UUID, bool) {
+	parts := strings.SplitN(key, "/", 4)
+	if len(parts) != 4 || parts[0] != "videos" {
+		return uuid.Nil, false
+	}
+	videoID, err := uuid.Parse(parts[2])
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	name := parts[3]
+	switch {
+	case strings.HasPrefix(name, "hls/"):
+	case strings.HasPrefix(name, "thumbnail-") && path.Ext(name) == ".jpg" && !strings.Contains(name, "/"):
+	case strings.HasPrefix(name, "preview-") && path.Ext(name) == ".gif" && !strings.Contains(name, "/"):
+	default:
+		return uuid.Nil, false
+	}
+	return videoID, true
+}
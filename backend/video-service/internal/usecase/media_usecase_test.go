@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediaServable_DerivedMediaOfViewableVideos(t *testing.T) {
+	mockVideoRepository := &MockVideoRepository{}
+	usecase := NewMediaUseCase(mockVideoRepository)
+
+	video := createTestVideo()
+	video.ProcessingStatus = domain.ProcessingStatusReady
+	video.ModerationState = domain.ModerationStateActive
+	prefix := videoMediaPrefix(video)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	for _, key := range []string{
+		prefix + "/hls/master.m3u8",
+		prefix + "/hls/720p/segment_000.ts",
+		prefix + "/thumbnail-1000.jpg",
+		prefix + "/preview-1000.gif",
+	} {
+		servable, err := usecase.Servable(context.Background(), key)
+		require.NoError(t, err)
+		assert.True(t, servable, key)
+	}
+
+	for _, key := range []string{
+		"videos/" + video.UserID.String() + "/" + uuid.NewString() + ".mp4",
+		prefix + "/720p.mp4",
+		prefix + "/source.mp4",
+		"uploads/" + video.ID.String() + ".part",
+	} {
+		servable, err := usecase.Servable(context.Background(), key)
+		require.NoError(t, err)
+		assert.False(t, servable, key)
+	}
+}
+
+func TestMediaServable_HidesVideosOthersCannotOpen(t *testing.T) {
+	for name, hide := range map[string]func(video *domain.Video){
+		"private":    func(video *domain.Video) { video.Visibility = domain.VisibilityPrivate },
+		"followers":  func(video *domain.Video) { video.Visibility = domain.VisibilityFollowers },
+		"taken down": func(video *domain.Video) { video.ModerationState = domain.ModerationStateTakenDown },
+		"processing": func(video *domain.Video) { video.ProcessingStatus = domain.ProcessingStatusProcessing },
+		"draft":      func(video *domain.Video) { video.PublicationState = domain.PublicationStateDraft },
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockVideoRepository := &MockVideoRepository{}
+			usecase := NewMediaUseCase(mockVideoRepository)
+
+			video := createTestVideo()
+			video.ProcessingStatus = domain.ProcessingStatusReady
+			video.ModerationState = domain.ModerationStateActive
+			hide(video)
+			mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+			servable, err := usecase.Servable(context.Background(), videoMediaPrefix(video)+"/hls/master.m3u8")
+
+			require.NoError(t, err)
+			assert.False(t, servable)
+		})
+	}
+}
+
+func TestMediaServable_TrashedVideo(t *testing.T) {
+	mockVideoRepository := &MockVideoRepository{}
+	usecase := NewMediaUseCase(mockVideoRepository)
+	video := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(nil, domain.ErrVideoNotFound)
+
+	servable, err := usecase.Servable(context.Background(), videoMediaPrefix(video)+"/hls/master.m3u8")
+
+	require.NoError(t, err)
+	assert.False(t, servable)
+}
+
+func TestMediaServable_RepositoryError(t *testing.T) {
+	mockVideoRepository := &MockVideoRepository{}
+	usecase := NewMediaUseCase(mockVideoRepository)
+	video := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(nil, errors.New("database error"))
+
+	_, err := usecase.Servable(context.Background(), videoMediaPrefix(video)+"/hls/master.m3u8")
+
+	assert.Error(t, err)
+}
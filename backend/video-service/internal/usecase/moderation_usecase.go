@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type ModerationPolicy struct {
+	Moderators []uuid.UUID
+}
+
+type ReportVideoRequest struct {
+	ReporterID string `json:"reporter_id"`
+	VideoID    string `json:"video_id"`
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+}
+
+type ModerateVideoRequest struct {
+	ModeratorID string `json:"moderator_id"`
+	VideoID     string `json:"video_id"`
+	Action      string `json:"action"`
+	Reason      string `json:"reason"`
+}
+
+type ModerationOutcome struct {
+	Video       *domain.Video
+	StrikeCount int64
+}
+
+type ModerationUseCase interface {
+	// ReportVideo returns false when the reporter had already reported the
+	// video, in which case nothing changes.
+	ReportVideo(ctx context.Context, req *ReportVideoRequest) (bool, error)
+	GetModerationQueue(ctx context.Context, moderatorID string, limit, offset int) (
+		[]*domain.ModerationCase, int64, error)
+	ModerateVideo(ctx context.Context, req *ModerateVideoRequest) (*ModerationOutcome, error)
+	ListModerationActions(ctx context.Context, moderatorID, videoID string) ([]*domain.ModerationAction, error)
+	// ListFlaggedViews returns views held back from counting for review.
+	ListFlaggedViews(ctx context.Context, moderatorID string, limit, offset int) (
+		[]*domain.UserVideoView, int64, error)
+}
+
+type moderationUseCase struct {
+	videoRepo      domain.VideoRepository
+	moderationRepo domain.ModerationRepository
+	viewRepo       domain.UserVideoViewRepository
+	directory      domain.UserDirectory
+	blockRepo      domain.BlockRepository
+	transactor     domain.Transactor
+	outbox         domain.OutboxRepository
+	moderators     map[uuid.UUID]bool
+}
+
+func NewModerationUseCase(
+	videoRepo domain.VideoRepository,
+	moderationRepo domain.ModerationRepository,
+	viewRepo domain.UserVideoViewRepository,
+	directory domain.UserDirectory,
+	blockRepo domain.BlockRepository,
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	policy ModerationPolicy,
+) ModerationUseCase {
+	moderators := make(map[uuid.UUID]bool, len(policy.Moderators))
+	for _, moderatorID := range policy.Moderators {
+		moderators[moderatorID] = true
+	}
+
+	return &moderationUseCase{
+		videoRepo:      videoRepo,
+		moderationRepo: moderationRepo,
+		viewRepo:       viewRepo,
+		directory:      directory,
+		blockRepo:      blockRepo,
+		transactor:     transactor,
+		outbox:         outbox,
+		moderators:     moderators,
+	}
+}
+
+func (usecase *moderationUseCase) ReportVideo(ctx context.Context, req *ReportVideoRequest) (bool, error) {
+	reporterID, err := parseID(req.ReporterID, "reporter_id")
+	if err != nil {
+		return false, err
+	}
+	videoID, err := parseID(req.VideoID, "video_id")
+	if err != nil {
+		return false, err
+	}
+	reason := domain.ReportReason(req.Reason)
+	if reason.Severity() == 0 {
+		return false, domain.ErrInvalidReportReason
+	}
+
+	video, err := viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoID, reporterID)
+	if err != nil {
+		return false, err
+	}
+	if video.UserID == reporterID {
+		return false, domain.ErrCannotReportOwnVideo
+	}
+
+	return usecase.moderationRepo.Report(ctx, &domain.VideoReport{
+		VideoID:    videoID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Details:    strings.TrimSpace(req.Details),
+	})
+}
+
+func (usecase *moderationUseCase) GetModerationQueue(ctx context.Context, moderatorID string,
+	limit, offset int) ([]*domain.ModerationCase, int64, error) {
+
+	if _, err := usecase.requireModerator(moderatorID); err != nil {
+		return nil, 0, err
+	}
+
+	cases, err := usecase.moderationRepo.ListQueue(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.moderationRepo.CountQueue(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cases, total, nil
+}
+
+// ModerateVideo applies the action, records it in the audit trail and closes
+// the video's open case. A strike also takes the video down.
+func (usecase *moderationUseCase) ModerateVideo(ctx context.Context, req *ModerateVideoRequest) (
+	*ModerationOutcome, error) {
+
+	moderatorID, err := usecase.requireModerator(req.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+	videoID, err := parseID(req.VideoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+	action := domain.ModerationActionType(req.Action)
+	state, changesState, err := moderationActionState(action)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if changesState && reason == "" {
+		return nil, domain.ErrModerationReasonMissing
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &ModerationOutcome{Video: video}
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := usecase.moderationRepo.RecordAction(ctx, &domain.ModerationAction{
+			VideoID:     video.ID,
+			ModeratorID: moderatorID,
+			Action:      action,
+			Reason:      reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := usecase.moderationRepo.ResolveCase(ctx, video.ID); err != nil {
+			return err
+		}
+
+		if action == domain.ModerationActionStrike {
+			outcome.StrikeCount, err = usecase.moderationRepo.AddStrike(ctx, &domain.CreatorStrike{
+				UserID:      video.UserID,
+				VideoID:     video.ID,
+				ModeratorID: moderatorID,
+				Reason:      reason,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !changesState {
+			return nil
+		}
+		if err := usecase.moderationRepo.SetVideoState(ctx, video.ID, state, reason); err != nil {
+			return err
+		}
+
+		video.ModerationState = state
+		video.ModerationReason = reason
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoModerated,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+			Video:   video,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outcome, nil
+}
+
+func (usecase *moderationUseCase) ListModerationActions(ctx context.Context, moderatorID, videoID string) (
+	[]*domain.ModerationAction, error) {
+
+	if _, err := usecase.requireModerator(moderatorID); err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.moderationRepo.ListActions(ctx, videoUUID)
+}
+
+func (usecase *moderationUseCase) ListFlaggedViews(ctx context.Context, moderatorID string,
+	limit, offset int) ([]*domain.UserVideoView, int64, error) {
+
+	if _, err := usecase.requireModerator(moderatorID); err != nil {
+		return nil, 0, err
+	}
+
+	views, err := usecase.viewRepo.ListFlagged(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.viewRepo.CountFlagged(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return views, total, nil
+}
+
+func (usecase *moderationUseCase) requireModerator(moderatorID string) (uuid.UUID, error) {
+	moderatorUUID, err := parseID(moderatorID, "moderator_id")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !usecase.moderators[moderatorUUID] {
+		return uuid.Nil, domain.ErrNotModerator
+	}
+	return moderatorUUID, nil
+}
+
+// moderationActionState returns the state the action moves the video to, and
+// false for actions that leave the video as it is.
+func moderationActionState(action domain.ModerationActionType) (domain.ModerationState, bool, error) {
+	switch action {
+	case domain.ModerationActionDismiss:
+		return "", false, nil
+	case domain.ModerationActionRestrict:
+		return domain.ModerationStateRestricted, true, nil
+	case domain.ModerationActionTakeDown, domain.ModerationActionStrike:
+		return domain.ModerationStateTakenDown, true, nil
+	}
+	return "", false, domain.ErrInvalidModerationAction
+}
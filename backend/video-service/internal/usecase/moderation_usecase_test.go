@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockModerationRepository) Report(ctx context.Context, report *domain.VideoReport) (bool, error) {
+	args := m.Called(ctx, report)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockModerationRepository) ListQueue(ctx context.Context, limit, offset int) (
+	[]*domain.ModerationCase, error) {
+
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ModerationCase), args.Error(1)
+}
+
+func (m *MockModerationRepository) CountQueue(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockModerationRepository) ResolveCase(ctx context.Context, videoID uuid.UUID) error {
+	args := m.Called(ctx, videoID)
+	return args.Error(0)
+}
+
+func (m *MockModerationRepository) SetVideoState(ctx context.Context, videoID uuid.UUID,
+	state domain.ModerationState, reason string) error {
+
+	args := m.Called(ctx, videoID, state, reason)
+	return args.Error(0)
+}
+
+func (m *MockModerationRepository) RecordAction(ctx context.Context, action *domain.ModerationAction) error {
+	args := m.Called(ctx, action)
+	return args.Error(0)
+}
+
+func (m *MockModerationRepository) ListActions(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.ModerationAction, error) {
+
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ModerationAction), args.Error(1)
+}
+
+func (m *MockModerationRepository) AddStrike(ctx context.Context, strike *domain.CreatorStrike) (int64, error) {
+	args := m.Called(ctx, strike)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+var testModeratorID = uuid.New()
+
+func createTestModerationUseCase() (ModerationUseCase, *MockVideoRepository, *MockModerationRepository,
+	*MockOutboxRepository) {
+
+	mockVideoRepo := &MockVideoRepository{}
+	mockModerationRepo := &MockModerationRepository{}
+	mockOutbox := &MockOutboxRepository{}
+	usecase := NewModerationUseCase(mockVideoRepo, mockModerationRepo, &MockUserVideoViewRepository{},
+		&MockUserDirectory{}, noBlocks(), fakeTransactor{}, mockOutbox,
+		ModerationPolicy{Moderators: []uuid.UUID{testModeratorID}})
+	return usecase, mockVideoRepo, mockModerationRepo, mockOutbox
+}
+
+func TestReportVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockModerationRepo, _ := createTestModerationUseCase()
+	video := createTestVideo()
+	reporterID := uuid.New()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockModerationRepo.On("Report", mock.Anything, mock.MatchedBy(func(report *domain.VideoReport) bool {
+		return report.VideoID == video.ID && report.ReporterID == reporterID &&
+			report.Reason == domain.ReportReasonSpam && report.Details == "same link in every video"
+	})).Return(true, nil)
+
+	created, err := usecase.ReportVideo(context.Background(), &ReportVideoRequest{
+		ReporterID: reporterID.String(),
+		VideoID:    video.ID.String(),
+		Reason:     "spam",
+		Details:    "  same link in every video ",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, created)
+	mockModerationRepo.AssertExpectations(t)
+}
+
+func TestReportVideo_InvalidReason(t *testing.T) {
+	usecase, _, mockModerationRepo, _ := createTestModerationUseCase()
+
+	_, err := usecase.ReportVideo(context.Background(), &ReportVideoRequest{
+		ReporterID: uuid.NewString(),
+		VideoID:    uuid.NewString(),
+		Reason:     "boring",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidReportReason)
+	mockModerationRepo.AssertNotCalled(t, "Report", mock.Anything, mock.Anything)
+}
+
+func TestReportVideo_OwnVideo(t *testing.T) {
+	usecase, mockVideoRepo, mockModerationRepo, _ := createTestModerationUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.ReportVideo(context.Background(), &ReportVideoRequest{
+		ReporterID: video.UserID.String(),
+		VideoID:    video.ID.String(),
+		Reason:     "spam",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrCannotReportOwnVideo)
+	mockModerationRepo.AssertNotCalled(t, "Report", mock.Anything, mock.Anything)
+}
+
+func TestReportVideo_HiddenVideo(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestModerationUseCase()
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityPrivate
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.ReportVideo(context.Background(), &ReportVideoRequest{
+		ReporterID: uuid.NewString(),
+		VideoID:    video.ID.String(),
+		Reason:     "spam",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+}
+
+func TestGetModerationQueue_NotModerator(t *testing.T) {
+	usecase, _, mockModerationRepo, _ := createTestModerationUseCase()
+
+	_, _, err := usecase.GetModerationQueue(context.Background(), uuid.NewString(), 10, 0)
+
+	assert.ErrorIs(t, err, domain.ErrNotModerator)
+	mockModerationRepo.AssertNotCalled(t, "ListQueue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetModerationQueue_Success(t *testing.T) {
+	usecase, _, mockModerationRepo, _ := createTestModerationUseCase()
+	cases := []*domain.ModerationCase{{VideoID: uuid.New(), Status: domain.CaseStatusOpen, ReportCount: 3}}
+
+	mockModerationRepo.On("ListQueue", mock.Anything, 10, 0).Return(cases, nil)
+	mockModerationRepo.On("CountQueue", mock.Anything).Return(int64(1), nil)
+
+	queue, total, err := usecase.GetModerationQueue(context.Background(), testModeratorID.String(), 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, cases, queue)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestListFlaggedViews_Success(t *testing.T) {
+	mockViewRepo := &MockUserVideoViewRepository{}
+	usecase := NewModerationUseCase(&MockVideoRepository{}, &MockModerationRepository{}, mockViewRepo,
+		&MockUserDirectory{}, noBlocks(), fakeTransactor{}, &MockOutboxRepository{},
+		ModerationPolicy{Moderators: []uuid.UUID{testModeratorID}})
+	views := []*domain.UserVideoView{{ID: uuid.New(), FlagReason: domain.ViewFlagBurst}}
+
+	mockViewRepo.On("ListFlagged", mock.Anything, 20, 0).Return(views, nil)
+	mockViewRepo.On("CountFlagged", mock.Anything).Return(int64(1), nil)
+
+	flagged, total, err := usecase.ListFlaggedViews(context.Background(), testModeratorID.String(), 20, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, views, flagged)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestListFlaggedViews_NotModerator(t *testing.T) {
+	usecase, _, _, _ := createTestModerationUseCase()
+
+	_, _, err := usecase.ListFlaggedViews(context.Background(), uuid.NewString(), 20, 0)
+
+	assert.ErrorIs(t, err, domain.ErrNotModerator)
+}
+
+func TestModerateVideo_TakeDown(t *testing.T) {
+	usecase, mockVideoRepo, mockModerationRepo, mockOutbox := createTestModerationUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockModerationRepo.On("RecordAction", mock.Anything, mock.MatchedBy(func(action *domain.ModerationAction) bool {
+		return action.ModeratorID == testModeratorID && action.Action == domain.ModerationActionTakeDown
+	})).Return(nil)
+	mockModerationRepo.On("ResolveCase", mock.Anything, video.ID).Return(nil)
+	mockModerationRepo.On("SetVideoState", mock.Anything, video.ID, domain.ModerationStateTakenDown,
+		"graphic violence").Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.VideoEvent) bool {
+		return event.Type == domain.EventVideoModerated &&
+			event.Video.ModerationState == domain.ModerationStateTakenDown
+	})).Return(nil)
+
+	outcome, err := usecase.ModerateVideo(context.Background(), &ModerateVideoRequest{
+		ModeratorID: testModeratorID.String(),
+		VideoID:     video.ID.String(),
+		Action:      "take_down",
+		Reason:      "graphic violence",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModerationStateTakenDown, outcome.Video.ModerationState)
+	assert.Equal(t, "graphic violence", outcome.Video.ModerationReason)
+	mockModerationRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+	mockModerationRepo.AssertNotCalled(t, "AddStrike", mock.Anything, mock.Anything)
+}
+
+func TestModerateVideo_StrikeTakesDownAndCountsStrike(t *testing.T) {
+	usecase, mockVideoRepo, mockModerationRepo, mockOutbox := createTestModerationUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockModerationRepo.On("RecordAction", mock.Anything, mock.Anything).Return(nil)
+	mockModerationRepo.On("ResolveCase", mock.Anything, video.ID).Return(nil)
+	mockModerationRepo.On("AddStrike", mock.Anything, mock.MatchedBy(func(strike *domain.CreatorStrike) bool {
+		return strike.UserID == video.UserID && strike.VideoID == video.ID
+	})).Return(int64(3), nil)
+	mockModerationRepo.On("SetVideoState", mock.Anything, video.ID, domain.ModerationStateTakenDown,
+		"repeated copyright claims").Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	outcome, err := usecase.ModerateVideo(context.Background(), &ModerateVideoRequest{
+		ModeratorID: testModeratorID.String(),
+		VideoID:     video.ID.String(),
+		Action:      "strike",
+		Reason:      "repeated copyright claims",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), outcome.StrikeCount)
+	mockModerationRepo.AssertExpectations(t)
+}
+
+func TestModerateVideo_DismissLeavesVideo(t *testing.T) {
+	usecase, mockVideoRepo, mockModerationRepo, mockOutbox := createTestModerationUseCase()
+	video := createTestVideo()
+	video.ModerationState = domain.ModerationStateActive
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockModerationRepo.On("RecordAction", mock.Anything, mock.Anything).Return(nil)
+	mockModerationRepo.On("ResolveCase", mock.Anything, video.ID).Return(nil)
+
+	outcome, err := usecase.ModerateVideo(context.Background(), &ModerateVideoRequest{
+		ModeratorID: testModeratorID.String(),
+		VideoID:     video.ID.String(),
+		Action:      "dismiss",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ModerationStateActive, outcome.Video.ModerationState)
+	mockModerationRepo.AssertNotCalled(t, "SetVideoState", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+	mockOutbox.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestModerateVideo_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *ModerateVideoRequest
+		err  error
+	}{
+		{"not moderator", &ModerateVideoRequest{ModeratorID: uuid.NewString(), VideoID: uuid.NewString(),
+			Action: "dismiss"}, domain.ErrNotModerator},
+		{"unknown action", &ModerateVideoRequest{ModeratorID: testModeratorID.String(), VideoID: uuid.NewString(),
+			Action: "ban"}, domain.ErrInvalidModerationAction},
+		{"missing reason", &ModerateVideoRequest{ModeratorID: testModeratorID.String(), VideoID: uuid.NewString(),
+			Action: "restrict", Reason: " "}, domain.ErrModerationReasonMissing},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			usecase, mockVideoRepo, _, _ := createTestModerationUseCase()
+
+			_, err := usecase.ModerateVideo(context.Background(), test.req)
+
+			assert.ErrorIs(t, err, test.err)
+			mockVideoRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Notifier records notifications alongside the action that caused them and
+// pushes them to live subscribers once that action has committed.
+type Notifier struct {
+	notificationRepo domain.NotificationRepository
+	hub              domain.NotificationHub
+}
+
+func NewNotifier(notificationRepo domain.NotificationRepository, hub domain.NotificationHub) *Notifier {
+	return &Notifier{
+		notificationRepo: notificationRepo,
+		hub:              hub,
+	}
+}
+
+// Record joins the caller's transaction. Users are never notified about
+// their own actions.
+func (notifier *Notifier) Record(ctx context.Context, activity *domain.Activity) (*domain.Notification, error) {
+	if activity.ActorID == activity.RecipientID {
+		return nil, nil
+	}
+	return notifier.notificationRepo.Record(ctx, activity)
+}
+
+// Deliver must only be called after the transaction that recorded the
+// notifications has committed.
+func (notifier *Notifier) Deliver(notifications ...*domain.Notification) {
+	for _, notification := range notifications {
+		if notification != nil {
+			notifier.hub.Publish(notification)
+		}
+	}
+}
+
+// RecordActivityRequest reports activity owned by other services, such as
+// follows and comments, so it can be notified alongside likes and mentions.
+type RecordActivityRequest struct {
+	Type        string `json:"type"`
+	RecipientID string `json:"recipient_id"`
+	ActorID     string `json:"actor_id"`
+	VideoID     string `json:"video_id"`
+}
+
+type NotificationUseCase interface {
+	// ListNotifications also returns the user's unread count.
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool,
+		limit, offset int) ([]*domain.Notification, int64, error)
+	// MarkRead marks every notification read when notificationIDs is empty,
+	// and returns how many remain unread.
+	MarkRead(ctx context.Context, userID string, notificationIDs []string) (int64, error)
+	SubscribeNotifications(ctx context.Context, userID string) (<-chan *domain.Notification, func(), error)
+	RecordActivity(ctx context.Context, req *RecordActivityRequest) error
+}
+
+type notificationUseCase struct {
+	notificationRepo domain.NotificationRepository
+	videoRepo        domain.VideoRepository
+	blockRepo        domain.BlockRepository
+	trendingRepo     domain.TrendingRepository
+	notifier         *Notifier
+	hub              domain.NotificationHub
+}
+
+func NewNotificationUseCase(
+	notificationRepo domain.NotificationRepository,
+	videoRepo domain.VideoRepository,
+	blockRepo domain.BlockRepository,
+	trendingRepo domain.TrendingRepository,
+	notifier *Notifier,
+	hub domain.NotificationHub,
+) NotificationUseCase {
+	return &notificationUseCase{
+		notificationRepo: notificationRepo,
+		videoRepo:        videoRepo,
+		blockRepo:        blockRepo,
+		trendingRepo:     trendingRepo,
+		notifier:         notifier,
+		hub:              hub,
+	}
+}
+
+func (usecase *notificationUseCase) ListNotifications(ctx context.Context, userID string, unreadOnly bool,
+	limit, offset int) ([]*domain.Notification, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	notifications, err := usecase.notificationRepo.List(ctx, userUUID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unread, err := usecase.notificationRepo.CountUnread(ctx, userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, unread, nil
+}
+
+func (usecase *notificationUseCase) MarkRead(ctx context.Context, userID string,
+	notificationIDs []string) (int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]uuid.UUID, len(notificationIDs))
+	for i, notificationID := range notificationIDs {
+		ids[i], err = parseID(notificationID, "notification_ids")
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := usecase.notificationRepo.MarkRead(ctx, userUUID, ids); err != nil {
+		return 0, err
+	}
+
+	return usecase.notificationRepo.CountUnread(ctx, userUUID)
+}
+
+func (usecase *notificationUseCase) SubscribeNotifications(ctx context.Context, userID string) (
+	<-chan *domain.Notification, func(), error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notifications, cancel := usecase.hub.Subscribe(userUUID)
+	return notifications, cancel, nil
+}
+
+// RecordActivity drops notifications between users who blocked each other.
+// Comments count toward trending either way.
+func (usecase *notificationUseCase) RecordActivity(ctx context.Context, req *RecordActivityRequest) error {
+	activity, err := parseActivity(req)
+	if err != nil {
+		return err
+	}
+
+	// A comment notifies the owner of the video it was left on, and nobody
+	// else.
+	if activity.VideoID != nil {
+		video, err := usecase.videoRepo.GetByID(ctx, *activity.VideoID)
+		if err != nil {
+			return err
+		}
+		if video.UserID != activity.RecipientID {
+			return domain.ErrNotificationNotVideoOwner
+		}
+
+		err = usecase.trendingRepo.RecordComment(ctx, &domain.VideoComment{
+			VideoID: video.ID,
+			UserID:  activity.ActorID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	blocked, err := isBlocked(ctx, usecase.blockRepo, activity.ActorID, activity.RecipientID)
+	if err != nil || blocked {
+		return err
+	}
+
+	notification, err := usecase.notifier.Record(ctx, activity)
+	if err != nil {
+		return err
+	}
+
+	usecase.notifier.Deliver(notification)
+	return nil
+}
+
+func parseActivity(req *RecordActivityRequest) (*domain.Activity, error) {
+	activity := &domain.Activity{Type: domain.NotificationType(req.Type)}
+	if activity.Type != domain.NotificationTypeComment && activity.Type != domain.NotificationTypeFollow {
+		return nil, domain.ErrInvalidNotificationType
+	}
+
+	var err error
+	activity.RecipientID, err = parseID(req.RecipientID, "recipient_id")
+	if err != nil {
+		return nil, err
+	}
+	activity.ActorID, err = parseID(req.ActorID, "actor_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if activity.Type == domain.NotificationTypeComment {
+		videoID, err := parseID(req.VideoID, "video_id")
+		if err != nil {
+			return nil, domain.ErrNotificationVideoMissing
+		}
+		activity.VideoID = &videoID
+	}
+	return activity, nil
+}
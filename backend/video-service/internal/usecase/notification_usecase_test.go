@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) Record(ctx context.Context, activity *domain.Activity) (
+	*domain.Notification, error) {
+
+	args := m.Called(ctx, activity)
+	if record, ok := args.Get(0).(func(*domain.Activity) *domain.Notification); ok {
+		return record(activity), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) List(ctx context.Context, userID uuid.UUID, unreadOnly bool,
+	limit, offset int) ([]*domain.Notification, error) {
+
+	args := m.Called(ctx, userID, unreadOnly, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	args := m.Called(ctx, userID, ids)
+	return args.Error(0)
+}
+
+type MockNotificationHub struct {
+	mock.Mock
+}
+
+func (m *MockNotificationHub) Publish(notification *domain.Notification) {
+	m.Called(notification)
+}
+
+func (m *MockNotificationHub) Subscribe(userID uuid.UUID) (<-chan *domain.Notification, func()) {
+	args := m.Called(userID)
+	return args.Get(0).(<-chan *domain.Notification), args.Get(1).(func())
+}
+
+// createTestNotifier records every activity as a fresh notification.
+func createTestNotifier() (*Notifier, *MockNotificationRepository, *MockNotificationHub) {
+	mockRepo := &MockNotificationRepository{}
+	mockRepo.On("Record", mock.Anything, mock.Anything).
+		Return(func(activity *domain.Activity) *domain.Notification {
+			return &domain.Notification{
+				ID:          uuid.New(),
+				UserID:      activity.RecipientID,
+				Type:        activity.Type,
+				VideoID:     activity.VideoID,
+				LastActorID: activity.ActorID,
+				ActorCount:  1,
+			}
+		}, nil).Maybe()
+	mockHub := &MockNotificationHub{}
+	mockHub.On("Publish", mock.Anything).Maybe()
+
+	return NewNotifier(mockRepo, mockHub), mockRepo, mockHub
+}
+
+func TestNotifier_SkipsSelfActivity(t *testing.T) {
+	mockRepo := &MockNotificationRepository{}
+	notifier := NewNotifier(mockRepo, &MockNotificationHub{})
+	userID := uuid.New()
+
+	notification, err := notifier.Record(context.Background(), &domain.Activity{
+		Type:        domain.NotificationTypeLike,
+		RecipientID: userID,
+		ActorID:     userID,
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, notification)
+	mockRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+}
+
+func TestListNotifications(t *testing.T) {
+	mockRepo := &MockNotificationRepository{}
+	usecase := NewNotificationUseCase(mockRepo, &MockVideoRepository{}, noBlocks(), &MockTrendingRepository{},
+		NewNotifier(mockRepo, &MockNotificationHub{}), &MockNotificationHub{})
+	userID := uuid.New()
+	notifications := []*domain.Notification{{ID: uuid.New(), UserID: userID}}
+
+	mockRepo.On("List", mock.Anything, userID, true, 20, 0).Return(notifications, nil)
+	mockRepo.On("CountUnread", mock.Anything, userID).Return(int64(4), nil)
+
+	result, unread, err := usecase.ListNotifications(context.Background(), userID.String(), true, 20, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, notifications, result)
+	assert.Equal(t, int64(4), unread)
+}
+
+func TestMarkRead_ReturnsRemainingUnread(t *testing.T) {
+	mockRepo := &MockNotificationRepository{}
+	usecase := NewNotificationUseCase(mockRepo, &MockVideoRepository{}, noBlocks(), &MockTrendingRepository{},
+		NewNotifier(mockRepo, &MockNotificationHub{}), &MockNotificationHub{})
+	userID := uuid.New()
+	notificationID := uuid.New()
+
+	mockRepo.On("MarkRead", mock.Anything, userID, []uuid.UUID{notificationID}).Return(nil)
+	mockRepo.On("CountUnread", mock.Anything, userID).Return(int64(2), nil)
+
+	unread, err := usecase.MarkRead(context.Background(), userID.String(), []string{notificationID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), unread)
+}
+
+func TestRecordActivity_FollowIsDelivered(t *testing.T) {
+	notifier, mockRepo, mockHub := createTestNotifier()
+	usecase := NewNotificationUseCase(mockRepo, &MockVideoRepository{}, noBlocks(), &MockTrendingRepository{},
+		notifier, mockHub)
+	recipientID, actorID := uuid.New(), uuid.New()
+
+	err := usecase.RecordActivity(context.Background(), &RecordActivityRequest{
+		Type:        string(domain.NotificationTypeFollow),
+		RecipientID: recipientID.String(),
+		ActorID:     actorID.String(),
+	})
+
+	require.NoError(t, err)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == recipientID && notification.Type == domain.NotificationTypeFollow &&
+			notification.VideoID == nil
+	}))
+}
+
+func TestRecordActivity_BlockedIsDropped(t *testing.T) {
+	mockRepo := &MockNotificationRepository{}
+	mockVideoRepo := &MockVideoRepository{}
+	mockBlockRepo := &MockBlockRepository{}
+	mockTrendingRepo := &MockTrendingRepository{}
+	usecase := NewNotificationUseCase(mockRepo, mockVideoRepo, mockBlockRepo, mockTrendingRepo,
+		NewNotifier(mockRepo, &MockNotificationHub{}), &MockNotificationHub{})
+	video := createTestVideo()
+	recipientID, actorID := video.UserID, uuid.New()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockTrendingRepo.On("RecordComment", mock.Anything, mock.Anything).Return(nil)
+	mockBlockRepo.On("BlockedAmong", mock.Anything, actorID, []uuid.UUID{recipientID}).
+		Return(map[uuid.UUID]bool{recipientID: true}, nil)
+
+	err := usecase.RecordActivity(context.Background(), &RecordActivityRequest{
+		Type:        string(domain.NotificationTypeComment),
+		RecipientID: recipientID.String(),
+		ActorID:     actorID.String(),
+		VideoID:     video.ID.String(),
+	})
+
+	require.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	mockTrendingRepo.AssertCalled(t, "RecordComment", mock.Anything,
+		mock.MatchedBy(func(comment *domain.VideoComment) bool {
+			return comment.VideoID == video.ID && comment.UserID == actorID
+		}))
+}
+
+func TestRecordActivity_Validation(t *testing.T) {
+	usecase := NewNotificationUseCase(&MockNotificationRepository{}, &MockVideoRepository{}, noBlocks(),
+		&MockTrendingRepository{}, nil, &MockNotificationHub{})
+
+	err := usecase.RecordActivity(context.Background(), &RecordActivityRequest{
+		Type:        string(domain.NotificationTypeLike),
+		RecipientID: uuid.NewString(),
+		ActorID:     uuid.NewString(),
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidNotificationType)
+
+	err = usecase.RecordActivity(context.Background(), &RecordActivityRequest{
+		Type:        string(domain.NotificationTypeComment),
+		RecipientID: uuid.NewString(),
+		ActorID:     uuid.NewString(),
+	})
+	assert.ErrorIs(t, err, domain.ErrNotificationVideoMissing)
+}
+
+func TestRecordActivity_CommentOnlyNotifiesVideoOwner(t *testing.T) {
+	mockRepo := &MockNotificationRepository{}
+	mockVideoRepo := &MockVideoRepository{}
+	usecase := NewNotificationUseCase(mockRepo, mockVideoRepo, noBlocks(), &MockTrendingRepository{},
+		NewNotifier(mockRepo, &MockNotificationHub{}), &MockNotificationHub{})
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	err := usecase.RecordActivity(context.Background(), &RecordActivityRequest{
+		Type:        string(domain.NotificationTypeComment),
+		RecipientID: uuid.NewString(),
+		ActorID:     uuid.NewString(),
+		VideoID:     video.ID.String(),
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotificationNotVideoOwner)
+	mockRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+}
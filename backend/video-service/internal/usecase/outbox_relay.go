@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type OutboxRelay interface {
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+type OutboxRelayPolicy struct {
+	BatchSize      int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+type outboxRelay struct {
+	transactor domain.Transactor
+	outbox     domain.OutboxRepository
+	publisher  domain.Publisher
+	policy     OutboxRelayPolicy
+}
+
+func NewOutboxRelay(
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	publisher domain.Publisher,
+	policy OutboxRelayPolicy,
+) OutboxRelay {
+	return &outboxRelay{
+		transactor: transactor,
+		outbox:     outbox,
+		publisher:  publisher,
+		policy:     policy,
+	}
+}
+
+// ProcessNext publishes one batch of pending events. The batch stays locked
+// while it is published, and a crash between publishing and committing means
+// the batch is sent again, so consumers must deduplicate on the event ID.
+func (relay *outboxRelay) ProcessNext(ctx context.Context) (bool, error) {
+	var processed bool
+	var publishErr error
+	err := relay.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		pending, err := relay.outbox.FetchPending(ctx, relay.policy.BatchSize)
+		if err != nil || len(pending) == 0 {
+			return err
+		}
+		processed = true
+
+		ids := make([]uuid.UUID, len(pending))
+		messages := make([]domain.EventMessage, len(pending))
+		attempts := 0
+		for i, event := range pending {
+			ids[i] = event.ID
+			messages[i] = domain.EventMessage{
+				ID:      event.ID,
+				Type:    event.EventType,
+				Key:     event.AggregateID.String(),
+				Payload: event.Payload,
+			}
+			attempts = max(attempts, event.Attempts)
+		}
+
+		publishErr = relay.publisher.Publish(ctx, messages...)
+		if publishErr != nil {
+			delay := backoffDelay(relay.policy.RetryBaseDelay, relay.policy.RetryMaxDelay, attempts+1)
+			return relay.outbox.MarkFailed(ctx, ids, publishErr.Error(), time.Now().Add(delay))
+		}
+
+		return relay.outbox.MarkPublished(ctx, ids)
+	})
+	if err != nil {
+		return processed, err
+	}
+	if publishErr != nil {
+		return false, publishErr
+	}
+
+	return processed, nil
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Append(ctx context.Context, event *domain.VideoEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) FetchPending(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, ids []uuid.UUID, lastError string,
+	retryAt time.Time) error {
+
+	args := m.Called(ctx, ids, lastError, retryAt)
+	return args.Error(0)
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, messages ...domain.EventMessage) error {
+	args := m.Called(ctx, messages)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func createTestOutboxRelay() (*outboxRelay, *MockOutboxRepository, *MockPublisher) {
+	mockOutbox := &MockOutboxRepository{}
+	mockPublisher := &MockPublisher{}
+
+	relay := &outboxRelay{
+		transactor: fakeTransactor{},
+		outbox:     mockOutbox,
+		publisher:  mockPublisher,
+		policy: OutboxRelayPolicy{
+			BatchSize:      10,
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  time.Minute,
+		},
+	}
+
+	return relay, mockOutbox, mockPublisher
+}
+
+func TestOutboxRelayProcessNext_PublishesBatch(t *testing.T) {
+	relay, mockOutbox, mockPublisher := createTestOutboxRelay()
+
+	event := &domain.OutboxEvent{
+		ID:          uuid.New(),
+		EventType:   domain.EventVideoLiked,
+		AggregateID: uuid.New(),
+		Payload:     []byte("payload"),
+	}
+	mockOutbox.On("FetchPending", mock.Anything, 10).Return([]*domain.OutboxEvent{event}, nil)
+	mockPublisher.On("Publish", mock.Anything, []domain.EventMessage{{
+		ID:      event.ID,
+		Type:    domain.EventVideoLiked,
+		Key:     event.AggregateID.String(),
+		Payload: []byte("payload"),
+	}}).Return(nil)
+	mockOutbox.On("MarkPublished", mock.Anything, []uuid.UUID{event.ID}).Return(nil)
+
+	processed, err := relay.ProcessNext(context.Background())
+
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	mockOutbox.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestOutboxRelayProcessNext_Empty(t *testing.T) {
+	relay, mockOutbox, mockPublisher := createTestOutboxRelay()
+
+	mockOutbox.On("FetchPending", mock.Anything, 10).Return([]*domain.OutboxEvent{}, nil)
+
+	processed, err := relay.ProcessNext(context.Background())
+
+	assert.NoError(t, err)
+	assert.False(t, processed)
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestOutboxRelayProcessNext_PublishErrorSchedulesRetry(t *testing.T) {
+	relay, mockOutbox, mockPublisher := createTestOutboxRelay()
+
+	event := &domain.OutboxEvent{ID: uuid.New(), AggregateID: uuid.New(), Attempts: 2}
+	mockOutbox.On("FetchPending", mock.Anything, 10).Return([]*domain.OutboxEvent{event}, nil)
+	mockPublisher.On("Publish", mock.Anything, mock.Anything).Return(errors.New("broker down"))
+	mockOutbox.On("MarkFailed", mock.Anything, []uuid.UUID{event.ID}, "broker down",
+		mock.MatchedBy(func(retryAt time.Time) bool {
+			return retryAt.After(time.Now().Add(3 * time.Second))
+		})).Return(nil)
+
+	processed, err := relay.ProcessNext(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broker down")
+	assert.False(t, processed)
+	mockOutbox.AssertExpectations(t)
+	mockOutbox.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything)
+}
+
+func TestOutboxRelayProcessNext_FetchError(t *testing.T) {
+	relay, mockOutbox, _ := createTestOutboxRelay()
+
+	mockOutbox.On("FetchPending", mock.Anything, 10).Return(nil, errors.New("database error"))
+
+	processed, err := relay.ProcessNext(context.Background())
+
+	assert.Error(t, err)
+	assert.False(t, processed)
+}
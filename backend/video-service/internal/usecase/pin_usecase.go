@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+)
+
+type PinUseCase interface {
+	PinVideo(ctx context.Context, userID, videoID string) error
+	UnpinVideo(ctx context.Context, userID, videoID string) error
+}
+
+type pinUseCase struct {
+	videoRepo domain.VideoRepository
+	pinRepo   domain.PinRepository
+}
+
+func NewPinUseCase(videoRepo domain.VideoRepository, pinRepo domain.PinRepository) PinUseCase {
+	return &pinUseCase{
+		videoRepo: videoRepo,
+		pinRepo:   pinRepo,
+	}
+}
+
+// PinVideo only accepts videos that others could find on the profile, since
+// a pin nobody else can see would only take up a slot.
+func (usecase *pinUseCase) PinVideo(ctx context.Context, userID, videoID string) error {
+	video, err := usecase.ownedVideo(ctx, userID, videoID)
+	if err != nil {
+		return err
+	}
+	if !video.Pinnable() {
+		return domain.ErrVideoNotPinnable
+	}
+
+	return usecase.pinRepo.Pin(ctx, video.UserID, video.ID)
+}
+
+func (usecase *pinUseCase) UnpinVideo(ctx context.Context, userID, videoID string) error {
+	video, err := usecase.ownedVideo(ctx, userID, videoID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.pinRepo.Unpin(ctx, video.ID)
+}
+
+func (usecase *pinUseCase) ownedVideo(ctx context.Context, userID, videoID string) (*domain.Video, error) {
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+	return video, nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPinRepository struct {
+	mock.Mock
+}
+
+func (m *MockPinRepository) Pin(ctx context.Context, userID, videoID uuid.UUID) error {
+	args := m.Called(ctx, userID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPinRepository) Unpin(ctx context.Context, videoID uuid.UUID) error {
+	args := m.Called(ctx, videoID)
+	return args.Error(0)
+}
+
+func createTestPinUseCase() (PinUseCase, *MockVideoRepository, *MockPinRepository) {
+	mockVideoRepo := &MockVideoRepository{}
+	mockPinRepo := &MockPinRepository{}
+	return NewPinUseCase(mockVideoRepo, mockPinRepo), mockVideoRepo, mockPinRepo
+}
+
+func TestPinVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockPinRepo := createTestPinUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPinRepo.On("Pin", mock.Anything, video.UserID, video.ID).Return(nil)
+
+	require.NoError(t, usecase.PinVideo(context.Background(), video.UserID.String(), video.ID.String()))
+	mockPinRepo.AssertExpectations(t)
+}
+
+func TestPinVideo_LimitReached(t *testing.T) {
+	usecase, mockVideoRepo, mockPinRepo := createTestPinUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPinRepo.On("Pin", mock.Anything, video.UserID, video.ID).Return(domain.ErrPinLimitReached)
+
+	err := usecase.PinVideo(context.Background(), video.UserID.String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrPinLimitReached)
+}
+
+func TestPinVideo_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(video *domain.Video)
+		err    error
+	}{
+		{"private", func(video *domain.Video) { video.Visibility = domain.VisibilityPrivate },
+			domain.ErrVideoNotPinnable},
+		{"unlisted", func(video *domain.Video) { video.Visibility = domain.VisibilityUnlisted },
+			domain.ErrVideoNotPinnable},
+		{"draft", func(video *domain.Video) { video.PublicationState = domain.PublicationStateDraft },
+			domain.ErrVideoNotPinnable},
+		{"not owner", func(video *domain.Video) { video.UserID = uuid.New() }, domain.ErrNotVideoOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, mockVideoRepo, mockPinRepo := createTestPinUseCase()
+			video := createTestVideo()
+			userID := video.UserID
+			tt.change(video)
+			mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+			err := usecase.PinVideo(context.Background(), userID.String(), video.ID.String())
+
+			assert.ErrorIs(t, err, tt.err)
+			mockPinRepo.AssertNotCalled(t, "Pin", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUnpinVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockPinRepo := createTestPinUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPinRepo.On("Unpin", mock.Anything, video.ID).Return(nil)
+
+	require.NoError(t, usecase.UnpinVideo(context.Background(), video.UserID.String(), video.ID.String()))
+	mockPinRepo.AssertExpectations(t)
+}
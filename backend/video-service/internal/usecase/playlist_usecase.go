@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxPlaylistTitleLength       = 150
+	maxPlaylistDescriptionLength = 5000
+)
+
+type PlaylistUseCase interface {
+	CreatePlaylist(ctx context.Context, req *PlaylistRequest) (*domain.Playlist, error)
+	UpdatePlaylist(ctx context.Context, req *PlaylistRequest) (*domain.Playlist, error)
+	DeletePlaylist(ctx context.Context, userID, playlistID string) error
+	AddVideo(ctx context.Context, userID, playlistID, videoID string) error
+	RemoveVideo(ctx context.Context, userID, playlistID, videoID string) error
+	ReorderPlaylist(ctx context.Context, userID, playlistID string, videoIDs []string) error
+	ListPlaylists(ctx context.Context, ownerID, viewerID string, limit, offset int) (
+		[]*domain.Playlist, int64, error)
+	ListPlaylistVideos(ctx context.Context, playlistID, viewerID string, limit, offset int) (
+		[]*domain.Video, int64, error)
+	GetNextInSeries(ctx context.Context, videoID, playlistID, viewerID string) (*SeriesNext, error)
+}
+
+type PlaylistRequest struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	CoverURL    string `json:"cover_url"`
+}
+
+// SeriesNext is what the player advances to after a video. Playlist is nil
+// when the video is in no playlist, and Next is nil at the end of the
+// series.
+type SeriesNext struct {
+	Playlist *domain.Playlist
+	Next     *domain.Video
+}
+
+type playlistUseCase struct {
+	videoRepo    domain.VideoRepository
+	playlistRepo domain.PlaylistRepository
+	directory    domain.UserDirectory
+	blockRepo    domain.BlockRepository
+}
+
+func NewPlaylistUseCase(
+	videoRepo domain.VideoRepository,
+	playlistRepo domain.PlaylistRepository,
+	directory domain.UserDirectory,
+	blockRepo domain.BlockRepository,
+) PlaylistUseCase {
+	return &playlistUseCase{
+		videoRepo:    videoRepo,
+		playlistRepo: playlistRepo,
+		directory:    directory,
+		blockRepo:    blockRepo,
+	}
+}
+
+func (usecase *playlistUseCase) CreatePlaylist(ctx context.Context, req *PlaylistRequest) (
+	*domain.Playlist, error) {
+
+	userUUID, err := parseID(req.UserID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	title, description, err := normalizePlaylistText(req.Title, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist := &domain.Playlist{
+		UserID:      userUUID,
+		Title:       title,
+		Description: description,
+		CoverURL:    strings.TrimSpace(req.CoverURL),
+	}
+	if err := usecase.playlistRepo.Create(ctx, playlist); err != nil {
+		return nil, err
+	}
+
+	return playlist, nil
+}
+
+func (usecase *playlistUseCase) UpdatePlaylist(ctx context.Context, req *PlaylistRequest) (
+	*domain.Playlist, error) {
+
+	title, description, err := normalizePlaylistText(req.Title, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := usecase.ownedPlaylist(ctx, req.UserID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist.Title = title
+	playlist.Description = description
+	playlist.CoverURL = strings.TrimSpace(req.CoverURL)
+	if err := usecase.playlistRepo.Update(ctx, playlist); err != nil {
+		return nil, err
+	}
+
+	return playlist, nil
+}
+
+func (usecase *playlistUseCase) DeletePlaylist(ctx context.Context, userID, playlistID string) error {
+	playlist, err := usecase.ownedPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.playlistRepo.Delete(ctx, playlist.ID)
+}
+
+// AddVideo accepts any of the creator's own videos, drafts included; what
+// others see of the playlist is decided per video when it is listed.
+func (usecase *playlistUseCase) AddVideo(ctx context.Context, userID, playlistID, videoID string) error {
+	playlist, err := usecase.ownedPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return err
+	}
+	if video.UserID != playlist.UserID {
+		return domain.ErrNotVideoOwner
+	}
+
+	return usecase.playlistRepo.AddVideo(ctx, playlist.ID, video.ID)
+}
+
+func (usecase *playlistUseCase) RemoveVideo(ctx context.Context, userID, playlistID, videoID string) error {
+	playlist, err := usecase.ownedPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return err
+	}
+
+	return usecase.playlistRepo.RemoveVideo(ctx, playlist.ID, videoUUID)
+}
+
+func (usecase *playlistUseCase) ReorderPlaylist(ctx context.Context, userID, playlistID string,
+	videoIDs []string) error {
+
+	playlist, err := usecase.ownedPlaylist(ctx, userID, playlistID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(videoIDs))
+	for i, videoID := range videoIDs {
+		ids[i], err = parseID(videoID, "video_ids")
+		if err != nil {
+			return err
+		}
+	}
+
+	return usecase.playlistRepo.Reorder(ctx, playlist.ID, ids)
+}
+
+// ListPlaylists lists nothing between users who blocked each other, like the
+// rest of the profile.
+func (usecase *playlistUseCase) ListPlaylists(ctx context.Context, ownerID, viewerID string,
+	limit, offset int) ([]*domain.Playlist, int64, error) {
+
+	ownerUUID, err := parseID(ownerID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	blocked, err := isBlocked(ctx, usecase.blockRepo, viewerUUID, ownerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if blocked {
+		return []*domain.Playlist{}, 0, nil
+	}
+
+	playlists, err := usecase.playlistRepo.ListByUserID(ctx, ownerUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.playlistRepo.CountByUserID(ctx, ownerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return playlists, total, nil
+}
+
+func (usecase *playlistUseCase) ListPlaylistVideos(ctx context.Context, playlistID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	playlistUUID, err := parseID(playlistID, "playlist_id")
+	if err != nil {
+		return nil, 0, err
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	playlist, err := usecase.playlistRepo.GetByID(ctx, playlistUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+	query, err := usecase.itemsQuery(ctx, playlist, viewerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query.Limit, query.Offset = limit, offset
+	videos, err := usecase.playlistRepo.ListVideos(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, video := range videos {
+		hideModerationReason(video, viewerUUID)
+	}
+
+	total, err := usecase.playlistRepo.CountVideos(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return videos, total, nil
+}
+
+// GetNextInSeries finds the item after the video that the viewer may watch,
+// skipping any they may not. Without a playlist ID it follows the oldest
+// playlist holding the video, which for a series is usually the only one.
+func (usecase *playlistUseCase) GetNextInSeries(ctx context.Context, videoID, playlistID, viewerID string) (
+	*SeriesNext, error) {
+
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoUUID,
+		viewerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var playlist *domain.Playlist
+	if playlistID != "" {
+		playlistUUID, err := parseID(playlistID, "playlist_id")
+		if err != nil {
+			return nil, err
+		}
+		playlist, err = usecase.playlistRepo.GetByID(ctx, playlistUUID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		playlists, err := usecase.playlistRepo.ListByVideoID(ctx, video.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(playlists) == 0 {
+			return &SeriesNext{}, nil
+		}
+		playlist = playlists[0]
+	}
+
+	position, err := usecase.playlistRepo.Position(ctx, playlist.ID, video.ID)
+	if err != nil {
+		return nil, err
+	}
+	query, err := usecase.itemsQuery(ctx, playlist, viewerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	query.AfterPosition, query.Limit = &position, 1
+	videos, err := usecase.playlistRepo.ListVideos(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &SeriesNext{Playlist: playlist}
+	if len(videos) > 0 {
+		series.Next = videos[0]
+		hideModerationReason(series.Next, viewerUUID)
+	}
+	return series, nil
+}
+
+// itemsQuery scopes a playlist's items to what the viewer may see of each
+// video. Unlisted videos are included because putting one in a playlist
+// shares it, just as handing out its link would.
+func (usecase *playlistUseCase) itemsQuery(ctx context.Context, playlist *domain.Playlist,
+	viewerID uuid.UUID) (domain.PlaylistVideosQuery, error) {
+
+	query := domain.PlaylistVideosQuery{PlaylistID: playlist.ID}
+	if viewerID != uuid.Nil && viewerID == playlist.UserID {
+		query.Owner = true
+		return query, nil
+	}
+
+	visibilities, err := listableVisibilities(ctx, usecase.directory, usecase.blockRepo, playlist.UserID, viewerID)
+	if err != nil {
+		return query, err
+	}
+	if visibilities == nil {
+		return query, domain.ErrPlaylistNotFound
+	}
+
+	query.Visibilities = append(visibilities, domain.VisibilityUnlisted)
+	return query, nil
+}
+
+func (usecase *playlistUseCase) ownedPlaylist(ctx context.Context, userID, playlistID string) (
+	*domain.Playlist, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	playlistUUID, err := parseID(playlistID, "playlist_id")
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := usecase.playlistRepo.GetByID(ctx, playlistUUID)
+	if err != nil {
+		return nil, err
+	}
+	if playlist.UserID != userUUID {
+		return nil, domain.ErrNotPlaylistOwner
+	}
+
+	return playlist, nil
+}
+
+func normalizePlaylistText(title, description string) (string, string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxPlaylistTitleLength {
+		return "", "", domain.ErrInvalidPlaylistTitle
+	}
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxPlaylistDescriptionLength {
+		return "", "", domain.ErrInvalidPlaylistDescription
+	}
+	return title, description, nil
+}
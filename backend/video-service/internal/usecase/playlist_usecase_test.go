@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPlaylistRepository struct {
+	mock.Mock
+}
+
+func (m *MockPlaylistRepository) Create(ctx context.Context, playlist *domain.Playlist) error {
+	args := m.Called(ctx, playlist)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Playlist, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) Update(ctx context.Context, playlist *domain.Playlist) error {
+	args := m.Called(ctx, playlist)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) (
+	[]*domain.Playlist, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) ListByVideoID(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.Playlist, error) {
+
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) AddVideo(ctx context.Context, playlistID, videoID uuid.UUID) error {
+	args := m.Called(ctx, playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) RemoveVideo(ctx context.Context, playlistID, videoID uuid.UUID) error {
+	args := m.Called(ctx, playlistID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) Reorder(ctx context.Context, playlistID uuid.UUID, videoIDs []uuid.UUID) error {
+	args := m.Called(ctx, playlistID, videoIDs)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistRepository) Position(ctx context.Context, playlistID, videoID uuid.UUID) (int, error) {
+	args := m.Called(ctx, playlistID, videoID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) ListVideos(ctx context.Context, query domain.PlaylistVideosQuery) (
+	[]*domain.Video, error) {
+
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockPlaylistRepository) CountVideos(ctx context.Context, query domain.PlaylistVideosQuery) (
+	int64, error) {
+
+	args := m.Called(ctx, query)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func createTestPlaylistUseCase() (PlaylistUseCase, *MockVideoRepository, *MockPlaylistRepository,
+	*MockUserDirectory) {
+
+	mockVideoRepo := &MockVideoRepository{}
+	mockPlaylistRepo := &MockPlaylistRepository{}
+	mockDirectory := &MockUserDirectory{}
+	usecase := NewPlaylistUseCase(mockVideoRepo, mockPlaylistRepo, mockDirectory, noBlocks())
+	return usecase, mockVideoRepo, mockPlaylistRepo, mockDirectory
+}
+
+func createTestPlaylist(userID uuid.UUID) *domain.Playlist {
+	return &domain.Playlist{ID: uuid.New(), UserID: userID, Title: "Season one"}
+}
+
+func TestCreatePlaylist_TrimsText(t *testing.T) {
+	usecase, _, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	userID := uuid.New()
+
+	mockPlaylistRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Playlist")).Return(nil)
+
+	playlist, err := usecase.CreatePlaylist(context.Background(), &PlaylistRequest{
+		UserID:      userID.String(),
+		Title:       "  Season one  ",
+		Description: " Episodes in order ",
+		CoverURL:    "https://example.com/cover.jpg",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, playlist.UserID)
+	assert.Equal(t, "Season one", playlist.Title)
+	assert.Equal(t, "Episodes in order", playlist.Description)
+	assert.Equal(t, "https://example.com/cover.jpg", playlist.CoverURL)
+}
+
+func TestCreatePlaylist_InvalidText(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		err         error
+	}{
+		{"blank title", "   ", "", domain.ErrInvalidPlaylistTitle},
+		{"long title", strings.Repeat("a", maxPlaylistTitleLength+1), "", domain.ErrInvalidPlaylistTitle},
+		{"long description", "Series", strings.Repeat("a", maxPlaylistDescriptionLength+1),
+			domain.ErrInvalidPlaylistDescription},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, _, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+
+			_, err := usecase.CreatePlaylist(context.Background(), &PlaylistRequest{
+				UserID:      uuid.New().String(),
+				Title:       tt.title,
+				Description: tt.description,
+			})
+
+			assert.ErrorIs(t, err, tt.err)
+			mockPlaylistRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdatePlaylist_NotOwner(t *testing.T) {
+	usecase, _, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	playlist := createTestPlaylist(uuid.New())
+
+	mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+
+	_, err := usecase.UpdatePlaylist(context.Background(), &PlaylistRequest{
+		ID:     playlist.ID.String(),
+		UserID: uuid.New().String(),
+		Title:  "Renamed",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrNotPlaylistOwner)
+	mockPlaylistRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestAddPlaylistVideo(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownVideo  bool
+		expectErr error
+	}{
+		{"own video", true, nil},
+		{"someone else's video", false, domain.ErrNotVideoOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, mockVideoRepo, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+			video := createTestVideo()
+			video.PublicationState = domain.PublicationStateDraft
+			playlist := createTestPlaylist(video.UserID)
+			if !tt.ownVideo {
+				playlist.UserID = uuid.New()
+			}
+
+			mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+			mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+			mockPlaylistRepo.On("AddVideo", mock.Anything, playlist.ID, video.ID).Return(nil)
+
+			err := usecase.AddVideo(context.Background(), playlist.UserID.String(), playlist.ID.String(),
+				video.ID.String())
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				mockPlaylistRepo.AssertNotCalled(t, "AddVideo", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockPlaylistRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReorderPlaylist_InvalidID(t *testing.T) {
+	usecase, _, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	playlist := createTestPlaylist(uuid.New())
+
+	mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+
+	err := usecase.ReorderPlaylist(context.Background(), playlist.UserID.String(), playlist.ID.String(),
+		[]string{uuid.New().String(), "not-a-uuid"})
+
+	var invalid *domain.InvalidArgumentError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "video_ids", invalid.Field)
+	mockPlaylistRepo.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListPlaylistVideos_ScopesItemsToViewer(t *testing.T) {
+	ownerID, followerID := uuid.New(), uuid.New()
+	tests := []struct {
+		name     string
+		viewerID uuid.UUID
+		query    func(playlist *domain.Playlist) domain.PlaylistVideosQuery
+	}{
+		{"owner sees everything", ownerID, func(playlist *domain.Playlist) domain.PlaylistVideosQuery {
+			return domain.PlaylistVideosQuery{PlaylistID: playlist.ID, Owner: true, Limit: 10}
+		}},
+		{"anonymous sees public and unlisted", uuid.Nil, func(playlist *domain.Playlist) domain.PlaylistVideosQuery {
+			return domain.PlaylistVideosQuery{
+				PlaylistID:   playlist.ID,
+				Visibilities: []domain.Visibility{domain.VisibilityPublic, domain.VisibilityUnlisted},
+				Limit:        10,
+			}
+		}},
+		{"follower also sees follower videos", followerID,
+			func(playlist *domain.Playlist) domain.PlaylistVideosQuery {
+				return domain.PlaylistVideosQuery{
+					PlaylistID: playlist.ID,
+					Visibilities: []domain.Visibility{domain.VisibilityPublic, domain.VisibilityFollowers,
+						domain.VisibilityUnlisted},
+					Limit: 10,
+				}
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, _, mockPlaylistRepo, mockDirectory := createTestPlaylistUseCase()
+			playlist := createTestPlaylist(ownerID)
+			video := createTestVideo()
+			video.UserID = ownerID
+			query := tt.query(playlist)
+
+			viewerID := ""
+			if tt.viewerID != uuid.Nil {
+				viewerID = tt.viewerID.String()
+			}
+			mockDirectory.On("GetRelationship", mock.Anything, followerID, ownerID).
+				Return(&domain.Relationship{Following: true}, nil).Maybe()
+			mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+			mockPlaylistRepo.On("ListVideos", mock.Anything, query).Return([]*domain.Video{video}, nil)
+			mockPlaylistRepo.On("CountVideos", mock.Anything, query).Return(int64(1), nil)
+
+			videos, total, err := usecase.ListPlaylistVideos(context.Background(), playlist.ID.String(),
+				viewerID, 10, 0)
+
+			require.NoError(t, err)
+			assert.Len(t, videos, 1)
+			assert.Equal(t, int64(1), total)
+			mockPlaylistRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListPlaylistVideos_Blocked(t *testing.T) {
+	mockPlaylistRepo := &MockPlaylistRepository{}
+	mockBlockRepo := &MockBlockRepository{}
+	usecase := NewPlaylistUseCase(&MockVideoRepository{}, mockPlaylistRepo, &MockUserDirectory{}, mockBlockRepo)
+	playlist := createTestPlaylist(uuid.New())
+	viewerID := uuid.New()
+
+	mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+	mockBlockRepo.On("BlockedAmong", mock.Anything, viewerID, []uuid.UUID{playlist.UserID}).
+		Return(map[uuid.UUID]bool{playlist.UserID: true}, nil)
+
+	_, _, err := usecase.ListPlaylistVideos(context.Background(), playlist.ID.String(), viewerID.String(), 10, 0)
+
+	assert.ErrorIs(t, err, domain.ErrPlaylistNotFound)
+	mockPlaylistRepo.AssertNotCalled(t, "ListVideos", mock.Anything, mock.Anything)
+}
+
+func TestGetNextInSeries_ReturnsNextViewableItem(t *testing.T) {
+	usecase, mockVideoRepo, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	current, next := createTestVideo(), createTestVideo()
+	next.UserID = current.UserID
+	playlist := createTestPlaylist(current.UserID)
+	position := 2
+
+	mockVideoRepo.On("GetByID", mock.Anything, current.ID).Return(current, nil)
+	mockPlaylistRepo.On("ListByVideoID", mock.Anything, current.ID).
+		Return([]*domain.Playlist{playlist, createTestPlaylist(current.UserID)}, nil)
+	mockPlaylistRepo.On("Position", mock.Anything, playlist.ID, current.ID).Return(position, nil)
+	mockPlaylistRepo.On("ListVideos", mock.Anything, domain.PlaylistVideosQuery{
+		PlaylistID:    playlist.ID,
+		Visibilities:  []domain.Visibility{domain.VisibilityPublic, domain.VisibilityUnlisted},
+		AfterPosition: &position,
+		Limit:         1,
+	}).Return([]*domain.Video{next}, nil)
+
+	series, err := usecase.GetNextInSeries(context.Background(), current.ID.String(), "", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, playlist.ID, series.Playlist.ID)
+	require.NotNil(t, series.Next)
+	assert.Equal(t, next.ID, series.Next.ID)
+}
+
+func TestGetNextInSeries_EndOfSeries(t *testing.T) {
+	usecase, mockVideoRepo, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	current := createTestVideo()
+	playlist := createTestPlaylist(current.UserID)
+
+	mockVideoRepo.On("GetByID", mock.Anything, current.ID).Return(current, nil)
+	mockPlaylistRepo.On("GetByID", mock.Anything, playlist.ID).Return(playlist, nil)
+	mockPlaylistRepo.On("Position", mock.Anything, playlist.ID, current.ID).Return(4, nil)
+	mockPlaylistRepo.On("ListVideos", mock.Anything, mock.Anything).Return([]*domain.Video{}, nil)
+
+	series, err := usecase.GetNextInSeries(context.Background(), current.ID.String(), playlist.ID.String(), "")
+
+	require.NoError(t, err)
+	assert.Equal(t, playlist.ID, series.Playlist.ID)
+	assert.Nil(t, series.Next)
+}
+
+func TestGetNextInSeries_NotInAnyPlaylist(t *testing.T) {
+	usecase, mockVideoRepo, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	current := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, current.ID).Return(current, nil)
+	mockPlaylistRepo.On("ListByVideoID", mock.Anything, current.ID).Return([]*domain.Playlist{}, nil)
+
+	series, err := usecase.GetNextInSeries(context.Background(), current.ID.String(), "", "")
+
+	require.NoError(t, err)
+	assert.Nil(t, series.Playlist)
+	assert.Nil(t, series.Next)
+}
+
+func TestGetNextInSeries_HiddenCurrentVideo(t *testing.T) {
+	usecase, mockVideoRepo, mockPlaylistRepo, _ := createTestPlaylistUseCase()
+	current := createTestVideo()
+	current.Visibility = domain.VisibilityPrivate
+
+	mockVideoRepo.On("GetByID", mock.Anything, current.ID).Return(current, nil)
+
+	_, err := usecase.GetNextInSeries(context.Background(), current.ID.String(), "", "")
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	mockPlaylistRepo.AssertNotCalled(t, "ListByVideoID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,430 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var DefaultRenditions = []domain.Rendition{
+	{Name: "360p", Height: 360, VideoBitrate: "800k", AudioBitrate: "96k"},
+	{Name: "720p", Height: 720, VideoBitrate: "2500k", AudioBitrate: "128k"},
+	{Name: "1080p", Height: 1080, VideoBitrate: "5000k", AudioBitrate: "192k"},
+}
+
+type ProcessingUseCase interface {
+	ProcessNext(ctx context.Context) (bool, error)
+}
+
+type ProcessingPolicy struct {
+	Renditions     []domain.Rendition
+	WorkDir        string
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	PreviewLength  time.Duration
+	PreviewWidth   int
+	PreviewFPS     int
+	HLSSegment     time.Duration
+	// HeartbeatInterval is how often a running job's lock is renewed. It
+	// must be well below the queue's lock timeout.
+	HeartbeatInterval time.Duration
+}
+
+const defaultCoverTime = time.Second
+
+type processingUseCase struct {
+	jobQueue      domain.JobQueue
+	videoRepo     domain.VideoRepository
+	renditionRepo domain.VideoRenditionRepository
+	storage       domain.ObjectStorage
+	transcoder    domain.Transcoder
+	policy        ProcessingPolicy
+}
+
+func NewProcessingUseCase(
+	jobQueue domain.JobQueue,
+	videoRepo domain.VideoRepository,
+	renditionRepo domain.VideoRenditionRepository,
+	storage domain.ObjectStorage,
+	transcoder domain.Transcoder,
+	policy ProcessingPolicy,
+) ProcessingUseCase {
+	return &processingUseCase{
+		jobQueue:      jobQueue,
+		videoRepo:     videoRepo,
+		renditionRepo: renditionRepo,
+		storage:       storage,
+		transcoder:    transcoder,
+		policy:        policy,
+	}
+}
+
+func (usecase *processingUseCase) ProcessNext(ctx context.Context) (bool, error) {
+	job, err := usecase.jobQueue.Dequeue(ctx, []domain.JobType{
+		domain.JobTypeTranscode,
+		domain.JobTypeThumbnail,
+	})
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	stopHeartbeat := usecase.keepLocked(ctx, job.ID)
+	jobErr := usecase.runJob(ctx, job)
+	stopHeartbeat()
+	if jobErr == nil {
+		return true, usecase.jobQueue.Complete(ctx, job.ID)
+	}
+
+	if job.Attempts < job.MaxAttempts {
+		runAt := time.Now().Add(usecase.retryDelay(job.Attempts))
+		return true, usecase.jobQueue.Retry(ctx, job.ID, runAt, jobErr.Error())
+	}
+
+	if err := usecase.jobQueue.Fail(ctx, job.ID, jobErr.Error()); err != nil {
+		return true, err
+	}
+	// Only a failed transcode leaves the video unplayable. Thumbnail jobs also
+	// run for videos that are already ready, which keep their old poster.
+	if job.Type != domain.JobTypeTranscode {
+		return true, nil
+	}
+	return true, usecase.videoRepo.UpdateProcessingStatus(ctx, job.VideoID, domain.ProcessingStatusFailed)
+}
+
+// keepLocked renews the job's lock until the returned function is called, so
+// a transcode that outlasts the lock timeout is not run twice.
+func (usecase *processingUseCase) keepLocked(ctx context.Context, jobID uuid.UUID) func() {
+	if usecase.policy.HeartbeatInterval <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(usecase.policy.HeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// A missed heartbeat is retried on the next tick; the lock
+				// only lapses if every renewal fails for a whole timeout.
+				_ = usecase.jobQueue.Heartbeat(ctx, jobID)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (usecase *processingUseCase) runJob(ctx context.Context, job *domain.Job) error {
+	switch job.Type {
+	case domain.JobTypeTranscode:
+		return usecase.transcode(ctx, job)
+	case domain.JobTypeThumbnail:
+		return usecase.thumbnail(ctx, job)
+	default:
+		return fmt.Errorf("unknown job type %q", job.Type)
+	}
+}
+
+func (usecase *processingUseCase) retryDelay(attempt int) time.Duration {
+	return backoffDelay(usecase.policy.RetryBaseDelay, usecase.policy.RetryMaxDelay, attempt)
+}
+
+func backoffDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+func (usecase *processingUseCase) transcode(ctx context.Context, job *domain.Job) error {
+	video, err := usecase.videoRepo.GetByID(ctx, job.VideoID)
+	if err != nil {
+		return err
+	}
+
+	err = usecase.videoRepo.UpdateProcessingStatus(ctx, video.ID, domain.ProcessingStatusProcessing)
+	if err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp(usecase.policy.WorkDir, "transcode-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath, err := usecase.fetchSource(ctx, video, workDir)
+	if err != nil {
+		return err
+	}
+
+	info, err := usecase.transcoder.Probe(ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	prefix := videoMediaPrefix(video)
+	var renditions []*domain.VideoRendition
+	for i, rendition := range usecase.policy.Renditions {
+		if i > 0 && rendition.Height > info.Height {
+			continue
+		}
+
+		outputPath := filepath.Join(workDir, rendition.Name+".mp4")
+		if err := usecase.transcoder.Transcode(ctx, sourcePath, outputPath, rendition); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s.mp4", prefix, rendition.Name)
+		if err := usecase.upload(ctx, outputPath, key); err != nil {
+			return err
+		}
+
+		hlsDir := filepath.Join(workDir, "hls", rendition.Name)
+		if err := os.MkdirAll(hlsDir, 0o755); err != nil {
+			return err
+		}
+		err = usecase.transcoder.PackageHLS(ctx, outputPath, hlsDir, usecase.policy.HLSSegment)
+		if err != nil {
+			return err
+		}
+		if err := usecase.uploadDir(ctx, hlsDir, fmt.Sprintf("%s/hls/%s", prefix, rendition.Name)); err != nil {
+			return err
+		}
+
+		videoRendition := &domain.VideoRendition{
+			VideoID:    video.ID,
+			Name:       rendition.Name,
+			Width:      scaledWidth(info, rendition.Height),
+			Height:     rendition.Height,
+			Bitrate:    rendition.VideoBitrate,
+			Bandwidth:  parseBitrate(rendition.VideoBitrate) + parseBitrate(rendition.AudioBitrate),
+			StorageKey: key,
+			URL:        usecase.storage.URL(key),
+		}
+		if err := usecase.renditionRepo.Upsert(ctx, videoRendition); err != nil {
+			return err
+		}
+		renditions = append(renditions, videoRendition)
+	}
+
+	masterKey := prefix + "/hls/master.m3u8"
+	master := strings.NewReader(buildMasterPlaylist(renditions))
+	if _, err := usecase.storage.Put(ctx, masterKey, master); err != nil {
+		return err
+	}
+	if err := usecase.videoRepo.UpdatePlaylistURL(ctx, video.ID, usecase.storage.URL(masterKey)); err != nil {
+		return err
+	}
+
+	if err := usecase.generateThumbnails(ctx, video, sourcePath, info, workDir); err != nil {
+		return err
+	}
+
+	return usecase.videoRepo.UpdateProcessingStatus(ctx, video.ID, domain.ProcessingStatusReady)
+}
+
+func (usecase *processingUseCase) thumbnail(ctx context.Context, job *domain.Job) error {
+	video, err := usecase.videoRepo.GetByID(ctx, job.VideoID)
+	if err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp(usecase.policy.WorkDir, "thumbnail-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath, err := usecase.fetchSource(ctx, video, workDir)
+	if err != nil {
+		return err
+	}
+
+	info, err := usecase.transcoder.Probe(ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	return usecase.generateThumbnails(ctx, video, sourcePath, info, workDir)
+}
+
+func (usecase *processingUseCase) generateThumbnails(ctx context.Context, video *domain.Video,
+	sourcePath string, info *domain.MediaInfo, workDir string) error {
+
+	duration := info.Duration
+	if duration <= 0 {
+		duration = time.Duration(video.Duration) * time.Second
+	}
+
+	coverTime := time.Duration(video.CoverTimeMs) * time.Millisecond
+	if video.CoverTimeMs <= 0 || coverTime >= duration {
+		coverTime = min(defaultCoverTime, duration/2)
+	}
+
+	posterPath := filepath.Join(workDir, "thumbnail.jpg")
+	if err := usecase.transcoder.ExtractFrame(ctx, sourcePath, posterPath, coverTime); err != nil {
+		return err
+	}
+
+	previewStart := coverTime
+	if previewStart+usecase.policy.PreviewLength > duration {
+		previewStart = max(0, duration-usecase.policy.PreviewLength)
+	}
+
+	previewPath := filepath.Join(workDir, "preview.gif")
+	err := usecase.transcoder.CreatePreview(ctx, sourcePath, previewPath, domain.PreviewSpec{
+		Start:  previewStart,
+		Length: min(usecase.policy.PreviewLength, duration),
+		Width:  usecase.policy.PreviewWidth,
+		FPS:    usecase.policy.PreviewFPS,
+	})
+	if err != nil {
+		return err
+	}
+
+	prefix := videoMediaPrefix(video)
+	posterKey := fmt.Sprintf("%s/thumbnail-%d.jpg", prefix, coverTime.Milliseconds())
+	if err := usecase.upload(ctx, posterPath, posterKey); err != nil {
+		return err
+	}
+
+	previewKey := fmt.Sprintf("%s/preview-%d.gif", prefix, coverTime.Milliseconds())
+	if err := usecase.upload(ctx, previewPath, previewKey); err != nil {
+		return err
+	}
+
+	return usecase.videoRepo.UpdateThumbnails(ctx, video.ID,
+		usecase.storage.URL(posterKey), usecase.storage.URL(previewKey))
+}
+
+// fetchSource copies the stored original into workDir. Videos registered by
+// URL have no stored object and are never processed: their URL is supplied
+// by the client, and handing it to ffmpeg would let it read internal hosts
+// or local files into public media.
+func (usecase *processingUseCase) fetchSource(ctx context.Context, video *domain.Video,
+	workDir string) (string, error) {
+
+	if video.StorageKey == "" {
+		return "", domain.ErrVideoNotStored
+	}
+
+	sourcePath := filepath.Join(workDir, "source"+filepath.Ext(video.StorageKey))
+	if err := usecase.download(ctx, video.StorageKey, sourcePath); err != nil {
+		return "", err
+	}
+	return sourcePath, nil
+}
+
+func (usecase *processingUseCase) uploadDir(ctx context.Context, dir, prefix string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err := usecase.upload(ctx, filepath.Join(dir, entry.Name()), prefix+"/"+entry.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (usecase *processingUseCase) download(ctx context.Context, key, path string) error {
+	reader, err := usecase.storage.Open(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (usecase *processingUseCase) upload(ctx context.Context, path, key string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = usecase.storage.Put(ctx, key, file)
+	return err
+}
+
+func buildMasterPlaylist(renditions []*domain.VideoRendition) string {
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+
+	for _, rendition := range renditions {
+		fmt.Fprintf(&playlist, "#EXT-X-STREAM-INF:BANDWIDTH=%d", rendition.Bandwidth)
+		if rendition.Width > 0 {
+			fmt.Fprintf(&playlist, ",RESOLUTION=%dx%d", rendition.Width, rendition.Height)
+		}
+		fmt.Fprintf(&playlist, ",NAME=\"%s\"\n%s/index.m3u8\n", rendition.Name, rendition.Name)
+	}
+
+	return playlist.String()
+}
+
+func scaledWidth(info *domain.MediaInfo, height int) int {
+	if info.Width == 0 || info.Height == 0 {
+		return 0
+	}
+	width := int(math.Round(float64(info.Width) * float64(height) / float64(info.Height)))
+	return width + width%2
+}
+
+func parseBitrate(bitrate string) int {
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(bitrate, "k"):
+		multiplier = 1_000
+	case strings.HasSuffix(bitrate, "M"):
+		multiplier = 1_000_000
+	}
+
+	value, err := strconv.Atoi(strings.TrimRight(bitrate, "kM"))
+	if err != nil {
+		return 0
+	}
+	return value * multiplier
+}
+
+// videoMediaPrefix is where every object derived from the video's source is
+// stored.
+func videoMediaPrefix(video *domain.Video) string {
+	return fmt.Sprintf("videos/%s/%s", video.UserID, video.ID)
+}
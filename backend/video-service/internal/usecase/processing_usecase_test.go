@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+	"video-service/internal/infrastructure/media"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockVideoRenditionRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoRenditionRepository) Upsert(ctx context.Context,
+	rendition *domain.VideoRendition) error {
+	args := m.Called(ctx, rendition)
+	return args.Error(0)
+}
+
+func (m *MockVideoRenditionRepository) ListByVideoID(ctx context.Context,
+	videoID uuid.UUID) ([]*domain.VideoRendition, error) {
+
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.VideoRendition), args.Error(1)
+}
+
+func createTestProcessingUseCase(t *testing.T, transcoder domain.Transcoder) (*processingUseCase,
+	*MockJobQueue, *MockVideoRepository, *MockVideoRenditionRepository, *MockObjectStorage) {
+
+	mockJobQueue := &MockJobQueue{}
+	mockVideoRepository := &MockVideoRepository{}
+	mockRenditionRepository := &MockVideoRenditionRepository{}
+	mockStorage := &MockObjectStorage{}
+
+	usecase := &processingUseCase{
+		jobQueue:      mockJobQueue,
+		videoRepo:     mockVideoRepository,
+		renditionRepo: mockRenditionRepository,
+		storage:       mockStorage,
+		transcoder:    transcoder,
+		policy: ProcessingPolicy{
+			Renditions:     DefaultRenditions,
+			WorkDir:        t.TempDir(),
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  10 * time.Second,
+			PreviewLength:  3 * time.Second,
+			PreviewWidth:   320,
+			PreviewFPS:     10,
+			HLSSegment:     4 * time.Second,
+		},
+	}
+
+	return usecase, mockJobQueue, mockVideoRepository, mockRenditionRepository, mockStorage
+}
+
+func createTestTranscodeJob(videoID uuid.UUID) *domain.Job {
+	return &domain.Job{
+		ID:          uuid.New(),
+		Type:        domain.JobTypeTranscode,
+		VideoID:     videoID,
+		Status:      domain.JobStatusRunning,
+		Attempts:    1,
+		MaxAttempts: 3,
+	}
+}
+
+func TestProcessNext_NoJob(t *testing.T) {
+	usecase, mockJobQueue, _, _, _ := createTestProcessingUseCase(t, media.NewFakeTranscoder(nil))
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(nil, nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, processed)
+}
+
+func TestProcessNext_TranscodesRenditionsUpToSourceHeight(t *testing.T) {
+	transcoder := media.NewFakeTranscoder(&domain.MediaInfo{Width: 1280, Height: 720, Duration: 20 * time.Second})
+	usecase, mockJobQueue, mockVideoRepository, mockRenditionRepository, mockStorage :=
+		createTestProcessingUseCase(t, transcoder)
+
+	video := createTestVideo()
+	video.StorageKey = "videos/u/source.mp4"
+	job := createTestTranscodeJob(video.ID)
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockVideoRepository.On("UpdateProcessingStatus", mock.Anything, video.ID, domain.ProcessingStatusProcessing).Return(nil)
+	mockStorage.On("Open", mock.Anything, video.StorageKey).Return(newObjectReader(createTestMP4()), nil)
+	mockStorage.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(int64(64), nil)
+	mockStorage.On("URL", mock.Anything).Return("https://cdn.example.com/rendition.mp4")
+	mockRenditionRepository.On("Upsert", mock.Anything, mock.AnythingOfType("*domain.VideoRendition")).Return(nil)
+	mockVideoRepository.On("UpdatePlaylistURL", mock.Anything, video.ID, "https://cdn.example.com/rendition.mp4").Return(nil)
+	mockVideoRepository.On("UpdateThumbnails", mock.Anything, video.ID, mock.Anything, mock.Anything).Return(nil)
+	mockVideoRepository.On("UpdateProcessingStatus", mock.Anything, video.ID, domain.ProcessingStatusReady).Return(nil)
+	mockJobQueue.On("Complete", mock.Anything, job.ID).Return(nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+	require.Len(t, transcoder.Transcoded, 2)
+	assert.Equal(t, "360p", transcoder.Transcoded[0].Name)
+	assert.Equal(t, "720p", transcoder.Transcoded[1].Name)
+	mockStorage.AssertCalled(t, "Put", mock.Anything,
+		"videos/"+video.UserID.String()+"/"+video.ID.String()+"/720p.mp4", mock.Anything)
+	mockRenditionRepository.AssertNumberOfCalls(t, "Upsert", 2)
+	assert.Equal(t, []time.Duration{time.Second}, transcoder.Frames)
+	assert.Len(t, transcoder.Packaged, 2)
+	hlsPrefix := "videos/" + video.UserID.String() + "/" + video.ID.String() + "/hls/"
+	mockStorage.AssertCalled(t, "Put", mock.Anything, hlsPrefix+"master.m3u8", mock.Anything)
+	mockStorage.AssertCalled(t, "Put", mock.Anything, hlsPrefix+"720p/index.m3u8", mock.Anything)
+	mockStorage.AssertCalled(t, "Put", mock.Anything, hlsPrefix+"720p/segment_000.ts", mock.Anything)
+	mockVideoRepository.AssertExpectations(t)
+	mockJobQueue.AssertExpectations(t)
+}
+
+func TestProcessNext_ThumbnailJobUsesCoverTime(t *testing.T) {
+	transcoder := media.NewFakeTranscoder(&domain.MediaInfo{Height: 720, Duration: 10 * time.Second})
+	usecase, mockJobQueue, mockVideoRepository, _, mockStorage := createTestProcessingUseCase(t, transcoder)
+
+	video := createTestVideo()
+	video.StorageKey = "videos/u/source.mp4"
+	video.CoverTimeMs = 8500
+	job := createTestTranscodeJob(video.ID)
+	job.Type = domain.JobTypeThumbnail
+
+	posterKey := "videos/" + video.UserID.String() + "/" + video.ID.String() + "/thumbnail-8500.jpg"
+	previewKey := "videos/" + video.UserID.String() + "/" + video.ID.String() + "/preview-8500.gif"
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockStorage.On("Open", mock.Anything, video.StorageKey).Return(newObjectReader(createTestMP4()), nil)
+	mockStorage.On("Put", mock.Anything, posterKey, mock.Anything).Return(int64(10), nil)
+	mockStorage.On("Put", mock.Anything, previewKey, mock.Anything).Return(int64(10), nil)
+	mockStorage.On("URL", posterKey).Return("https://cdn/thumb.jpg")
+	mockStorage.On("URL", previewKey).Return("https://cdn/preview.gif")
+	mockVideoRepository.On("UpdateThumbnails", mock.Anything, video.ID,
+		"https://cdn/thumb.jpg", "https://cdn/preview.gif").Return(nil)
+	mockJobQueue.On("Complete", mock.Anything, job.ID).Return(nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, []time.Duration{8500 * time.Millisecond}, transcoder.Frames)
+	require.Len(t, transcoder.Previews, 1)
+	assert.Equal(t, 7*time.Second, transcoder.Previews[0].Start)
+	assert.Equal(t, 3*time.Second, transcoder.Previews[0].Length)
+	assert.Empty(t, transcoder.Transcoded)
+	mockStorage.AssertExpectations(t)
+	mockVideoRepository.AssertExpectations(t)
+	mockJobQueue.AssertExpectations(t)
+}
+
+func TestProcessNext_ThumbnailJobRejectsURLVideos(t *testing.T) {
+	transcoder := media.NewFakeTranscoder(&domain.MediaInfo{Height: 720})
+	usecase, mockJobQueue, mockVideoRepository, _, mockStorage := createTestProcessingUseCase(t, transcoder)
+
+	video := createTestVideo()
+	video.VideoURL = "file:///etc/passwd"
+	job := createTestTranscodeJob(video.ID)
+	job.Type = domain.JobTypeThumbnail
+	job.Attempts = job.MaxAttempts
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockJobQueue.On("Fail", mock.Anything, job.ID, domain.ErrVideoNotStored.Error()).Return(nil)
+
+	_, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	mockJobQueue.AssertExpectations(t)
+	mockStorage.AssertNotCalled(t, "Open", mock.Anything, mock.Anything)
+	mockStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, transcoder.Frames)
+}
+
+func TestProcessNext_RetriesWithBackoff(t *testing.T) {
+	transcoder := media.NewFakeTranscoder(&domain.MediaInfo{Height: 720})
+	transcoder.Err = errors.New("ffmpeg crashed")
+	usecase, mockJobQueue, mockVideoRepository, _, mockStorage := createTestProcessingUseCase(t, transcoder)
+
+	video := createTestVideo()
+	video.StorageKey = "videos/u/source.mp4"
+	job := createTestTranscodeJob(video.ID)
+	job.Attempts = 2
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockVideoRepository.On("UpdateProcessingStatus", mock.Anything, video.ID, domain.ProcessingStatusProcessing).Return(nil)
+	mockStorage.On("Open", mock.Anything, mock.Anything).Return(newObjectReader(createTestMP4()), nil)
+	mockJobQueue.On("Retry", mock.Anything, job.ID, mock.MatchedBy(func(runAt time.Time) bool {
+		delay := time.Until(runAt)
+		return delay > time.Second && delay <= 2*time.Second
+	}), "ffmpeg crashed").Return(nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+	mockJobQueue.AssertExpectations(t)
+}
+
+func TestProcessNext_FailsAfterMaxAttempts(t *testing.T) {
+	usecase, mockJobQueue, mockVideoRepository, _, _ := createTestProcessingUseCase(t, media.NewFakeTranscoder(nil))
+
+	videoID := uuid.New()
+	job := createTestTranscodeJob(videoID)
+	job.Attempts = job.MaxAttempts
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, videoID).Return(nil, errors.New("database error"))
+	mockJobQueue.On("Fail", mock.Anything, job.ID, "database error").Return(nil)
+	mockVideoRepository.On("UpdateProcessingStatus", mock.Anything, videoID, domain.ProcessingStatusFailed).Return(nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+	mockJobQueue.AssertExpectations(t)
+	mockVideoRepository.AssertExpectations(t)
+}
+
+func TestProcessNext_FailedThumbnailJobLeavesVideoReady(t *testing.T) {
+	transcoder := media.NewFakeTranscoder(&domain.MediaInfo{Height: 720, Duration: 10 * time.Second})
+	transcoder.Err = errors.New("ffmpeg crashed")
+	usecase, mockJobQueue, mockVideoRepository, _, mockStorage := createTestProcessingUseCase(t, transcoder)
+
+	video := createTestVideo()
+	video.StorageKey = "videos/u/source.mp4"
+	video.ProcessingStatus = domain.ProcessingStatusReady
+	job := createTestTranscodeJob(video.ID)
+	job.Type = domain.JobTypeThumbnail
+	job.Attempts = job.MaxAttempts
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(job, nil)
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockStorage.On("Open", mock.Anything, video.StorageKey).Return(newObjectReader(createTestMP4()), nil)
+	mockJobQueue.On("Fail", mock.Anything, job.ID, "ffmpeg crashed").Return(nil)
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, processed)
+	mockJobQueue.AssertExpectations(t)
+	mockVideoRepository.AssertNotCalled(t, "UpdateProcessingStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProcessNext_DequeueError(t *testing.T) {
+	usecase, mockJobQueue, _, _, _ := createTestProcessingUseCase(t, media.NewFakeTranscoder(nil))
+
+	mockJobQueue.On("Dequeue", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+	processed, err := usecase.ProcessNext(context.Background())
+
+	assert.Error(t, err)
+	assert.False(t, processed)
+}
+
+func TestKeepLocked_RenewsUntilStopped(t *testing.T) {
+	usecase, mockJobQueue, _, _, _ := createTestProcessingUseCase(t, media.NewFakeTranscoder(nil))
+	usecase.policy.HeartbeatInterval = time.Millisecond
+	jobID := uuid.New()
+
+	heartbeats := make(chan struct{}, 1)
+	mockJobQueue.On("Heartbeat", mock.Anything, jobID).Run(func(mock.Arguments) {
+		select {
+		case heartbeats <- struct{}{}:
+		default:
+		}
+	}).Return(nil)
+
+	stop := usecase.keepLocked(context.Background(), jobID)
+	select {
+	case <-heartbeats:
+	case <-time.After(time.Second):
+		t.Fatal("job lock was not renewed")
+	}
+	stop()
+
+	renewals := len(mockJobQueue.Calls)
+	time.Sleep(5 * time.Millisecond)
+	assert.Len(t, mockJobQueue.Calls, renewals)
+}
+
+func TestRetryDelay(t *testing.T) {
+	usecase, _, _, _, _ := createTestProcessingUseCase(t, media.NewFakeTranscoder(nil))
+
+	assert.Equal(t, time.Second, usecase.retryDelay(1))
+	assert.Equal(t, 2*time.Second, usecase.retryDelay(2))
+	assert.Equal(t, 8*time.Second, usecase.retryDelay(4))
+	assert.Equal(t, 10*time.Second, usecase.retryDelay(10))
+}
+
+func TestBuildMasterPlaylist(t *testing.T) {
+	playlist := buildMasterPlaylist([]*domain.VideoRendition{
+		{Name: "360p", Width: 640, Height: 360, Bandwidth: 896000},
+		{Name: "720p", Height: 720, Bandwidth: 2628000},
+	})
+
+	assert.Equal(t, "#EXTM3U\n#EXT-X-VERSION:3\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=896000,RESOLUTION=640x360,NAME=\"360p\"\n360p/index.m3u8\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=2628000,NAME=\"720p\"\n720p/index.m3u8\n", playlist)
+}
+
+func TestScaledWidth(t *testing.T) {
+	assert.Equal(t, 1280, scaledWidth(&domain.MediaInfo{Width: 1920, Height: 1080}, 720))
+	assert.Equal(t, 360, scaledWidth(&domain.MediaInfo{Width: 1080, Height: 1920}, 640))
+	assert.Equal(t, 0, scaledWidth(&domain.MediaInfo{}, 720))
+}
+
+func TestParseBitrate(t *testing.T) {
+	assert.Equal(t, 2500000, parseBitrate("2500k"))
+	assert.Equal(t, 5000000, parseBitrate("5M"))
+	assert.Equal(t, 800, parseBitrate("800"))
+	assert.Equal(t, 0, parseBitrate("fast"))
+}
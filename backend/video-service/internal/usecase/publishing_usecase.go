@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"video-service/internal/domain"
+)
+
+type PublishingPolicy struct {
+	BatchSize int
+}
+
+type PublishingUseCase interface {
+	ListDrafts(ctx context.Context, userID string, limit, offset int) ([]*domain.Video, int64, error)
+	PublishVideo(ctx context.Context, userID, videoID string) (*domain.Video, error)
+	ScheduleVideo(ctx context.Context, userID, videoID string, publishAt time.Time) (*domain.Video, error)
+	CancelSchedule(ctx context.Context, userID, videoID string) (*domain.Video, error)
+	PublishDue(ctx context.Context) error
+}
+
+type publishingUseCase struct {
+	videoRepo       domain.VideoRepository
+	publicationRepo domain.PublicationRepository
+	tagRepo         domain.TagRepository
+	directory       domain.UserDirectory
+	blockRepo       domain.BlockRepository
+	transactor      domain.Transactor
+	outbox          domain.OutboxRepository
+	notifier        *Notifier
+	policy          PublishingPolicy
+}
+
+func NewPublishingUseCase(
+	videoRepo domain.VideoRepository,
+	publicationRepo domain.PublicationRepository,
+	tagRepo domain.TagRepository,
+	directory domain.UserDirectory,
+	blockRepo domain.BlockRepository,
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	notifier *Notifier,
+	policy PublishingPolicy,
+) PublishingUseCase {
+	return &publishingUseCase{
+		videoRepo:       videoRepo,
+		publicationRepo: publicationRepo,
+		tagRepo:         tagRepo,
+		directory:       directory,
+		blockRepo:       blockRepo,
+		transactor:      transactor,
+		outbox:          outbox,
+		notifier:        notifier,
+		policy:          policy,
+	}
+}
+
+// setInitialPublication starts a new video as a draft, or publishes it
+// straight away.
+func setInitialPublication(video *domain.Video, draft bool) {
+	if draft {
+		video.PublicationState = domain.PublicationStateDraft
+		return
+	}
+	now := time.Now()
+	video.PublicationState = domain.PublicationStatePublished
+	video.PublishAt = &now
+}
+
+func (usecase *publishingUseCase) ListDrafts(ctx context.Context, userID string, limit, offset int) (
+	[]*domain.Video, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	videos, err := usecase.publicationRepo.ListDrafts(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.publicationRepo.CountDrafts(ctx, userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return videos, total, nil
+}
+
+func (usecase *publishingUseCase) PublishVideo(ctx context.Context, userID, videoID string) (
+	*domain.Video, error) {
+
+	video, err := usecase.ownedVideo(ctx, userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Published() {
+		return nil, domain.ErrVideoAlreadyPublished
+	}
+
+	from := video.PublicationState
+	now := time.Now()
+	video.PublicationState = domain.PublicationStatePublished
+	video.PublishAt = &now
+	video.UpdatedAt = now
+
+	var notifications []*domain.Notification
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := usecase.publicationRepo.SetPublication(ctx, video, from); err != nil {
+			return err
+		}
+		var err error
+		notifications, err = usecase.appendPublished(ctx, video)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.notifier.Deliver(notifications...)
+	return video, nil
+}
+
+// ScheduleVideo sets or moves the time a draft or scheduled video goes live.
+func (usecase *publishingUseCase) ScheduleVideo(ctx context.Context, userID, videoID string,
+	publishAt time.Time) (*domain.Video, error) {
+
+	video, err := usecase.ownedVideo(ctx, userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.Published() {
+		return nil, domain.ErrVideoAlreadyPublished
+	}
+	if !publishAt.After(time.Now()) {
+		return nil, domain.ErrPublishAtNotInFuture
+	}
+
+	from := video.PublicationState
+	video.PublicationState = domain.PublicationStateScheduled
+	video.PublishAt = &publishAt
+	video.UpdatedAt = time.Now()
+
+	if err := usecase.publicationRepo.SetPublication(ctx, video, from); err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+// CancelSchedule turns a scheduled video back into a draft.
+func (usecase *publishingUseCase) CancelSchedule(ctx context.Context, userID, videoID string) (
+	*domain.Video, error) {
+
+	video, err := usecase.ownedVideo(ctx, userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.PublicationState != domain.PublicationStateScheduled {
+		return nil, domain.ErrVideoNotScheduled
+	}
+
+	video.PublicationState = domain.PublicationStateDraft
+	video.PublishAt = nil
+	video.UpdatedAt = time.Now()
+
+	if err := usecase.publicationRepo.SetPublication(ctx, video, domain.PublicationStateScheduled); err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+// PublishDue publishes scheduled videos whose time has come, one batch at a
+// time until none are left. Each batch commits together with its events and
+// mention notifications, so a video is either published with them or left
+// for the next run.
+func (usecase *publishingUseCase) PublishDue(ctx context.Context) error {
+	for {
+		var published int
+		var notifications []*domain.Notification
+		err := usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			videos, err := usecase.publicationRepo.PublishDue(ctx, time.Now(), usecase.policy.BatchSize)
+			if err != nil {
+				return err
+			}
+			for _, video := range videos {
+				mentioned, err := usecase.appendPublished(ctx, video)
+				if err != nil {
+					return fmt.Errorf("failed to publish video %s: %w", video.ID, err)
+				}
+				notifications = append(notifications, mentioned...)
+			}
+			published = len(videos)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		usecase.notifier.Deliver(notifications...)
+
+		if published == 0 || published < usecase.policy.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (usecase *publishingUseCase) ownedVideo(ctx context.Context, userID, videoID string) (
+	*domain.Video, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+	return video, nil
+}
+
+// appendPublished records the video's published event and, since nobody was
+// notified while it was a draft, the notifications for the users its
+// description mentions.
+func (usecase *publishingUseCase) appendPublished(ctx context.Context, video *domain.Video) (
+	[]*domain.Notification, error) {
+
+	err := usecase.outbox.Append(ctx, &domain.VideoEvent{
+		Type:    domain.EventVideoPublished,
+		VideoID: video.ID,
+		UserID:  video.UserID,
+		Video:   video,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := usecase.tagRepo.GetVideoMentions(ctx, video.ID)
+	if err != nil {
+		return nil, err
+	}
+	mentioned, err := mentionRecipients(ctx, usecase.directory, usecase.blockRepo, video, mentions)
+	if err != nil {
+		return nil, err
+	}
+	return recordMentions(ctx, usecase.notifier, video, mentioned)
+}
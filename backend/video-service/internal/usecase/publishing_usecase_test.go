@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockPublicationRepository struct {
+	mock.Mock
+}
+
+func (m *MockPublicationRepository) ListDrafts(ctx context.Context, userID uuid.UUID, limit, offset int) (
+	[]*domain.Video, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockPublicationRepository) CountDrafts(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPublicationRepository) SetPublication(ctx context.Context, video *domain.Video,
+	from domain.PublicationState) error {
+
+	args := m.Called(ctx, video, from)
+	return args.Error(0)
+}
+
+func (m *MockPublicationRepository) PublishDue(ctx context.Context, now time.Time, limit int) (
+	[]*domain.Video, error) {
+
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func createTestPublishingUseCase() (*publishingUseCase, *MockVideoRepository, *MockPublicationRepository,
+	*MockOutboxRepository) {
+
+	mockVideoRepo := &MockVideoRepository{}
+	mockPublicationRepo := &MockPublicationRepository{}
+	mockTagRepo := &MockTagRepository{}
+	mockTagRepo.On("GetVideoMentions", mock.Anything, mock.Anything).Return([]*domain.VideoMention{}, nil).Maybe()
+	mockOutbox := &MockOutboxRepository{}
+	notifier, _, _ := createTestNotifier()
+
+	usecase := &publishingUseCase{
+		videoRepo:       mockVideoRepo,
+		publicationRepo: mockPublicationRepo,
+		tagRepo:         mockTagRepo,
+		directory:       &MockUserDirectory{},
+		blockRepo:       noBlocks(),
+		transactor:      fakeTransactor{},
+		outbox:          mockOutbox,
+		notifier:        notifier,
+		policy:          PublishingPolicy{BatchSize: 2},
+	}
+	return usecase, mockVideoRepo, mockPublicationRepo, mockOutbox
+}
+
+// mentionsOf makes the use case's tag repository report mentions of the
+// given users for the video.
+func mentionsOf(usecase *publishingUseCase, videoID uuid.UUID, userIDs ...uuid.UUID) {
+	mentions := make([]*domain.VideoMention, len(userIDs))
+	for i, userID := range userIDs {
+		mentions[i] = &domain.VideoMention{VideoID: videoID, UserID: userID, Username: userID.String()}
+	}
+	mockTagRepo := &MockTagRepository{}
+	mockTagRepo.On("GetVideoMentions", mock.Anything, videoID).Return(mentions, nil)
+	mockTagRepo.On("GetVideoMentions", mock.Anything, mock.Anything).Return([]*domain.VideoMention{}, nil).Maybe()
+	usecase.tagRepo = mockTagRepo
+}
+
+func createDraftVideo() *domain.Video {
+	video := createTestVideo()
+	video.PublicationState = domain.PublicationStateDraft
+	return video
+}
+
+func TestSetInitialPublication(t *testing.T) {
+	draft := &domain.Video{}
+	setInitialPublication(draft, true)
+	assert.Equal(t, domain.PublicationStateDraft, draft.PublicationState)
+	assert.Nil(t, draft.PublishAt)
+
+	published := &domain.Video{}
+	setInitialPublication(published, false)
+	assert.True(t, published.Published())
+	assert.NotNil(t, published.PublishAt)
+}
+
+func TestPublishVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockPublicationRepo, mockOutbox := createTestPublishingUseCase()
+	video := createDraftVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPublicationRepo.On("SetPublication", mock.Anything, video, domain.PublicationStateDraft).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.VideoEvent) bool {
+		return event.Type == domain.EventVideoPublished && event.VideoID == video.ID
+	})).Return(nil)
+
+	published, err := usecase.PublishVideo(context.Background(), video.UserID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	assert.True(t, published.Published())
+	assert.NotNil(t, published.PublishAt)
+	mockPublicationRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestPublishVideo_NotifiesMentionedUsers(t *testing.T) {
+	usecase, mockVideoRepo, mockPublicationRepo, mockOutbox := createTestPublishingUseCase()
+	notifier, mockNotificationRepo, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+	video := createDraftVideo()
+	aliceID := uuid.New()
+	mentionsOf(usecase, video.ID, aliceID)
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPublicationRepo.On("SetPublication", mock.Anything, video, domain.PublicationStateDraft).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := usecase.PublishVideo(context.Background(), video.UserID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	mockNotificationRepo.AssertNumberOfCalls(t, "Record", 1)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == aliceID && notification.Type == domain.NotificationTypeMention &&
+			*notification.VideoID == video.ID && notification.LastActorID == video.UserID
+	}))
+}
+
+func TestPublishVideo_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID func(video *domain.Video) uuid.UUID
+		state  domain.PublicationState
+		err    error
+	}{
+		{"not owner", func(*domain.Video) uuid.UUID { return uuid.New() }, domain.PublicationStateDraft,
+			domain.ErrNotVideoOwner},
+		{"already published", func(video *domain.Video) uuid.UUID { return video.UserID },
+			domain.PublicationStatePublished, domain.ErrVideoAlreadyPublished},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, mockVideoRepo, mockPublicationRepo, _ := createTestPublishingUseCase()
+			video := createTestVideo()
+			video.PublicationState = tt.state
+			mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+			_, err := usecase.PublishVideo(context.Background(), tt.userID(video).String(), video.ID.String())
+
+			assert.ErrorIs(t, err, tt.err)
+			mockPublicationRepo.AssertNotCalled(t, "SetPublication", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestScheduleVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockPublicationRepo, mockOutbox := createTestPublishingUseCase()
+	video := createDraftVideo()
+	publishAt := time.Now().Add(time.Hour)
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPublicationRepo.On("SetPublication", mock.Anything, video, domain.PublicationStateDraft).Return(nil)
+
+	scheduled, err := usecase.ScheduleVideo(context.Background(), video.UserID.String(), video.ID.String(),
+		publishAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.PublicationStateScheduled, scheduled.PublicationState)
+	assert.Equal(t, publishAt, *scheduled.PublishAt)
+	mockOutbox.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestScheduleVideo_PastTime(t *testing.T) {
+	usecase, mockVideoRepo, mockPublicationRepo, _ := createTestPublishingUseCase()
+	video := createDraftVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.ScheduleVideo(context.Background(), video.UserID.String(), video.ID.String(),
+		time.Now().Add(-time.Minute))
+
+	var invalid *domain.InvalidArgumentError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "publish_at", invalid.Field)
+	mockPublicationRepo.AssertNotCalled(t, "SetPublication", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCancelSchedule_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockPublicationRepo, _ := createTestPublishingUseCase()
+	video := createTestVideo()
+	publishAt := time.Now().Add(time.Hour)
+	video.PublicationState = domain.PublicationStateScheduled
+	video.PublishAt = &publishAt
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockPublicationRepo.On("SetPublication", mock.Anything, video, domain.PublicationStateScheduled).Return(nil)
+
+	draft, err := usecase.CancelSchedule(context.Background(), video.UserID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.PublicationStateDraft, draft.PublicationState)
+	assert.Nil(t, draft.PublishAt)
+}
+
+func TestCancelSchedule_NotScheduled(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestPublishingUseCase()
+	video := createDraftVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.CancelSchedule(context.Background(), video.UserID.String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotScheduled)
+}
+
+func TestPublishDue_EmitsEventPerVideoUntilDrained(t *testing.T) {
+	usecase, _, mockPublicationRepo, mockOutbox := createTestPublishingUseCase()
+	first, second, third := createTestVideo(), createTestVideo(), createTestVideo()
+
+	mockPublicationRepo.On("PublishDue", mock.Anything, mock.Anything, 2).
+		Return([]*domain.Video{first, second}, nil).Once()
+	mockPublicationRepo.On("PublishDue", mock.Anything, mock.Anything, 2).
+		Return([]*domain.Video{third}, nil).Once()
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.VideoEvent) bool {
+		return event.Type == domain.EventVideoPublished
+	})).Return(nil)
+
+	require.NoError(t, usecase.PublishDue(context.Background()))
+
+	mockPublicationRepo.AssertNumberOfCalls(t, "PublishDue", 2)
+	mockOutbox.AssertNumberOfCalls(t, "Append", 3)
+}
+
+func TestPublishDue_NotifiesMentionedUsersWhoCanSeeIt(t *testing.T) {
+	usecase, _, mockPublicationRepo, mockOutbox := createTestPublishingUseCase()
+	notifier, mockNotificationRepo, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+	video := createTestVideo()
+	aliceID, blockedID := uuid.New(), uuid.New()
+	mentionsOf(usecase, video.ID, aliceID, blockedID)
+	mockBlockRepo := &MockBlockRepository{}
+	mockBlockRepo.On("BlockedAmong", mock.Anything, blockedID, []uuid.UUID{video.UserID}).
+		Return(map[uuid.UUID]bool{video.UserID: true}, nil)
+	mockBlockRepo.On("BlockedAmong", mock.Anything, aliceID, []uuid.UUID{video.UserID}).
+		Return(map[uuid.UUID]bool{}, nil)
+	usecase.blockRepo = mockBlockRepo
+
+	mockPublicationRepo.On("PublishDue", mock.Anything, mock.Anything, 2).Return([]*domain.Video{video}, nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	require.NoError(t, usecase.PublishDue(context.Background()))
+
+	mockNotificationRepo.AssertNumberOfCalls(t, "Record", 1)
+	mockHub.AssertNumberOfCalls(t, "Publish", 1)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == aliceID && notification.Type == domain.NotificationTypeMention
+	}))
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+	maxSearchTerms     = 8
+)
+
+var searchTermPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+type SearchPolicy struct {
+	PopularityWeight float64
+}
+
+type SearchVideosRequest struct {
+	Query         string    `json:"query"`
+	ViewerID      string    `json:"viewer_id"`
+	CreatorID     string    `json:"creator_id"`
+	CreatedAfter  time.Time `json:"created_after"`
+	CreatedBefore time.Time `json:"created_before"`
+	MinDuration   int       `json:"min_duration"`
+	MaxDuration   int       `json:"max_duration"`
+	Limit         int       `json:"limit"`
+	Cursor        string    `json:"cursor"`
+}
+
+type SearchUseCase interface {
+	SearchVideos(ctx context.Context, req *SearchVideosRequest) ([]*domain.Video, string, error)
+}
+
+type searchUseCase struct {
+	searchRepo domain.VideoSearchRepository
+	policy     SearchPolicy
+}
+
+func NewSearchUseCase(searchRepo domain.VideoSearchRepository, policy SearchPolicy) SearchUseCase {
+	return &searchUseCase{
+		searchRepo: searchRepo,
+		policy:     policy,
+	}
+}
+
+func (usecase *searchUseCase) SearchVideos(ctx context.Context, req *SearchVideosRequest) (
+	[]*domain.Video, string, error) {
+
+	terms := parseSearchTerms(req.Query)
+	if len(terms) == 0 {
+		return nil, "", domain.ErrInvalidSearchQuery
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	query := domain.VideoSearchQuery{
+		Terms:            terms,
+		MinDuration:      req.MinDuration,
+		MaxDuration:      req.MaxDuration,
+		PopularityWeight: usecase.policy.PopularityWeight,
+		Limit:            limit + 1,
+	}
+	if req.ViewerID != "" {
+		viewerID, err := parseID(req.ViewerID, "viewer_id")
+		if err != nil {
+			return nil, "", err
+		}
+		query.ViewerID = &viewerID
+	}
+	if req.CreatorID != "" {
+		creatorID, err := parseID(req.CreatorID, "creator_id")
+		if err != nil {
+			return nil, "", err
+		}
+		query.CreatorID = &creatorID
+	}
+	if !req.CreatedAfter.IsZero() {
+		query.CreatedAfter = &req.CreatedAfter
+	}
+	if !req.CreatedBefore.IsZero() {
+		query.CreatedBefore = &req.CreatedBefore
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeSearchCursor(req.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		query.After = cursor
+	}
+
+	results, err := usecase.searchRepo.Search(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if len(results) > limit {
+		results = results[:limit]
+		last := results[limit-1]
+		nextCursor = encodeSearchCursor(&domain.SearchCursor{Score: last.Score, ID: last.Video.ID})
+	}
+
+	videos := make([]*domain.Video, len(results))
+	for i, result := range results {
+		videos[i] = result.Video
+	}
+
+	return videos, nextCursor, nil
+}
+
+func parseSearchTerms(text string) []string {
+	return searchTermPattern.FindAllString(strings.ToLower(text), maxSearchTerms)
+}
+
+func encodeSearchCursor(cursor *domain.SearchCursor) string {
+	raw := strconv.FormatFloat(cursor.Score, 'g', -1, 64) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSearchCursor(encoded string) (*domain.SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.ErrInvalidSearchCursor
+	}
+
+	scorePart, idPart, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, domain.ErrInvalidSearchCursor
+	}
+	score, err := strconv.ParseFloat(scorePart, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidSearchCursor
+	}
+	id, err := uuid.Parse(idPart)
+	if err != nil {
+		return nil, domain.ErrInvalidSearchCursor
+	}
+
+	return &domain.SearchCursor{Score: score, ID: id}, nil
+}
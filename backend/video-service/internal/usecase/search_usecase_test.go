@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockVideoSearchRepository struct {
+	mock.Mock
+}
+
+func (m *MockVideoSearchRepository) Search(ctx context.Context, query domain.VideoSearchQuery) (
+	[]*domain.VideoSearchResult, error) {
+
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.VideoSearchResult), args.Error(1)
+}
+
+func createTestSearchUseCase() (SearchUseCase, *MockVideoSearchRepository) {
+	mockRepo := &MockVideoSearchRepository{}
+	return NewSearchUseCase(mockRepo, SearchPolicy{PopularityWeight: 0.1}), mockRepo
+}
+
+func createTestSearchResults(count int) []*domain.VideoSearchResult {
+	results := make([]*domain.VideoSearchResult, count)
+	for i := range results {
+		results[i] = &domain.VideoSearchResult{Video: createTestVideo(), Score: float64(count - i)}
+	}
+	return results
+}
+
+func TestSearchVideos_BuildsQuery(t *testing.T) {
+	usecase, mockRepo := createTestSearchUseCase()
+	viewerID := uuid.New()
+	creatorID := uuid.New()
+	after := time.Now().Add(-time.Hour)
+
+	mockRepo.On("Search", mock.Anything, mock.MatchedBy(func(query domain.VideoSearchQuery) bool {
+		return assert.ObjectsAreEqual([]string{"dance", "tiếng"}, query.Terms) &&
+			*query.ViewerID == viewerID &&
+			*query.CreatorID == creatorID &&
+			query.CreatedAfter.Equal(after) &&
+			query.CreatedBefore == nil &&
+			query.MaxDuration == 60 &&
+			query.PopularityWeight == 0.1 &&
+			query.Limit == 11 &&
+			query.After == nil
+	})).Return(createTestSearchResults(3), nil)
+
+	videos, cursor, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{
+		Query:        "#Dance  Tiếng!",
+		ViewerID:     viewerID.String(),
+		CreatorID:    creatorID.String(),
+		CreatedAfter: after,
+		MaxDuration:  60,
+		Limit:        10,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, videos, 3)
+	assert.Empty(t, cursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchVideos_ReturnsCursorWhenMoreResults(t *testing.T) {
+	usecase, mockRepo := createTestSearchUseCase()
+	results := createTestSearchResults(3)
+
+	mockRepo.On("Search", mock.Anything, mock.Anything).Return(results, nil)
+
+	videos, cursor, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{Query: "dance", Limit: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, videos, 2)
+	require.NotEmpty(t, cursor)
+
+	decoded, err := decodeSearchCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, results[1].Score, decoded.Score)
+	assert.Equal(t, results[1].Video.ID, decoded.ID)
+}
+
+func TestSearchVideos_PassesCursor(t *testing.T) {
+	usecase, mockRepo := createTestSearchUseCase()
+	cursor := &domain.SearchCursor{Score: 0.123456789, ID: uuid.New()}
+
+	mockRepo.On("Search", mock.Anything, mock.MatchedBy(func(query domain.VideoSearchQuery) bool {
+		return query.After != nil && *query.After == *cursor && query.Limit == defaultSearchLimit+1
+	})).Return([]*domain.VideoSearchResult{}, nil)
+
+	_, _, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{
+		Query:  "dance",
+		Cursor: encodeSearchCursor(cursor),
+	})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchVideos_EmptyQuery(t *testing.T) {
+	usecase, _ := createTestSearchUseCase()
+
+	_, _, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{Query: " #!? "})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+}
+
+func TestSearchVideos_InvalidCursor(t *testing.T) {
+	usecase, _ := createTestSearchUseCase()
+
+	_, _, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{Query: "dance", Cursor: "not-a-cursor"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchCursor)
+}
+
+func TestSearchVideos_RepositoryError(t *testing.T) {
+	usecase, mockRepo := createTestSearchUseCase()
+
+	mockRepo.On("Search", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+	_, _, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{Query: "dance"})
+
+	assert.Error(t, err)
+}
+
+func TestSearchVideos_CapsLimit(t *testing.T) {
+	usecase, mockRepo := createTestSearchUseCase()
+
+	mockRepo.On("Search", mock.Anything, mock.MatchedBy(func(query domain.VideoSearchQuery) bool {
+		return query.Limit == maxSearchLimit+1
+	})).Return([]*domain.VideoSearchResult{}, nil)
+
+	_, _, err := usecase.SearchVideos(context.Background(), &SearchVideosRequest{Query: "dance", Limit: 1000})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
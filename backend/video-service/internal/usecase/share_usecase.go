@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	shareCodeAlphabet = "abcdefghijkmnpqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	shareCodeLength   = 8
+	topSharersLimit   = 10
+)
+
+type SharePolicy struct {
+	LinkBaseURL string
+}
+
+type SharedVideo struct {
+	Share      *domain.VideoShare
+	URL        string
+	ShareCount int64
+}
+
+type ResolvedShareLink struct {
+	Share *domain.VideoShare
+	Video *domain.Video
+}
+
+type ShareUseCase interface {
+	ShareVideo(ctx context.Context, userID, videoID, channel string) (*SharedVideo, error)
+	ResolveShareLink(ctx context.Context, code, viewerID string) (*ResolvedShareLink, error)
+	GetShareAnalytics(ctx context.Context, userID, videoID string) (*domain.ShareAnalytics, error)
+}
+
+type shareUseCase struct {
+	videoRepo domain.VideoRepository
+	shareRepo domain.ShareRepository
+	directory domain.UserDirectory
+	blockRepo domain.BlockRepository
+	policy    SharePolicy
+}
+
+func NewShareUseCase(videoRepo domain.VideoRepository, shareRepo domain.ShareRepository,
+	directory domain.UserDirectory, blockRepo domain.BlockRepository, policy SharePolicy) ShareUseCase {
+
+	return &shareUseCase{
+		videoRepo: videoRepo,
+		shareRepo: shareRepo,
+		directory: directory,
+		blockRepo: blockRepo,
+		policy:    policy,
+	}
+}
+
+func (usecase *shareUseCase) ShareVideo(ctx context.Context, userID, videoID, channel string) (
+	*SharedVideo, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+	shareChannel, err := parseShareChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoUUID, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := generateShareCode()
+	if err != nil {
+		return nil, err
+	}
+
+	share := &domain.VideoShare{
+		VideoID: videoUUID,
+		UserID:  userUUID,
+		Channel: shareChannel,
+		Code:    code,
+	}
+	shareCount, err := usecase.shareRepo.Create(ctx, share)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedVideo{
+		Share:      share,
+		URL:        usecase.shareURL(code),
+		ShareCount: shareCount,
+	}, nil
+}
+
+// ResolveShareLink records the click-through before returning the video.
+// Sharers opening their own link are not counted.
+func (usecase *shareUseCase) ResolveShareLink(ctx context.Context, code, viewerID string) (
+	*ResolvedShareLink, error) {
+
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, err
+	}
+	var viewer *uuid.UUID
+	if viewerUUID != uuid.Nil {
+		viewer = &viewerUUID
+	}
+
+	share, err := usecase.shareRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo,
+		share.VideoID, viewerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if viewer == nil || *viewer != share.UserID {
+		err = usecase.shareRepo.RecordClick(ctx, &domain.ShareClick{
+			ShareID:  share.ID,
+			ViewerID: viewer,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ResolvedShareLink{Share: share, Video: video}, nil
+}
+
+func (usecase *shareUseCase) GetShareAnalytics(ctx context.Context, userID, videoID string) (
+	*domain.ShareAnalytics, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+
+	return usecase.shareRepo.GetAnalytics(ctx, videoUUID, topSharersLimit)
+}
+
+func (usecase *shareUseCase) shareURL(code string) string {
+	return strings.TrimSuffix(usecase.policy.LinkBaseURL, "/") + "/" + code
+}
+
+func parseShareChannel(channel string) (domain.ShareChannel, error) {
+	switch shareChannel := domain.ShareChannel(channel); shareChannel {
+	case domain.ShareChannelCopyLink, domain.ShareChannelDirectMessage, domain.ShareChannelExternalApp:
+		return shareChannel, nil
+	}
+	return "", domain.ErrInvalidShareChannel
+}
+
+// generateShareCode skips look-alike characters so codes survive being
+// read aloud or retyped.
+func generateShareCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(shareCodeAlphabet)))
+	code := make([]byte, shareCodeLength)
+	for i := range code {
+		index, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = shareCodeAlphabet[index.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockShareRepository struct {
+	mock.Mock
+}
+
+func (m *MockShareRepository) Create(ctx context.Context, share *domain.VideoShare) (int64, error) {
+	args := m.Called(ctx, share)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockShareRepository) GetByCode(ctx context.Context, code string) (*domain.VideoShare, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VideoShare), args.Error(1)
+}
+
+func (m *MockShareRepository) RecordClick(ctx context.Context, click *domain.ShareClick) error {
+	args := m.Called(ctx, click)
+	return args.Error(0)
+}
+
+func (m *MockShareRepository) GetAnalytics(ctx context.Context, videoID uuid.UUID,
+	topSharers int) (*domain.ShareAnalytics, error) {
+
+	args := m.Called(ctx, videoID, topSharers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ShareAnalytics), args.Error(1)
+}
+
+func createTestShareUseCase() (ShareUseCase, *MockVideoRepository, *MockShareRepository) {
+	mockVideoRepo := &MockVideoRepository{}
+	mockShareRepo := &MockShareRepository{}
+	usecase := NewShareUseCase(mockVideoRepo, mockShareRepo, &MockUserDirectory{}, noBlocks(),
+		SharePolicy{LinkBaseURL: "https://vid.example/s/"})
+	return usecase, mockVideoRepo, mockShareRepo
+}
+
+func TestShareVideo_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	userID := uuid.New()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockShareRepo.On("Create", mock.Anything, mock.MatchedBy(func(share *domain.VideoShare) bool {
+		return share.VideoID == video.ID && share.UserID == userID &&
+			share.Channel == domain.ShareChannelDirectMessage && len(share.Code) == shareCodeLength
+	})).Return(int64(7), nil)
+
+	shared, err := usecase.ShareVideo(context.Background(), userID.String(), video.ID.String(), "direct_message")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), shared.ShareCount)
+	assert.Equal(t, "https://vid.example/s/"+shared.Share.Code, shared.URL)
+	mockShareRepo.AssertExpectations(t)
+}
+
+func TestShareVideo_InvalidChannel(t *testing.T) {
+	usecase, _, mockShareRepo := createTestShareUseCase()
+
+	_, err := usecase.ShareVideo(context.Background(), uuid.New().String(), uuid.New().String(), "carrier_pigeon")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidShareChannel)
+	mockShareRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestShareVideo_VideoNotFound(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+
+	mockVideoRepo.On("GetByID", mock.Anything, mock.Anything).Return(nil, domain.ErrVideoNotFound)
+
+	_, err := usecase.ShareVideo(context.Background(), uuid.New().String(), uuid.New().String(), "copy_link")
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	mockShareRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestResolveShareLink_RecordsClick(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	share := &domain.VideoShare{ID: uuid.New(), VideoID: video.ID, UserID: uuid.New(), Code: "abcd2345"}
+	viewerID := uuid.New()
+
+	mockShareRepo.On("GetByCode", mock.Anything, "abcd2345").Return(share, nil)
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockShareRepo.On("RecordClick", mock.Anything, mock.MatchedBy(func(click *domain.ShareClick) bool {
+		return click.ShareID == share.ID && *click.ViewerID == viewerID
+	})).Return(nil)
+
+	resolved, err := usecase.ResolveShareLink(context.Background(), "abcd2345", viewerID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, video, resolved.Video)
+	assert.Equal(t, share, resolved.Share)
+	mockShareRepo.AssertExpectations(t)
+}
+
+func TestResolveShareLink_AnonymousViewer(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	share := &domain.VideoShare{ID: uuid.New(), VideoID: video.ID, UserID: uuid.New(), Code: "abcd2345"}
+
+	mockShareRepo.On("GetByCode", mock.Anything, "abcd2345").Return(share, nil)
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockShareRepo.On("RecordClick", mock.Anything, mock.MatchedBy(func(click *domain.ShareClick) bool {
+		return click.ViewerID == nil
+	})).Return(nil)
+
+	_, err := usecase.ResolveShareLink(context.Background(), "abcd2345", "")
+
+	require.NoError(t, err)
+	mockShareRepo.AssertExpectations(t)
+}
+
+func TestResolveShareLink_UnlistedVideo(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityUnlisted
+	share := &domain.VideoShare{ID: uuid.New(), VideoID: video.ID, UserID: uuid.New(), Code: "abcd2345"}
+
+	mockShareRepo.On("GetByCode", mock.Anything, "abcd2345").Return(share, nil)
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockShareRepo.On("RecordClick", mock.Anything, mock.Anything).Return(nil)
+
+	resolved, err := usecase.ResolveShareLink(context.Background(), "abcd2345", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, video, resolved.Video)
+}
+
+func TestResolveShareLink_PrivateVideo(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityPrivate
+	share := &domain.VideoShare{ID: uuid.New(), VideoID: video.ID, UserID: uuid.New(), Code: "abcd2345"}
+
+	mockShareRepo.On("GetByCode", mock.Anything, "abcd2345").Return(share, nil)
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.ResolveShareLink(context.Background(), "abcd2345", uuid.NewString())
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	mockShareRepo.AssertNotCalled(t, "RecordClick", mock.Anything, mock.Anything)
+}
+
+func TestResolveShareLink_SharerClickNotCounted(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	share := &domain.VideoShare{ID: uuid.New(), VideoID: video.ID, UserID: uuid.New(), Code: "abcd2345"}
+
+	mockShareRepo.On("GetByCode", mock.Anything, "abcd2345").Return(share, nil)
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.ResolveShareLink(context.Background(), "abcd2345", share.UserID.String())
+
+	require.NoError(t, err)
+	mockShareRepo.AssertNotCalled(t, "RecordClick", mock.Anything, mock.Anything)
+}
+
+func TestResolveShareLink_NotFound(t *testing.T) {
+	usecase, _, mockShareRepo := createTestShareUseCase()
+
+	mockShareRepo.On("GetByCode", mock.Anything, "missing").Return(nil, domain.ErrShareLinkNotFound)
+
+	_, err := usecase.ResolveShareLink(context.Background(), "missing", "")
+
+	assert.ErrorIs(t, err, domain.ErrShareLinkNotFound)
+}
+
+func TestGetShareAnalytics_Success(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+	analytics := &domain.ShareAnalytics{TotalShares: 3, TotalClicks: 5}
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockShareRepo.On("GetAnalytics", mock.Anything, video.ID, topSharersLimit).Return(analytics, nil)
+
+	result, err := usecase.GetShareAnalytics(context.Background(), video.UserID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, analytics, result)
+}
+
+func TestGetShareAnalytics_NotOwner(t *testing.T) {
+	usecase, mockVideoRepo, mockShareRepo := createTestShareUseCase()
+	video := createTestVideo()
+
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.GetShareAnalytics(context.Background(), uuid.New().String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrNotVideoOwner)
+	mockShareRepo.AssertNotCalled(t, "GetAnalytics", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGenerateShareCode(t *testing.T) {
+	first, err := generateShareCode()
+	require.NoError(t, err)
+	second, err := generateShareCode()
+	require.NoError(t, err)
+
+	assert.Len(t, first, shareCodeLength)
+	assert.NotEqual(t, first, second)
+	for _, char := range first {
+		assert.Contains(t, shareCodeAlphabet, string(char))
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	maxHashtagsPerVideo = 30
+	maxMentionsPerVideo = 20
+)
+
+var (
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&#])#([\p{L}\p{N}_]{1,100})`)
+	mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([A-Za-z0-9_.]{1,30})`)
+)
+
+func parseHashtags(text string) []string {
+	return collectMatches(hashtagPattern, text, maxHashtagsPerVideo)
+}
+
+func parseMentions(text string) []string {
+	return collectMatches(mentionPattern, text, maxMentionsPerVideo)
+}
+
+func normalizeHashtag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+func collectMatches(pattern *regexp.Regexp, text string, limit int) []string {
+	var values []string
+	seen := make(map[string]bool)
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		value := strings.ToLower(strings.TrimRight(match[1], "."))
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		values = append(values, value)
+		if len(values) == limit {
+			break
+		}
+	}
+	return values
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHashtags(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"", nil},
+		{"#Dance challenge with #fun and #dance again", []string{"dance", "fun"}},
+		{"no tags here", nil},
+		{"url https://example.com/page#anchor stays out", nil},
+		{"(#inside) and #tiếng_việt", []string{"inside", "tiếng_việt"}},
+		{"##double and &#39; entity", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseHashtags(tt.text))
+		})
+	}
+}
+
+func TestParseHashtags_Limit(t *testing.T) {
+	var tags []string
+	for i := 0; i < maxHashtagsPerVideo+5; i++ {
+		tags = append(tags, fmt.Sprintf("#tag%d", i))
+	}
+
+	assert.Len(t, parseHashtags(strings.Join(tags, " ")), maxHashtagsPerVideo)
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{"thanks @Alice and @bob.smith.", []string{"alice", "bob.smith"}},
+		{"mail me at someone@example.com", nil},
+		{"@alice @ALICE", []string{"alice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseMentions(tt.text))
+		})
+	}
+}
+
+func TestNormalizeHashtag(t *testing.T) {
+	assert.Equal(t, "dance", normalizeHashtag(" #Dance "))
+	assert.Equal(t, "dance", normalizeHashtag("dance"))
+}
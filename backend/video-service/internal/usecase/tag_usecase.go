@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type TagUseCase interface {
+	ListHashtagVideos(ctx context.Context, tag, viewerID string, sort domain.HashtagSort,
+		limit, offset int) ([]*domain.Video, int64, error)
+	GetHashtagStats(ctx context.Context, tag string) (*domain.HashtagStats, error)
+	ListMentionedVideos(ctx context.Context, userID, viewerID string,
+		limit, offset int) ([]*domain.Video, int64, error)
+}
+
+type tagUseCase struct {
+	tagRepo domain.TagRepository
+}
+
+func NewTagUseCase(tagRepo domain.TagRepository) TagUseCase {
+	return &tagUseCase{tagRepo: tagRepo}
+}
+
+func (usecase *tagUseCase) ListHashtagVideos(ctx context.Context, tag, viewerID string, sort domain.HashtagSort,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	name := normalizeHashtag(tag)
+	videos, err := usecase.tagRepo.GetVideosByHashtag(ctx, name, sort, viewerUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.tagRepo.CountVideosByHashtag(ctx, name, viewerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return videos, total, nil
+}
+
+func (usecase *tagUseCase) GetHashtagStats(ctx context.Context, tag string) (*domain.HashtagStats, error) {
+	return usecase.tagRepo.GetHashtagStats(ctx, normalizeHashtag(tag))
+}
+
+func (usecase *tagUseCase) ListMentionedVideos(ctx context.Context, userID, viewerID string,
+	limit, offset int) ([]*domain.Video, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	videos, err := usecase.tagRepo.GetVideosByMention(ctx, userUUID, viewerUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.tagRepo.CountVideosByMention(ctx, userUUID, viewerUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return videos, total, nil
+}
+
+// resolveVideoTags parses the hashtags and mentions in a video description.
+// Mentions of unknown usernames are dropped.
+func resolveVideoTags(ctx context.Context, directory domain.UserDirectory, description string) (
+	[]string, []*domain.VideoMention, error) {
+
+	hashtags := parseHashtags(description)
+	usernames := parseMentions(description)
+	if len(usernames) == 0 {
+		return hashtags, nil, nil
+	}
+
+	resolved, err := directory.ResolveUsernames(ctx, usernames)
+	if err != nil {
+		return nil, nil, err
+	}
+	byUsername := make(map[string]uuid.UUID, len(resolved))
+	for username, userID := range resolved {
+		byUsername[strings.ToLower(username)] = userID
+	}
+
+	var mentions []*domain.VideoMention
+	for _, username := range usernames {
+		if userID, ok := byUsername[username]; ok {
+			mentions = append(mentions, &domain.VideoMention{UserID: userID, Username: username})
+		}
+	}
+
+	return hashtags, mentions, nil
+}
+
+// mentionRecipients returns the mentioned users who can see the video.
+// Mentions are notified once, when the video is first published: on creation,
+// or when a draft or scheduled video goes live. Editing a description does
+// not notify the same users again.
+func mentionRecipients(ctx context.Context, directory domain.UserDirectory, blocks domain.BlockRepository,
+	video *domain.Video, mentions []*domain.VideoMention) ([]uuid.UUID, error) {
+
+	var recipients []uuid.UUID
+	for _, mention := range mentions {
+		visible, err := canViewVideo(ctx, directory, blocks, video, mention.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if visible {
+			recipients = append(recipients, mention.UserID)
+		}
+	}
+	return recipients, nil
+}
+
+// recordMentions joins the caller's transaction; the notifications it returns
+// are delivered once that transaction commits.
+func recordMentions(ctx context.Context, notifier *Notifier, video *domain.Video,
+	recipients []uuid.UUID) ([]*domain.Notification, error) {
+
+	notifications := make([]*domain.Notification, 0, len(recipients))
+	for _, userID := range recipients {
+		notification, err := notifier.Record(ctx, &domain.Activity{
+			Type:        domain.NotificationTypeMention,
+			RecipientID: userID,
+			ActorID:     video.UserID,
+			VideoID:     &video.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, nil
+}
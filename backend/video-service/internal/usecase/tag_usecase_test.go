@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) ReplaceVideoTags(ctx context.Context, videoID uuid.UUID,
+	hashtags []string, mentions []*domain.VideoMention) error {
+
+	args := m.Called(ctx, videoID, hashtags, mentions)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) GetVideosByHashtag(ctx context.Context, name string,
+	sort domain.HashtagSort, viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, name, sort, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTagRepository) CountVideosByHashtag(ctx context.Context, name string,
+	viewerID uuid.UUID) (int64, error) {
+
+	args := m.Called(ctx, name, viewerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTagRepository) GetHashtagStats(ctx context.Context, name string) (
+	*domain.HashtagStats, error) {
+
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.HashtagStats), args.Error(1)
+}
+
+func (m *MockTagRepository) GetVideosByMention(ctx context.Context, userID, viewerID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, userID, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTagRepository) CountVideosByMention(ctx context.Context, userID, viewerID uuid.UUID) (
+	int64, error) {
+
+	args := m.Called(ctx, userID, viewerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTagRepository) GetVideoMentions(ctx context.Context, videoID uuid.UUID) (
+	[]*domain.VideoMention, error) {
+
+	args := m.Called(ctx, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.VideoMention), args.Error(1)
+}
+
+type MockUserDirectory struct {
+	mock.Mock
+}
+
+func (m *MockUserDirectory) ResolveUsernames(ctx context.Context, usernames []string) (
+	map[string]uuid.UUID, error) {
+
+	args := m.Called(ctx, usernames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockUserDirectory) GetRelationship(ctx context.Context, viewerID, ownerID uuid.UUID) (
+	*domain.Relationship, error) {
+
+	args := m.Called(ctx, viewerID, ownerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Relationship), args.Error(1)
+}
+
+func (m *MockUserDirectory) RemoveFollows(ctx context.Context, userID, otherID uuid.UUID) error {
+	args := m.Called(ctx, userID, otherID)
+	return args.Error(0)
+}
+
+func TestCreateVideo_IndexesHashtagsAndMentions(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockTagRepo := &MockTagRepository{}
+	mockDirectory := &MockUserDirectory{}
+	usecase.tagRepo = mockTagRepo
+	usecase.directory = mockDirectory
+
+	aliceID := uuid.New()
+	req := createTestCreateVideoRequest()
+	req.Description = "#Dance with @Alice and @ghost #fyp"
+
+	mockVideoRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+	mockDirectory.On("ResolveUsernames", mock.Anything, []string{"alice", "ghost"}).
+		Return(map[string]uuid.UUID{"Alice": aliceID}, nil)
+	mockTagRepo.On("ReplaceVideoTags", mock.Anything, mock.Anything, []string{"dance", "fyp"},
+		[]*domain.VideoMention{{UserID: aliceID, Username: "alice"}}).Return(nil)
+
+	video, err := usecase.CreateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.NotNil(t, video)
+	mockDirectory.AssertExpectations(t)
+	mockTagRepo.AssertExpectations(t)
+}
+
+func TestCreateVideo_NotifiesMentionedUsersWhoCanSeeIt(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+	notifier, mockNotificationRepo, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+
+	aliceID, bobID := uuid.New(), uuid.New()
+	req := createTestCreateVideoRequest()
+	req.Description = "with @alice and @bob"
+	req.Visibility = string(domain.VisibilityFollowers)
+
+	mockVideoRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+	mockDirectory.On("ResolveUsernames", mock.Anything, []string{"alice", "bob"}).
+		Return(map[string]uuid.UUID{"alice": aliceID, "bob": bobID}, nil)
+	mockDirectory.On("GetRelationship", mock.Anything, aliceID, mock.Anything).
+		Return(&domain.Relationship{Following: true}, nil)
+	mockDirectory.On("GetRelationship", mock.Anything, bobID, mock.Anything).
+		Return(&domain.Relationship{}, nil)
+
+	video, err := usecase.CreateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	mockNotificationRepo.AssertNumberOfCalls(t, "Record", 1)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == aliceID && notification.Type == domain.NotificationTypeMention &&
+			*notification.VideoID == video.ID && notification.LastActorID == video.UserID
+	}))
+}
+
+func TestCreateVideo_DraftDefersMentionNotifications(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+	notifier, mockNotificationRepo, _ := createTestNotifier()
+	usecase.notifier = notifier
+
+	req := createTestCreateVideoRequest()
+	req.Description = "with @alice"
+	req.Draft = true
+
+	mockVideoRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+	mockDirectory.On("ResolveUsernames", mock.Anything, []string{"alice"}).
+		Return(map[string]uuid.UUID{"alice": uuid.New()}, nil)
+
+	_, err := usecase.CreateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	mockNotificationRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+}
+
+func TestCreateVideo_DirectoryError(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+
+	req := createTestCreateVideoRequest()
+	req.Description = "hi @alice"
+
+	mockVideoRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+	mockDirectory.On("ResolveUsernames", mock.Anything, []string{"alice"}).
+		Return(nil, errors.New("directory unavailable"))
+
+	video, err := usecase.CreateVideo(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, video)
+}
+
+func TestUpdateVideo_SkipsIndexingWhenDescriptionUnchanged(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockTagRepo := &MockTagRepository{}
+	usecase.tagRepo = mockTagRepo
+
+	original := createTestVideo()
+	req := createTestUpdateVideoRequest()
+	req.ID = original.ID.String()
+	req.Description = original.Description
+
+	mockVideoRepo.On("GetByID", mock.Anything, original.ID).Return(original, nil)
+	mockVideoRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+
+	_, err := usecase.UpdateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	mockTagRepo.AssertNotCalled(t, "ReplaceVideoTags", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateVideo_NotifiesOnlyNewlyMentionedUsers(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockTagRepo := &MockTagRepository{}
+	usecase.tagRepo = mockTagRepo
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+	notifier, mockNotificationRepo, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+
+	original := createTestVideo()
+	original.Description = "with @alice"
+	aliceID, bobID := uuid.New(), uuid.New()
+	req := &UpdateVideoRequest{
+		ID:          original.ID.String(),
+		Description: "with @alice and @bob",
+		UpdateMask:  []string{VideoFieldDescription},
+	}
+
+	mockVideoRepo.On("GetByID", mock.Anything, original.ID).Return(original, nil)
+	mockVideoRepo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+	mockDirectory.On("ResolveUsernames", mock.Anything, []string{"alice", "bob"}).
+		Return(map[string]uuid.UUID{"alice": aliceID, "bob": bobID}, nil)
+	mockTagRepo.On("GetVideoMentions", mock.Anything, original.ID).
+		Return([]*domain.VideoMention{{VideoID: original.ID, UserID: aliceID, Username: "alice"}}, nil)
+	mockTagRepo.On("ReplaceVideoTags", mock.Anything, original.ID, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := usecase.UpdateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	mockNotificationRepo.AssertNumberOfCalls(t, "Record", 1)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == bobID && notification.Type == domain.NotificationTypeMention
+	}))
+}
+
+func TestListHashtagVideos_Success(t *testing.T) {
+	mockTagRepo := &MockTagRepository{}
+	usecase := NewTagUseCase(mockTagRepo)
+	videos := []*domain.Video{createTestVideo()}
+
+	viewerID := uuid.New()
+
+	mockTagRepo.On("GetVideosByHashtag", mock.Anything, "dance", domain.HashtagSortPopular, viewerID, 10, 0).
+		Return(videos, nil)
+	mockTagRepo.On("CountVideosByHashtag", mock.Anything, "dance", viewerID).Return(int64(7), nil)
+
+	result, total, err := usecase.ListHashtagVideos(context.Background(), "#Dance", viewerID.String(),
+		domain.HashtagSortPopular, 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, result)
+	assert.Equal(t, int64(7), total)
+	mockTagRepo.AssertExpectations(t)
+}
+
+func TestListHashtagVideos_InvalidSort(t *testing.T) {
+	mockTagRepo := &MockTagRepository{}
+	usecase := NewTagUseCase(mockTagRepo)
+
+	mockTagRepo.On("GetVideosByHashtag", mock.Anything, "dance", domain.HashtagSort("oldest"), uuid.Nil, 10, 0).
+		Return(nil, domain.ErrInvalidHashtagSort)
+
+	_, _, err := usecase.ListHashtagVideos(context.Background(), "dance", "", "oldest", 10, 0)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidHashtagSort)
+}
+
+func TestGetHashtagStats_Success(t *testing.T) {
+	mockTagRepo := &MockTagRepository{}
+	usecase := NewTagUseCase(mockTagRepo)
+	stats := &domain.HashtagStats{Name: "dance", VideoCount: 2, TotalViews: 40}
+
+	mockTagRepo.On("GetHashtagStats", mock.Anything, "dance").Return(stats, nil)
+
+	result, err := usecase.GetHashtagStats(context.Background(), "#dance")
+
+	require.NoError(t, err)
+	assert.Equal(t, stats, result)
+}
+
+func TestListMentionedVideos_Success(t *testing.T) {
+	mockTagRepo := &MockTagRepository{}
+	usecase := NewTagUseCase(mockTagRepo)
+	userID := uuid.New()
+	videos := []*domain.Video{createTestVideo()}
+
+	mockTagRepo.On("GetVideosByMention", mock.Anything, userID, uuid.Nil, 20, 0).Return(videos, nil)
+	mockTagRepo.On("CountVideosByMention", mock.Anything, userID, uuid.Nil).Return(int64(1), nil)
+
+	result, total, err := usecase.ListMentionedVideos(context.Background(), userID.String(), "", 20, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, result)
+	assert.Equal(t, int64(1), total)
+}
+
+func TestListMentionedVideos_InvalidUserID(t *testing.T) {
+	usecase := NewTagUseCase(&MockTagRepository{})
+
+	_, _, err := usecase.ListMentionedVideos(context.Background(), "invalid", "", 20, 0)
+
+	assert.Error(t, err)
+}
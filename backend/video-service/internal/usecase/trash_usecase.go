@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"video-service/internal/domain"
+)
+
+type TrashPolicy struct {
+	Retention      time.Duration
+	PurgeBatchSize int
+}
+
+type TrashedVideo struct {
+	Video   *domain.Video
+	PurgeAt time.Time
+}
+
+type TrashUseCase interface {
+	ListTrash(ctx context.Context, userID string, limit, offset int) ([]*TrashedVideo, int64, error)
+	RestoreVideo(ctx context.Context, userID, videoID string) (*domain.Video, error)
+	PurgeExpired(ctx context.Context) error
+}
+
+type trashUseCase struct {
+	trashRepo  domain.TrashRepository
+	storage    domain.ObjectStorage
+	transactor domain.Transactor
+	outbox     domain.OutboxRepository
+	policy     TrashPolicy
+}
+
+func NewTrashUseCase(
+	trashRepo domain.TrashRepository,
+	storage domain.ObjectStorage,
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	policy TrashPolicy,
+) TrashUseCase {
+	return &trashUseCase{
+		trashRepo:  trashRepo,
+		storage:    storage,
+		transactor: transactor,
+		outbox:     outbox,
+		policy:     policy,
+	}
+}
+
+func (usecase *trashUseCase) ListTrash(ctx context.Context, userID string, limit, offset int) (
+	[]*TrashedVideo, int64, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	videos, err := usecase.trashRepo.ListByUserID(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := usecase.trashRepo.CountByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	trashed := make([]*TrashedVideo, len(videos))
+	for i, video := range videos {
+		trashed[i] = &TrashedVideo{Video: video, PurgeAt: usecase.purgeAt(video)}
+	}
+
+	return trashed, total, nil
+}
+
+func (usecase *trashUseCase) RestoreVideo(ctx context.Context, userID, videoID string) (*domain.Video, error) {
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.trashRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+	if !time.Now().Before(usecase.purgeAt(video)) {
+		return nil, domain.ErrRestoreWindowExpired
+	}
+
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := usecase.trashRepo.Restore(ctx, video.ID); err != nil {
+			return err
+		}
+
+		video.DeletedAt.Valid = false
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoRestored,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+			Video:   video,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return video, nil
+}
+
+// PurgeExpired permanently removes videos whose retention window has passed,
+// one batch at a time until none are left. Media objects are deleted before
+// the rows so a failed purge is retried on the next run rather than leaving
+// objects nobody points at.
+func (usecase *trashUseCase) PurgeExpired(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-usecase.policy.Retention)
+
+	for {
+		videos, err := usecase.trashRepo.ListExpired(ctx, deletedBefore, usecase.policy.PurgeBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, video := range videos {
+			if err := usecase.purge(ctx, video); err != nil {
+				return fmt.Errorf("failed to purge video %s: %w", video.ID, err)
+			}
+		}
+
+		if len(videos) == 0 || len(videos) < usecase.policy.PurgeBatchSize {
+			return nil
+		}
+	}
+}
+
+func (usecase *trashUseCase) purge(ctx context.Context, video *domain.Video) error {
+	if video.StorageKey != "" {
+		if err := usecase.storage.Delete(ctx, video.StorageKey); err != nil {
+			return err
+		}
+	}
+	if err := usecase.storage.DeletePrefix(ctx, videoMediaPrefix(video)); err != nil {
+		return err
+	}
+
+	return usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		purged, err := usecase.trashRepo.Purge(ctx, video.ID)
+		if err != nil || !purged {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoPurged,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+		})
+	})
+}
+
+func (usecase *trashUseCase) purgeAt(video *domain.Video) time.Time {
+	return video.DeletedAt.Time.Add(usecase.policy.Retention)
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type MockTrashRepository struct {
+	mock.Mock
+}
+
+func (m *MockTrashRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Video), args.Error(1)
+}
+
+func (m *MockTrashRepository) ListByUserID(ctx context.Context, userID uuid.UUID,
+	limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTrashRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTrashRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTrashRepository) ListExpired(ctx context.Context, deletedBefore time.Time,
+	limit int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, deletedBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTrashRepository) Purge(ctx context.Context, id uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+var testTrashPolicy = TrashPolicy{Retention: 30 * 24 * time.Hour, PurgeBatchSize: 2}
+
+func createTestTrashUseCase() (TrashUseCase, *MockTrashRepository, *MockObjectStorage, *MockOutboxRepository) {
+	mockTrashRepo := &MockTrashRepository{}
+	mockStorage := &MockObjectStorage{}
+	mockOutbox := &MockOutboxRepository{}
+	usecase := NewTrashUseCase(mockTrashRepo, mockStorage, fakeTransactor{}, mockOutbox, testTrashPolicy)
+	return usecase, mockTrashRepo, mockStorage, mockOutbox
+}
+
+func createTrashedVideo(deletedAt time.Time) *domain.Video {
+	video := createTestVideo()
+	video.DeletedAt = gorm.DeletedAt{Time: deletedAt, Valid: true}
+	return video
+}
+
+func TestListTrash_Success(t *testing.T) {
+	usecase, mockTrashRepo, _, _ := createTestTrashUseCase()
+	video := createTrashedVideo(time.Now().Add(-time.Hour))
+
+	mockTrashRepo.On("ListByUserID", mock.Anything, video.UserID, 10, 0).Return([]*domain.Video{video}, nil)
+	mockTrashRepo.On("CountByUserID", mock.Anything, video.UserID).Return(int64(1), nil)
+
+	trashed, total, err := usecase.ListTrash(context.Background(), video.UserID.String(), 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, video.DeletedAt.Time.Add(testTrashPolicy.Retention), trashed[0].PurgeAt)
+	mockTrashRepo.AssertExpectations(t)
+}
+
+func TestRestoreVideo_Success(t *testing.T) {
+	usecase, mockTrashRepo, _, mockOutbox := createTestTrashUseCase()
+	video := createTrashedVideo(time.Now().Add(-time.Hour))
+
+	mockTrashRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockTrashRepo.On("Restore", mock.Anything, video.ID).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.VideoEvent) bool {
+		return event.Type == domain.EventVideoRestored && event.VideoID == video.ID
+	})).Return(nil)
+
+	restored, err := usecase.RestoreVideo(context.Background(), video.UserID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	assert.False(t, restored.DeletedAt.Valid)
+	mockTrashRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestRestoreVideo_NotOwner(t *testing.T) {
+	usecase, mockTrashRepo, _, _ := createTestTrashUseCase()
+	video := createTrashedVideo(time.Now().Add(-time.Hour))
+
+	mockTrashRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.RestoreVideo(context.Background(), uuid.New().String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrNotVideoOwner)
+	mockTrashRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestRestoreVideo_WindowExpired(t *testing.T) {
+	usecase, mockTrashRepo, _, _ := createTestTrashUseCase()
+	video := createTrashedVideo(time.Now().Add(-testTrashPolicy.Retention - time.Minute))
+
+	mockTrashRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := usecase.RestoreVideo(context.Background(), video.UserID.String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrRestoreWindowExpired)
+	mockTrashRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestPurgeExpired_DeletesMediaThenRows(t *testing.T) {
+	usecase, mockTrashRepo, mockStorage, mockOutbox := createTestTrashUseCase()
+	first := createTrashedVideo(time.Now().Add(-40 * 24 * time.Hour))
+	first.StorageKey = "uploads/first.mp4"
+	second := createTrashedVideo(time.Now().Add(-35 * 24 * time.Hour))
+	third := createTrashedVideo(time.Now().Add(-31 * 24 * time.Hour))
+
+	mockTrashRepo.On("ListExpired", mock.Anything, mock.Anything, 2).
+		Return([]*domain.Video{first, second}, nil).Once()
+	mockTrashRepo.On("ListExpired", mock.Anything, mock.Anything, 2).
+		Return([]*domain.Video{third}, nil).Once()
+	mockStorage.On("Delete", mock.Anything, "uploads/first.mp4").Return(nil)
+	for _, video := range []*domain.Video{first, second, third} {
+		mockStorage.On("DeletePrefix", mock.Anything, videoMediaPrefix(video)).Return(nil)
+	}
+	mockTrashRepo.On("Purge", mock.Anything, first.ID).Return(true, nil)
+	mockTrashRepo.On("Purge", mock.Anything, second.ID).Return(true, nil)
+	mockTrashRepo.On("Purge", mock.Anything, third.ID).Return(false, nil)
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.VideoEvent) bool {
+		return event.Type == domain.EventVideoPurged
+	})).Return(nil).Twice()
+
+	err := usecase.PurgeExpired(context.Background())
+
+	require.NoError(t, err)
+	mockTrashRepo.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestPurgeExpired_StorageErrorKeepsRows(t *testing.T) {
+	usecase, mockTrashRepo, mockStorage, _ := createTestTrashUseCase()
+	video := createTrashedVideo(time.Now().Add(-40 * 24 * time.Hour))
+
+	mockTrashRepo.On("ListExpired", mock.Anything, mock.Anything, 2).Return([]*domain.Video{video}, nil)
+	mockStorage.On("DeletePrefix", mock.Anything, videoMediaPrefix(video)).Return(errors.New("storage unavailable"))
+
+	err := usecase.PurgeExpired(context.Background())
+
+	assert.Error(t, err)
+	mockTrashRepo.AssertNotCalled(t, "Purge", mock.Anything, mock.Anything)
+}
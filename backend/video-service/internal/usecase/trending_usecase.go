@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"video-service/internal/domain"
+)
+
+type TrendingWindowSpec struct {
+	Window   domain.TrendingWindow
+	Length   time.Duration
+	HalfLife time.Duration
+}
+
+var DefaultTrendingWindows = []TrendingWindowSpec{
+	{Window: domain.TrendingWindowDay, Length: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	{Window: domain.TrendingWindowWeek, Length: 7 * 24 * time.Hour, HalfLife: 48 * time.Hour},
+	{Window: domain.TrendingWindowMonth, Length: 30 * 24 * time.Hour, HalfLife: 7 * 24 * time.Hour},
+}
+
+type TrendingPolicy struct {
+	Windows []TrendingWindowSpec
+	Weights domain.TrendingWeights
+}
+
+type TrendingUseCase interface {
+	RefreshTrending(ctx context.Context) error
+	GetTrendingVideos(ctx context.Context, window, region, viewerID string,
+		limit, offset int) ([]*domain.Video, error)
+	GetTrendingHashtags(ctx context.Context, window, region string, limit int) ([]*domain.TrendingHashtag, error)
+}
+
+type trendingUseCase struct {
+	trendingRepo domain.TrendingRepository
+	policy       TrendingPolicy
+}
+
+func NewTrendingUseCase(trendingRepo domain.TrendingRepository, policy TrendingPolicy) TrendingUseCase {
+	return &trendingUseCase{
+		trendingRepo: trendingRepo,
+		policy:       policy,
+	}
+}
+
+func (usecase *trendingUseCase) RefreshTrending(ctx context.Context) error {
+	now := time.Now()
+	for _, spec := range usecase.policy.Windows {
+		err := usecase.trendingRepo.Refresh(ctx, domain.TrendingRefresh{
+			Window:   spec.Window,
+			Since:    now.Add(-spec.Length),
+			Now:      now,
+			HalfLife: spec.HalfLife,
+			Weights:  usecase.policy.Weights,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (usecase *trendingUseCase) GetTrendingVideos(ctx context.Context, window, region, viewerID string,
+	limit, offset int) ([]*domain.Video, error) {
+
+	trendingWindow, err := usecase.resolveWindow(window)
+	if err != nil {
+		return nil, err
+	}
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.trendingRepo.GetTrendingVideos(ctx, trendingWindow, normalizeRegion(region), viewerUUID,
+		limit, offset)
+}
+
+func (usecase *trendingUseCase) GetTrendingHashtags(ctx context.Context, window, region string,
+	limit int) ([]*domain.TrendingHashtag, error) {
+
+	trendingWindow, err := usecase.resolveWindow(window)
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.trendingRepo.GetTrendingHashtags(ctx, trendingWindow, normalizeRegion(region), limit)
+}
+
+func (usecase *trendingUseCase) resolveWindow(window string) (domain.TrendingWindow, error) {
+	if window == "" {
+		return domain.TrendingWindowDay, nil
+	}
+	for _, spec := range usecase.policy.Windows {
+		if string(spec.Window) == window {
+			return spec.Window, nil
+		}
+	}
+	return "", domain.ErrInvalidTrendingWindow
+}
+
+func normalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
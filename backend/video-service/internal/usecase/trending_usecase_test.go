@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTrendingRepository struct {
+	mock.Mock
+}
+
+func (m *MockTrendingRepository) Refresh(ctx context.Context, refresh domain.TrendingRefresh) error {
+	args := m.Called(ctx, refresh)
+	return args.Error(0)
+}
+
+func (m *MockTrendingRepository) RecordComment(ctx context.Context, comment *domain.VideoComment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockTrendingRepository) GetTrendingVideos(ctx context.Context, window domain.TrendingWindow,
+	region string, viewerID uuid.UUID, limit, offset int) ([]*domain.Video, error) {
+
+	args := m.Called(ctx, window, region, viewerID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
+func (m *MockTrendingRepository) GetTrendingHashtags(ctx context.Context, window domain.TrendingWindow,
+	region string, limit int) ([]*domain.TrendingHashtag, error) {
+
+	args := m.Called(ctx, window, region, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TrendingHashtag), args.Error(1)
+}
+
+func createTestTrendingUseCase() (TrendingUseCase, *MockTrendingRepository) {
+	mockRepo := &MockTrendingRepository{}
+	usecase := NewTrendingUseCase(mockRepo, TrendingPolicy{
+		Windows: DefaultTrendingWindows,
+		Weights: domain.TrendingWeights{View: 1, Like: 3},
+	})
+
+	return usecase, mockRepo
+}
+
+func TestRefreshTrending_RefreshesEveryWindow(t *testing.T) {
+	usecase, mockRepo := createTestTrendingUseCase()
+
+	for _, spec := range DefaultTrendingWindows {
+		spec := spec
+		mockRepo.On("Refresh", mock.Anything, mock.MatchedBy(func(refresh domain.TrendingRefresh) bool {
+			return refresh.Window == spec.Window &&
+				refresh.HalfLife == spec.HalfLife &&
+				refresh.Now.Sub(refresh.Since) == spec.Length &&
+				refresh.Weights.Like == 3
+		})).Return(nil).Once()
+	}
+
+	err := usecase.RefreshTrending(context.Background())
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRefreshTrending_StopsOnError(t *testing.T) {
+	usecase, mockRepo := createTestTrendingUseCase()
+
+	mockRepo.On("Refresh", mock.Anything, mock.Anything).Return(errors.New("database error")).Once()
+
+	err := usecase.RefreshTrending(context.Background())
+
+	assert.Error(t, err)
+	mockRepo.AssertNumberOfCalls(t, "Refresh", 1)
+}
+
+func TestGetTrendingVideos_DefaultsToDayWindow(t *testing.T) {
+	usecase, mockRepo := createTestTrendingUseCase()
+	videos := []*domain.Video{createTestVideo()}
+
+	mockRepo.On("GetTrendingVideos", mock.Anything, domain.TrendingWindowDay, "VN", uuid.Nil, 10, 0).Return(videos, nil)
+
+	result, err := usecase.GetTrendingVideos(context.Background(), "", " vn ", "", 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, videos, result)
+}
+
+func TestGetTrendingVideos_InvalidWindow(t *testing.T) {
+	usecase, _ := createTestTrendingUseCase()
+
+	_, err := usecase.GetTrendingVideos(context.Background(), "year", "", "", 10, 0)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTrendingWindow)
+}
+
+func TestGetTrendingHashtags_Success(t *testing.T) {
+	usecase, mockRepo := createTestTrendingUseCase()
+	hashtags := []*domain.TrendingHashtag{{Name: "dance", Score: 12.5}}
+
+	mockRepo.On("GetTrendingHashtags", mock.Anything, domain.TrendingWindowWeek, "", 5).Return(hashtags, nil)
+
+	result, err := usecase.GetTrendingHashtags(context.Background(), "week", "", 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, hashtags, result)
+}
+
+func TestDefaultTrendingWindows_HalfLifeShorterThanWindow(t *testing.T) {
+	for _, spec := range DefaultTrendingWindows {
+		assert.Less(t, spec.HalfLife, spec.Length, string(spec.Window))
+		assert.Greater(t, spec.HalfLife, time.Duration(0))
+	}
+}
@@ -0,0 +1,372 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type UploadUseCase interface {
+	CreateUploadSession(ctx context.Context, req *CreateUploadSessionRequest) (*domain.UploadSession, error)
+	GetUploadSession(ctx context.Context, id, userID string) (*domain.UploadSession, error)
+	// ResumeUpload returns the caller's session for WriteChunk and
+	// CompleteUpload, which fail with ErrUploadOffsetMismatch when another
+	// stream has written to it since.
+	ResumeUpload(ctx context.Context, id, userID string, offset int64) (*domain.UploadSession, error)
+	WriteChunk(ctx context.Context, session *domain.UploadSession, chunk []byte) error
+	CompleteUpload(ctx context.Context, session *domain.UploadSession) (*domain.Video, error)
+}
+
+type UploadPolicy struct {
+	MaxSizeBytes      int64
+	AllowedContainers []string
+	SessionTTL        time.Duration
+}
+
+type uploadUseCase struct {
+	sessionRepo domain.UploadSessionRepository
+	videoRepo   domain.VideoRepository
+	jobQueue    domain.JobQueue
+	storage     domain.ObjectStorage
+	transactor  domain.Transactor
+	outbox      domain.OutboxRepository
+	policy      UploadPolicy
+}
+
+func NewUploadUseCase(
+	sessionRepo domain.UploadSessionRepository,
+	videoRepo domain.VideoRepository,
+	jobQueue domain.JobQueue,
+	storage domain.ObjectStorage,
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	policy UploadPolicy,
+) UploadUseCase {
+	return &uploadUseCase{
+		sessionRepo: sessionRepo,
+		videoRepo:   videoRepo,
+		jobQueue:    jobQueue,
+		storage:     storage,
+		transactor:  transactor,
+		outbox:      outbox,
+		policy:      policy,
+	}
+}
+
+type CreateUploadSessionRequest struct {
+	UserID      string `json:"user_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Duration    int    `json:"duration"`
+	Visibility  string `json:"visibility"`
+	Region      string `json:"region"`
+	Draft       bool   `json:"draft"`
+	FileName    string `json:"file_name"`
+	TotalSize   int64  `json:"total_size"`
+	Checksum    string `json:"checksum"`
+}
+
+func (usecase *uploadUseCase) CreateUploadSession(ctx context.Context, req *CreateUploadSessionRequest) (
+	*domain.UploadSession, error) {
+
+	userID, err := parseID(req.UserID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	visibility, err := parseVisibility(req.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TotalSize > usecase.policy.MaxSizeBytes {
+		return nil, domain.ErrUploadTooLarge
+	}
+
+	container := strings.ToLower(strings.TrimPrefix(filepath.Ext(req.FileName), "."))
+	if !usecase.isAllowedContainer(container) {
+		return nil, domain.ErrUnsupportedContainer
+	}
+
+	sessionID := uuid.New()
+	session := &domain.UploadSession{
+		ID:          sessionID,
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+		Duration:    req.Duration,
+		Visibility:  visibility,
+		Region:      normalizeRegion(req.Region),
+		Draft:       req.Draft,
+		FileName:    req.FileName,
+		Container:   container,
+		TotalSize:   req.TotalSize,
+		Checksum:    strings.ToLower(req.Checksum),
+		ObjectKey:   fmt.Sprintf("uploads/%s.part", sessionID),
+		Status:      domain.UploadStatusPending,
+		ExpiresAt:   time.Now().Add(usecase.policy.SessionTTL),
+	}
+
+	err = usecase.sessionRepo.Create(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (usecase *uploadUseCase) GetUploadSession(ctx context.Context, id, userID string) (
+	*domain.UploadSession, error) {
+
+	sessionID, err := parseID(id, "upload_id")
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := usecase.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userUUID {
+		return nil, domain.ErrNotUploadOwner
+	}
+
+	if session.Status == domain.UploadStatusPending {
+		if err := usecase.syncReceivedSize(ctx, session); err != nil {
+			return nil, err
+		}
+	}
+
+	return session, nil
+}
+
+func (usecase *uploadUseCase) ResumeUpload(ctx context.Context, id, userID string, offset int64) (
+	*domain.UploadSession, error) {
+
+	session, err := usecase.GetUploadSession(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.Status != domain.UploadStatusPending {
+		return session, domain.ErrUploadNotPending
+	}
+	if time.Now().After(session.ExpiresAt) {
+		return session, domain.ErrUploadExpired
+	}
+	if offset != session.ReceivedSize {
+		return session, domain.ErrUploadOffsetMismatch
+	}
+
+	return session, nil
+}
+
+// syncReceivedSize treats the staged object as the source of truth, so a
+// crash between appending a chunk and recording it never corrupts the offset.
+func (usecase *uploadUseCase) syncReceivedSize(ctx context.Context, session *domain.UploadSession) error {
+	var storedSize int64
+	info, err := usecase.storage.Stat(ctx, session.ObjectKey)
+	switch {
+	case err == nil:
+		storedSize = info.Size
+	case !errors.Is(err, domain.ErrObjectNotFound):
+		return err
+	}
+
+	if storedSize == session.ReceivedSize {
+		return nil
+	}
+
+	session.ReceivedSize = storedSize
+	return usecase.sessionRepo.UpdateReceivedSize(ctx, session.ID, storedSize)
+}
+
+// WriteChunk holds the session row while appending, so a concurrent stream
+// on the same session sees the new offset and stops instead of interleaving
+// its bytes.
+func (usecase *uploadUseCase) WriteChunk(ctx context.Context, session *domain.UploadSession,
+	chunk []byte) error {
+
+	return usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := usecase.lockSession(ctx, session); err != nil {
+			return err
+		}
+		if int64(len(chunk)) > session.Remaining() {
+			return domain.ErrUploadTooLarge
+		}
+
+		written, err := usecase.storage.Append(ctx, session.ObjectKey, bytes.NewReader(chunk))
+		if err != nil {
+			return err
+		}
+
+		session.ReceivedSize += written
+		return usecase.sessionRepo.UpdateReceivedSize(ctx, session.ID, session.ReceivedSize)
+	})
+}
+
+// lockSession checks that the stored session is still where this stream
+// left it.
+func (usecase *uploadUseCase) lockSession(ctx context.Context, session *domain.UploadSession) error {
+	stored, err := usecase.sessionRepo.GetByIDForUpdate(ctx, session.ID)
+	if err != nil {
+		return err
+	}
+	if stored.Status != domain.UploadStatusPending {
+		return domain.ErrUploadNotPending
+	}
+	if stored.ReceivedSize != session.ReceivedSize {
+		return domain.ErrUploadOffsetMismatch
+	}
+	return nil
+}
+
+func (usecase *uploadUseCase) CompleteUpload(ctx context.Context, session *domain.UploadSession) (
+	*domain.Video, error) {
+
+	if session.Status != domain.UploadStatusPending {
+		return nil, domain.ErrUploadNotPending
+	}
+	if session.Remaining() != 0 {
+		return nil, domain.ErrUploadIncomplete
+	}
+
+	container, checksum, err := usecase.inspectObject(ctx, session.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usecase.isAllowedContainer(container) {
+		usecase.discardUpload(ctx, session)
+		return nil, domain.ErrUnsupportedContainer
+	}
+	if session.Checksum != "" && checksum != session.Checksum {
+		usecase.discardUpload(ctx, session)
+		return nil, domain.ErrUploadChecksumMismatch
+	}
+
+	objectKey := fmt.Sprintf("videos/%s/%s.%s", session.UserID, session.ID, container)
+	err = usecase.storage.Move(ctx, session.ObjectKey, objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	video := &domain.Video{
+		UserID:           session.UserID,
+		Title:            session.Title,
+		Description:      session.Description,
+		VideoURL:         usecase.storage.URL(objectKey),
+		StorageKey:       objectKey,
+		Duration:         session.Duration,
+		Visibility:       session.Visibility,
+		ModerationState:  domain.ModerationStateActive,
+		Region:           session.Region,
+		ProcessingStatus: domain.ProcessingStatusUploaded,
+	}
+	setInitialPublication(video, session.Draft)
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := usecase.lockSession(ctx, session); err != nil {
+			return err
+		}
+
+		err := usecase.videoRepo.Create(ctx, video)
+		if err != nil {
+			return err
+		}
+
+		err = usecase.jobQueue.Enqueue(ctx, &domain.Job{
+			Type:    domain.JobTypeTranscode,
+			VideoID: video.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = usecase.sessionRepo.MarkCompleted(ctx, session.ID, video.ID)
+		if err != nil {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoCreated,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+			Video:   video,
+		})
+	})
+	if err != nil {
+		// Put the object back so the session still matches its received size
+		// and the client can retry the completion.
+		restoreErr := usecase.storage.Move(context.WithoutCancel(ctx), objectKey, session.ObjectKey)
+		if restoreErr != nil {
+			return nil, fmt.Errorf("%w; restoring upload: %w", err, restoreErr)
+		}
+		return nil, err
+	}
+
+	session.Status = domain.UploadStatusCompleted
+	session.VideoID = &video.ID
+
+	return video, nil
+}
+
+func (usecase *uploadUseCase) inspectObject(ctx context.Context, key string) (string, string, error) {
+	reader, err := usecase.storage.Open(ctx, key)
+	if err != nil {
+		return "", "", err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	header := make([]byte, 12)
+	n, err := io.ReadFull(io.TeeReader(reader, hash), header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return "", "", err
+	}
+
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", "", err
+	}
+
+	return detectContainer(header[:n]), hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (usecase *uploadUseCase) discardUpload(ctx context.Context, session *domain.UploadSession) {
+	if err := usecase.storage.Delete(ctx, session.ObjectKey); err != nil {
+		return
+	}
+	session.ReceivedSize = 0
+	_ = usecase.sessionRepo.UpdateReceivedSize(ctx, session.ID, 0)
+}
+
+func (usecase *uploadUseCase) isAllowedContainer(container string) bool {
+	return container != "" && slices.Contains(usecase.policy.AllowedContainers, container)
+}
+
+func detectContainer(header []byte) string {
+	switch {
+	case len(header) >= 12 && string(header[4:8]) == "ftyp":
+		if string(header[8:12]) == "qt  " {
+			return "mov"
+		}
+		return "mp4"
+	case len(header) >= 4 && bytes.Equal(header[:4], []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return "webm"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,517 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockUploadSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockUploadSessionRepository) Create(ctx context.Context,
+	session *domain.UploadSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockUploadSessionRepository) GetByID(ctx context.Context,
+	id uuid.UUID) (*domain.UploadSession, error) {
+
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadSession), args.Error(1)
+}
+
+func (m *MockUploadSessionRepository) GetByIDForUpdate(ctx context.Context,
+	id uuid.UUID) (*domain.UploadSession, error) {
+
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UploadSession), args.Error(1)
+}
+
+func (m *MockUploadSessionRepository) UpdateReceivedSize(ctx context.Context,
+	id uuid.UUID, receivedSize int64) error {
+	args := m.Called(ctx, id, receivedSize)
+	return args.Error(0)
+}
+
+func (m *MockUploadSessionRepository) MarkCompleted(ctx context.Context,
+	id, videoID uuid.UUID) error {
+	args := m.Called(ctx, id, videoID)
+	return args.Error(0)
+}
+
+type MockJobQueue struct {
+	mock.Mock
+}
+
+func (m *MockJobQueue) Enqueue(ctx context.Context, job *domain.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Dequeue(ctx context.Context, types []domain.JobType) (*domain.Job, error) {
+	args := m.Called(ctx, types)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *MockJobQueue) Complete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Retry(ctx context.Context, id uuid.UUID, runAt time.Time, lastError string) error {
+	args := m.Called(ctx, id, runAt, lastError)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Fail(ctx context.Context, id uuid.UUID, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func (m *MockJobQueue) Heartbeat(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockObjectStorage struct {
+	mock.Mock
+}
+
+func (m *MockObjectStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	args := m.Called(ctx, key, r)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockObjectStorage) Append(ctx context.Context, key string, r io.Reader) (int64, error) {
+	args := m.Called(ctx, key, r)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockObjectStorage) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadSeekCloser), args.Error(1)
+}
+
+func (m *MockObjectStorage) Stat(ctx context.Context, key string) (*domain.ObjectInfo, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ObjectInfo), args.Error(1)
+}
+
+func (m *MockObjectStorage) Move(ctx context.Context, srcKey, dstKey string) error {
+	args := m.Called(ctx, srcKey, dstKey)
+	return args.Error(0)
+}
+
+func (m *MockObjectStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockObjectStorage) DeletePrefix(ctx context.Context, prefix string) error {
+	args := m.Called(ctx, prefix)
+	return args.Error(0)
+}
+
+func (m *MockObjectStorage) URL(key string) string {
+	args := m.Called(key)
+	return args.String(0)
+}
+
+type nopReadSeekCloser struct {
+	*bytes.Reader
+}
+
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
+
+func newObjectReader(data []byte) io.ReadSeekCloser {
+	return nopReadSeekCloser{bytes.NewReader(data)}
+}
+
+func createTestUploadUseCase() (*uploadUseCase, *MockUploadSessionRepository,
+	*MockVideoRepository, *MockObjectStorage) {
+
+	mockSessionRepository := &MockUploadSessionRepository{}
+	mockVideoRepository := &MockVideoRepository{}
+	mockJobQueue := &MockJobQueue{}
+	mockStorage := &MockObjectStorage{}
+
+	mockJobQueue.On("Enqueue", mock.Anything, mock.AnythingOfType("*domain.Job")).Return(nil)
+	mockOutbox := &MockOutboxRepository{}
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	usecase := &uploadUseCase{
+		sessionRepo: mockSessionRepository,
+		videoRepo:   mockVideoRepository,
+		jobQueue:    mockJobQueue,
+		storage:     mockStorage,
+		transactor:  fakeTransactor{},
+		outbox:      mockOutbox,
+		policy: UploadPolicy{
+			MaxSizeBytes:      1024,
+			AllowedContainers: []string{"mp4", "webm"},
+			SessionTTL:        time.Hour,
+		},
+	}
+
+	return usecase, mockSessionRepository, mockVideoRepository, mockStorage
+}
+
+func createTestMP4() []byte {
+	data := []byte{0x00, 0x00, 0x00, 0x18, 'f', 't', 'y', 'p', 'i', 's', 'o', 'm'}
+	return append(data, bytes.Repeat([]byte{0x01}, 52)...)
+}
+
+func checksumOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func createTestUploadSession(data []byte) *domain.UploadSession {
+	return &domain.UploadSession{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Title:     "Test Upload",
+		Duration:  30,
+		FileName:  "clip.mp4",
+		Container: "mp4",
+		TotalSize: int64(len(data)),
+		Checksum:  checksumOf(data),
+		ObjectKey: "uploads/test.part",
+		Status:    domain.UploadStatusPending,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+// storedAs makes the locked session row read back as a copy of session.
+func storedAs(mockSessionRepository *MockUploadSessionRepository, session *domain.UploadSession) {
+	stored := *session
+	mockSessionRepository.On("GetByIDForUpdate", mock.Anything, session.ID).Return(&stored, nil)
+}
+
+func TestCreateUploadSession_Success(t *testing.T) {
+	usecase, mockSessionRepository, _, _ := createTestUploadUseCase()
+
+	mockSessionRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UploadSession")).
+		Return(nil)
+
+	session, err := usecase.CreateUploadSession(context.Background(), &CreateUploadSessionRequest{
+		UserID:    uuid.NewString(),
+		Title:     "Clip",
+		Duration:  10,
+		FileName:  "Clip.MP4",
+		TotalSize: 512,
+		Checksum:  "ABCDEF",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "mp4", session.Container)
+	assert.Equal(t, "abcdef", session.Checksum)
+	assert.Equal(t, domain.UploadStatusPending, session.Status)
+	assert.Contains(t, session.ObjectKey, session.ID.String())
+	mockSessionRepository.AssertExpectations(t)
+}
+
+func TestCreateUploadSession_TooLarge(t *testing.T) {
+	usecase, _, _, _ := createTestUploadUseCase()
+
+	session, err := usecase.CreateUploadSession(context.Background(), &CreateUploadSessionRequest{
+		UserID:    uuid.NewString(),
+		FileName:  "clip.mp4",
+		TotalSize: 2048,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrUploadTooLarge)
+	assert.Nil(t, session)
+}
+
+func TestCreateUploadSession_UnsupportedContainer(t *testing.T) {
+	usecase, _, _, _ := createTestUploadUseCase()
+
+	session, err := usecase.CreateUploadSession(context.Background(), &CreateUploadSessionRequest{
+		UserID:    uuid.NewString(),
+		FileName:  "clip.avi",
+		TotalSize: 100,
+	})
+
+	assert.ErrorIs(t, err, domain.ErrUnsupportedContainer)
+	assert.Nil(t, session)
+}
+
+func TestResumeUpload_SyncsOffsetFromStorage(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+	session.ReceivedSize = 10
+
+	mockSessionRepository.On("GetByID", mock.Anything, session.ID).Return(session, nil)
+	mockStorage.On("Stat", mock.Anything, session.ObjectKey).
+		Return(&domain.ObjectInfo{Size: 20}, nil)
+	mockSessionRepository.On("UpdateReceivedSize", mock.Anything, session.ID, int64(20)).Return(nil)
+
+	resumed, err := usecase.ResumeUpload(context.Background(), session.ID.String(), session.UserID.String(), 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), resumed.ReceivedSize)
+	mockSessionRepository.AssertExpectations(t)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestResumeUpload_OffsetMismatch(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+
+	mockSessionRepository.On("GetByID", mock.Anything, session.ID).Return(session, nil)
+	mockStorage.On("Stat", mock.Anything, session.ObjectKey).Return(nil, domain.ErrObjectNotFound)
+
+	resumed, err := usecase.ResumeUpload(context.Background(), session.ID.String(), session.UserID.String(), 32)
+
+	assert.ErrorIs(t, err, domain.ErrUploadOffsetMismatch)
+	assert.Equal(t, int64(0), resumed.ReceivedSize)
+}
+
+func TestResumeUpload_Expired(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+	session.ExpiresAt = time.Now().Add(-time.Minute)
+
+	mockSessionRepository.On("GetByID", mock.Anything, session.ID).Return(session, nil)
+	mockStorage.On("Stat", mock.Anything, session.ObjectKey).Return(nil, domain.ErrObjectNotFound)
+
+	_, err := usecase.ResumeUpload(context.Background(), session.ID.String(), session.UserID.String(), 0)
+
+	assert.ErrorIs(t, err, domain.ErrUploadExpired)
+}
+
+func TestResumeUpload_OtherUsersSession(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+
+	mockSessionRepository.On("GetByID", mock.Anything, session.ID).Return(session, nil)
+
+	_, err := usecase.ResumeUpload(context.Background(), session.ID.String(), uuid.NewString(), 0)
+
+	assert.ErrorIs(t, err, domain.ErrNotUploadOwner)
+	mockStorage.AssertNotCalled(t, "Stat", mock.Anything, mock.Anything)
+}
+
+func TestWriteChunk_Success(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+
+	storedAs(mockSessionRepository, session)
+	mockStorage.On("Append", mock.Anything, session.ObjectKey, mock.Anything).Return(int64(16), nil)
+	mockSessionRepository.On("UpdateReceivedSize", mock.Anything, session.ID, int64(16)).Return(nil)
+
+	err := usecase.WriteChunk(context.Background(), session, make([]byte, 16))
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(16), session.ReceivedSize)
+	mockStorage.AssertExpectations(t)
+	mockSessionRepository.AssertExpectations(t)
+}
+
+func TestWriteChunk_ExceedsDeclaredSize(t *testing.T) {
+	usecase, mockSessionRepository, _, _ := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+	session.ReceivedSize = session.TotalSize - 4
+	storedAs(mockSessionRepository, session)
+
+	err := usecase.WriteChunk(context.Background(), session, make([]byte, 8))
+
+	assert.ErrorIs(t, err, domain.ErrUploadTooLarge)
+}
+
+func TestWriteChunk_AnotherStreamWroteFirst(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+	stored := *session
+	stored.ReceivedSize = 16
+
+	mockSessionRepository.On("GetByIDForUpdate", mock.Anything, session.ID).Return(&stored, nil)
+
+	err := usecase.WriteChunk(context.Background(), session, make([]byte, 16))
+
+	assert.ErrorIs(t, err, domain.ErrUploadOffsetMismatch)
+	mockStorage.AssertNotCalled(t, "Append", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCompleteUpload_Success(t *testing.T) {
+	usecase, mockSessionRepository, mockVideoRepository, mockStorage := createTestUploadUseCase()
+	data := createTestMP4()
+	session := createTestUploadSession(data)
+	session.ReceivedSize = session.TotalSize
+	storedAs(mockSessionRepository, session)
+
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(newObjectReader(data), nil)
+	mockStorage.On("Move", mock.Anything, session.ObjectKey, mock.MatchedBy(func(key string) bool {
+		return key == "videos/"+session.UserID.String()+"/"+session.ID.String()+".mp4"
+	})).Return(nil)
+	mockStorage.On("URL", mock.Anything).Return("https://cdn.example.com/video.mp4")
+	mockVideoRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.Video).ID = uuid.New()
+		}).Return(nil)
+	mockSessionRepository.On("MarkCompleted", mock.Anything, session.ID, mock.Anything).Return(nil)
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/video.mp4", video.VideoURL)
+	assert.Equal(t, session.UserID, video.UserID)
+	assert.Equal(t, domain.ProcessingStatusUploaded, video.ProcessingStatus)
+	usecase.jobQueue.(*MockJobQueue).AssertCalled(t, "Enqueue", mock.Anything, mock.MatchedBy(func(job *domain.Job) bool {
+		return job.Type == domain.JobTypeTranscode && job.VideoID == video.ID
+	}))
+	assert.Equal(t, domain.UploadStatusCompleted, session.Status)
+	assert.Equal(t, video.ID, *session.VideoID)
+	mockStorage.AssertExpectations(t)
+	mockVideoRepository.AssertExpectations(t)
+	mockSessionRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertCalled(t, "Append", mock.Anything,
+		mock.MatchedBy(func(event *domain.VideoEvent) bool {
+			return event.Type == domain.EventVideoCreated && event.Video == video
+		}))
+}
+
+func TestCompleteUpload_CreateFailureRestoresUpload(t *testing.T) {
+	usecase, mockSessionRepository, mockVideoRepository, mockStorage := createTestUploadUseCase()
+	data := createTestMP4()
+	session := createTestUploadSession(data)
+	session.ReceivedSize = session.TotalSize
+	objectKey := "videos/" + session.UserID.String() + "/" + session.ID.String() + ".mp4"
+	storedAs(mockSessionRepository, session)
+
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(newObjectReader(data), nil)
+	mockStorage.On("Move", mock.Anything, session.ObjectKey, objectKey).Return(nil).Once()
+	mockStorage.On("URL", mock.Anything).Return("https://cdn.example.com/video.mp4")
+	mockVideoRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).
+		Return(errors.New("database unavailable"))
+	mockStorage.On("Move", mock.Anything, objectKey, session.ObjectKey).Return(nil).Once()
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.EqualError(t, err, "database unavailable")
+	assert.Nil(t, video)
+	assert.Equal(t, domain.UploadStatusPending, session.Status)
+	assert.Equal(t, session.TotalSize, session.ReceivedSize)
+	mockStorage.AssertExpectations(t)
+	mockSessionRepository.AssertNotCalled(t, "MarkCompleted", mock.Anything, mock.Anything, mock.Anything)
+	usecase.jobQueue.(*MockJobQueue).AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+}
+
+func TestCompleteUpload_AlreadyCompletedByAnotherStream(t *testing.T) {
+	usecase, mockSessionRepository, mockVideoRepository, mockStorage := createTestUploadUseCase()
+	data := createTestMP4()
+	session := createTestUploadSession(data)
+	session.ReceivedSize = session.TotalSize
+	objectKey := "videos/" + session.UserID.String() + "/" + session.ID.String() + ".mp4"
+	stored := *session
+	stored.Status = domain.UploadStatusCompleted
+
+	mockSessionRepository.On("GetByIDForUpdate", mock.Anything, session.ID).Return(&stored, nil)
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(newObjectReader(data), nil)
+	mockStorage.On("Move", mock.Anything, session.ObjectKey, objectKey).Return(nil).Once()
+	mockStorage.On("URL", mock.Anything).Return("https://cdn.example.com/video.mp4")
+	mockStorage.On("Move", mock.Anything, objectKey, session.ObjectKey).Return(nil).Once()
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.ErrorIs(t, err, domain.ErrUploadNotPending)
+	assert.Nil(t, video)
+	mockVideoRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCompleteUpload_ChecksumMismatch(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	data := createTestMP4()
+	session := createTestUploadSession(data)
+	session.ReceivedSize = session.TotalSize
+	session.Checksum = checksumOf([]byte("something else"))
+
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(newObjectReader(data), nil)
+	mockStorage.On("Delete", mock.Anything, session.ObjectKey).Return(nil)
+	mockSessionRepository.On("UpdateReceivedSize", mock.Anything, session.ID, int64(0)).Return(nil)
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.ErrorIs(t, err, domain.ErrUploadChecksumMismatch)
+	assert.Nil(t, video)
+	assert.Equal(t, int64(0), session.ReceivedSize)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestCompleteUpload_UnsupportedContent(t *testing.T) {
+	usecase, mockSessionRepository, _, mockStorage := createTestUploadUseCase()
+	data := []byte("definitely not a video file")
+	session := createTestUploadSession(data)
+	session.ReceivedSize = session.TotalSize
+
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(newObjectReader(data), nil)
+	mockStorage.On("Delete", mock.Anything, session.ObjectKey).Return(nil)
+	mockSessionRepository.On("UpdateReceivedSize", mock.Anything, session.ID, int64(0)).Return(nil)
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.ErrorIs(t, err, domain.ErrUnsupportedContainer)
+	assert.Nil(t, video)
+}
+
+func TestCompleteUpload_Incomplete(t *testing.T) {
+	usecase, _, _, _ := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.ErrorIs(t, err, domain.ErrUploadIncomplete)
+	assert.Nil(t, video)
+}
+
+func TestCompleteUpload_StorageError(t *testing.T) {
+	usecase, _, _, mockStorage := createTestUploadUseCase()
+	session := createTestUploadSession(createTestMP4())
+	session.ReceivedSize = session.TotalSize
+
+	mockStorage.On("Open", mock.Anything, session.ObjectKey).Return(nil, errors.New("disk error"))
+
+	video, err := usecase.CompleteUpload(context.Background(), session)
+
+	assert.Error(t, err)
+	assert.Nil(t, video)
+}
+
+func TestDetectContainer(t *testing.T) {
+	assert.Equal(t, "mp4", detectContainer(createTestMP4()[:12]))
+	assert.Equal(t, "mov", detectContainer([]byte{0, 0, 0, 0x14, 'f', 't', 'y', 'p', 'q', 't', ' ', ' '}))
+	assert.Equal(t, "webm", detectContainer([]byte{0x1A, 0x45, 0xDF, 0xA3, 0x01}))
+	assert.Equal(t, "", detectContainer([]byte("RIFF")))
+}
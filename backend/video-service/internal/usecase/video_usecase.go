@@ -2,41 +2,76 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 	"video-service/internal/domain"
 
 	"github.com/google/uuid"
 )
 
+const (
+	defaultProfileLimit = 20
+	maxProfileLimit     = 100
+)
+
 type VideoUseCase interface {
 	CreateVideo(ctx context.Context, req *CreateVideoRequest) (*domain.Video, error)
-	GetVideo(ctx context.Context, id string) (*domain.Video, error)
-	ListVideos(ctx context.Context, limit, offset int) ([]*domain.Video, int64, error)
-	GetVideosByUser(ctx context.Context, userID string, limit, offset int) ([]*domain.Video, int64, error)
+	GetVideo(ctx context.Context, id, viewerID string) (*domain.Video, error)
+	ListVideos(ctx context.Context, viewerID string, limit, offset int) ([]*domain.Video, int64, error)
+	GetVideosByUser(ctx context.Context, req *GetVideosByUserRequest) (*UserVideosPage, error)
 	UpdateVideo(ctx context.Context, req *UpdateVideoRequest) (*domain.Video, error)
 	DeleteVideo(ctx context.Context, id string) error
 	LikeVideo(ctx context.Context, userID, videoID string) (int64, error)
 	UnlikeVideo(ctx context.Context, userID, videoID string) (int64, error)
-	CreateView(ctx context.Context, userID, videoID string, watchTime int) (int64, error)
+	CreateView(ctx context.Context, req *CreateViewRequest) (*ViewOutcome, error)
 	CheckUserLikedVideo(ctx context.Context, userID, videoID string) (bool, error)
 	GetVideoLikeCount(ctx context.Context, videoID string) (int64, error)
+	SetVideoCover(ctx context.Context, userID, videoID string, coverTimeMs int) (*domain.Video, error)
 }
 
 type videoUseCase struct {
-	videoRepo domain.VideoRepository
-	likeRepo  domain.UserVideoLikeRepository
-	viewRepo  domain.UserVideoViewRepository
+	videoRepo  domain.VideoRepository
+	likeRepo   domain.UserVideoLikeRepository
+	viewRepo   domain.UserVideoViewRepository
+	jobQueue   domain.JobQueue
+	tagRepo    domain.TagRepository
+	directory  domain.UserDirectory
+	blockRepo  domain.BlockRepository
+	transactor domain.Transactor
+	outbox     domain.OutboxRepository
+	notifier   *Notifier
+	viewPolicy ViewPolicy
 }
 
 func NewVideoUseCase(
 	videoRepo domain.VideoRepository,
 	likeRepo domain.UserVideoLikeRepository,
 	viewRepo domain.UserVideoViewRepository,
+	jobQueue domain.JobQueue,
+	tagRepo domain.TagRepository,
+	directory domain.UserDirectory,
+	blockRepo domain.BlockRepository,
+	transactor domain.Transactor,
+	outbox domain.OutboxRepository,
+	notifier *Notifier,
+	viewPolicy ViewPolicy,
 ) VideoUseCase {
 	return &videoUseCase{
-		videoRepo: videoRepo,
-		likeRepo:  likeRepo,
-		viewRepo:  viewRepo,
+		videoRepo:  videoRepo,
+		likeRepo:   likeRepo,
+		viewRepo:   viewRepo,
+		jobQueue:   jobQueue,
+		tagRepo:    tagRepo,
+		directory:  directory,
+		blockRepo:  blockRepo,
+		transactor: transactor,
+		outbox:     outbox,
+		notifier:   notifier,
+		viewPolicy: viewPolicy,
 	}
 }
 
@@ -47,58 +82,107 @@ type CreateVideoRequest struct {
 	VideoURL     string `json:"video_url"`
 	ThumbnailURL string `json:"thumbnail_url"`
 	Duration     int    `json:"duration"`
-	IsPublic     bool   `json:"is_public"`
+	Visibility   string `json:"visibility"`
+	Region       string `json:"region"`
+	Draft        bool   `json:"draft"`
 }
 
 func (usecase *videoUseCase) CreateVideo(ctx context.Context, req *CreateVideoRequest) (
 	*domain.Video, error) {
 
-	userID, err := uuid.Parse(req.UserID)
+	userID, err := parseID(req.UserID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	visibility, err := parseVisibility(req.Visibility)
 	if err != nil {
 		return nil, err
 	}
 
 	video := domain.Video{
-		UserID:       userID,
-		Title:        req.Title,
-		Description:  req.Description,
-		VideoURL:     req.VideoURL,
-		ThumbnailURL: req.ThumbnailURL,
-		Duration:     req.Duration,
-		IsPublic:     req.IsPublic,
+		UserID:          userID,
+		Title:           req.Title,
+		Description:     req.Description,
+		VideoURL:        req.VideoURL,
+		ThumbnailURL:    req.ThumbnailURL,
+		Duration:        req.Duration,
+		Visibility:      visibility,
+		ModerationState: domain.ModerationStateActive,
+		Region:          normalizeRegion(req.Region),
+	}
+	setInitialPublication(&video, req.Draft)
+	hashtags, mentions, err := resolveVideoTags(ctx, usecase.directory, video.Description)
+	if err != nil {
+		return nil, err
 	}
-	err = usecase.videoRepo.Create(ctx, &video)
+	// Nobody else can see a draft, so drafts notify no one until published.
+	mentioned, err := mentionRecipients(ctx, usecase.directory, usecase.blockRepo, &video, mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []*domain.Notification
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := usecase.videoRepo.Create(ctx, &video)
+		if err != nil {
+			return err
+		}
+
+		err = usecase.tagRepo.ReplaceVideoTags(ctx, video.ID, hashtags, mentions)
+		if err != nil {
+			return err
+		}
+
+		notifications, err = recordMentions(ctx, usecase.notifier, &video, mentioned)
+		if err != nil {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoCreated,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+			Video:   &video,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	usecase.notifier.Deliver(notifications...)
 	return &video, nil
 }
 
-func (usecase *videoUseCase) GetVideo(ctx context.Context, id string) (
+func (usecase *videoUseCase) GetVideo(ctx context.Context, id, viewerID string) (
 	*domain.Video, error) {
 
-	uuidParsed, err := uuid.Parse(id)
+	uuidParsed, err := parseID(id, "id")
 	if err != nil {
 		return nil, err
 	}
-	video, err := usecase.videoRepo.GetByID(ctx, uuidParsed)
+	viewerUUID, err := parseViewerID(viewerID)
 	if err != nil {
 		return nil, err
 	}
 
-	return video, nil
+	return viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, uuidParsed, viewerUUID)
 }
 
-func (usecase *videoUseCase) ListVideos(ctx context.Context, limit, offset int) (
+// ListVideos leaves out videos from users blocked with the viewer.
+func (usecase *videoUseCase) ListVideos(ctx context.Context, viewerID string, limit, offset int) (
 	[]*domain.Video, int64, error) {
 
-	videos, err := usecase.videoRepo.GetPublicVideos(ctx, limit, offset)
+	viewerUUID, err := parseViewerID(viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	videos, err := usecase.videoRepo.GetPublicVideos(ctx, viewerUUID, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	totalCount, err := usecase.videoRepo.CountPublicVideos(ctx)
+	totalCount, err := usecase.videoRepo.CountPublicVideos(ctx, viewerUUID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -106,38 +190,197 @@ func (usecase *videoUseCase) ListVideos(ctx context.Context, limit, offset int)
 	return videos, totalCount, nil
 }
 
-func (usecase *videoUseCase) GetVideosByUser(ctx context.Context, userID string,
-	limit, offset int) ([]*domain.Video, int64, error) {
-	uuidParsed, err := uuid.Parse(userID)
+type GetVideosByUserRequest struct {
+	UserID   string `json:"user_id"`
+	ViewerID string `json:"viewer_id"`
+	Sort     string `json:"sort"`
+	Cursor   string `json:"cursor"`
+	Limit    int    `json:"limit"`
+	Offset   int    `json:"offset"`
+}
+
+// UserVideosPage is one page of a creator's profile grid. Pinned is only
+// filled on the first page and its videos are never repeated in Videos.
+type UserVideosPage struct {
+	Pinned     []*domain.Video
+	Videos     []*domain.Video
+	Total      int64
+	NextCursor string
+}
+
+func (usecase *videoUseCase) GetVideosByUser(ctx context.Context, req *GetVideosByUserRequest) (
+	*UserVideosPage, error) {
+
+	uuidParsed, err := parseID(req.UserID, "user_id")
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	viewerUUID, err := parseViewerID(req.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	sort, err := parseVideoSort(req.Sort)
+	if err != nil {
+		return nil, err
 	}
 
-	videos, err := usecase.videoRepo.GetByUserID(ctx, uuidParsed, limit, offset)
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultProfileLimit
+	}
+	limit = min(limit, maxProfileLimit)
+
+	query := domain.UserVideosQuery{
+		UserID: uuidParsed,
+		Sort:   sort,
+		Limit:  limit + 1,
+		Offset: req.Offset,
+	}
+	if req.Cursor != "" {
+		query.After, err = decodeVideoCursor(req.Cursor, sort)
+		if err != nil {
+			return nil, err
+		}
+		query.Offset = 0
+	}
+
+	query.Visibilities, err = listableVisibilities(ctx, usecase.directory, usecase.blockRepo, uuidParsed,
+		viewerUUID)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
+	if len(query.Visibilities) == 0 {
+		return &UserVideosPage{Pinned: []*domain.Video{}, Videos: []*domain.Video{}}, nil
+	}
+	query.OwnerListing = viewerUUID == uuidParsed
 
-	totalCount, err := usecase.videoRepo.CountByUserID(ctx, uuidParsed)
+	page := &UserVideosPage{}
+	if query.After == nil && query.Offset == 0 {
+		pinnedQuery := query
+		pinnedQuery.Pinned = true
+		pinnedQuery.Limit = domain.MaxPinnedVideos
+		page.Pinned, err = usecase.videoRepo.GetByUserID(ctx, pinnedQuery)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page.Videos, err = usecase.videoRepo.GetByUserID(ctx, query)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	if len(page.Videos) > limit {
+		page.Videos = page.Videos[:limit]
+		page.NextCursor = encodeVideoCursor(videoCursorAt(page.Videos[limit-1], sort))
+	}
+	for _, video := range slices.Concat(page.Pinned, page.Videos) {
+		hideModerationReason(video, viewerUUID)
 	}
 
-	return videos, totalCount, nil
+	page.Total, err = usecase.videoRepo.CountByUserID(ctx, uuidParsed, query.Visibilities, query.OwnerListing)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func parseVideoSort(sort string) (domain.VideoSort, error) {
+	switch domain.VideoSort(sort) {
+	case "", domain.VideoSortLatest:
+		return domain.VideoSortLatest, nil
+	case domain.VideoSortPopular, domain.VideoSortOldest:
+		return domain.VideoSort(sort), nil
+	}
+	return "", domain.ErrInvalidVideoSort
+}
+
+func videoCursorAt(video *domain.Video, sort domain.VideoSort) *domain.VideoCursor {
+	return &domain.VideoCursor{Sort: sort, PublishedAt: video.PublishedAt(), ViewCount: video.ViewCount, ID: video.ID}
+}
+
+// encodeVideoCursor records the sort with the key so that a cursor cannot be
+// replayed against a different order.
+func encodeVideoCursor(cursor *domain.VideoCursor) string {
+	key := cursor.PublishedAt.UnixMicro()
+	if cursor.Sort == domain.VideoSortPopular {
+		key = cursor.ViewCount
+	}
+	raw := string(cursor.Sort) + "|" + strconv.FormatInt(key, 10) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeVideoCursor(encoded string, sort domain.VideoSort) (*domain.VideoCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.ErrInvalidVideoCursor
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 || domain.VideoSort(parts[0]) != sort {
+		return nil, domain.ErrInvalidVideoCursor
+	}
+	key, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidVideoCursor
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, domain.ErrInvalidVideoCursor
+	}
+
+	cursor := &domain.VideoCursor{Sort: sort, ID: id}
+	if sort == domain.VideoSortPopular {
+		cursor.ViewCount = key
+	} else {
+		cursor.PublishedAt = time.UnixMicro(key)
+	}
+	return cursor, nil
 }
 
+// Paths an UpdateVideoRequest's UpdateMask may name.
+const (
+	VideoFieldTitle        = "title"
+	VideoFieldDescription  = "description"
+	VideoFieldThumbnailURL = "thumbnail_url"
+	VideoFieldVisibility   = "visibility"
+)
+
 type UpdateVideoRequest struct {
 	ID           string `json:"id"`
 	Title        string `json:"title"`
 	Description  string `json:"description"`
 	ThumbnailURL string `json:"thumbnail_url"`
-	IsPublic     bool   `json:"is_public"`
+	Visibility   string `json:"visibility"`
+	// UpdateMask lists the fields to write. When empty, the fields with
+	// non-zero values are written and the rest are left alone.
+	UpdateMask []string `json:"update_mask"`
+	// ETag, when set, must match the stored video's ETag.
+	ETag string `json:"etag"`
+}
+
+func (req *UpdateVideoRequest) fieldsToUpdate() []string {
+	if len(req.UpdateMask) > 0 {
+		return req.UpdateMask
+	}
+	var fields []string
+	for _, candidate := range []struct{ field, value string }{
+		{VideoFieldTitle, req.Title},
+		{VideoFieldDescription, req.Description},
+		{VideoFieldThumbnailURL, req.ThumbnailURL},
+		{VideoFieldVisibility, req.Visibility},
+	} {
+		if candidate.value != "" {
+			fields = append(fields, candidate.field)
+		}
+	}
+	return fields
 }
 
 func (usecase *videoUseCase) UpdateVideo(ctx context.Context, req *UpdateVideoRequest) (
 	*domain.Video, error) {
 
-	uuidParsed, err := uuid.Parse(req.ID)
+	uuidParsed, err := parseID(req.ID, "id")
 	if err != nil {
 		return nil, err
 	}
@@ -146,37 +389,153 @@ func (usecase *videoUseCase) UpdateVideo(ctx context.Context, req *UpdateVideoRe
 	if err != nil {
 		return nil, err
 	}
-
-	video.Title = req.Title
-	video.Description = req.Description
-	video.ThumbnailURL = req.ThumbnailURL
-	video.IsPublic = req.IsPublic
+	if req.ETag != "" && req.ETag != video.ETag() {
+		return nil, domain.ErrVideoModified
+	}
+
+	fields := req.fieldsToUpdate()
+	if len(fields) == 0 {
+		return nil, domain.NewInvalidArgumentError("update_mask", "update_mask must name at least one field")
+	}
+	descriptionChanged := false
+	for _, field := range fields {
+		switch field {
+		case VideoFieldTitle:
+			if req.Title == "" {
+				return nil, domain.NewInvalidArgumentError("title", "title is required")
+			}
+			video.Title = req.Title
+		case VideoFieldDescription:
+			descriptionChanged = video.Description != req.Description
+			video.Description = req.Description
+		case VideoFieldThumbnailURL:
+			video.ThumbnailURL = req.ThumbnailURL
+		case VideoFieldVisibility:
+			if req.Visibility == "" {
+				return nil, domain.ErrInvalidVisibility
+			}
+			video.Visibility, err = parseVisibility(req.Visibility)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, domain.NewInvalidArgumentError("update_mask",
+				fmt.Sprintf("update_mask path %q cannot be updated", field))
+		}
+	}
 	video.UpdatedAt = time.Now()
 
-	err = usecase.videoRepo.Update(ctx, video)
+	var hashtags []string
+	var mentions []*domain.VideoMention
+	var mentioned []uuid.UUID
+	if descriptionChanged {
+		hashtags, mentions, err = resolveVideoTags(ctx, usecase.directory, video.Description)
+		if err != nil {
+			return nil, err
+		}
+		added, err := usecase.addedMentions(ctx, video.ID, mentions)
+		if err != nil {
+			return nil, err
+		}
+		mentioned, err = mentionRecipients(ctx, usecase.directory, usecase.blockRepo, video, added)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var notifications []*domain.Notification
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := usecase.videoRepo.Update(ctx, video)
+		if err != nil {
+			return err
+		}
+
+		if descriptionChanged {
+			err = usecase.tagRepo.ReplaceVideoTags(ctx, video.ID, hashtags, mentions)
+			if err != nil {
+				return err
+			}
+			notifications, err = recordMentions(ctx, usecase.notifier, video, mentioned)
+			if err != nil {
+				return err
+			}
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoUpdated,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+			Video:   video,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	usecase.notifier.Deliver(notifications...)
 	return video, nil
 }
 
+// addedMentions leaves out users the description already mentioned, so an
+// edit only notifies people it newly mentions.
+func (usecase *videoUseCase) addedMentions(ctx context.Context, videoID uuid.UUID,
+	mentions []*domain.VideoMention) ([]*domain.VideoMention, error) {
+
+	previous, err := usecase.tagRepo.GetVideoMentions(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	mentionedBefore := make(map[uuid.UUID]bool, len(previous))
+	for _, mention := range previous {
+		mentionedBefore[mention.UserID] = true
+	}
+
+	var added []*domain.VideoMention
+	for _, mention := range mentions {
+		if !mentionedBefore[mention.UserID] {
+			added = append(added, mention)
+		}
+	}
+	return added, nil
+}
+
 func (usecase *videoUseCase) DeleteVideo(ctx context.Context, id string) error {
-	uuidParsed, err := uuid.Parse(id)
+	uuidParsed, err := parseID(id, "id")
 	if err != nil {
 		return err
 	}
 
-	return usecase.videoRepo.Delete(ctx, uuidParsed)
+	video, err := usecase.videoRepo.GetByID(ctx, uuidParsed)
+	if err != nil {
+		return err
+	}
+
+	return usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := usecase.videoRepo.Delete(ctx, video.ID)
+		if err != nil {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:    domain.EventVideoDeleted,
+			VideoID: video.ID,
+			UserID:  video.UserID,
+		})
+	})
 }
 
 func (usecase *videoUseCase) LikeVideo(ctx context.Context, userID, videoID string) (
 	int64, error) {
-	userUUID, err := uuid.Parse(userID)
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return 0, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
 	if err != nil {
 		return 0, err
 	}
-	videoUUID, err := uuid.Parse(videoID)
+
+	video, err := viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoUUID, userUUID)
 	if err != nil {
 		return 0, err
 	}
@@ -198,38 +557,83 @@ func (usecase *videoUseCase) LikeVideo(ctx context.Context, userID, videoID stri
 		VideoID: videoUUID,
 	}
 
-	err = usecase.likeRepo.Create(ctx, like)
-	if err != nil {
-		return 0, err
-	}
+	var count int64
+	var notification *domain.Notification
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := usecase.likeRepo.Create(ctx, like)
+		if err != nil {
+			return err
+		}
 
-	count, err := usecase.likeRepo.CountByVideoID(ctx, videoUUID)
+		// A concurrent request may have recorded the like after the check
+		// above; it has already notified and emitted the event.
+		count, err = usecase.likeRepo.CountByVideoID(ctx, videoUUID)
+		if err != nil || !created {
+			return err
+		}
+
+		notification, err = usecase.notifier.Record(ctx, &domain.Activity{
+			Type:        domain.NotificationTypeLike,
+			RecipientID: video.UserID,
+			ActorID:     userUUID,
+			VideoID:     &videoUUID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:      domain.EventVideoLiked,
+			VideoID:   videoUUID,
+			UserID:    userUUID,
+			LikeCount: count,
+		})
+	})
 	if err != nil {
 		return 0, err
 	}
 
+	usecase.notifier.Deliver(notification)
 	return count, nil
 }
 
 func (usecase *videoUseCase) UnlikeVideo(ctx context.Context, userID, videoID string) (
 	int64, error) {
 
-	userUUID, err := uuid.Parse(userID)
+	userUUID, err := parseID(userID, "user_id")
 	if err != nil {
 		return 0, err
 	}
 
-	videoUUID, err := uuid.Parse(videoID)
+	videoUUID, err := parseID(videoID, "video_id")
 	if err != nil {
 		return 0, err
 	}
 
-	err = usecase.likeRepo.Delete(ctx, userUUID, videoUUID)
+	_, err = viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoUUID, userUUID)
 	if err != nil {
 		return 0, err
 	}
 
-	count, err := usecase.likeRepo.CountByVideoID(ctx, videoUUID)
+	var count int64
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := usecase.likeRepo.Delete(ctx, userUUID, videoUUID)
+		if err != nil {
+			return err
+		}
+
+		count, err = usecase.likeRepo.CountByVideoID(ctx, videoUUID)
+		if err != nil {
+			return err
+		}
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:      domain.EventVideoUnliked,
+			VideoID:   videoUUID,
+			UserID:    userUUID,
+			LikeCount: count,
+		})
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -237,44 +641,94 @@ func (usecase *videoUseCase) UnlikeVideo(ctx context.Context, userID, videoID st
 	return count, nil
 }
 
-func (usecase *videoUseCase) CreateView(ctx context.Context, userID, videoID string,
-	watchTime int) (int64, error) {
-
-	userUUID, err := uuid.Parse(userID)
+func (usecase *videoUseCase) CreateView(ctx context.Context, req *CreateViewRequest) (*ViewOutcome, error) {
+	userUUID, err := parseID(req.UserID, "user_id")
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	videoUUID, err := uuid.Parse(videoID)
+	videoUUID, err := parseID(req.VideoID, "video_id")
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	video, err := viewableVideo(ctx, usecase.videoRepo, usecase.directory, usecase.blockRepo, videoUUID, userUUID)
+	if err != nil {
+		return nil, err
 	}
 
 	view := &domain.UserVideoView{
 		UserID:    userUUID,
 		VideoID:   videoUUID,
-		WatchTime: watchTime,
+		WatchTime: req.WatchTime,
+		ClientIP:  req.ClientIP,
 	}
 
-	err = usecase.viewRepo.Create(ctx, view)
+	outcome := &ViewOutcome{}
+	err = usecase.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		counted, err := usecase.recordView(ctx, video, view)
+		if err != nil {
+			return err
+		}
+
+		outcome.TotalViews, err = usecase.viewRepo.CountByVideoID(ctx, videoUUID)
+		if err != nil || !counted {
+			return err
+		}
+		outcome.Counted = true
+
+		return usecase.outbox.Append(ctx, &domain.VideoEvent{
+			Type:      domain.EventVideoViewed,
+			VideoID:   videoUUID,
+			UserID:    userUUID,
+			ViewCount: outcome.TotalViews,
+			WatchTime: req.WatchTime,
+		})
+	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	count, err := usecase.viewRepo.CountByVideoID(ctx, videoUUID)
+	return outcome, nil
+}
+
+// recordView applies the view policy and reports whether the view was
+// stored as a new counted view.
+func (usecase *videoUseCase) recordView(ctx context.Context, video *domain.Video,
+	view *domain.UserVideoView) (bool, error) {
+
+	recent, err := usecase.viewPolicy.recentView(ctx, usecase.viewRepo, view.UserID, view.VideoID)
 	if err != nil {
-		return 0, err
+		return false, err
+	}
+	if recent != nil {
+		if view.WatchTime <= recent.WatchTime {
+			return false, nil
+		}
+		return false, usecase.viewRepo.ExtendWatchTime(ctx, recent.ID, view.WatchTime)
 	}
 
-	return count, nil
+	if view.WatchTime < usecase.viewPolicy.minWatchTime(video) {
+		return false, nil
+	}
+
+	view.FlagReason, err = usecase.viewPolicy.judgeView(ctx, usecase.viewRepo, view)
+	if err != nil {
+		return false, err
+	}
+	if err := usecase.viewRepo.Create(ctx, view); err != nil {
+		return false, err
+	}
+
+	return view.FlagReason == "", nil
 }
 
 func (usecase *videoUseCase) CheckUserLikedVideo(ctx context.Context, userID, videoID string) (bool, error) {
-	userUUID, err := uuid.Parse(userID)
+	userUUID, err := parseID(userID, "user_id")
 	if err != nil {
 		return false, err
 	}
 
-	videoUUID, err := uuid.Parse(videoID)
+	videoUUID, err := parseID(videoID, "video_id")
 	if err != nil {
 		return false, err
 	}
@@ -288,7 +742,7 @@ func (usecase *videoUseCase) CheckUserLikedVideo(ctx context.Context, userID, vi
 }
 
 func (usecase *videoUseCase) GetVideoLikeCount(ctx context.Context, videoID string) (int64, error) {
-	videoUUID, err := uuid.Parse(videoID)
+	videoUUID, err := parseID(videoID, "video_id")
 	if err != nil {
 		return 0, err
 	}
@@ -300,3 +754,46 @@ func (usecase *videoUseCase) GetVideoLikeCount(ctx context.Context, videoID stri
 
 	return count, nil
 }
+
+func (usecase *videoUseCase) SetVideoCover(ctx context.Context, userID, videoID string,
+	coverTimeMs int) (*domain.Video, error) {
+
+	userUUID, err := parseID(userID, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	videoUUID, err := parseID(videoID, "video_id")
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := usecase.videoRepo.GetByID(ctx, videoUUID)
+	if err != nil {
+		return nil, err
+	}
+	if video.UserID != userUUID {
+		return nil, domain.ErrNotVideoOwner
+	}
+	if video.StorageKey == "" {
+		return nil, domain.ErrVideoNotStored
+	}
+	if coverTimeMs < 0 || coverTimeMs >= video.Duration*1000 {
+		return nil, domain.ErrInvalidCoverTime
+	}
+
+	err = usecase.videoRepo.SetCoverTime(ctx, videoUUID, coverTimeMs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.jobQueue.Enqueue(ctx, &domain.Job{
+		Type:    domain.JobTypeThumbnail,
+		VideoID: videoUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	video.CoverTimeMs = coverTimeMs
+	return video, nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	"gorm.io/gorm"
 )
 
 type MockVideoRepository struct {
@@ -34,22 +33,29 @@ func (m *MockVideoRepository) GetByID(ctx context.Context, id uuid.UUID) (
 	return args.Get(0).(*domain.Video), args.Error(1)
 }
 
+func (m *MockVideoRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Video, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Video), args.Error(1)
+}
+
 func (m *MockVideoRepository) GetByUserID(ctx context.Context,
-	userID uuid.UUID,
-	limit, offset int) ([]*domain.Video, error) {
+	query domain.UserVideosQuery) ([]*domain.Video, error) {
 
-	args := m.Called(ctx, userID, limit, offset)
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domain.Video), args.Error(1)
 }
 
-func (m *MockVideoRepository) GetPublicVideos(ctx context.Context,
+func (m *MockVideoRepository) GetPublicVideos(ctx context.Context, viewerID uuid.UUID,
 	limit, offset int) (
 	[]*domain.Video, error) {
 
-	args := m.Called(ctx, limit, offset)
+	args := m.Called(ctx, viewerID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -68,26 +74,50 @@ func (m *MockVideoRepository) Delete(ctx context.Context,
 	return args.Error(0)
 }
 
-func (m *MockVideoRepository) CountPublicVideos(ctx context.Context) (
+func (m *MockVideoRepository) CountPublicVideos(ctx context.Context, viewerID uuid.UUID) (
 	int64, error) {
-	args := m.Called(ctx)
+	args := m.Called(ctx, viewerID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
 func (m *MockVideoRepository) CountByUserID(ctx context.Context,
-	userID uuid.UUID) (int64, error) {
-	args := m.Called(ctx, userID)
+	userID uuid.UUID, visibilities []domain.Visibility, ownerListing bool) (int64, error) {
+	args := m.Called(ctx, userID, visibilities, ownerListing)
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockVideoRepository) UpdateProcessingStatus(ctx context.Context,
+	id uuid.UUID, status domain.ProcessingStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) SetCoverTime(ctx context.Context,
+	id uuid.UUID, coverTimeMs int) error {
+	args := m.Called(ctx, id, coverTimeMs)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdateThumbnails(ctx context.Context,
+	id uuid.UUID, thumbnailURL, previewURL string) error {
+	args := m.Called(ctx, id, thumbnailURL, previewURL)
+	return args.Error(0)
+}
+
+func (m *MockVideoRepository) UpdatePlaylistURL(ctx context.Context,
+	id uuid.UUID, playlistURL string) error {
+	args := m.Called(ctx, id, playlistURL)
+	return args.Error(0)
+}
+
 type MockUserVideoLikeRepository struct {
 	mock.Mock
 }
 
 func (m *MockUserVideoLikeRepository) Create(ctx context.Context,
-	like *domain.UserVideoLike) error {
+	like *domain.UserVideoLike) (bool, error) {
 	args := m.Called(ctx, like)
-	return args.Error(0)
+	return args.Bool(0), args.Error(1)
 }
 
 func (m *MockUserVideoLikeRepository) Delete(ctx context.Context,
@@ -109,6 +139,16 @@ func (m *MockUserVideoLikeRepository) CountByVideoID(ctx context.Context,
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockUserVideoLikeRepository) LikedAmong(ctx context.Context, userID uuid.UUID,
+	videoIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+
+	args := m.Called(ctx, userID, videoIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]bool), args.Error(1)
+}
+
 type MockUserVideoViewRepository struct {
 	mock.Mock
 }
@@ -138,18 +178,82 @@ func (m *MockUserVideoViewRepository) CountByVideoID(ctx context.Context,
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockUserVideoViewRepository) FindRecent(ctx context.Context, userID, videoID uuid.UUID,
+	since time.Time) (*domain.UserVideoView, error) {
+
+	args := m.Called(ctx, userID, videoID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UserVideoView), args.Error(1)
+}
+
+func (m *MockUserVideoViewRepository) LockViewer(ctx context.Context, userID, videoID uuid.UUID) error {
+	args := m.Called(ctx, userID, videoID)
+	return args.Error(0)
+}
+
+func (m *MockUserVideoViewRepository) ExtendWatchTime(ctx context.Context, viewID uuid.UUID,
+	watchTime int) error {
+
+	args := m.Called(ctx, viewID, watchTime)
+	return args.Error(0)
+}
+
+func (m *MockUserVideoViewRepository) GetActivity(ctx context.Context, userID, videoID uuid.UUID,
+	clientIP string, rateSince, burstSince time.Time) (*domain.ViewActivity, error) {
+
+	args := m.Called(ctx, userID, videoID, clientIP, rateSince, burstSince)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ViewActivity), args.Error(1)
+}
+
+func (m *MockUserVideoViewRepository) ListFlagged(ctx context.Context,
+	limit, offset int) ([]*domain.UserVideoView, error) {
+
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UserVideoView), args.Error(1)
+}
+
+func (m *MockUserVideoViewRepository) CountFlagged(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+var publicOnly = []domain.Visibility{domain.VisibilityPublic}
+
 func createTestVideoUseCase() (*videoUseCase, *MockVideoRepository,
 	*MockUserVideoLikeRepository, *MockUserVideoViewRepository) {
 
 	mockVideoRepository := &MockVideoRepository{}
 	mockLikeRepository := &MockUserVideoLikeRepository{}
 	mockViewRepository := &MockUserVideoViewRepository{}
+	mockJobQueue := &MockJobQueue{}
+	mockTagRepository := &MockTagRepository{}
+	mockTagRepository.On("ReplaceVideoTags", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+	mockTagRepository.On("GetVideoMentions", mock.Anything, mock.Anything).
+		Return([]*domain.VideoMention{}, nil).Maybe()
+	mockOutbox := &MockOutboxRepository{}
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	usecase := &videoUseCase{
-		videoRepo: mockVideoRepository,
-		likeRepo:  mockLikeRepository,
-		viewRepo:  mockViewRepository,
+		videoRepo:  mockVideoRepository,
+		likeRepo:   mockLikeRepository,
+		viewRepo:   mockViewRepository,
+		jobQueue:   mockJobQueue,
+		tagRepo:    mockTagRepository,
+		directory:  &MockUserDirectory{},
+		blockRepo:  noBlocks(),
+		transactor: fakeTransactor{},
+		outbox:     mockOutbox,
 	}
+	usecase.notifier, _, _ = createTestNotifier()
 
 	return usecase, mockVideoRepository, mockLikeRepository, mockViewRepository
 }
@@ -157,7 +261,17 @@ func createTestVideoUseCase() (*videoUseCase, *MockVideoRepository,
 func TestNewVideoUseCase(t *testing.T) {
 	_, mockVideoRepository, mockLikeRepository, mockViewRepository := createTestVideoUseCase()
 
-	usecase := NewVideoUseCase(mockVideoRepository, mockLikeRepository, mockViewRepository)
+	mockJobQueue := &MockJobQueue{}
+	mockTagRepository := &MockTagRepository{}
+	mockDirectory := &MockUserDirectory{}
+	mockBlockRepo := &MockBlockRepository{}
+	mockOutbox := &MockOutboxRepository{}
+	notifier, _, _ := createTestNotifier()
+
+	viewPolicy := ViewPolicy{DedupWindow: time.Hour, MinWatchTime: 3}
+
+	usecase := NewVideoUseCase(mockVideoRepository, mockLikeRepository, mockViewRepository, mockJobQueue,
+		mockTagRepository, mockDirectory, mockBlockRepo, fakeTransactor{}, mockOutbox, notifier, viewPolicy)
 
 	assert.NotNil(t, usecase)
 	concreteUseCase, ok := usecase.(*videoUseCase)
@@ -166,20 +280,28 @@ func TestNewVideoUseCase(t *testing.T) {
 	assert.Equal(t, mockVideoRepository, concreteUseCase.videoRepo)
 	assert.Equal(t, mockLikeRepository, concreteUseCase.likeRepo)
 	assert.Equal(t, mockViewRepository, concreteUseCase.viewRepo)
+	assert.Equal(t, mockJobQueue, concreteUseCase.jobQueue)
+	assert.Equal(t, mockTagRepository, concreteUseCase.tagRepo)
+	assert.Equal(t, mockDirectory, concreteUseCase.directory)
+	assert.Equal(t, mockBlockRepo, concreteUseCase.blockRepo)
+	assert.Equal(t, mockOutbox, concreteUseCase.outbox)
+	assert.Equal(t, notifier, concreteUseCase.notifier)
+	assert.Equal(t, viewPolicy, concreteUseCase.viewPolicy)
 }
 
 func createTestVideo() *domain.Video {
 	return &domain.Video{
-		ID:           uuid.New(),
-		UserID:       uuid.New(),
-		Title:        "Test Video",
-		Description:  "Test Description",
-		VideoURL:     "https://example.com/video.mp4",
-		ThumbnailURL: "https://example.com/thumb.jpg",
-		Duration:     120,
-		IsPublic:     true,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Title:            "Test Video",
+		Description:      "Test Description",
+		VideoURL:         "https://example.com/video.mp4",
+		ThumbnailURL:     "https://example.com/thumb.jpg",
+		Duration:         120,
+		Visibility:       domain.VisibilityPublic,
+		PublicationState: domain.PublicationStatePublished,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
 	}
 }
 
@@ -191,7 +313,7 @@ func createTestCreateVideoRequest() *CreateVideoRequest {
 		VideoURL:     "https://example.com/video.mp4",
 		ThumbnailURL: "https://example.com/thumb.jpg",
 		Duration:     120,
-		IsPublic:     true,
+		Visibility:   string(domain.VisibilityPublic),
 	}
 }
 
@@ -211,6 +333,23 @@ func TestCreateVideo_Success(t *testing.T) {
 	mockVideoRepo.AssertExpectations(t)
 }
 
+func TestCreateVideo_WithoutThumbnailDoesNotFetchTheURL(t *testing.T) {
+	usecase, mockVideoRepo, _, _ := createTestVideoUseCase()
+	mockJobQueue := usecase.jobQueue.(*MockJobQueue)
+	req := createTestCreateVideoRequest()
+	req.VideoURL = "file:///etc/passwd"
+	req.ThumbnailURL = ""
+
+	mockVideoRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Video")).
+		Return(nil)
+
+	video, err := usecase.CreateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.NotNil(t, video)
+	mockJobQueue.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+}
+
 func TestCreateVideo_InvalidUserID(t *testing.T) {
 	usecase, _, _, _ := createTestVideoUseCase()
 	req := createTestCreateVideoRequest()
@@ -222,6 +361,17 @@ func TestCreateVideo_InvalidUserID(t *testing.T) {
 	assert.Nil(t, video)
 }
 
+func TestCreateVideo_InvalidVisibility(t *testing.T) {
+	usecase, _, _, _ := createTestVideoUseCase()
+	req := createTestCreateVideoRequest()
+	req.Visibility = "everyone"
+
+	video, err := usecase.CreateVideo(context.Background(), req)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidVisibility)
+	assert.Nil(t, video)
+}
+
 func TestCreateVideo_RepositoryError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 	req := createTestCreateVideoRequest()
@@ -244,7 +394,7 @@ func TestGetVideo_Success(t *testing.T) {
 	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).
 		Return(testVideo, nil)
 
-	video, err := usecase.GetVideo(context.Background(), testVideo.ID.String())
+	video, err := usecase.GetVideo(context.Background(), testVideo.ID.String(), "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, testVideo, video)
@@ -254,7 +404,7 @@ func TestGetVideo_Success(t *testing.T) {
 func TestGetVideo_InvalidID(t *testing.T) {
 	usecase, _, _, _ := createTestVideoUseCase()
 
-	video, err := usecase.GetVideo(context.Background(), "Invalid ID")
+	video, err := usecase.GetVideo(context.Background(), "Invalid ID", "")
 
 	assert.Error(t, err)
 	assert.Nil(t, video)
@@ -264,16 +414,63 @@ func TestGetVideo_NotFound(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
 	mockVideoRepository.On("GetByID", mock.Anything, mock.Anything).
-		Return(nil, gorm.ErrRecordNotFound)
+		Return(nil, domain.ErrVideoNotFound)
 
-	video, err := usecase.GetVideo(context.Background(), uuid.NewString())
+	video, err := usecase.GetVideo(context.Background(), uuid.NewString(), "")
 
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
 	assert.Nil(t, video)
 	mockVideoRepository.AssertExpectations(t)
 }
 
+func TestGetVideo_PrivateVideoHiddenFromViewer(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	testVideo := createTestVideo()
+	testVideo.Visibility = domain.VisibilityPrivate
+
+	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).
+		Return(testVideo, nil)
+
+	video, err := usecase.GetVideo(context.Background(), testVideo.ID.String(), uuid.NewString())
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	assert.Nil(t, video)
+
+	video, err = usecase.GetVideo(context.Background(), testVideo.ID.String(), testVideo.UserID.String())
+	require.NoError(t, err)
+	assert.Equal(t, testVideo, video)
+}
+
+func TestGetVideosByUser_FollowerSeesFollowersOnlyVideos(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+
+	ownerID := uuid.New()
+	viewerID := uuid.New()
+	visible := []domain.Visibility{domain.VisibilityPublic, domain.VisibilityFollowers}
+
+	mockDirectory.On("GetRelationship", mock.Anything, viewerID, ownerID).
+		Return(&domain.Relationship{Following: true}, nil)
+	mockVideoRepository.On("GetByUserID", mock.Anything, mock.MatchedBy(func(query domain.UserVideosQuery) bool {
+		return query.UserID == ownerID && assert.ObjectsAreEqual(visible, query.Visibilities) &&
+			!query.OwnerListing
+	})).Return([]*domain.Video{createTestVideo()}, nil)
+	mockVideoRepository.On("CountByUserID", mock.Anything, ownerID, visible, false).
+		Return(int64(1), nil)
+
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID:   ownerID.String(),
+		ViewerID: viewerID.String(),
+		Limit:    10,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, page.Videos, 1)
+	assert.Equal(t, int64(1), page.Total)
+	mockDirectory.AssertExpectations(t)
+	mockVideoRepository.AssertExpectations(t)
+}
 func TestListVideos_Success(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
@@ -289,12 +486,12 @@ func TestListVideos_Success(t *testing.T) {
 
 	expectedTotalCount := int64(100)
 
-	mockVideoRepository.On("GetPublicVideos", mock.Anything, limit, offset).
+	mockVideoRepository.On("GetPublicVideos", mock.Anything, uuid.Nil, limit, offset).
 		Return(expectedVideos, nil)
-	mockVideoRepository.On("CountPublicVideos", mock.Anything).
+	mockVideoRepository.On("CountPublicVideos", mock.Anything, uuid.Nil).
 		Return(expectedTotalCount, nil)
 
-	videosListed, totalCount, err := usecase.ListVideos(context.Background(), limit, offset)
+	videosListed, totalCount, err := usecase.ListVideos(context.Background(), "", limit, offset)
 
 	require.NoError(t, err)
 	assert.Equal(t, expectedVideos, videosListed)
@@ -306,10 +503,10 @@ func TestListVideos_Success(t *testing.T) {
 func TestListVideos_GetPublicVideosError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
-	mockVideoRepository.On("GetPublicVideos", mock.Anything, mock.Anything, mock.Anything).
+	mockVideoRepository.On("GetPublicVideos", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(nil, errors.New("database error"))
 
-	videos, totalCount, err := usecase.ListVideos(context.Background(), 10, 0)
+	videos, totalCount, err := usecase.ListVideos(context.Background(), "", 10, 0)
 
 	assert.Nil(t, videos)
 	assert.Equal(t, int64(0), totalCount)
@@ -322,13 +519,13 @@ func TestListVideos_CountPublicVideosError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
 	expectedVideos := []*domain.Video{createTestVideo()}
-	mockVideoRepository.On("GetPublicVideos", mock.Anything, 10, 0).
+	mockVideoRepository.On("GetPublicVideos", mock.Anything, uuid.Nil, 10, 0).
 		Return(expectedVideos, nil)
 
-	mockVideoRepository.On("CountPublicVideos", mock.Anything).
+	mockVideoRepository.On("CountPublicVideos", mock.Anything, uuid.Nil).
 		Return(int64(0), errors.New("database error"))
 
-	videos, totalCount, err := usecase.ListVideos(context.Background(), 10, 0)
+	videos, totalCount, err := usecase.ListVideos(context.Background(), "", 10, 0)
 
 	assert.Nil(t, videos)
 	assert.Equal(t, int64(0), totalCount)
@@ -337,66 +534,186 @@ func TestListVideos_CountPublicVideosError(t *testing.T) {
 	mockVideoRepository.AssertExpectations(t)
 }
 
+// userVideosQuery matches the main listing query, as opposed to the one for
+// pinned videos.
+func userVideosQuery(userID uuid.UUID, sort domain.VideoSort, limit, offset int) any {
+	return mock.MatchedBy(func(query domain.UserVideosQuery) bool {
+		return query.UserID == userID && !query.Pinned && query.Sort == sort &&
+			query.Limit == limit && query.Offset == offset
+	})
+}
+
+func pinnedVideosQuery(userID uuid.UUID) any {
+	return mock.MatchedBy(func(query domain.UserVideosQuery) bool {
+		return query.UserID == userID && query.Pinned
+	})
+}
+
 func TestGetVideosByUser_Success(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
-	limit, offset := 10, 0
 	userID := uuid.New()
 	expectedTotalCount := int64(100)
+	pinned := createTestVideo()
 	expectedVideos := []*domain.Video{
 		createTestVideo(),
 		createTestVideo(),
 		createTestVideo(),
 	}
-	expectedVideos[0].Title = "Video 1"
-	expectedVideos[0].UserID = userID
-	expectedVideos[1].Title = "Video 2"
-	expectedVideos[1].UserID = userID
-	expectedVideos[2].Title = "Video 3"
-	expectedVideos[2].UserID = userID
+	for _, video := range append(expectedVideos, pinned) {
+		video.UserID = userID
+	}
 
-	mockVideoRepository.On("GetByUserID", mock.Anything, userID, limit, offset).
+	mockVideoRepository.On("GetByUserID", mock.Anything, pinnedVideosQuery(userID)).
+		Return([]*domain.Video{pinned}, nil)
+	mockVideoRepository.On("GetByUserID", mock.Anything, userVideosQuery(userID, domain.VideoSortLatest, 11, 0)).
 		Return(expectedVideos, nil)
-	mockVideoRepository.On("CountByUserID", mock.Anything, userID).
+	mockVideoRepository.On("CountByUserID", mock.Anything, userID, publicOnly, false).
 		Return(expectedTotalCount, nil)
 
-	videos, totalCount, err := usecase.GetVideosByUser(context.Background(), userID.String(), limit, offset)
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: userID.String(),
+		Limit:  10,
+	})
 
 	require.NoError(t, err)
-	assert.Equal(t, expectedVideos, videos)
-	assert.Equal(t, expectedTotalCount, totalCount)
-	assert.Len(t, videos, 3)
-	for _, video := range videos {
-		assert.Equal(t, userID, video.UserID)
-	}
+	assert.Equal(t, expectedVideos, page.Videos)
+	assert.Equal(t, []*domain.Video{pinned}, page.Pinned)
+	assert.Equal(t, expectedTotalCount, page.Total)
+	assert.Empty(t, page.NextCursor, "a short page is the last one")
 	mockVideoRepository.AssertExpectations(t)
 }
 
+func TestGetVideosByUser_CursorPagination(t *testing.T) {
+	for _, sort := range []domain.VideoSort{domain.VideoSortLatest, domain.VideoSortPopular, domain.VideoSortOldest} {
+		t.Run(string(sort), func(t *testing.T) {
+			usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+			userID := uuid.New()
+			videos := []*domain.Video{createTestVideo(), createTestVideo(), createTestVideo()}
+			videos[1].ViewCount = 42
+			videos[1].CreatedAt = time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+			publishedAt := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+			videos[1].PublishAt = &publishedAt
+
+			mockVideoRepository.On("GetByUserID", mock.Anything, pinnedVideosQuery(userID)).
+				Return([]*domain.Video{}, nil)
+			mockVideoRepository.On("GetByUserID", mock.Anything, userVideosQuery(userID, sort, 3, 0)).
+				Return(videos, nil)
+			mockVideoRepository.On("CountByUserID", mock.Anything, userID, publicOnly, false).
+				Return(int64(3), nil)
+
+			page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+				UserID: userID.String(),
+				Sort:   string(sort),
+				Limit:  2,
+			})
+
+			require.NoError(t, err)
+			assert.Len(t, page.Videos, 2)
+			require.NotEmpty(t, page.NextCursor)
+
+			cursor, err := decodeVideoCursor(page.NextCursor, sort)
+			require.NoError(t, err)
+			assert.Equal(t, videos[1].ID, cursor.ID)
+			if sort == domain.VideoSortPopular {
+				assert.Equal(t, int64(42), cursor.ViewCount)
+			} else {
+				assert.True(t, publishedAt.Equal(cursor.PublishedAt), "a cursor is keyed on when the video went live")
+			}
+
+			_, err = decodeVideoCursor(page.NextCursor, "other")
+			assert.ErrorIs(t, err, domain.ErrInvalidVideoCursor, "a cursor only continues its own sort")
+		})
+	}
+}
+
+func TestGetVideosByUser_LaterPageSkipsPinned(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	userID := uuid.New()
+	last := createTestVideo()
+	cursor := encodeVideoCursor(videoCursorAt(last, domain.VideoSortOldest))
+
+	mockVideoRepository.On("GetByUserID", mock.Anything, mock.MatchedBy(func(query domain.UserVideosQuery) bool {
+		return !query.Pinned && query.After != nil && query.After.ID == last.ID && query.Offset == 0
+	})).Return([]*domain.Video{createTestVideo()}, nil)
+	mockVideoRepository.On("CountByUserID", mock.Anything, userID, publicOnly, false).Return(int64(5), nil)
+
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: userID.String(),
+		Sort:   "oldest",
+		Cursor: cursor,
+		Offset: 7,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Pinned)
+	assert.Len(t, page.Videos, 1)
+	mockVideoRepository.AssertNotCalled(t, "GetByUserID", mock.Anything, pinnedVideosQuery(userID))
+}
+
+func TestGetVideosByUser_InvalidSortOrCursor(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+
+	_, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: uuid.NewString(),
+		Sort:   "random",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidVideoSort)
+
+	_, err = usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: uuid.NewString(),
+		Cursor: "not a cursor",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidVideoCursor)
+	mockVideoRepository.AssertNotCalled(t, "GetByUserID", mock.Anything, mock.Anything)
+}
+func TestGetVideosByUser_BlockedViewerSeesNothing(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	mockBlockRepo := &MockBlockRepository{}
+	usecase.blockRepo = mockBlockRepo
+
+	ownerID, viewerID := uuid.New(), uuid.New()
+	mockBlockRepo.On("BlockedAmong", mock.Anything, viewerID, []uuid.UUID{ownerID}).
+		Return(map[uuid.UUID]bool{ownerID: true}, nil)
+
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID:   ownerID.String(),
+		ViewerID: viewerID.String(),
+		Limit:    10,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Videos)
+	assert.Empty(t, page.Pinned)
+	assert.Equal(t, int64(0), page.Total)
+	mockVideoRepository.AssertNotCalled(t, "GetByUserID", mock.Anything, mock.Anything)
+}
 func TestGetVideosByUser_InvalidUserID(t *testing.T) {
 	usecase, _, _, _ := createTestVideoUseCase()
 
-	videos, totalCount, err := usecase.GetVideosByUser(context.Background(), "Invalid UserID", 10, 0)
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: "Invalid UserID",
+		Limit:  10,
+	})
 
-	assert.Nil(t, videos)
-	assert.Equal(t, int64(0), totalCount)
+	assert.Nil(t, page)
 	assert.Error(t, err)
 }
-
 func TestGetVideosByUser_GetByUserIDError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
-	mockVideoRepository.On("GetByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(nil, errors.New("database error"))
+	mockVideoRepository.On("GetByUserID", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 
-	videos, totalCount, err := usecase.GetVideosByUser(context.Background(), uuid.NewString(), 10, 0)
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: uuid.NewString(),
+		Limit:  10,
+	})
 
-	assert.Nil(t, videos)
-	assert.Equal(t, int64(0), totalCount)
+	assert.Nil(t, page)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "database error")
 	mockVideoRepository.AssertExpectations(t)
 }
-
 func TestGetVideosByUser_CountByUserIDError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
@@ -404,28 +721,27 @@ func TestGetVideosByUser_CountByUserIDError(t *testing.T) {
 	expectedVideos := []*domain.Video{createTestVideo()}
 	expectedVideos[0].UserID = userID
 
-	mockVideoRepository.On("GetByUserID", mock.Anything, userID, 10, 0).
-		Return(expectedVideos, nil)
-
-	mockVideoRepository.On("CountByUserID", mock.Anything, userID).
+	mockVideoRepository.On("GetByUserID", mock.Anything, mock.Anything).Return(expectedVideos, nil)
+	mockVideoRepository.On("CountByUserID", mock.Anything, userID, publicOnly, false).
 		Return(int64(0), errors.New("database error"))
 
-	videos, totalCount, err := usecase.GetVideosByUser(context.Background(), userID.String(), 10, 0)
+	page, err := usecase.GetVideosByUser(context.Background(), &GetVideosByUserRequest{
+		UserID: userID.String(),
+		Limit:  10,
+	})
 
-	assert.Nil(t, videos)
-	assert.Equal(t, int64(0), totalCount)
+	assert.Nil(t, page)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "database error")
 	mockVideoRepository.AssertExpectations(t)
 }
-
 func createTestUpdateVideoRequest() *UpdateVideoRequest {
 	return &UpdateVideoRequest{
 		ID:           uuid.NewString(),
 		Title:        "Test Update Video",
 		Description:  "Test Update Description",
 		ThumbnailURL: "https://update.exmple.com/thumb.jpg",
-		IsPublic:     false,
+		Visibility:   string(domain.VisibilityPrivate),
 	}
 }
 
@@ -451,7 +767,7 @@ func TestUpdatevideo_Success(t *testing.T) {
 	assert.Equal(t, req.Title, updatedVideo.Title)
 	assert.Equal(t, req.Description, updatedVideo.Description)
 	assert.Equal(t, req.ThumbnailURL, updatedVideo.ThumbnailURL)
-	assert.Equal(t, req.IsPublic, updatedVideo.IsPublic)
+	assert.Equal(t, domain.VisibilityPrivate, updatedVideo.Visibility)
 	assert.True(t, updatedVideo.UpdatedAt.After(originalTime))
 	assert.WithinDuration(t, time.Now(), updatedVideo.UpdatedAt, time.Second)
 	assert.Equal(t, originalVideo.ID, updatedVideo.ID)
@@ -483,13 +799,13 @@ func TestUpdatevideo_NotFound(t *testing.T) {
 	req.ID = videoID.String()
 
 	mockVideoRepository.On("GetByID", mock.Anything, videoID).
-		Return(nil, gorm.ErrRecordNotFound)
+		Return(nil, domain.ErrVideoNotFound)
 
 	video, err := usecase.UpdateVideo(context.Background(), req)
 
 	assert.Nil(t, video)
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
 
 	mockVideoRepository.AssertExpectations(t)
 }
@@ -534,11 +850,120 @@ func TestUpdatevideo_UpdateError(t *testing.T) {
 	mockVideoRepository.AssertExpectations(t)
 }
 
+func TestUpdateVideo_MaskWritesOnlyNamedFields(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+
+	originalVideo := createTestVideo()
+	req := &UpdateVideoRequest{
+		ID:         originalVideo.ID.String(),
+		Visibility: string(domain.VisibilityUnlisted),
+		UpdateMask: []string{VideoFieldVisibility},
+	}
+
+	mockVideoRepository.On("GetByID", mock.Anything, originalVideo.ID).Return(originalVideo, nil)
+	mockVideoRepository.On("Update", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+
+	video, err := usecase.UpdateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityUnlisted, video.Visibility)
+	assert.Equal(t, "Test Video", video.Title)
+	assert.Equal(t, "Test Description", video.Description)
+	assert.Equal(t, "https://example.com/thumb.jpg", video.ThumbnailURL)
+}
+
+func TestUpdateVideo_MaskCanClearDescription(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+
+	originalVideo := createTestVideo()
+	req := &UpdateVideoRequest{ID: originalVideo.ID.String(), UpdateMask: []string{VideoFieldDescription}}
+
+	mockVideoRepository.On("GetByID", mock.Anything, originalVideo.ID).Return(originalVideo, nil)
+	mockVideoRepository.On("Update", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+
+	video, err := usecase.UpdateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Empty(t, video.Description)
+	assert.Equal(t, domain.VisibilityPublic, video.Visibility)
+	usecase.tagRepo.(*MockTagRepository).AssertCalled(t, "ReplaceVideoTags", mock.Anything, originalVideo.ID,
+		mock.Anything, mock.Anything)
+}
+
+func TestUpdateVideo_WithoutMaskLeavesEmptyFieldsAlone(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+
+	originalVideo := createTestVideo()
+	originalVideo.Visibility = domain.VisibilityFriends
+	req := &UpdateVideoRequest{ID: originalVideo.ID.String(), Title: "Renamed"}
+
+	mockVideoRepository.On("GetByID", mock.Anything, originalVideo.ID).Return(originalVideo, nil)
+	mockVideoRepository.On("Update", mock.Anything, mock.AnythingOfType("*domain.Video")).Return(nil)
+
+	video, err := usecase.UpdateVideo(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", video.Title)
+	assert.Equal(t, "Test Description", video.Description)
+	assert.Equal(t, domain.VisibilityFriends, video.Visibility)
+}
+
+func TestUpdateVideo_InvalidMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *UpdateVideoRequest
+		field string
+	}{
+		{"unknown path", &UpdateVideoRequest{UpdateMask: []string{"view_count"}}, "update_mask"},
+		{"nothing to update", &UpdateVideoRequest{}, "update_mask"},
+		{"empty title", &UpdateVideoRequest{UpdateMask: []string{VideoFieldTitle}}, "title"},
+		{"empty visibility", &UpdateVideoRequest{UpdateMask: []string{VideoFieldVisibility}}, "visibility"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+			originalVideo := createTestVideo()
+			tt.req.ID = originalVideo.ID.String()
+			mockVideoRepository.On("GetByID", mock.Anything, originalVideo.ID).Return(originalVideo, nil)
+
+			video, err := usecase.UpdateVideo(context.Background(), tt.req)
+
+			assert.Nil(t, video)
+			var invalid *domain.InvalidArgumentError
+			require.ErrorAs(t, err, &invalid)
+			assert.Equal(t, tt.field, invalid.Field)
+			mockVideoRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateVideo_StaleETag(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+
+	originalVideo := createTestVideo()
+	originalVideo.Version = 3
+	req := createTestUpdateVideoRequest()
+	req.ID = originalVideo.ID.String()
+	req.ETag = "2"
+
+	mockVideoRepository.On("GetByID", mock.Anything, originalVideo.ID).Return(originalVideo, nil)
+
+	video, err := usecase.UpdateVideo(context.Background(), req)
+
+	assert.Nil(t, video)
+	assert.ErrorIs(t, err, domain.ErrVideoModified)
+	mockVideoRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
 func TestDeleteVideo_Success(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
-	videoID := uuid.New()
+	video := createTestVideo()
+	videoID := video.ID
 
+	mockVideoRepository.On("GetByID", mock.Anything, videoID).
+		Return(video, nil)
 	mockVideoRepository.On("Delete", mock.Anything, videoID).
 		Return(nil)
 
@@ -546,6 +971,11 @@ func TestDeleteVideo_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	mockVideoRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertCalled(t, "Append", mock.Anything,
+		mock.MatchedBy(func(event *domain.VideoEvent) bool {
+			return event.Type == domain.EventVideoDeleted && event.VideoID == videoID &&
+				event.UserID == video.UserID
+		}))
 }
 
 func TestDeleteVideo_InvalidID(t *testing.T) {
@@ -561,21 +991,25 @@ func TestDeleteVideo_NotFound(t *testing.T) {
 
 	videoID := uuid.New()
 
-	mockVideoRepository.On("Delete", mock.Anything, videoID).
-		Return(gorm.ErrRecordNotFound)
+	mockVideoRepository.On("GetByID", mock.Anything, videoID).
+		Return(nil, domain.ErrVideoNotFound)
 
 	err := usecase.DeleteVideo(context.Background(), videoID.String())
 
 	assert.Error(t, err)
-	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
 	mockVideoRepository.AssertExpectations(t)
+	mockVideoRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
 }
 
 func TestDeleteVideo_RepositoryError(t *testing.T) {
 	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
 
-	videoID := uuid.New()
+	video := createTestVideo()
+	videoID := video.ID
 
+	mockVideoRepository.On("GetByID", mock.Anything, videoID).
+		Return(video, nil)
 	mockVideoRepository.On("Delete", mock.Anything, videoID).
 		Return(errors.New("database error"))
 
@@ -587,18 +1021,19 @@ func TestDeleteVideo_RepositoryError(t *testing.T) {
 }
 
 func TestLikeVideo_Success(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(false, nil)
 	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).
-		Return(nil)
+		Return(true, nil)
 	mockLikeRepository.On("CountByVideoID", mock.Anything, videoUUID).
 		Return(int64(1), nil)
 
@@ -607,6 +1042,81 @@ func TestLikeVideo_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), likeCount)
 	mockLikeRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertCalled(t, "Append", mock.Anything,
+		mock.MatchedBy(func(event *domain.VideoEvent) bool {
+			return event.Type == domain.EventVideoLiked && event.UserID == userUUID &&
+				event.VideoID == videoUUID && event.LikeCount == 1
+		}))
+}
+
+func TestLikeVideo_NotifiesOwner(t *testing.T) {
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
+	notifier, _, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+
+	userUUID := uuid.New()
+	video := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockLikeRepository.On("Exists", mock.Anything, userUUID, video.ID).Return(false, nil)
+	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).Return(true, nil)
+	mockLikeRepository.On("CountByVideoID", mock.Anything, video.ID).Return(int64(3), nil)
+
+	_, err := usecase.LikeVideo(context.Background(), userUUID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	mockHub.AssertCalled(t, "Publish", mock.MatchedBy(func(notification *domain.Notification) bool {
+		return notification.UserID == video.UserID && notification.Type == domain.NotificationTypeLike &&
+			notification.LastActorID == userUUID && *notification.VideoID == video.ID
+	}))
+}
+
+func TestLikeVideo_ConcurrentDuplicateDoesNotNotifyAgain(t *testing.T) {
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
+	notifier, _, mockHub := createTestNotifier()
+	usecase.notifier = notifier
+	mockOutbox := &MockOutboxRepository{}
+	usecase.outbox = mockOutbox
+
+	userUUID := uuid.New()
+	video := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockLikeRepository.On("Exists", mock.Anything, userUUID, video.ID).Return(false, nil)
+	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).Return(false, nil)
+	mockLikeRepository.On("CountByVideoID", mock.Anything, video.ID).Return(int64(1), nil)
+
+	likeCount, err := usecase.LikeVideo(context.Background(), userUUID.String(), video.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), likeCount)
+	mockHub.AssertNotCalled(t, "Publish", mock.Anything)
+	mockOutbox.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestLikeVideo_OutboxError(t *testing.T) {
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
+	mockOutbox := &MockOutboxRepository{}
+	usecase.outbox = mockOutbox
+
+	userUUID := uuid.New()
+	videoUUID := uuid.New()
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
+
+	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
+		Return(false, nil)
+	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).
+		Return(true, nil)
+	mockLikeRepository.On("CountByVideoID", mock.Anything, videoUUID).
+		Return(int64(1), nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).
+		Return(errors.New("database error"))
+
+	likeCount, err := usecase.LikeVideo(context.Background(), userUUID.String(), videoUUID.String())
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), likeCount)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestLikeVideo_InvalidUserID(t *testing.T) {
@@ -627,14 +1137,54 @@ func TestLikeVideo_InvalidVideoID(t *testing.T) {
 	assert.Equal(t, int64(0), likeCount)
 }
 
+func TestLikeVideo_FollowersOnlyVideoOfUnfollowedUser(t *testing.T) {
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
+	mockDirectory := &MockUserDirectory{}
+	usecase.directory = mockDirectory
+
+	userUUID := uuid.New()
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityFollowers
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockDirectory.On("GetRelationship", mock.Anything, userUUID, video.UserID).
+		Return(&domain.Relationship{FollowedBy: true}, nil)
+
+	likeCount, err := usecase.LikeVideo(context.Background(), userUUID.String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	assert.Equal(t, int64(0), likeCount)
+	mockLikeRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestLikeVideo_BlockedOwner(t *testing.T) {
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
+	mockBlockRepo := &MockBlockRepository{}
+	usecase.blockRepo = mockBlockRepo
+
+	userUUID := uuid.New()
+	video := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockBlockRepo.On("BlockedAmong", mock.Anything, userUUID, []uuid.UUID{video.UserID}).
+		Return(map[uuid.UUID]bool{video.UserID: true}, nil)
+
+	likeCount, err := usecase.LikeVideo(context.Background(), userUUID.String(), video.ID.String())
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+	assert.Equal(t, int64(0), likeCount)
+	mockLikeRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
 func TestLikeVideo_AlreadyLiked(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(true, nil)
@@ -646,16 +1196,18 @@ func TestLikeVideo_AlreadyLiked(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(5), likeCount)
 	mockLikeRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
 }
 
 func TestLikeVideo_ExistsCountError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(true, nil)
@@ -671,13 +1223,14 @@ func TestLikeVideo_ExistsCountError(t *testing.T) {
 }
 
 func TestLikeVideo_ExistsError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(false, errors.New("database error"))
@@ -691,18 +1244,19 @@ func TestLikeVideo_ExistsError(t *testing.T) {
 }
 
 func TestLikeVideo_CreateError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(false, nil)
 	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).
-		Return(errors.New("database error"))
+		Return(false, errors.New("database error"))
 
 	likeCount, err := usecase.LikeVideo(context.Background(), userID, videoID)
 
@@ -713,18 +1267,19 @@ func TestLikeVideo_CreateError(t *testing.T) {
 }
 
 func TestLikeVideo_CountError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Exists", mock.Anything, userUUID, videoUUID).
 		Return(false, nil)
 	mockLikeRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoLike")).
-		Return(nil)
+		Return(true, nil)
 	mockLikeRepository.On("CountByVideoID", mock.Anything, videoUUID).
 		Return(int64(0), errors.New("database error"))
 
@@ -737,13 +1292,14 @@ func TestLikeVideo_CountError(t *testing.T) {
 }
 
 func TestUnlikeVideo_Success(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Delete", mock.Anything, userUUID, videoUUID).
 		Return(nil)
@@ -755,6 +1311,10 @@ func TestUnlikeVideo_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int64(4), likeCount)
 	mockLikeRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertCalled(t, "Append", mock.Anything,
+		mock.MatchedBy(func(event *domain.VideoEvent) bool {
+			return event.Type == domain.EventVideoUnliked && event.LikeCount == 4
+		}))
 }
 
 func TestUnlikeVideo_InvalidUserID(t *testing.T) {
@@ -776,33 +1336,35 @@ func TestUnlikeVideo_InvalidVideoID(t *testing.T) {
 }
 
 func TestUnlikeVideo_NotLiked(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Delete", mock.Anything, userUUID, videoUUID).
-		Return(gorm.ErrRecordNotFound)
+		Return(domain.ErrVideoNotFound)
 
 	likeCount, err := usecase.UnlikeVideo(context.Background(), userID, videoID)
 
 	assert.Error(t, err)
 	assert.Equal(t, int64(0), likeCount)
-	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
 	mockLikeRepository.AssertExpectations(t)
 }
 
 func TestUnlikeVideo_ExistsError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Delete", mock.Anything, userUUID, videoUUID).
 		Return(errors.New("database error"))
@@ -816,13 +1378,14 @@ func TestUnlikeVideo_ExistsError(t *testing.T) {
 }
 
 func TestUnlikeVideo_DeleteError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Delete", mock.Anything, userUUID, videoUUID).
 		Return(errors.New("database error"))
@@ -836,13 +1399,14 @@ func TestUnlikeVideo_DeleteError(t *testing.T) {
 }
 
 func TestUnlikeVideo_CountError(t *testing.T) {
-	usecase, _, mockLikeRepository, _ := createTestVideoUseCase()
+	usecase, mockVideoRepository, mockLikeRepository, _ := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 
 	userUUID, _ := uuid.Parse(userID)
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockLikeRepository.On("Delete", mock.Anything, userUUID, videoUUID).
 		Return(nil)
@@ -858,80 +1422,94 @@ func TestUnlikeVideo_CountError(t *testing.T) {
 }
 
 func TestCreateView_Success(t *testing.T) {
-	usecase, _, _, mockViewRepository := createTestVideoUseCase()
+	usecase, mockVideoRepository, _, mockViewRepository := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 	watchTime := 30
 
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockViewRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoView")).
 		Return(nil)
 	mockViewRepository.On("CountByVideoID", mock.Anything, videoUUID).
 		Return(int64(1), nil)
 
-	viewCount, err := usecase.CreateView(context.Background(), userID, videoID, watchTime)
+	outcome, err := usecase.CreateView(context.Background(), &CreateViewRequest{
+		UserID: userID, VideoID: videoID, WatchTime: watchTime})
 
 	assert.NoError(t, err)
-	assert.Equal(t, int64(1), viewCount)
+	assert.Equal(t, &ViewOutcome{TotalViews: 1, Counted: true}, outcome)
 	mockViewRepository.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertCalled(t, "Append", mock.Anything,
+		mock.MatchedBy(func(event *domain.VideoEvent) bool {
+			return event.Type == domain.EventVideoViewed && event.VideoID == videoUUID &&
+				event.ViewCount == 1 && event.WatchTime == watchTime
+		}))
 }
 
 func TestCreateView_InvalidUserID(t *testing.T) {
 	usecase, _, _, _ := createTestVideoUseCase()
 
-	viewCount, err := usecase.CreateView(context.Background(), "invalid-uuid", uuid.New().String(), 30)
+	outcome, err := usecase.CreateView(context.Background(), &CreateViewRequest{
+		UserID: "invalid-uuid", VideoID: uuid.New().String(), WatchTime: 30})
 
 	assert.Error(t, err)
-	assert.Equal(t, int64(0), viewCount)
+	assert.Nil(t, outcome)
 }
 
 func TestCreateView_InvalidVideoID(t *testing.T) {
 	usecase, _, _, _ := createTestVideoUseCase()
 
-	viewCount, err := usecase.CreateView(context.Background(), uuid.New().String(), "invalid-uuid", 30)
+	outcome, err := usecase.CreateView(context.Background(), &CreateViewRequest{
+		UserID: uuid.New().String(), VideoID: "invalid-uuid", WatchTime: 30})
 
 	assert.Error(t, err)
-	assert.Equal(t, int64(0), viewCount)
+	assert.Nil(t, outcome)
 }
 
 func TestCreateView_CreateError(t *testing.T) {
-	usecase, _, _, mockViewRepository := createTestVideoUseCase()
+	usecase, mockVideoRepository, _, mockViewRepository := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
+	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 	watchTime := 30
 
 	mockViewRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoView")).
 		Return(errors.New("database error"))
 
-	viewCount, err := usecase.CreateView(context.Background(), userID, videoID, watchTime)
+	outcome, err := usecase.CreateView(context.Background(), &CreateViewRequest{
+		UserID: userID, VideoID: videoID, WatchTime: watchTime})
 
 	assert.Error(t, err)
-	assert.Equal(t, int64(0), viewCount)
+	assert.Nil(t, outcome)
 	assert.Contains(t, err.Error(), "database error")
 	mockViewRepository.AssertExpectations(t)
 }
 
 func TestCreateView_CountError(t *testing.T) {
-	usecase, _, _, mockViewRepository := createTestVideoUseCase()
+	usecase, mockVideoRepository, _, mockViewRepository := createTestVideoUseCase()
 
 	userID := uuid.New().String()
 	videoID := uuid.New().String()
 	watchTime := 30
 
 	videoUUID, _ := uuid.Parse(videoID)
+	mockVideoRepository.On("GetByID", mock.Anything, videoUUID).Return(createTestVideo(), nil)
 
 	mockViewRepository.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoView")).
 		Return(nil)
 	mockViewRepository.On("CountByVideoID", mock.Anything, videoUUID).
 		Return(int64(0), errors.New("database error"))
 
-	viewCount, err := usecase.CreateView(context.Background(), userID, videoID, watchTime)
+	outcome, err := usecase.CreateView(context.Background(), &CreateViewRequest{
+		UserID: userID, VideoID: videoID, WatchTime: watchTime})
 
 	assert.Error(t, err)
-	assert.Equal(t, int64(0), viewCount)
+	assert.Nil(t, outcome)
 	assert.Contains(t, err.Error(), "database error")
 	mockViewRepository.AssertExpectations(t)
 }
@@ -1034,3 +1612,65 @@ func TestGetVideoLikeCount_UseCaseError(t *testing.T) {
 
 	mockLikeRepository.AssertExpectations(t)
 }
+
+func TestSetVideoCover_Success(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	mockJobQueue := usecase.jobQueue.(*MockJobQueue)
+	testVideo := createTestVideo()
+	testVideo.StorageKey = "videos/u/source.mp4"
+
+	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).Return(testVideo, nil)
+	mockVideoRepository.On("SetCoverTime", mock.Anything, testVideo.ID, 4500).Return(nil)
+	mockJobQueue.On("Enqueue", mock.Anything, mock.MatchedBy(func(job *domain.Job) bool {
+		return job.Type == domain.JobTypeThumbnail && job.VideoID == testVideo.ID
+	})).Return(nil)
+
+	video, err := usecase.SetVideoCover(context.Background(), testVideo.UserID.String(),
+		testVideo.ID.String(), 4500)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4500, video.CoverTimeMs)
+	mockVideoRepository.AssertExpectations(t)
+	mockJobQueue.AssertExpectations(t)
+}
+
+func TestSetVideoCover_NotOwner(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	testVideo := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).Return(testVideo, nil)
+
+	video, err := usecase.SetVideoCover(context.Background(), uuid.NewString(), testVideo.ID.String(), 0)
+
+	assert.ErrorIs(t, err, domain.ErrNotVideoOwner)
+	assert.Nil(t, video)
+	mockVideoRepository.AssertNotCalled(t, "SetCoverTime", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetVideoCover_URLVideo(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	testVideo := createTestVideo()
+
+	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).Return(testVideo, nil)
+
+	video, err := usecase.SetVideoCover(context.Background(), testVideo.UserID.String(),
+		testVideo.ID.String(), 0)
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotStored)
+	assert.Nil(t, video)
+	mockVideoRepository.AssertNotCalled(t, "SetCoverTime", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetVideoCover_OutsideDuration(t *testing.T) {
+	usecase, mockVideoRepository, _, _ := createTestVideoUseCase()
+	testVideo := createTestVideo()
+	testVideo.StorageKey = "videos/u/source.mp4"
+
+	mockVideoRepository.On("GetByID", mock.Anything, testVideo.ID).Return(testVideo, nil)
+
+	video, err := usecase.SetVideoCover(context.Background(), testVideo.UserID.String(),
+		testVideo.ID.String(), testVideo.Duration*1000)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCoverTime)
+	assert.Nil(t, video)
+}
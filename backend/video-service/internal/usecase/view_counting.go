@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// ViewPolicy decides which views count. A zero value disables the
+// corresponding rule.
+type ViewPolicy struct {
+	// DedupWindow is how long a user's view of a video keeps absorbing
+	// repeat views, which only extend its watch time.
+	DedupWindow time.Duration
+	// MinWatchTime is capped at the video's duration so short clips can
+	// still be counted.
+	MinWatchTime int
+	// MaxViewsPerUser and MaxViewsPerIP are allowed per RateWindow.
+	RateWindow      time.Duration
+	MaxViewsPerUser int64
+	MaxViewsPerIP   int64
+	// A view is flagged instead of counted once BurstViewers distinct users
+	// watched the same video from the same IP within BurstWindow.
+	BurstWindow  time.Duration
+	BurstViewers int64
+}
+
+type CreateViewRequest struct {
+	UserID    string `json:"user_id"`
+	VideoID   string `json:"video_id"`
+	WatchTime int    `json:"watch_time"`
+	ClientIP  string `json:"client_ip"`
+}
+
+// ViewOutcome reports the video's view count and whether this request
+// added to it.
+type ViewOutcome struct {
+	TotalViews int64
+	Counted    bool
+}
+
+func (policy ViewPolicy) minWatchTime(video *domain.Video) int {
+	if video.Duration > 0 && video.Duration < policy.MinWatchTime {
+		return video.Duration
+	}
+	return policy.MinWatchTime
+}
+
+// judgeView returns the flag to store the view with, or ErrViewRateLimited
+// when it must not be stored at all.
+func (policy ViewPolicy) judgeView(ctx context.Context, viewRepo domain.UserVideoViewRepository,
+	view *domain.UserVideoView) (domain.ViewFlagReason, error) {
+
+	if policy.MaxViewsPerUser == 0 && policy.MaxViewsPerIP == 0 && policy.BurstViewers == 0 {
+		return "", nil
+	}
+
+	now := time.Now()
+	activity, err := viewRepo.GetActivity(ctx, view.UserID, view.VideoID, view.ClientIP,
+		now.Add(-policy.RateWindow), now.Add(-policy.BurstWindow))
+	if err != nil {
+		return "", err
+	}
+
+	if policy.MaxViewsPerUser > 0 && activity.UserViews >= policy.MaxViewsPerUser {
+		return "", domain.ErrViewRateLimited
+	}
+	if view.ClientIP == "" {
+		return "", nil
+	}
+	if policy.MaxViewsPerIP > 0 && activity.IPViews >= policy.MaxViewsPerIP {
+		return "", domain.ErrViewRateLimited
+	}
+	if policy.BurstViewers > 0 && activity.IPVideoViewers >= policy.BurstViewers {
+		return domain.ViewFlagBurst, nil
+	}
+	return "", nil
+}
+
+// recentView finds the view a repeat request should fold into, if any. It
+// must run in the transaction that stores the new view: the lock it takes
+// keeps a concurrent request from storing a second one in between.
+func (policy ViewPolicy) recentView(ctx context.Context, viewRepo domain.UserVideoViewRepository,
+	userID, videoID uuid.UUID) (*domain.UserVideoView, error) {
+
+	if policy.DedupWindow == 0 {
+		return nil, nil
+	}
+	if err := viewRepo.LockViewer(ctx, userID, videoID); err != nil {
+		return nil, err
+	}
+	view, err := viewRepo.FindRecent(ctx, userID, videoID, time.Now().Add(-policy.DedupWindow))
+	if errors.Is(err, domain.ErrViewNotFound) {
+		return nil, nil
+	}
+	return view, err
+}
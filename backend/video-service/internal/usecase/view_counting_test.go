@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testViewPolicy = ViewPolicy{
+	DedupWindow:     time.Hour,
+	MinWatchTime:    3,
+	RateWindow:      time.Minute,
+	MaxViewsPerUser: 10,
+	MaxViewsPerIP:   50,
+	BurstWindow:     10 * time.Minute,
+	BurstViewers:    5,
+}
+
+func createTestViewUseCase(video *domain.Video) (*videoUseCase, *MockUserVideoViewRepository) {
+	usecase, mockVideoRepo, _, mockViewRepo := createTestVideoUseCase()
+	usecase.viewPolicy = testViewPolicy
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+	mockViewRepo.On("CountByVideoID", mock.Anything, video.ID).Return(int64(7), nil).Maybe()
+	mockViewRepo.On("LockViewer", mock.Anything, mock.Anything, video.ID).Return(nil).Maybe()
+	return usecase, mockViewRepo
+}
+
+func createTestViewRequest(video *domain.Video, watchTime int) *CreateViewRequest {
+	return &CreateViewRequest{
+		UserID:    uuid.NewString(),
+		VideoID:   video.ID.String(),
+		WatchTime: watchTime,
+		ClientIP:  "203.0.113.7",
+	}
+}
+
+func TestCreateView_RepeatViewExtendsWatchTime(t *testing.T) {
+	video := createTestVideo()
+	usecase, mockViewRepo := createTestViewUseCase(video)
+	existing := &domain.UserVideoView{ID: uuid.New(), VideoID: video.ID, WatchTime: 10}
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).Return(existing, nil)
+	mockViewRepo.On("ExtendWatchTime", mock.Anything, existing.ID, 40).Return(nil)
+
+	outcome, err := usecase.CreateView(context.Background(), createTestViewRequest(video, 40))
+
+	require.NoError(t, err)
+	assert.Equal(t, &ViewOutcome{TotalViews: 7}, outcome)
+	mockViewRepo.AssertCalled(t, "LockViewer", mock.Anything, mock.Anything, video.ID)
+	mockViewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	usecase.outbox.(*MockOutboxRepository).AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestCreateView_BelowMinimumWatchTimeIsNotStored(t *testing.T) {
+	video := createTestVideo()
+	usecase, mockViewRepo := createTestViewUseCase(video)
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).
+		Return(nil, domain.ErrViewNotFound)
+
+	outcome, err := usecase.CreateView(context.Background(), createTestViewRequest(video, 2))
+
+	require.NoError(t, err)
+	assert.False(t, outcome.Counted)
+	mockViewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateView_MinimumWatchTimeCappedAtDuration(t *testing.T) {
+	video := createTestVideo()
+	video.Duration = 2
+	usecase, mockViewRepo := createTestViewUseCase(video)
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).
+		Return(nil, domain.ErrViewNotFound)
+	mockViewRepo.On("GetActivity", mock.Anything, mock.Anything, video.ID, "203.0.113.7", mock.Anything,
+		mock.Anything).Return(&domain.ViewActivity{}, nil)
+	mockViewRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoView")).Return(nil)
+
+	outcome, err := usecase.CreateView(context.Background(), createTestViewRequest(video, 2))
+
+	require.NoError(t, err)
+	assert.True(t, outcome.Counted)
+}
+
+func TestCreateView_RateLimited(t *testing.T) {
+	video := createTestVideo()
+	usecase, mockViewRepo := createTestViewUseCase(video)
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).
+		Return(nil, domain.ErrViewNotFound)
+	mockViewRepo.On("GetActivity", mock.Anything, mock.Anything, video.ID, mock.Anything, mock.Anything,
+		mock.Anything).Return(&domain.ViewActivity{IPViews: 50}, nil)
+
+	outcome, err := usecase.CreateView(context.Background(), createTestViewRequest(video, 30))
+
+	assert.ErrorIs(t, err, domain.ErrViewRateLimited)
+	assert.Nil(t, outcome)
+	mockViewRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateView_BurstIsFlaggedInsteadOfCounted(t *testing.T) {
+	video := createTestVideo()
+	usecase, mockViewRepo := createTestViewUseCase(video)
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).
+		Return(nil, domain.ErrViewNotFound)
+	mockViewRepo.On("GetActivity", mock.Anything, mock.Anything, video.ID, mock.Anything, mock.Anything,
+		mock.Anything).Return(&domain.ViewActivity{UserViews: 1, IPViews: 8, IPVideoViewers: 5}, nil)
+	mockViewRepo.On("Create", mock.Anything, mock.MatchedBy(func(view *domain.UserVideoView) bool {
+		return view.FlagReason == domain.ViewFlagBurst && view.ClientIP == "203.0.113.7"
+	})).Return(nil)
+
+	outcome, err := usecase.CreateView(context.Background(), createTestViewRequest(video, 30))
+
+	require.NoError(t, err)
+	assert.Equal(t, &ViewOutcome{TotalViews: 7}, outcome)
+	mockViewRepo.AssertExpectations(t)
+	usecase.outbox.(*MockOutboxRepository).AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestCreateView_AnonymousIPOnlyJudgedByUser(t *testing.T) {
+	video := createTestVideo()
+	usecase, mockViewRepo := createTestViewUseCase(video)
+	req := createTestViewRequest(video, 30)
+	req.ClientIP = ""
+
+	mockViewRepo.On("FindRecent", mock.Anything, mock.Anything, video.ID, mock.Anything).
+		Return(nil, domain.ErrViewNotFound)
+	mockViewRepo.On("GetActivity", mock.Anything, mock.Anything, video.ID, "", mock.Anything, mock.Anything).
+		Return(&domain.ViewActivity{IPViews: 1000, IPVideoViewers: 1000}, nil)
+	mockViewRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.UserVideoView")).Return(nil)
+
+	outcome, err := usecase.CreateView(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.True(t, outcome.Counted)
+}
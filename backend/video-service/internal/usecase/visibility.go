@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var allVisibilities = []domain.Visibility{
+	domain.VisibilityPublic,
+	domain.VisibilityFollowers,
+	domain.VisibilityFriends,
+	domain.VisibilityPrivate,
+	domain.VisibilityUnlisted,
+}
+
+// parseVisibility treats an empty value as public, which is what new
+// videos default to.
+func parseVisibility(visibility string) (domain.Visibility, error) {
+	if visibility == "" {
+		return domain.VisibilityPublic, nil
+	}
+	for _, known := range allVisibilities {
+		if domain.Visibility(visibility) == known {
+			return known, nil
+		}
+	}
+	return "", domain.ErrInvalidVisibility
+}
+
+// parseID reports a malformed ID as an invalid argument naming the request
+// field it came from.
+func parseID(id, field string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, domain.NewInvalidArgumentError(field, field+" must be a valid UUID")
+	}
+	return parsed, nil
+}
+
+// parseViewerID returns uuid.Nil for signed-out viewers.
+func parseViewerID(viewerID string) (uuid.UUID, error) {
+	if viewerID == "" {
+		return uuid.Nil, nil
+	}
+	return parseID(viewerID, "viewer_id")
+}
+
+// canViewVideo reports whether the viewer may open the video directly, which
+// includes unlisted videos reached by ID or share link. Drafts and scheduled
+// videos are only visible to their owner.
+func canViewVideo(ctx context.Context, directory domain.UserDirectory, blocks domain.BlockRepository,
+	video *domain.Video, viewerID uuid.UUID) (bool, error) {
+
+	if viewerID != uuid.Nil && viewerID == video.UserID {
+		return true, nil
+	}
+	if video.ModerationState == domain.ModerationStateTakenDown || !video.Published() {
+		return false, nil
+	}
+	blocked, err := isBlocked(ctx, blocks, viewerID, video.UserID)
+	if err != nil || blocked {
+		return false, err
+	}
+
+	return visibilityAllows(ctx, directory.GetRelationship, video, viewerID)
+}
+
+type relationshipLookup func(ctx context.Context, viewerID, ownerID uuid.UUID) (*domain.Relationship, error)
+
+// visibilityAllows applies the video's visibility level alone; ownership,
+// moderation and blocks are checked by the callers.
+func visibilityAllows(ctx context.Context, relationshipOf relationshipLookup, video *domain.Video,
+	viewerID uuid.UUID) (bool, error) {
+
+	switch video.Visibility {
+	case domain.VisibilityPublic, domain.VisibilityUnlisted:
+		return true, nil
+	case domain.VisibilityFollowers, domain.VisibilityFriends:
+		if viewerID == uuid.Nil {
+			return false, nil
+		}
+		relationship, err := relationshipOf(ctx, viewerID, video.UserID)
+		if err != nil {
+			return false, err
+		}
+		if video.Visibility == domain.VisibilityFollowers {
+			return relationship.Following, nil
+		}
+		return relationship.Following && relationship.FollowedBy, nil
+	}
+	return false, nil
+}
+
+// cachedRelationships asks the directory about each owner at most once,
+// for checks that span many videos.
+func cachedRelationships(directory domain.UserDirectory) relationshipLookup {
+	relationships := make(map[uuid.UUID]*domain.Relationship)
+	return func(ctx context.Context, viewerID, ownerID uuid.UUID) (*domain.Relationship, error) {
+		if relationship, ok := relationships[ownerID]; ok {
+			return relationship, nil
+		}
+		relationship, err := directory.GetRelationship(ctx, viewerID, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		relationships[ownerID] = relationship
+		return relationship, nil
+	}
+}
+
+// viewableAmong keeps the videos the viewer may open directly, with one block
+// lookup for the whole batch and one directory lookup per owner.
+func viewableAmong(ctx context.Context, relationshipOf relationshipLookup, blocks domain.BlockRepository,
+	videos []*domain.Video, viewerID uuid.UUID) ([]*domain.Video, error) {
+
+	videos, err := withoutBlocked(ctx, blocks, viewerID, videos)
+	if err != nil {
+		return nil, err
+	}
+
+	viewable := make([]*domain.Video, 0, len(videos))
+	for _, video := range videos {
+		if viewerID == uuid.Nil || viewerID != video.UserID {
+			if video.ModerationState == domain.ModerationStateTakenDown || !video.Published() {
+				continue
+			}
+			visible, err := visibilityAllows(ctx, relationshipOf, video, viewerID)
+			if err != nil {
+				return nil, err
+			}
+			if !visible {
+				continue
+			}
+		}
+		hideModerationReason(video, viewerID)
+		viewable = append(viewable, video)
+	}
+	return viewable, nil
+}
+
+// viewableVideo hides videos the viewer may not see behind the same error as
+// a missing video, so their existence is not revealed.
+func viewableVideo(ctx context.Context, videoRepo domain.VideoRepository, directory domain.UserDirectory,
+	blocks domain.BlockRepository, videoID, viewerID uuid.UUID) (*domain.Video, error) {
+
+	video, err := videoRepo.GetByID(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := canViewVideo(ctx, directory, blocks, video, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, domain.ErrVideoNotFound
+	}
+
+	hideModerationReason(video, viewerID)
+	return video, nil
+}
+
+// hideModerationReason keeps why a video was moderated between the
+// moderators and the video's owner.
+func hideModerationReason(video *domain.Video, viewerID uuid.UUID) {
+	if viewerID == uuid.Nil || viewerID != video.UserID {
+		video.ModerationReason = ""
+	}
+}
+
+// listableVisibilities returns the visibility levels of the owner's videos
+// that the viewer may see on the owner's profile. Unlisted videos are only
+// listed for the owner themselves, and nothing is listed between users who
+// blocked each other.
+func listableVisibilities(ctx context.Context, directory domain.UserDirectory, blocks domain.BlockRepository,
+	ownerID, viewerID uuid.UUID) ([]domain.Visibility, error) {
+
+	if viewerID == ownerID {
+		return allVisibilities, nil
+	}
+
+	visibilities := []domain.Visibility{domain.VisibilityPublic}
+	if viewerID == uuid.Nil {
+		return visibilities, nil
+	}
+
+	blocked, err := isBlocked(ctx, blocks, viewerID, ownerID)
+	if err != nil || blocked {
+		return nil, err
+	}
+
+	relationship, err := directory.GetRelationship(ctx, viewerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if relationship.Following {
+		visibilities = append(visibilities, domain.VisibilityFollowers)
+		if relationship.FollowedBy {
+			visibilities = append(visibilities, domain.VisibilityFriends)
+		}
+	}
+	return visibilities, nil
+}
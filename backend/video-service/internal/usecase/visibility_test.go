@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"video-service/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVisibility(t *testing.T) {
+	visibility, err := parseVisibility("")
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityPublic, visibility)
+
+	visibility, err = parseVisibility("unlisted")
+	require.NoError(t, err)
+	assert.Equal(t, domain.VisibilityUnlisted, visibility)
+
+	_, err = parseVisibility("everyone")
+	assert.ErrorIs(t, err, domain.ErrInvalidVisibility)
+}
+
+func TestCanViewVideo(t *testing.T) {
+	ownerID := uuid.New()
+	viewerID := uuid.New()
+
+	tests := []struct {
+		name         string
+		visibility   domain.Visibility
+		viewerID     uuid.UUID
+		relationship *domain.Relationship
+		visible      bool
+	}{
+		{"public to anonymous", domain.VisibilityPublic, uuid.Nil, nil, true},
+		{"unlisted to anonymous", domain.VisibilityUnlisted, uuid.Nil, nil, true},
+		{"private to owner", domain.VisibilityPrivate, ownerID, nil, true},
+		{"private to viewer", domain.VisibilityPrivate, viewerID, nil, false},
+		{"followers to anonymous", domain.VisibilityFollowers, uuid.Nil, nil, false},
+		{"followers to follower", domain.VisibilityFollowers, viewerID,
+			&domain.Relationship{Following: true}, true},
+		{"followers to stranger", domain.VisibilityFollowers, viewerID, &domain.Relationship{}, false},
+		{"friends to follower", domain.VisibilityFriends, viewerID,
+			&domain.Relationship{Following: true}, false},
+		{"friends to mutual follower", domain.VisibilityFriends, viewerID,
+			&domain.Relationship{Following: true, FollowedBy: true}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			directory := &MockUserDirectory{}
+			if test.relationship != nil {
+				directory.On("GetRelationship", mock.Anything, test.viewerID, ownerID).
+					Return(test.relationship, nil)
+			}
+			video := createTestVideo()
+			video.UserID = ownerID
+			video.Visibility = test.visibility
+
+			visible, err := canViewVideo(context.Background(), directory, noBlocks(), video, test.viewerID)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.visible, visible)
+			directory.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCanViewVideo_DirectoryError(t *testing.T) {
+	directory := &MockUserDirectory{}
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityFriends
+	directory.On("GetRelationship", mock.Anything, mock.Anything, video.UserID).
+		Return(nil, errors.New("directory unavailable"))
+
+	_, err := canViewVideo(context.Background(), directory, noBlocks(), video, uuid.New())
+
+	assert.Error(t, err)
+}
+
+func TestViewableVideo_HiddenAsNotFound(t *testing.T) {
+	mockVideoRepo := &MockVideoRepository{}
+	video := createTestVideo()
+	video.Visibility = domain.VisibilityPrivate
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil)
+
+	_, err := viewableVideo(context.Background(), mockVideoRepo, &MockUserDirectory{}, noBlocks(),
+		video.ID, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrVideoNotFound)
+}
+
+func TestParseID_InvalidUUIDNamesField(t *testing.T) {
+	_, err := parseID("not-a-uuid", "video_id")
+
+	var invalid *domain.InvalidArgumentError
+	require.ErrorAs(t, err, &invalid)
+	assert.Equal(t, "video_id", invalid.Field)
+	assert.Equal(t, "video_id must be a valid UUID", invalid.Reason)
+}
+
+func TestListableVisibilities(t *testing.T) {
+	ownerID := uuid.New()
+	viewerID := uuid.New()
+
+	visibilities, err := listableVisibilities(context.Background(), &MockUserDirectory{}, noBlocks(), ownerID, ownerID)
+	require.NoError(t, err)
+	assert.Equal(t, allVisibilities, visibilities)
+
+	visibilities, err = listableVisibilities(context.Background(), &MockUserDirectory{}, noBlocks(), ownerID, uuid.Nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Visibility{domain.VisibilityPublic}, visibilities)
+
+	directory := &MockUserDirectory{}
+	directory.On("GetRelationship", mock.Anything, viewerID, ownerID).
+		Return(&domain.Relationship{Following: true, FollowedBy: true}, nil)
+
+	visibilities, err = listableVisibilities(context.Background(), directory, noBlocks(), ownerID, viewerID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Visibility{
+		domain.VisibilityPublic,
+		domain.VisibilityFollowers,
+		domain.VisibilityFriends,
+	}, visibilities)
+}
+
+func TestCanViewVideo_TakenDown(t *testing.T) {
+	video := createTestVideo()
+	video.ModerationState = domain.ModerationStateTakenDown
+
+	visible, err := canViewVideo(context.Background(), &MockUserDirectory{}, noBlocks(), video, uuid.New())
+	require.NoError(t, err)
+	assert.False(t, visible)
+
+	visible, err = canViewVideo(context.Background(), &MockUserDirectory{}, noBlocks(), video, video.UserID)
+	require.NoError(t, err)
+	assert.True(t, visible, "owners still see their taken-down videos")
+}
+
+func TestCanViewVideo_Unpublished(t *testing.T) {
+	for _, state := range []domain.PublicationState{domain.PublicationStateDraft, domain.PublicationStateScheduled} {
+		video := createTestVideo()
+		video.PublicationState = state
+
+		visible, err := canViewVideo(context.Background(), &MockUserDirectory{}, noBlocks(), video, uuid.New())
+		require.NoError(t, err)
+		assert.False(t, visible, state)
+
+		visible, err = canViewVideo(context.Background(), &MockUserDirectory{}, noBlocks(), video, video.UserID)
+		require.NoError(t, err)
+		assert.True(t, visible, state)
+	}
+}
+
+func TestViewableVideo_ModerationReasonOnlyForOwner(t *testing.T) {
+	video := createTestVideo()
+	video.ModerationState = domain.ModerationStateRestricted
+	video.ModerationReason = "misleading thumbnail"
+
+	forViewer := *video
+	mockVideoRepo := &MockVideoRepository{}
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(&forViewer, nil).Once()
+	mockVideoRepo.On("GetByID", mock.Anything, video.ID).Return(video, nil).Once()
+
+	seen, err := viewableVideo(context.Background(), mockVideoRepo, &MockUserDirectory{}, noBlocks(),
+		video.ID, uuid.New())
+	require.NoError(t, err)
+	assert.Empty(t, seen.ModerationReason)
+
+	seen, err = viewableVideo(context.Background(), mockVideoRepo, &MockUserDirectory{}, noBlocks(),
+		video.ID, video.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, "misleading thumbnail", seen.ModerationReason)
+}
@@ -0,0 +1,9 @@
+package video
+
+import _ "embed"
+
+// OpenAPI is the document protoc-gen-openapiv2 generates from the HTTP
+// annotations on VideoService.
+//
+//go:embed video_service.swagger.json
+var OpenAPI []byte
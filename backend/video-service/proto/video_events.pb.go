@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: proto/video_events.proto
+
+package video
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VideoEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	VideoId    string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*VideoEvent_Created
+	//	*VideoEvent_Updated
+	//	*VideoEvent_Deleted
+	//	*VideoEvent_Liked
+	//	*VideoEvent_Unliked
+	//	*VideoEvent_Viewed
+	//	*VideoEvent_Restored
+	//	*VideoEvent_Purged
+	//	*VideoEvent_Moderated
+	Payload       isVideoEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoEvent) Reset() {
+	*x = VideoEvent{}
+	mi := &file_proto_video_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoEvent) ProtoMessage() {}
+
+func (x *VideoEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoEvent.ProtoReflect.Descriptor instead.
+func (*VideoEvent) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VideoEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VideoEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VideoEvent) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetPayload() isVideoEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetCreated() *VideoCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetUpdated() *VideoUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetDeleted() *VideoDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetLiked() *VideoLiked {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Liked); ok {
+			return x.Liked
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetUnliked() *VideoUnliked {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Unliked); ok {
+			return x.Unliked
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetViewed() *VideoViewed {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Viewed); ok {
+			return x.Viewed
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetRestored() *VideoRestored {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Restored); ok {
+			return x.Restored
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetPurged() *VideoPurged {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Purged); ok {
+			return x.Purged
+		}
+	}
+	return nil
+}
+
+func (x *VideoEvent) GetModerated() *VideoModerated {
+	if x != nil {
+		if x, ok := x.Payload.(*VideoEvent_Moderated); ok {
+			return x.Moderated
+		}
+	}
+	return nil
+}
+
+type isVideoEvent_Payload interface {
+	isVideoEvent_Payload()
+}
+
+type VideoEvent_Created struct {
+	Created *VideoCreated `protobuf:"bytes,10,opt,name=created,proto3,oneof"`
+}
+
+type VideoEvent_Updated struct {
+	Updated *VideoUpdated `protobuf:"bytes,11,opt,name=updated,proto3,oneof"`
+}
+
+type VideoEvent_Deleted struct {
+	Deleted *VideoDeleted `protobuf:"bytes,12,opt,name=deleted,proto3,oneof"`
+}
+
+type VideoEvent_Liked struct {
+	Liked *VideoLiked `protobuf:"bytes,13,opt,name=liked,proto3,oneof"`
+}
+
+type VideoEvent_Unliked struct {
+	Unliked *VideoUnliked `protobuf:"bytes,14,opt,name=unliked,proto3,oneof"`
+}
+
+type VideoEvent_Viewed struct {
+	Viewed *VideoViewed `protobuf:"bytes,15,opt,name=viewed,proto3,oneof"`
+}
+
+type VideoEvent_Restored struct {
+	Restored *VideoRestored `protobuf:"bytes,16,opt,name=restored,proto3,oneof"`
+}
+
+type VideoEvent_Purged struct {
+	Purged *VideoPurged `protobuf:"bytes,17,opt,name=purged,proto3,oneof"`
+}
+
+type VideoEvent_Moderated struct {
+	Moderated *VideoModerated `protobuf:"bytes,18,opt,name=moderated,proto3,oneof"`
+}
+
+func (*VideoEvent_Created) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Updated) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Deleted) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Liked) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Unliked) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Viewed) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Restored) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Purged) isVideoEvent_Payload() {}
+
+func (*VideoEvent_Moderated) isVideoEvent_Payload() {}
+
+type VideoCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoCreated) Reset() {
+	*x = VideoCreated{}
+	mi := &file_proto_video_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoCreated) ProtoMessage() {}
+
+func (x *VideoCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoCreated.ProtoReflect.Descriptor instead.
+func (*VideoCreated) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VideoCreated) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type VideoUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoUpdated) Reset() {
+	*x = VideoUpdated{}
+	mi := &file_proto_video_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoUpdated) ProtoMessage() {}
+
+func (x *VideoUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoUpdated.ProtoReflect.Descriptor instead.
+func (*VideoUpdated) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VideoUpdated) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type VideoDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoDeleted) Reset() {
+	*x = VideoDeleted{}
+	mi := &file_proto_video_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoDeleted) ProtoMessage() {}
+
+func (x *VideoDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoDeleted.ProtoReflect.Descriptor instead.
+func (*VideoDeleted) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VideoDeleted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VideoRestored struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoRestored) Reset() {
+	*x = VideoRestored{}
+	mi := &file_proto_video_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoRestored) ProtoMessage() {}
+
+func (x *VideoRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoRestored.ProtoReflect.Descriptor instead.
+func (*VideoRestored) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VideoRestored) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type VideoPurged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoPurged) Reset() {
+	*x = VideoPurged{}
+	mi := &file_proto_video_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoPurged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoPurged) ProtoMessage() {}
+
+func (x *VideoPurged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoPurged.ProtoReflect.Descriptor instead.
+func (*VideoPurged) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VideoPurged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VideoModerated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoModerated) Reset() {
+	*x = VideoModerated{}
+	mi := &file_proto_video_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoModerated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoModerated) ProtoMessage() {}
+
+func (x *VideoModerated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoModerated.ProtoReflect.Descriptor instead.
+func (*VideoModerated) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VideoModerated) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type VideoLiked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoLiked) Reset() {
+	*x = VideoLiked{}
+	mi := &file_proto_video_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoLiked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoLiked) ProtoMessage() {}
+
+func (x *VideoLiked) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoLiked.ProtoReflect.Descriptor instead.
+func (*VideoLiked) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VideoLiked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VideoLiked) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type VideoUnliked struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikeCount     int64                  `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoUnliked) Reset() {
+	*x = VideoUnliked{}
+	mi := &file_proto_video_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoUnliked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoUnliked) ProtoMessage() {}
+
+func (x *VideoUnliked) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoUnliked.ProtoReflect.Descriptor instead.
+func (*VideoUnliked) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VideoUnliked) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VideoUnliked) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type VideoViewed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WatchTime     int32                  `protobuf:"varint,2,opt,name=watch_time,json=watchTime,proto3" json:"watch_time,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoViewed) Reset() {
+	*x = VideoViewed{}
+	mi := &file_proto_video_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoViewed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoViewed) ProtoMessage() {}
+
+func (x *VideoViewed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoViewed.ProtoReflect.Descriptor instead.
+func (*VideoViewed) Descriptor() ([]byte, []int) {
+	return file_proto_video_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VideoViewed) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VideoViewed) GetWatchTime() int32 {
+	if x != nil {
+		return x.WatchTime
+	}
+	return 0
+}
+
+func (x *VideoViewed) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+var File_proto_video_events_proto protoreflect.FileDescriptor
+
+const file_proto_video_events_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/video_events.proto\x12\x05video\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/video_service.proto\"\xd4\x04\n" +
+	"\n" +
+	"VideoEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12/\n" +
+	"\acreated\x18\n" +
+	" \x01(\v2\x13.video.VideoCreatedH\x00R\acreated\x12/\n" +
+	"\aupdated\x18\v \x01(\v2\x13.video.VideoUpdatedH\x00R\aupdated\x12/\n" +
+	"\adeleted\x18\f \x01(\v2\x13.video.VideoDeletedH\x00R\adeleted\x12)\n" +
+	"\x05liked\x18\r \x01(\v2\x11.video.VideoLikedH\x00R\x05liked\x12/\n" +
+	"\aunliked\x18\x0e \x01(\v2\x13.video.VideoUnlikedH\x00R\aunliked\x12,\n" +
+	"\x06viewed\x18\x0f \x01(\v2\x12.video.VideoViewedH\x00R\x06viewed\x122\n" +
+	"\brestored\x18\x10 \x01(\v2\x14.video.VideoRestoredH\x00R\brestored\x12,\n" +
+	"\x06purged\x18\x11 \x01(\v2\x12.video.VideoPurgedH\x00R\x06purged\x125\n" +
+	"\tmoderated\x18\x12 \x01(\v2\x15.video.VideoModeratedH\x00R\tmoderatedB\t\n" +
+	"\apayload\"2\n" +
+	"\fVideoCreated\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"2\n" +
+	"\fVideoUpdated\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"'\n" +
+	"\fVideoDeleted\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"3\n" +
+	"\rVideoRestored\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"&\n" +
+	"\vVideoPurged\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x0eVideoModerated\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"D\n" +
+	"\n" +
+	"VideoLiked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x02 \x01(\x03R\tlikeCount\"F\n" +
+	"\fVideoUnliked\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x02 \x01(\x03R\tlikeCount\"d\n" +
+	"\vVideoViewed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"watch_time\x18\x02 \x01(\x05R\twatchTime\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x03R\tviewCountB\x1bZ\x19video-service/proto/videob\x06proto3"
+
+var (
+	file_proto_video_events_proto_rawDescOnce sync.Once
+	file_proto_video_events_proto_rawDescData []byte
+)
+
+func file_proto_video_events_proto_rawDescGZIP() []byte {
+	file_proto_video_events_proto_rawDescOnce.Do(func() {
+		file_proto_video_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_video_events_proto_rawDesc), len(file_proto_video_events_proto_rawDesc)))
+	})
+	return file_proto_video_events_proto_rawDescData
+}
+
+var file_proto_video_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_video_events_proto_goTypes = []any{
+	(*VideoEvent)(nil),            // 0: video.VideoEvent
+	(*VideoCreated)(nil),          // 1: video.VideoCreated
+	(*VideoUpdated)(nil),          // 2: video.VideoUpdated
+	(*VideoDeleted)(nil),          // 3: video.VideoDeleted
+	(*VideoRestored)(nil),         // 4: video.VideoRestored
+	(*VideoPurged)(nil),           // 5: video.VideoPurged
+	(*VideoModerated)(nil),        // 6: video.VideoModerated
+	(*VideoLiked)(nil),            // 7: video.VideoLiked
+	(*VideoUnliked)(nil),          // 8: video.VideoUnliked
+	(*VideoViewed)(nil),           // 9: video.VideoViewed
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*Video)(nil),                 // 11: video.Video
+}
+var file_proto_video_events_proto_depIdxs = []int32{
+	10, // 0: video.VideoEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: video.VideoEvent.created:type_name -> video.VideoCreated
+	2,  // 2: video.VideoEvent.updated:type_name -> video.VideoUpdated
+	3,  // 3: video.VideoEvent.deleted:type_name -> video.VideoDeleted
+	7,  // 4: video.VideoEvent.liked:type_name -> video.VideoLiked
+	8,  // 5: video.VideoEvent.unliked:type_name -> video.VideoUnliked
+	9,  // 6: video.VideoEvent.viewed:type_name -> video.VideoViewed
+	4,  // 7: video.VideoEvent.restored:type_name -> video.VideoRestored
+	5,  // 8: video.VideoEvent.purged:type_name -> video.VideoPurged
+	6,  // 9: video.VideoEvent.moderated:type_name -> video.VideoModerated
+	11, // 10: video.VideoCreated.video:type_name -> video.Video
+	11, // 11: video.VideoUpdated.video:type_name -> video.Video
+	11, // 12: video.VideoRestored.video:type_name -> video.Video
+	11, // 13: video.VideoModerated.video:type_name -> video.Video
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_proto_video_events_proto_init() }
+func file_proto_video_events_proto_init() {
+	if File_proto_video_events_proto != nil {
+		return
+	}
+	file_proto_video_service_proto_init()
+	file_proto_video_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*VideoEvent_Created)(nil),
+		(*VideoEvent_Updated)(nil),
+		(*VideoEvent_Deleted)(nil),
+		(*VideoEvent_Liked)(nil),
+		(*VideoEvent_Unliked)(nil),
+		(*VideoEvent_Viewed)(nil),
+		(*VideoEvent_Restored)(nil),
+		(*VideoEvent_Purged)(nil),
+		(*VideoEvent_Moderated)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_video_events_proto_rawDesc), len(file_proto_video_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_video_events_proto_goTypes,
+		DependencyIndexes: file_proto_video_events_proto_depIdxs,
+		MessageInfos:      file_proto_video_events_proto_msgTypes,
+	}.Build()
+	File_proto_video_events_proto = out.File
+	file_proto_video_events_proto_goTypes = nil
+	file_proto_video_events_proto_depIdxs = nil
+}
@@ -7,8 +7,10 @@
 package video
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,22 +25,33 @@ const (
 )
 
 type Video struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	VideoUrl      string                 `protobuf:"bytes,5,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	Duration      int32                  `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	ViewCount     int64                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	LikeCount     int64                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	ShareCount    int64                  `protobuf:"varint,10,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	VideoUrl         string                 `protobuf:"bytes,5,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	ThumbnailUrl     string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Duration         int32                  `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	ViewCount        int64                  `protobuf:"varint,8,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	LikeCount        int64                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	ShareCount       int64                  `protobuf:"varint,10,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProcessingStatus string                 `protobuf:"bytes,14,opt,name=processing_status,json=processingStatus,proto3" json:"processing_status,omitempty"`
+	PreviewUrl       string                 `protobuf:"bytes,15,opt,name=preview_url,json=previewUrl,proto3" json:"preview_url,omitempty"`
+	CoverTimeMs      int32                  `protobuf:"varint,16,opt,name=cover_time_ms,json=coverTimeMs,proto3" json:"cover_time_ms,omitempty"`
+	PlaylistUrl      string                 `protobuf:"bytes,17,opt,name=playlist_url,json=playlistUrl,proto3" json:"playlist_url,omitempty"`
+	Region           string                 `protobuf:"bytes,18,opt,name=region,proto3" json:"region,omitempty"`
+	FavoriteCount    int64                  `protobuf:"varint,19,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	Visibility       string                 `protobuf:"bytes,20,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	ModerationState  string                 `protobuf:"bytes,21,opt,name=moderation_state,json=moderationState,proto3" json:"moderation_state,omitempty"`
+	ModerationReason string                 `protobuf:"bytes,22,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	Etag             string                 `protobuf:"bytes,23,opt,name=etag,proto3" json:"etag,omitempty"`
+	PublicationState string                 `protobuf:"bytes,24,opt,name=publication_state,json=publicationState,proto3" json:"publication_state,omitempty"`
+	PublishAt        *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Video) Reset() {
@@ -141,13 +154,6 @@ func (x *Video) GetShareCount() int64 {
 	return 0
 }
 
-func (x *Video) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
-	}
-	return false
-}
-
 func (x *Video) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -162,6 +168,90 @@ func (x *Video) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Video) GetProcessingStatus() string {
+	if x != nil {
+		return x.ProcessingStatus
+	}
+	return ""
+}
+
+func (x *Video) GetPreviewUrl() string {
+	if x != nil {
+		return x.PreviewUrl
+	}
+	return ""
+}
+
+func (x *Video) GetCoverTimeMs() int32 {
+	if x != nil {
+		return x.CoverTimeMs
+	}
+	return 0
+}
+
+func (x *Video) GetPlaylistUrl() string {
+	if x != nil {
+		return x.PlaylistUrl
+	}
+	return ""
+}
+
+func (x *Video) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Video) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *Video) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Video) GetModerationState() string {
+	if x != nil {
+		return x.ModerationState
+	}
+	return ""
+}
+
+func (x *Video) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
+func (x *Video) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Video) GetPublicationState() string {
+	if x != nil {
+		return x.PublicationState
+	}
+	return ""
+}
+
+func (x *Video) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
 type CreateVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -170,7 +260,9 @@ type CreateVideoRequest struct {
 	VideoUrl      string                 `protobuf:"bytes,4,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
 	ThumbnailUrl  string                 `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
 	Duration      int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,7,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Region        string                 `protobuf:"bytes,8,opt,name=region,proto3" json:"region,omitempty"`
+	Visibility    string                 `protobuf:"bytes,9,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Draft         bool                   `protobuf:"varint,10,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,9 +339,23 @@ func (x *CreateVideoRequest) GetDuration() int32 {
 	return 0
 }
 
-func (x *CreateVideoRequest) GetIsPublic() bool {
+func (x *CreateVideoRequest) GetRegion() string {
 	if x != nil {
-		return x.IsPublic
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateVideoRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateVideoRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
 	}
 	return false
 }
@@ -301,6 +407,7 @@ func (x *CreateVideoResponse) GetVideo() *Video {
 type GetVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -342,6 +449,13 @@ func (x *GetVideoRequest) GetId() string {
 	return ""
 }
 
+func (x *GetVideoRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
 type GetVideoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
@@ -390,6 +504,7 @@ type ListVideosRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -438,6 +553,13 @@ func (x *ListVideosRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
 type ListVideosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
@@ -491,10 +613,17 @@ func (x *ListVideosResponse) GetTotal() int64 {
 }
 
 type GetVideosByUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// offset is ignored when a cursor is given.
+	Offset   int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ViewerId string `protobuf:"bytes,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// One of latest (default), popular or oldest.
+	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Popular pages continue from the view count the previous page ended at,
+	// so videos whose counts change in between may be skipped or repeated.
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -550,10 +679,34 @@ func (x *GetVideosByUserRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetVideosByUserRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *GetVideosByUserRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetVideosByUserRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type GetVideosByUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Videos []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total  int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Only set on the first page; these videos are not repeated in videos.
+	PinnedVideos  []*Video `protobuf:"bytes,3,rep,name=pinned_videos,json=pinnedVideos,proto3" json:"pinned_videos,omitempty"`
+	NextCursor    string   `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -602,13 +755,33 @@ func (x *GetVideosByUserResponse) GetTotal() int64 {
 	return 0
 }
 
+func (x *GetVideosByUserResponse) GetPinnedVideos() []*Video {
+	if x != nil {
+		return x.PinnedVideos
+	}
+	return nil
+}
+
+func (x *GetVideosByUserResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type UpdateVideoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ThumbnailUrl string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Visibility   string                 `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Paths among title, description, thumbnail_url and visibility. When
+	// empty, only the fields set to non-default values are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the update is rejected with ABORTED unless it matches the
+	// video's current etag.
+	Etag          string `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -671,11 +844,25 @@ func (x *UpdateVideoRequest) GetThumbnailUrl() string {
 	return ""
 }
 
-func (x *UpdateVideoRequest) GetIsPublic() bool {
+func (x *UpdateVideoRequest) GetVisibility() string {
 	if x != nil {
-		return x.IsPublic
+		return x.Visibility
 	}
-	return false
+	return ""
+}
+
+func (x *UpdateVideoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateVideoRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
 }
 
 type UpdateVideoResponse struct {
@@ -1203,10 +1390,14 @@ func (x *GetVideoLikeCountResponse) GetLikeCount() int64 {
 }
 
 type CreateViewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	WatchTime     int32                  `protobuf:"varint,3,opt,name=watch_time,json=watchTime,proto3" json:"watch_time,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId   string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	WatchTime int32                  `protobuf:"varint,3,opt,name=watch_time,json=watchTime,proto3" json:"watch_time,omitempty"`
+	// client_ip is the viewer's address as seen by an edge proxy on the same
+	// host. It is ignored on other connections and on REST requests, which
+	// are limited by the address the gateway sees.
+	ClientIp      string `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1262,10 +1453,18 @@ func (x *CreateViewRequest) GetWatchTime() int32 {
 	return 0
 }
 
+func (x *CreateViewRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
 type CreateViewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	TotalViews    int64                  `protobuf:"varint,2,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	Counted       bool                   `protobuf:"varint,3,opt,name=counted,proto3" json:"counted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1314,192 +1513,8674 @@ func (x *CreateViewResponse) GetTotalViews() int64 {
 	return 0
 }
 
-var File_proto_video_service_proto protoreflect.FileDescriptor
-
-const file_proto_video_service_proto_rawDesc = "" +
-	"\n" +
-	"\x19proto/video_service.proto\x12\x05video\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb8\x03\n" +
-	"\x05Video\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tvideo_url\x18\x05 \x01(\tR\bvideoUrl\x12#\n" +
-	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\x12\x1a\n" +
-	"\bduration\x18\a \x01(\x05R\bduration\x12\x1d\n" +
-	"\n" +
-	"view_count\x18\b \x01(\x03R\tviewCount\x12\x1d\n" +
-	"\n" +
-	"like_count\x18\t \x01(\x03R\tlikeCount\x12\x1f\n" +
-	"\vshare_count\x18\n" +
-	" \x01(\x03R\n" +
-	"shareCount\x12\x1b\n" +
-	"\tis_public\x18\v \x01(\bR\bisPublic\x129\n" +
-	"\n" +
-	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe0\x01\n" +
-	"\x12CreateVideoRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tvideo_url\x18\x04 \x01(\tR\bvideoUrl\x12#\n" +
-	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x1a\n" +
-	"\bduration\x18\x06 \x01(\x05R\bduration\x12\x1b\n" +
-	"\tis_public\x18\a \x01(\bR\bisPublic\"9\n" +
-	"\x13CreateVideoResponse\x12\"\n" +
-	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"!\n" +
-	"\x0fGetVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
-	"\x10GetVideoResponse\x12\"\n" +
-	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"A\n" +
-	"\x11ListVideosRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"P\n" +
-	"\x12ListVideosResponse\x12$\n" +
-	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"_\n" +
-	"\x16GetVideosByUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"U\n" +
-	"\x17GetVideosByUserResponse\x12$\n" +
-	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\x9e\x01\n" +
-	"\x12UpdateVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
-	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x1b\n" +
-	"\tis_public\x18\x05 \x01(\bR\bisPublic\"9\n" +
-	"\x13UpdateVideoResponse\x12\"\n" +
-	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"$\n" +
-	"\x12DeleteVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
-	"\x13DeleteVideoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
-	"\x10LikeVideoRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"L\n" +
-	"\x11LikeVideoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
-	"\n" +
-	"like_count\x18\x02 \x01(\x03R\tlikeCount\"H\n" +
-	"\x12UnlikeVideoRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"N\n" +
-	"\x13UnlikeVideoResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
-	"\n" +
-	"like_count\x18\x02 \x01(\x03R\tlikeCount\"P\n" +
-	"\x1aCheckUserLikedVideoRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"8\n" +
-	"\x1bCheckUserLikedVideoResponse\x12\x19\n" +
-	"\bis_liked\x18\x01 \x01(\bR\aisLiked\"5\n" +
-	"\x18GetVideoLikeCountRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\":\n" +
-	"\x19GetVideoLikeCountResponse\x12\x1d\n" +
-	"\n" +
-	"like_count\x18\x01 \x01(\x03R\tlikeCount\"f\n" +
-	"\x11CreateViewRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x1d\n" +
-	"\n" +
-	"watch_time\x18\x03 \x01(\x05R\twatchTime\"O\n" +
-	"\x12CreateViewResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
-	"\vtotal_views\x18\x02 \x01(\x03R\n" +
-	"totalViews2\xb1\x06\n" +
-	"\fVideoService\x12D\n" +
-	"\vCreateVideo\x12\x19.video.CreateVideoRequest\x1a\x1a.video.CreateVideoResponse\x12;\n" +
-	"\bGetVideo\x12\x16.video.GetVideoRequest\x1a\x17.video.GetVideoResponse\x12A\n" +
-	"\n" +
-	"ListVideos\x12\x18.video.ListVideosRequest\x1a\x19.video.ListVideosResponse\x12P\n" +
-	"\x0fGetVideosByUser\x12\x1d.video.GetVideosByUserRequest\x1a\x1e.video.GetVideosByUserResponse\x12D\n" +
-	"\vUpdateVideo\x12\x19.video.UpdateVideoRequest\x1a\x1a.video.UpdateVideoResponse\x12D\n" +
-	"\vDeleteVideo\x12\x19.video.DeleteVideoRequest\x1a\x1a.video.DeleteVideoResponse\x12>\n" +
-	"\tLikeVideo\x12\x17.video.LikeVideoRequest\x1a\x18.video.LikeVideoResponse\x12D\n" +
-	"\vUnlikeVideo\x12\x19.video.UnlikeVideoRequest\x1a\x1a.video.UnlikeVideoResponse\x12\\\n" +
-	"\x13CheckUserLikedVideo\x12!.video.CheckUserLikedVideoRequest\x1a\".video.CheckUserLikedVideoResponse\x12V\n" +
-	"\x11GetVideoLikeCount\x12\x1f.video.GetVideoLikeCountRequest\x1a .video.GetVideoLikeCountResponse\x12A\n" +
-	"\n" +
-	"CreateView\x12\x18.video.CreateViewRequest\x1a\x19.video.CreateViewResponseB\x1bZ\x19video-service/proto/videob\x06proto3"
-
-var (
-	file_proto_video_service_proto_rawDescOnce sync.Once
-	file_proto_video_service_proto_rawDescData []byte
-)
-
-func file_proto_video_service_proto_rawDescGZIP() []byte {
-	file_proto_video_service_proto_rawDescOnce.Do(func() {
-		file_proto_video_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_video_service_proto_rawDesc), len(file_proto_video_service_proto_rawDesc)))
-	})
-	return file_proto_video_service_proto_rawDescData
+func (x *CreateViewResponse) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
 }
 
-var file_proto_video_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_proto_video_service_proto_goTypes = []any{
-	(*Video)(nil),                       // 0: video.Video
-	(*CreateVideoRequest)(nil),          // 1: video.CreateVideoRequest
-	(*CreateVideoResponse)(nil),         // 2: video.CreateVideoResponse
-	(*GetVideoRequest)(nil),             // 3: video.GetVideoRequest
-	(*GetVideoResponse)(nil),            // 4: video.GetVideoResponse
-	(*ListVideosRequest)(nil),           // 5: video.ListVideosRequest
-	(*ListVideosResponse)(nil),          // 6: video.ListVideosResponse
-	(*GetVideosByUserRequest)(nil),      // 7: video.GetVideosByUserRequest
-	(*GetVideosByUserResponse)(nil),     // 8: video.GetVideosByUserResponse
-	(*UpdateVideoRequest)(nil),          // 9: video.UpdateVideoRequest
-	(*UpdateVideoResponse)(nil),         // 10: video.UpdateVideoResponse
-	(*DeleteVideoRequest)(nil),          // 11: video.DeleteVideoRequest
-	(*DeleteVideoResponse)(nil),         // 12: video.DeleteVideoResponse
-	(*LikeVideoRequest)(nil),            // 13: video.LikeVideoRequest
-	(*LikeVideoResponse)(nil),           // 14: video.LikeVideoResponse
-	(*UnlikeVideoRequest)(nil),          // 15: video.UnlikeVideoRequest
-	(*UnlikeVideoResponse)(nil),         // 16: video.UnlikeVideoResponse
-	(*CheckUserLikedVideoRequest)(nil),  // 17: video.CheckUserLikedVideoRequest
-	(*CheckUserLikedVideoResponse)(nil), // 18: video.CheckUserLikedVideoResponse
-	(*GetVideoLikeCountRequest)(nil),    // 19: video.GetVideoLikeCountRequest
-	(*GetVideoLikeCountResponse)(nil),   // 20: video.GetVideoLikeCountResponse
-	(*CreateViewRequest)(nil),           // 21: video.CreateViewRequest
-	(*CreateViewResponse)(nil),          // 22: video.CreateViewResponse
-	(*timestamppb.Timestamp)(nil),       // 23: google.protobuf.Timestamp
+type SetVideoCoverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	CoverTimeMs   int32                  `protobuf:"varint,3,opt,name=cover_time_ms,json=coverTimeMs,proto3" json:"cover_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-var file_proto_video_service_proto_depIdxs = []int32{
-	23, // 0: video.Video.created_at:type_name -> google.protobuf.Timestamp
-	23, // 1: video.Video.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: video.CreateVideoResponse.video:type_name -> video.Video
-	0,  // 3: video.GetVideoResponse.video:type_name -> video.Video
-	0,  // 4: video.ListVideosResponse.videos:type_name -> video.Video
-	0,  // 5: video.GetVideosByUserResponse.videos:type_name -> video.Video
-	0,  // 6: video.UpdateVideoResponse.video:type_name -> video.Video
-	1,  // 7: video.VideoService.CreateVideo:input_type -> video.CreateVideoRequest
-	3,  // 8: video.VideoService.GetVideo:input_type -> video.GetVideoRequest
-	5,  // 9: video.VideoService.ListVideos:input_type -> video.ListVideosRequest
-	7,  // 10: video.VideoService.GetVideosByUser:input_type -> video.GetVideosByUserRequest
-	9,  // 11: video.VideoService.UpdateVideo:input_type -> video.UpdateVideoRequest
-	11, // 12: video.VideoService.DeleteVideo:input_type -> video.DeleteVideoRequest
-	13, // 13: video.VideoService.LikeVideo:input_type -> video.LikeVideoRequest
-	15, // 14: video.VideoService.UnlikeVideo:input_type -> video.UnlikeVideoRequest
-	17, // 15: video.VideoService.CheckUserLikedVideo:input_type -> video.CheckUserLikedVideoRequest
-	19, // 16: video.VideoService.GetVideoLikeCount:input_type -> video.GetVideoLikeCountRequest
-	21, // 17: video.VideoService.CreateView:input_type -> video.CreateViewRequest
-	2,  // 18: video.VideoService.CreateVideo:output_type -> video.CreateVideoResponse
-	4,  // 19: video.VideoService.GetVideo:output_type -> video.GetVideoResponse
-	6,  // 20: video.VideoService.ListVideos:output_type -> video.ListVideosResponse
-	8,  // 21: video.VideoService.GetVideosByUser:output_type -> video.GetVideosByUserResponse
-	10, // 22: video.VideoService.UpdateVideo:output_type -> video.UpdateVideoResponse
-	12, // 23: video.VideoService.DeleteVideo:output_type -> video.DeleteVideoResponse
-	14, // 24: video.VideoService.LikeVideo:output_type -> video.LikeVideoResponse
-	16, // 25: video.VideoService.UnlikeVideo:output_type -> video.UnlikeVideoResponse
-	18, // 26: video.VideoService.CheckUserLikedVideo:output_type -> video.CheckUserLikedVideoResponse
-	20, // 27: video.VideoService.GetVideoLikeCount:output_type -> video.GetVideoLikeCountResponse
-	22, // 28: video.VideoService.CreateView:output_type -> video.CreateViewResponse
-	18, // [18:29] is the sub-list for method output_type
-	7,  // [7:18] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+func (x *SetVideoCoverRequest) Reset() {
+	*x = SetVideoCoverRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoCoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoCoverRequest) ProtoMessage() {}
+
+func (x *SetVideoCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoCoverRequest.ProtoReflect.Descriptor instead.
+func (*SetVideoCoverRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetVideoCoverRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetVideoCoverRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *SetVideoCoverRequest) GetCoverTimeMs() int32 {
+	if x != nil {
+		return x.CoverTimeMs
+	}
+	return 0
+}
+
+type SetVideoCoverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVideoCoverResponse) Reset() {
+	*x = SetVideoCoverResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVideoCoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVideoCoverResponse) ProtoMessage() {}
+
+func (x *SetVideoCoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVideoCoverResponse.ProtoReflect.Descriptor instead.
+func (*SetVideoCoverResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetVideoCoverResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type CreateUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Duration      int32                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	FileName      string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,8,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Region        string                 `protobuf:"bytes,9,opt,name=region,proto3" json:"region,omitempty"`
+	Visibility    string                 `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Draft         bool                   `protobuf:"varint,11,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadSessionRequest) Reset() {
+	*x = CreateUploadSessionRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadSessionRequest) ProtoMessage() {}
+
+func (x *CreateUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateUploadSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateUploadSessionRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *CreateUploadSessionRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+type UploadSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	VideoId       string                 `protobuf:"bytes,5,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadSession) Reset() {
+	*x = UploadSession{}
+	mi := &file_proto_video_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSession) ProtoMessage() {}
+
+func (x *UploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadSession) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadSession) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadSession) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *UploadSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UploadSession) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *UploadSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateUploadSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *UploadSession         `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadSessionResponse) Reset() {
+	*x = CreateUploadSessionResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadSessionResponse) ProtoMessage() {}
+
+func (x *CreateUploadSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateUploadSessionResponse) GetSession() *UploadSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadSessionRequest) Reset() {
+	*x = GetUploadSessionRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadSessionRequest) ProtoMessage() {}
+
+func (x *GetUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUploadSessionRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *GetUploadSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUploadSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *UploadSession         `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadSessionResponse) Reset() {
+	*x = GetUploadSessionResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadSessionResponse) ProtoMessage() {}
+
+func (x *GetUploadSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetUploadSessionResponse) GetSession() *UploadSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type UploadStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadStart) Reset() {
+	*x = UploadStart{}
+	mi := &file_proto_video_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStart) ProtoMessage() {}
+
+func (x *UploadStart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStart.ProtoReflect.Descriptor instead.
+func (*UploadStart) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UploadStart) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadStart) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadStart) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UploadVideoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadVideoRequest_Start
+	//	*UploadVideoRequest_Chunk
+	Payload       isUploadVideoRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadVideoRequest) Reset() {
+	*x = UploadVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadVideoRequest) ProtoMessage() {}
+
+func (x *UploadVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadVideoRequest.ProtoReflect.Descriptor instead.
+func (*UploadVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UploadVideoRequest) GetPayload() isUploadVideoRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadVideoRequest) GetStart() *UploadStart {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadVideoRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *UploadVideoRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadVideoRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadVideoRequest_Payload interface {
+	isUploadVideoRequest_Payload()
+}
+
+type UploadVideoRequest_Start struct {
+	Start *UploadStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type UploadVideoRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadVideoRequest_Start) isUploadVideoRequest_Payload() {}
+
+func (*UploadVideoRequest_Chunk) isUploadVideoRequest_Payload() {}
+
+type UploadVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *UploadSession         `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Video         *Video                 `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadVideoResponse) Reset() {
+	*x = UploadVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadVideoResponse) ProtoMessage() {}
+
+func (x *UploadVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadVideoResponse.ProtoReflect.Descriptor instead.
+func (*UploadVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UploadVideoResponse) GetSession() *UploadSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *UploadVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type ListHashtagVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtag       string                 `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+	Sort          string                 `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,5,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHashtagVideosRequest) Reset() {
+	*x = ListHashtagVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHashtagVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHashtagVideosRequest) ProtoMessage() {}
+
+func (x *ListHashtagVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHashtagVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListHashtagVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListHashtagVideosRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *ListHashtagVideosRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListHashtagVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHashtagVideosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListHashtagVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type ListHashtagVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHashtagVideosResponse) Reset() {
+	*x = ListHashtagVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHashtagVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHashtagVideosResponse) ProtoMessage() {}
+
+func (x *ListHashtagVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHashtagVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListHashtagVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListHashtagVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListHashtagVideosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type HashtagStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VideoCount    int64                  `protobuf:"varint,2,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	TotalViews    int64                  `protobuf:"varint,3,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashtagStats) Reset() {
+	*x = HashtagStats{}
+	mi := &file_proto_video_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashtagStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashtagStats) ProtoMessage() {}
+
+func (x *HashtagStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashtagStats.ProtoReflect.Descriptor instead.
+func (*HashtagStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HashtagStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HashtagStats) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *HashtagStats) GetTotalViews() int64 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
+type GetHashtagStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtag       string                 `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagStatsRequest) Reset() {
+	*x = GetHashtagStatsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagStatsRequest) ProtoMessage() {}
+
+func (x *GetHashtagStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetHashtagStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetHashtagStatsRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+type GetHashtagStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *HashtagStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagStatsResponse) Reset() {
+	*x = GetHashtagStatsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagStatsResponse) ProtoMessage() {}
+
+func (x *GetHashtagStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetHashtagStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetHashtagStatsResponse) GetStats() *HashtagStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListMentionedVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionedVideosRequest) Reset() {
+	*x = ListMentionedVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionedVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionedVideosRequest) ProtoMessage() {}
+
+func (x *ListMentionedVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionedVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionedVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMentionedVideosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMentionedVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMentionedVideosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMentionedVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type ListMentionedVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionedVideosResponse) Reset() {
+	*x = ListMentionedVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionedVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionedVideosResponse) ProtoMessage() {}
+
+func (x *ListMentionedVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionedVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionedVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMentionedVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListMentionedVideosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTrendingVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,5,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingVideosRequest) Reset() {
+	*x = GetTrendingVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingVideosRequest) ProtoMessage() {}
+
+func (x *GetTrendingVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingVideosRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetTrendingVideosRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetTrendingVideosRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetTrendingVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTrendingVideosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTrendingVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type GetTrendingVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingVideosResponse) Reset() {
+	*x = GetTrendingVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingVideosResponse) ProtoMessage() {}
+
+func (x *GetTrendingVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingVideosResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetTrendingVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+type TrendingHashtag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingHashtag) Reset() {
+	*x = TrendingHashtag{}
+	mi := &file_proto_video_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingHashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingHashtag) ProtoMessage() {}
+
+func (x *TrendingHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingHashtag.ProtoReflect.Descriptor instead.
+func (*TrendingHashtag) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TrendingHashtag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrendingHashtag) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetTrendingHashtagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingHashtagsRequest) Reset() {
+	*x = GetTrendingHashtagsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsRequest) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetTrendingHashtagsRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *GetTrendingHashtagsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetTrendingHashtagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingHashtagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtags      []*TrendingHashtag     `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrendingHashtagsResponse) Reset() {
+	*x = GetTrendingHashtagsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrendingHashtagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingHashtagsResponse) ProtoMessage() {}
+
+func (x *GetTrendingHashtagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingHashtagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrendingHashtagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTrendingHashtagsResponse) GetHashtags() []*TrendingHashtag {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+type SearchVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	MinDuration   int32                  `protobuf:"varint,6,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration   int32                  `protobuf:"varint,7,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchVideosRequest) Reset() {
+	*x = SearchVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideosRequest) ProtoMessage() {}
+
+func (x *SearchVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideosRequest.ProtoReflect.Descriptor instead.
+func (*SearchVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchVideosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *SearchVideosRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *SearchVideosRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *SearchVideosRequest) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetMaxDuration() int32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchVideosRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchVideosResponse) Reset() {
+	*x = SearchVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVideosResponse) ProtoMessage() {}
+
+func (x *SearchVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVideosResponse.ProtoReflect.Descriptor instead.
+func (*SearchVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SearchVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *SearchVideosResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ShareLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Code          string                 `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_proto_video_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ShareLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareLink) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ShareLink) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareLink) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ShareLink) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ShareLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ShareVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareVideoRequest) Reset() {
+	*x = ShareVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareVideoRequest) ProtoMessage() {}
+
+func (x *ShareVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareVideoRequest.ProtoReflect.Descriptor instead.
+func (*ShareVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ShareVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ShareVideoRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type ShareVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareLink     *ShareLink             `protobuf:"bytes,1,opt,name=share_link,json=shareLink,proto3" json:"share_link,omitempty"`
+	ShareCount    int64                  `protobuf:"varint,2,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareVideoResponse) Reset() {
+	*x = ShareVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareVideoResponse) ProtoMessage() {}
+
+func (x *ShareVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareVideoResponse.ProtoReflect.Descriptor instead.
+func (*ShareVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ShareVideoResponse) GetShareLink() *ShareLink {
+	if x != nil {
+		return x.ShareLink
+	}
+	return nil
+}
+
+func (x *ShareVideoResponse) GetShareCount() int64 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+type ResolveShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareLinkRequest) Reset() {
+	*x = ResolveShareLinkRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareLinkRequest) ProtoMessage() {}
+
+func (x *ResolveShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResolveShareLinkRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ResolveShareLinkRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type ResolveShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	SharerId      string                 `protobuf:"bytes,2,opt,name=sharer_id,json=sharerId,proto3" json:"sharer_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareLinkResponse) Reset() {
+	*x = ResolveShareLinkResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareLinkResponse) ProtoMessage() {}
+
+func (x *ResolveShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*ResolveShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResolveShareLinkResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ResolveShareLinkResponse) GetSharerId() string {
+	if x != nil {
+		return x.SharerId
+	}
+	return ""
+}
+
+func (x *ResolveShareLinkResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type GetShareAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareAnalyticsRequest) Reset() {
+	*x = GetShareAnalyticsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetShareAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetShareAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetShareAnalyticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetShareAnalyticsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type ShareChannelStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Shares        int64                  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Clicks        int64                  `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareChannelStats) Reset() {
+	*x = ShareChannelStats{}
+	mi := &file_proto_video_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareChannelStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareChannelStats) ProtoMessage() {}
+
+func (x *ShareChannelStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareChannelStats.ProtoReflect.Descriptor instead.
+func (*ShareChannelStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ShareChannelStats) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ShareChannelStats) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ShareChannelStats) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type SharerStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Shares        int64                  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Clicks        int64                  `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharerStats) Reset() {
+	*x = SharerStats{}
+	mi := &file_proto_video_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharerStats) ProtoMessage() {}
+
+func (x *SharerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharerStats.ProtoReflect.Descriptor instead.
+func (*SharerStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SharerStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SharerStats) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *SharerStats) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type GetShareAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalShares   int64                  `protobuf:"varint,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	TotalClicks   int64                  `protobuf:"varint,2,opt,name=total_clicks,json=totalClicks,proto3" json:"total_clicks,omitempty"`
+	Channels      []*ShareChannelStats   `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	TopSharers    []*SharerStats         `protobuf:"bytes,4,rep,name=top_sharers,json=topSharers,proto3" json:"top_sharers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareAnalyticsResponse) Reset() {
+	*x = GetShareAnalyticsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetShareAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetShareAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetShareAnalyticsResponse) GetTotalShares() int64 {
+	if x != nil {
+		return x.TotalShares
+	}
+	return 0
+}
+
+func (x *GetShareAnalyticsResponse) GetTotalClicks() int64 {
+	if x != nil {
+		return x.TotalClicks
+	}
+	return 0
+}
+
+func (x *GetShareAnalyticsResponse) GetChannels() []*ShareChannelStats {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetShareAnalyticsResponse) GetTopSharers() []*SharerStats {
+	if x != nil {
+		return x.TopSharers
+	}
+	return nil
+}
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_proto_video_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Collection) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FavoriteCount int64                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddFavoriteResponse) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	FavoriteCount int64                  `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RemoveFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveFavoriteResponse) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListFavoritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFavoritesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFavoritesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListFavoritesResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  string                 `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReorderCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionIds []string               `protobuf:"bytes,2,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionsRequest) Reset() {
+	*x = ReorderCollectionsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionsRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReorderCollectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderCollectionsRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+type ReorderCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionsResponse) Reset() {
+	*x = ReorderCollectionsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionsResponse) ProtoMessage() {}
+
+func (x *ReorderCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReorderCollectionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListCollectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListCollectionVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionVideosRequest) Reset() {
+	*x = ListCollectionVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionVideosRequest) ProtoMessage() {}
+
+func (x *ListCollectionVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListCollectionVideosRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ListCollectionVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListCollectionVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionVideosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCollectionVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionVideosResponse) Reset() {
+	*x = ListCollectionVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionVideosResponse) ProtoMessage() {}
+
+func (x *ListCollectionVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListCollectionVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListCollectionVideosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TrashedVideo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedVideo) Reset() {
+	*x = TrashedVideo{}
+	mi := &file_proto_video_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedVideo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedVideo) ProtoMessage() {}
+
+func (x *TrashedVideo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedVideo.ProtoReflect.Descriptor instead.
+func (*TrashedVideo) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TrashedVideo) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *TrashedVideo) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashedVideo) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListTrashRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTrashRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*TrashedVideo        `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListTrashResponse) GetVideos() []*TrashedVideo {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestoreVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVideoRequest) Reset() {
+	*x = RestoreVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVideoRequest) ProtoMessage() {}
+
+func (x *RestoreVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVideoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RestoreVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type RestoreVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVideoResponse) Reset() {
+	*x = RestoreVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVideoResponse) ProtoMessage() {}
+
+func (x *RestoreVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVideoResponse.ProtoReflect.Descriptor instead.
+func (*RestoreVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RestoreVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type ReportVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReporterId    string                 `protobuf:"bytes,1,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details       string                 `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportVideoRequest) Reset() {
+	*x = ReportVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportVideoRequest) ProtoMessage() {}
+
+func (x *ReportVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportVideoRequest.ProtoReflect.Descriptor instead.
+func (*ReportVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReportVideoRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ReportVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ReportVideoRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportVideoRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ReportVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicate     bool                   `protobuf:"varint,1,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportVideoResponse) Reset() {
+	*x = ReportVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportVideoResponse) ProtoMessage() {}
+
+func (x *ReportVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportVideoResponse.ProtoReflect.Descriptor instead.
+func (*ReportVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReportVideoResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type ModerationCase struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Video           *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Severity        int32                  `protobuf:"varint,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	ReportCount     int64                  `protobuf:"varint,4,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	ReasonCounts    map[string]int64       `protobuf:"bytes,5,rep,name=reason_counts,json=reasonCounts,proto3" json:"reason_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	FirstReportedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=first_reported_at,json=firstReportedAt,proto3" json:"first_reported_at,omitempty"`
+	LastReportedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_reported_at,json=lastReportedAt,proto3" json:"last_reported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModerationCase) Reset() {
+	*x = ModerationCase{}
+	mi := &file_proto_video_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationCase) ProtoMessage() {}
+
+func (x *ModerationCase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationCase.ProtoReflect.Descriptor instead.
+func (*ModerationCase) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ModerationCase) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ModerationCase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerationCase) GetSeverity() int32 {
+	if x != nil {
+		return x.Severity
+	}
+	return 0
+}
+
+func (x *ModerationCase) GetReportCount() int64 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ModerationCase) GetReasonCounts() map[string]int64 {
+	if x != nil {
+		return x.ReasonCounts
+	}
+	return nil
+}
+
+func (x *ModerationCase) GetFirstReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstReportedAt
+	}
+	return nil
+}
+
+func (x *ModerationCase) GetLastReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportedAt
+	}
+	return nil
+}
+
+type GetModerationQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModeratorId   string                 `protobuf:"bytes,1,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationQueueRequest) Reset() {
+	*x = GetModerationQueueRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationQueueRequest) ProtoMessage() {}
+
+func (x *GetModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetModerationQueueRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *GetModerationQueueRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetModerationQueueRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetModerationQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cases         []*ModerationCase      `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationQueueResponse) Reset() {
+	*x = GetModerationQueueResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationQueueResponse) ProtoMessage() {}
+
+func (x *GetModerationQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetModerationQueueResponse) GetCases() []*ModerationCase {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+func (x *GetModerationQueueResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ModerateVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModeratorId   string                 `protobuf:"bytes,1,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateVideoRequest) Reset() {
+	*x = ModerateVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateVideoRequest) ProtoMessage() {}
+
+func (x *ModerateVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateVideoRequest.ProtoReflect.Descriptor instead.
+func (*ModerateVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ModerateVideoRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ModerateVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ModerateVideoRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerateVideoRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateVideoResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Video              *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	CreatorStrikeCount int64                  `protobuf:"varint,2,opt,name=creator_strike_count,json=creatorStrikeCount,proto3" json:"creator_strike_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ModerateVideoResponse) Reset() {
+	*x = ModerateVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateVideoResponse) ProtoMessage() {}
+
+func (x *ModerateVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateVideoResponse.ProtoReflect.Descriptor instead.
+func (*ModerateVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ModerateVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ModerateVideoResponse) GetCreatorStrikeCount() int64 {
+	if x != nil {
+		return x.CreatorStrikeCount
+	}
+	return 0
+}
+
+type ModerationAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,3,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_proto_video_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ModerationAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListModerationActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModeratorId   string                 `protobuf:"bytes,1,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsRequest) Reset() {
+	*x = ListModerationActionsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsRequest) ProtoMessage() {}
+
+func (x *ListModerationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListModerationActionsRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ListModerationActionsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type ListModerationActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsResponse) Reset() {
+	*x = ListModerationActionsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsResponse) ProtoMessage() {}
+
+func (x *ListModerationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListModerationActionsResponse) GetActions() []*ModerationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type FlaggedView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	WatchTime     int32                  `protobuf:"varint,4,opt,name=watch_time,json=watchTime,proto3" json:"watch_time,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlaggedView) Reset() {
+	*x = FlaggedView{}
+	mi := &file_proto_video_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlaggedView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedView) ProtoMessage() {}
+
+func (x *FlaggedView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedView.ProtoReflect.Descriptor instead.
+func (*FlaggedView) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *FlaggedView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlaggedView) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FlaggedView) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *FlaggedView) GetWatchTime() int32 {
+	if x != nil {
+		return x.WatchTime
+	}
+	return 0
+}
+
+func (x *FlaggedView) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *FlaggedView) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FlaggedView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListFlaggedViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModeratorId   string                 `protobuf:"bytes,1,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedViewsRequest) Reset() {
+	*x = ListFlaggedViewsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedViewsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListFlaggedViewsRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ListFlaggedViewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFlaggedViewsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListFlaggedViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*FlaggedView         `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedViewsResponse) Reset() {
+	*x = ListFlaggedViewsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedViewsResponse) ProtoMessage() {}
+
+func (x *ListFlaggedViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListFlaggedViewsResponse) GetViews() []*FlaggedView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *ListFlaggedViewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId string                 `protobuf:"bytes,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() string {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UnblockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_proto_video_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetBlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return nil
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListBlockedUsersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBlockedUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	LastActorId   string                 `protobuf:"bytes,4,opt,name=last_actor_id,json=lastActorId,proto3" json:"last_actor_id,omitempty"`
+	ActorCount    int64                  `protobuf:"varint,5,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"`
+	Read          bool                   `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_video_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *Notification) GetLastActorId() string {
+	if x != nil {
+		return x.LastActorId
+	}
+	return ""
+}
+
+func (x *Notification) GetActorCount() int64 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotificationIds []string               `protobuf:"bytes,2,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *MarkReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkReadRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type MarkReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int64                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *MarkReadResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type SubscribeNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeNotificationsRequest) Reset() {
+	*x = SubscribeNotificationsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeNotificationsRequest) ProtoMessage() {}
+
+func (x *SubscribeNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SubscribeNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RecordActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,4,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordActivityRequest) Reset() {
+	*x = RecordActivityRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordActivityRequest) ProtoMessage() {}
+
+func (x *RecordActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordActivityRequest.ProtoReflect.Descriptor instead.
+func (*RecordActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RecordActivityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecordActivityRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *RecordActivityRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *RecordActivityRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type RecordActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordActivityResponse) Reset() {
+	*x = RecordActivityResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordActivityResponse) ProtoMessage() {}
+
+func (x *RecordActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordActivityResponse.ProtoReflect.Descriptor instead.
+func (*RecordActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RecordActivityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type EngagementStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Views            int64                  `protobuf:"varint,1,opt,name=views,proto3" json:"views,omitempty"`
+	UniqueViewers    int64                  `protobuf:"varint,2,opt,name=unique_viewers,json=uniqueViewers,proto3" json:"unique_viewers,omitempty"`
+	TotalWatchTime   int64                  `protobuf:"varint,3,opt,name=total_watch_time,json=totalWatchTime,proto3" json:"total_watch_time,omitempty"`
+	AverageWatchTime float64                `protobuf:"fixed64,4,opt,name=average_watch_time,json=averageWatchTime,proto3" json:"average_watch_time,omitempty"`
+	CompletedViews   int64                  `protobuf:"varint,5,opt,name=completed_views,json=completedViews,proto3" json:"completed_views,omitempty"`
+	CompletionRate   float64                `protobuf:"fixed64,6,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	Likes            int64                  `protobuf:"varint,7,opt,name=likes,proto3" json:"likes,omitempty"`
+	Shares           int64                  `protobuf:"varint,8,opt,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EngagementStats) Reset() {
+	*x = EngagementStats{}
+	mi := &file_proto_video_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngagementStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngagementStats) ProtoMessage() {}
+
+func (x *EngagementStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngagementStats.ProtoReflect.Descriptor instead.
+func (*EngagementStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *EngagementStats) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetUniqueViewers() int64 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetTotalWatchTime() int64 {
+	if x != nil {
+		return x.TotalWatchTime
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetAverageWatchTime() float64 {
+	if x != nil {
+		return x.AverageWatchTime
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetCompletedViews() int64 {
+	if x != nil {
+		return x.CompletedViews
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *EngagementStats) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type DailyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Stats         *EngagementStats       `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyStats) Reset() {
+	*x = DailyStats{}
+	mi := &file_proto_video_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStats) ProtoMessage() {}
+
+func (x *DailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStats.ProtoReflect.Descriptor instead.
+func (*DailyStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *DailyStats) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DailyStats) GetStats() *EngagementStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type RetentionPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percent       int32                  `protobuf:"varint,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	Viewers       int64                  `protobuf:"varint,2,opt,name=viewers,proto3" json:"viewers,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPoint) Reset() {
+	*x = RetentionPoint{}
+	mi := &file_proto_video_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPoint) ProtoMessage() {}
+
+func (x *RetentionPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPoint.ProtoReflect.Descriptor instead.
+func (*RetentionPoint) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RetentionPoint) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RetentionPoint) GetViewers() int64 {
+	if x != nil {
+		return x.Viewers
+	}
+	return 0
+}
+
+func (x *RetentionPoint) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type VideoStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Stats         *EngagementStats       `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoStats) Reset() {
+	*x = VideoStats{}
+	mi := &file_proto_video_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoStats) ProtoMessage() {}
+
+func (x *VideoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoStats.ProtoReflect.Descriptor instead.
+func (*VideoStats) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *VideoStats) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *VideoStats) GetStats() *EngagementStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetVideoAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoAnalyticsRequest) Reset() {
+	*x = GetVideoAnalyticsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetVideoAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetVideoAnalyticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetVideoAnalyticsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetVideoAnalyticsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetVideoAnalyticsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetVideoAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Totals        *EngagementStats       `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	Daily         []*DailyStats          `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`
+	Retention     []*RetentionPoint      `protobuf:"bytes,5,rep,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoAnalyticsResponse) Reset() {
+	*x = GetVideoAnalyticsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetVideoAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetVideoAnalyticsResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetVideoAnalyticsResponse) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetVideoAnalyticsResponse) GetTotals() *EngagementStats {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetVideoAnalyticsResponse) GetDaily() []*DailyStats {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetVideoAnalyticsResponse) GetRetention() []*RetentionPoint {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type GetCreatorAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorAnalyticsRequest) Reset() {
+	*x = GetCreatorAnalyticsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetCreatorAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreatorAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetCreatorAnalyticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCreatorAnalyticsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetCreatorAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Totals        *EngagementStats       `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	Daily         []*DailyStats          `protobuf:"bytes,4,rep,name=daily,proto3" json:"daily,omitempty"`
+	TopVideos     []*VideoStats          `protobuf:"bytes,5,rep,name=top_videos,json=topVideos,proto3" json:"top_videos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorAnalyticsResponse) Reset() {
+	*x = GetCreatorAnalyticsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetCreatorAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetCreatorAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetCreatorAnalyticsResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsResponse) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsResponse) GetTotals() *EngagementStats {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsResponse) GetDaily() []*DailyStats {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+func (x *GetCreatorAnalyticsResponse) GetTopVideos() []*VideoStats {
+	if x != nil {
+		return x.TopVideos
+	}
+	return nil
+}
+
+type BatchGetVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoIds      []string               `protobuf:"bytes,1,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetVideosRequest) Reset() {
+	*x = BatchGetVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVideosRequest) ProtoMessage() {}
+
+func (x *BatchGetVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVideosRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *BatchGetVideosRequest) GetVideoIds() []string {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+func (x *BatchGetVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+type BatchGetVideosResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Videos []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	// missing_ids lists requested videos that do not exist or that the
+	// viewer may not see.
+	MissingIds    []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetVideosResponse) Reset() {
+	*x = BatchGetVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetVideosResponse) ProtoMessage() {}
+
+func (x *BatchGetVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetVideosResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *BatchGetVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *BatchGetVideosResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type ViewerVideoState struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VideoId          string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Liked            bool                   `protobuf:"varint,2,opt,name=liked,proto3" json:"liked,omitempty"`
+	Bookmarked       bool                   `protobuf:"varint,3,opt,name=bookmarked,proto3" json:"bookmarked,omitempty"`
+	FollowingCreator bool                   `protobuf:"varint,4,opt,name=following_creator,json=followingCreator,proto3" json:"following_creator,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ViewerVideoState) Reset() {
+	*x = ViewerVideoState{}
+	mi := &file_proto_video_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewerVideoState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewerVideoState) ProtoMessage() {}
+
+func (x *ViewerVideoState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewerVideoState.ProtoReflect.Descriptor instead.
+func (*ViewerVideoState) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ViewerVideoState) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ViewerVideoState) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *ViewerVideoState) GetBookmarked() bool {
+	if x != nil {
+		return x.Bookmarked
+	}
+	return false
+}
+
+func (x *ViewerVideoState) GetFollowingCreator() bool {
+	if x != nil {
+		return x.FollowingCreator
+	}
+	return false
+}
+
+type BatchGetViewerStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      string                 `protobuf:"bytes,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	VideoIds      []string               `protobuf:"bytes,2,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetViewerStateRequest) Reset() {
+	*x = BatchGetViewerStateRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetViewerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetViewerStateRequest) ProtoMessage() {}
+
+func (x *BatchGetViewerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetViewerStateRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetViewerStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *BatchGetViewerStateRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *BatchGetViewerStateRequest) GetVideoIds() []string {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+type BatchGetViewerStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*ViewerVideoState    `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetViewerStateResponse) Reset() {
+	*x = BatchGetViewerStateResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetViewerStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetViewerStateResponse) ProtoMessage() {}
+
+func (x *BatchGetViewerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetViewerStateResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetViewerStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *BatchGetViewerStateResponse) GetStates() []*ViewerVideoState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListDraftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftsRequest) Reset() {
+	*x = ListDraftsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsRequest) ProtoMessage() {}
+
+func (x *ListDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListDraftsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDraftsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDraftsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDraftsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDraftsResponse) Reset() {
+	*x = ListDraftsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDraftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsResponse) ProtoMessage() {}
+
+func (x *ListDraftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsResponse.ProtoReflect.Descriptor instead.
+func (*ListDraftsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListDraftsResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListDraftsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PublishVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVideoRequest) Reset() {
+	*x = PublishVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVideoRequest) ProtoMessage() {}
+
+func (x *PublishVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVideoRequest.ProtoReflect.Descriptor instead.
+func (*PublishVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PublishVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PublishVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type PublishVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishVideoResponse) Reset() {
+	*x = PublishVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishVideoResponse) ProtoMessage() {}
+
+func (x *PublishVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishVideoResponse.ProtoReflect.Descriptor instead.
+func (*PublishVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PublishVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type ScheduleVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleVideoRequest) Reset() {
+	*x = ScheduleVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVideoRequest) ProtoMessage() {}
+
+func (x *ScheduleVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVideoRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ScheduleVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScheduleVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ScheduleVideoRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type ScheduleVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleVideoResponse) Reset() {
+	*x = ScheduleVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVideoResponse) ProtoMessage() {}
+
+func (x *ScheduleVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVideoResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ScheduleVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type CancelScheduledVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledVideoRequest) Reset() {
+	*x = CancelScheduledVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledVideoRequest) ProtoMessage() {}
+
+func (x *CancelScheduledVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledVideoRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CancelScheduledVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelScheduledVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type CancelScheduledVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Video         *Video                 `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledVideoResponse) Reset() {
+	*x = CancelScheduledVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledVideoResponse) ProtoMessage() {}
+
+func (x *CancelScheduledVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledVideoResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CancelScheduledVideoResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type PinVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinVideoRequest) Reset() {
+	*x = PinVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinVideoRequest) ProtoMessage() {}
+
+func (x *PinVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinVideoRequest.ProtoReflect.Descriptor instead.
+func (*PinVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *PinVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PinVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type PinVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinVideoResponse) Reset() {
+	*x = PinVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinVideoResponse) ProtoMessage() {}
+
+func (x *PinVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinVideoResponse.ProtoReflect.Descriptor instead.
+func (*PinVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *PinVideoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnpinVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinVideoRequest) Reset() {
+	*x = UnpinVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinVideoRequest) ProtoMessage() {}
+
+func (x *UnpinVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinVideoRequest.ProtoReflect.Descriptor instead.
+func (*UnpinVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *UnpinVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnpinVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type UnpinVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinVideoResponse) Reset() {
+	*x = UnpinVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinVideoResponse) ProtoMessage() {}
+
+func (x *UnpinVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinVideoResponse.ProtoReflect.Descriptor instead.
+func (*UnpinVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UnpinVideoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Playlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,5,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	mi := &file_proto_video_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Playlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Playlist) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Playlist) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Playlist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Playlist) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *Playlist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Playlist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,4,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistRequest) Reset() {
+	*x = CreatePlaylistRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistRequest) ProtoMessage() {}
+
+func (x *CreatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *CreatePlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+type CreatePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistResponse) Reset() {
+	*x = CreatePlaylistResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistResponse) ProtoMessage() {}
+
+func (x *CreatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CreatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+type UpdatePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaylistId    string                 `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CoverUrl      string                 `protobuf:"bytes,5,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistRequest) Reset() {
+	*x = UpdatePlaylistRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistRequest) ProtoMessage() {}
+
+func (x *UpdatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdatePlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+type UpdatePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistResponse) Reset() {
+	*x = UpdatePlaylistResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistResponse) ProtoMessage() {}
+
+func (x *UpdatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *UpdatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+type DeletePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaylistId    string                 `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistRequest) Reset() {
+	*x = DeletePlaylistRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistRequest) ProtoMessage() {}
+
+func (x *DeletePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeletePlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+type DeletePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistResponse) Reset() {
+	*x = DeletePlaylistResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistResponse) ProtoMessage() {}
+
+func (x *DeletePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DeletePlaylistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReorderPlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaylistId    string                 `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	VideoIds      []string               `protobuf:"bytes,3,rep,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPlaylistRequest) Reset() {
+	*x = ReorderPlaylistRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistRequest) ProtoMessage() {}
+
+func (x *ReorderPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ReorderPlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistRequest) GetVideoIds() []string {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+type ReorderPlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPlaylistResponse) Reset() {
+	*x = ReorderPlaylistResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistResponse) ProtoMessage() {}
+
+func (x *ReorderPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ReorderPlaylistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddPlaylistVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaylistId    string                 `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistVideoRequest) Reset() {
+	*x = AddPlaylistVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistVideoRequest) ProtoMessage() {}
+
+func (x *AddPlaylistVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistVideoRequest.ProtoReflect.Descriptor instead.
+func (*AddPlaylistVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *AddPlaylistVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddPlaylistVideoRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *AddPlaylistVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type AddPlaylistVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistVideoResponse) Reset() {
+	*x = AddPlaylistVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistVideoResponse) ProtoMessage() {}
+
+func (x *AddPlaylistVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistVideoResponse.ProtoReflect.Descriptor instead.
+func (*AddPlaylistVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *AddPlaylistVideoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemovePlaylistVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlaylistId    string                 `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistVideoRequest) Reset() {
+	*x = RemovePlaylistVideoRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistVideoRequest) ProtoMessage() {}
+
+func (x *RemovePlaylistVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistVideoRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RemovePlaylistVideoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemovePlaylistVideoRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *RemovePlaylistVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type RemovePlaylistVideoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistVideoResponse) Reset() {
+	*x = RemovePlaylistVideoResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistVideoResponse) ProtoMessage() {}
+
+func (x *RemovePlaylistVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistVideoResponse.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistVideoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RemovePlaylistVideoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPlaylistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsRequest) Reset() {
+	*x = ListPlaylistsRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListPlaylistsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPlaylistsRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListPlaylistsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPlaylistsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListPlaylistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlists     []*Playlist            `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsResponse) Reset() {
+	*x = ListPlaylistsResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListPlaylistsResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *ListPlaylistsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListPlaylistVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistId    string                 `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	ViewerId      string                 `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistVideosRequest) Reset() {
+	*x = ListPlaylistVideosRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistVideosRequest) ProtoMessage() {}
+
+func (x *ListPlaylistVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistVideosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ListPlaylistVideosRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *ListPlaylistVideosRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *ListPlaylistVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPlaylistVideosRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListPlaylistVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*Video               `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistVideosResponse) Reset() {
+	*x = ListPlaylistVideosResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistVideosResponse) ProtoMessage() {}
+
+func (x *ListPlaylistVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistVideosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListPlaylistVideosResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListPlaylistVideosResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetNextInSeriesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VideoId string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// Optional; defaults to the oldest playlist holding the video.
+	PlaylistId    string `protobuf:"bytes,2,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	ViewerId      string `protobuf:"bytes,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextInSeriesRequest) Reset() {
+	*x = GetNextInSeriesRequest{}
+	mi := &file_proto_video_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextInSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextInSeriesRequest) ProtoMessage() {}
+
+func (x *GetNextInSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextInSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetNextInSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetNextInSeriesRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetNextInSeriesRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *GetNextInSeriesRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+// Both fields are unset when the video is in no playlist, and next_video is
+// unset at the end of the series.
+type GetNextInSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Playlist      *Playlist              `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	NextVideo     *Video                 `protobuf:"bytes,2,opt,name=next_video,json=nextVideo,proto3" json:"next_video,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextInSeriesResponse) Reset() {
+	*x = GetNextInSeriesResponse{}
+	mi := &file_proto_video_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextInSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextInSeriesResponse) ProtoMessage() {}
+
+func (x *GetNextInSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_video_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextInSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetNextInSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_video_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetNextInSeriesResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *GetNextInSeriesResponse) GetNextVideo() *Video {
+	if x != nil {
+		return x.NextVideo
+	}
+	return nil
+}
+
+var File_proto_video_service_proto protoreflect.FileDescriptor
+
+const file_proto_video_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/video_service.proto\x12\x05video\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x06\n" +
+	"\x05Video\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tvideo_url\x18\x05 \x01(\tR\bvideoUrl\x12#\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\x12\x1a\n" +
+	"\bduration\x18\a \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\b \x01(\x03R\tviewCount\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\t \x01(\x03R\tlikeCount\x12\x1f\n" +
+	"\vshare_count\x18\n" +
+	" \x01(\x03R\n" +
+	"shareCount\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x11processing_status\x18\x0e \x01(\tR\x10processingStatus\x12\x1f\n" +
+	"\vpreview_url\x18\x0f \x01(\tR\n" +
+	"previewUrl\x12\"\n" +
+	"\rcover_time_ms\x18\x10 \x01(\x05R\vcoverTimeMs\x12!\n" +
+	"\fplaylist_url\x18\x11 \x01(\tR\vplaylistUrl\x12\x16\n" +
+	"\x06region\x18\x12 \x01(\tR\x06region\x12%\n" +
+	"\x0efavorite_count\x18\x13 \x01(\x03R\rfavoriteCount\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x14 \x01(\tR\n" +
+	"visibility\x12)\n" +
+	"\x10moderation_state\x18\x15 \x01(\tR\x0fmoderationState\x12+\n" +
+	"\x11moderation_reason\x18\x16 \x01(\tR\x10moderationReason\x12\x12\n" +
+	"\x04etag\x18\x17 \x01(\tR\x04etag\x12+\n" +
+	"\x11publication_state\x18\x18 \x01(\tR\x10publicationState\x129\n" +
+	"\n" +
+	"publish_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAtJ\x04\b\v\x10\fR\tis_public\"\xa2\x02\n" +
+	"\x12CreateVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tvideo_url\x18\x04 \x01(\tR\bvideoUrl\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x05R\bduration\x12\x16\n" +
+	"\x06region\x18\b \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\t \x01(\tR\n" +
+	"visibility\x12\x14\n" +
+	"\x05draft\x18\n" +
+	" \x01(\bR\x05draftJ\x04\b\a\x10\bR\tis_public\"9\n" +
+	"\x13CreateVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\">\n" +
+	"\x0fGetVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"6\n" +
+	"\x10GetVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"^\n" +
+	"\x11ListVideosRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tviewer_id\x18\x03 \x01(\tR\bviewerId\"P\n" +
+	"\x12ListVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa8\x01\n" +
+	"\x16GetVideosByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tviewer_id\x18\x04 \x01(\tR\bviewerId\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\"\xa9\x01\n" +
+	"\x17GetVideosByUserResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x121\n" +
+	"\rpinned_videos\x18\x03 \x03(\v2\f.video.VideoR\fpinnedVideos\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\"\x83\x02\n" +
+	"\x12UpdateVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etagJ\x04\b\x05\x10\x06R\tis_public\"9\n" +
+	"\x13UpdateVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"$\n" +
+	"\x12DeleteVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x13DeleteVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x10LikeVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"L\n" +
+	"\x11LikeVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x02 \x01(\x03R\tlikeCount\"H\n" +
+	"\x12UnlikeVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"N\n" +
+	"\x13UnlikeVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x02 \x01(\x03R\tlikeCount\"P\n" +
+	"\x1aCheckUserLikedVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"8\n" +
+	"\x1bCheckUserLikedVideoResponse\x12\x19\n" +
+	"\bis_liked\x18\x01 \x01(\bR\aisLiked\"5\n" +
+	"\x18GetVideoLikeCountRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\":\n" +
+	"\x19GetVideoLikeCountResponse\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x01 \x01(\x03R\tlikeCount\"\x83\x01\n" +
+	"\x11CreateViewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x1d\n" +
+	"\n" +
+	"watch_time\x18\x03 \x01(\x05R\twatchTime\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\"i\n" +
+	"\x12CreateViewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vtotal_views\x18\x02 \x01(\x03R\n" +
+	"totalViews\x12\x18\n" +
+	"\acounted\x18\x03 \x01(\bR\acounted\"n\n" +
+	"\x14SetVideoCoverRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\"\n" +
+	"\rcover_time_ms\x18\x03 \x01(\x05R\vcoverTimeMs\";\n" +
+	"\x15SetVideoCoverResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"\xbc\x02\n" +
+	"\x1aCreateUploadSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x05R\bduration\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\a \x01(\x03R\ttotalSize\x12\x16\n" +
+	"\x06sha256\x18\b \x01(\tR\x06sha256\x12\x16\n" +
+	"\x06region\x18\t \x01(\tR\x06region\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibility\x12\x14\n" +
+	"\x05draft\x18\v \x01(\bR\x05draftJ\x04\b\x05\x10\x06R\tis_public\"\xd1\x01\n" +
+	"\rUploadSession\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\bvideo_id\x18\x05 \x01(\tR\avideoId\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"M\n" +
+	"\x1bCreateUploadSessionResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.video.UploadSessionR\asession\"O\n" +
+	"\x17GetUploadSessionRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x18GetUploadSessionResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.video.UploadSessionR\asession\"[\n" +
+	"\vUploadStart\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"c\n" +
+	"\x12UploadVideoRequest\x12*\n" +
+	"\x05start\x18\x01 \x01(\v2\x12.video.UploadStartH\x00R\x05start\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"i\n" +
+	"\x13UploadVideoResponse\x12.\n" +
+	"\asession\x18\x01 \x01(\v2\x14.video.UploadSessionR\asession\x12\"\n" +
+	"\x05video\x18\x02 \x01(\v2\f.video.VideoR\x05video\"\x93\x01\n" +
+	"\x18ListHashtagVideosRequest\x12\x18\n" +
+	"\ahashtag\x18\x01 \x01(\tR\ahashtag\x12\x12\n" +
+	"\x04sort\x18\x02 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tviewer_id\x18\x05 \x01(\tR\bviewerId\"W\n" +
+	"\x19ListHashtagVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"d\n" +
+	"\fHashtagStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vvideo_count\x18\x02 \x01(\x03R\n" +
+	"videoCount\x12\x1f\n" +
+	"\vtotal_views\x18\x03 \x01(\x03R\n" +
+	"totalViews\"2\n" +
+	"\x16GetHashtagStatsRequest\x12\x18\n" +
+	"\ahashtag\x18\x01 \x01(\tR\ahashtag\"D\n" +
+	"\x17GetHashtagStatsResponse\x12)\n" +
+	"\x05stats\x18\x01 \x01(\v2\x13.video.HashtagStatsR\x05stats\"\x80\x01\n" +
+	"\x1aListMentionedVideosRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tviewer_id\x18\x04 \x01(\tR\bviewerId\"Y\n" +
+	"\x1bListMentionedVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x95\x01\n" +
+	"\x18GetTrendingVideosRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tviewer_id\x18\x05 \x01(\tR\bviewerId\"A\n" +
+	"\x19GetTrendingVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\";\n" +
+	"\x0fTrendingHashtag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"b\n" +
+	"\x1aGetTrendingHashtagsRequest\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Q\n" +
+	"\x1bGetTrendingHashtagsResponse\x122\n" +
+	"\bhashtags\x18\x01 \x03(\v2\x16.video.TrendingHashtagR\bhashtags\"\xdf\x02\n" +
+	"\x13SearchVideosRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\tR\tcreatorId\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12!\n" +
+	"\fmin_duration\x18\x06 \x01(\x05R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\a \x01(\x05R\vmaxDuration\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"]\n" +
+	"\x14SearchVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xca\x01\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x11ShareVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\"f\n" +
+	"\x12ShareVideoResponse\x12/\n" +
+	"\n" +
+	"share_link\x18\x01 \x01(\v2\x10.video.ShareLinkR\tshareLink\x12\x1f\n" +
+	"\vshare_count\x18\x02 \x01(\x03R\n" +
+	"shareCount\"J\n" +
+	"\x17ResolveShareLinkRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"u\n" +
+	"\x18ResolveShareLinkResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\x12\x1b\n" +
+	"\tsharer_id\x18\x02 \x01(\tR\bsharerId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\"N\n" +
+	"\x18GetShareAnalyticsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"]\n" +
+	"\x11ShareChannelStats\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x03R\x06shares\x12\x16\n" +
+	"\x06clicks\x18\x03 \x01(\x03R\x06clicks\"V\n" +
+	"\vSharerStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x03R\x06shares\x12\x16\n" +
+	"\x06clicks\x18\x03 \x01(\x03R\x06clicks\"\xcc\x01\n" +
+	"\x19GetShareAnalyticsResponse\x12!\n" +
+	"\ftotal_shares\x18\x01 \x01(\x03R\vtotalShares\x12!\n" +
+	"\ftotal_clicks\x18\x02 \x01(\x03R\vtotalClicks\x124\n" +
+	"\bchannels\x18\x03 \x03(\v2\x18.video.ShareChannelStatsR\bchannels\x123\n" +
+	"\vtop_sharers\x18\x04 \x03(\v2\x12.video.SharerStatsR\n" +
+	"topSharers\"\xf8\x01\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x04 \x01(\bR\bisPublic\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"m\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12#\n" +
+	"\rcollection_id\x18\x03 \x01(\tR\fcollectionId\"V\n" +
+	"\x13AddFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0efavorite_count\x18\x02 \x01(\x03R\rfavoriteCount\"p\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12#\n" +
+	"\rcollection_id\x18\x03 \x01(\tR\fcollectionId\"Y\n" +
+	"\x16RemoveFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0efavorite_count\x18\x02 \x01(\x03R\rfavoriteCount\"]\n" +
+	"\x14ListFavoritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"S\n" +
+	"\x15ListFavoritesResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"c\n" +
+	"\x17CreateCollectionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x03 \x01(\bR\bisPublic\"M\n" +
+	"\x18CreateCollectionResponse\x121\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x11.video.CollectionR\n" +
+	"collection\"\x88\x01\n" +
+	"\x17UpdateCollectionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\tR\fcollectionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_public\x18\x04 \x01(\bR\bisPublic\"M\n" +
+	"\x18UpdateCollectionResponse\x121\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x11.video.CollectionR\n" +
+	"collection\"W\n" +
+	"\x17DeleteCollectionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\tR\fcollectionId\"4\n" +
+	"\x18DeleteCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"[\n" +
+	"\x19ReorderCollectionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0ecollection_ids\x18\x02 \x03(\tR\rcollectionIds\"6\n" +
+	"\x1aReorderCollectionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x16ListCollectionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"d\n" +
+	"\x17ListCollectionsResponse\x123\n" +
+	"\vcollections\x18\x01 \x03(\v2\x11.video.CollectionR\vcollections\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x8d\x01\n" +
+	"\x1bListCollectionVideosRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"Z\n" +
+	"\x1cListCollectionVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa4\x01\n" +
+	"\fTrashedVideo\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"Y\n" +
+	"\x10ListTrashRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"V\n" +
+	"\x11ListTrashResponse\x12+\n" +
+	"\x06videos\x18\x01 \x03(\v2\x13.video.TrashedVideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"I\n" +
+	"\x13RestoreVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\":\n" +
+	"\x14RestoreVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"\x82\x01\n" +
+	"\x12ReportVideoRequest\x12\x1f\n" +
+	"\vreporter_id\x18\x01 \x01(\tR\n" +
+	"reporterId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x04 \x01(\tR\adetails\"3\n" +
+	"\x13ReportVideoResponse\x12\x1c\n" +
+	"\tduplicate\x18\x01 \x01(\bR\tduplicate\"\xa8\x03\n" +
+	"\x0eModerationCase\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\x05R\bseverity\x12!\n" +
+	"\freport_count\x18\x04 \x01(\x03R\vreportCount\x12L\n" +
+	"\rreason_counts\x18\x05 \x03(\v2'.video.ModerationCase.ReasonCountsEntryR\freasonCounts\x12F\n" +
+	"\x11first_reported_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstReportedAt\x12D\n" +
+	"\x10last_reported_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReportedAt\x1a?\n" +
+	"\x11ReasonCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"l\n" +
+	"\x19GetModerationQueueRequest\x12!\n" +
+	"\fmoderator_id\x18\x01 \x01(\tR\vmoderatorId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"_\n" +
+	"\x1aGetModerationQueueResponse\x12+\n" +
+	"\x05cases\x18\x01 \x03(\v2\x15.video.ModerationCaseR\x05cases\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x84\x01\n" +
+	"\x14ModerateVideoRequest\x12!\n" +
+	"\fmoderator_id\x18\x01 \x01(\tR\vmoderatorId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"m\n" +
+	"\x15ModerateVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\x120\n" +
+	"\x14creator_strike_count\x18\x02 \x01(\x03R\x12creatorStrikeCount\"\xcb\x01\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12!\n" +
+	"\fmoderator_id\x18\x03 \x01(\tR\vmoderatorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"\x1cListModerationActionsRequest\x12!\n" +
+	"\fmoderator_id\x18\x01 \x01(\tR\vmoderatorId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"R\n" +
+	"\x1dListModerationActionsResponse\x121\n" +
+	"\aactions\x18\x01 \x03(\v2\x17.video.ModerationActionR\aactions\"\xe0\x01\n" +
+	"\vFlaggedView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\x12\x1d\n" +
+	"\n" +
+	"watch_time\x18\x04 \x01(\x05R\twatchTime\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"j\n" +
+	"\x17ListFlaggedViewsRequest\x12!\n" +
+	"\fmoderator_id\x18\x01 \x01(\tR\vmoderatorId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"Z\n" +
+	"\x18ListFlaggedViewsResponse\x12(\n" +
+	"\x05views\x18\x01 \x03(\v2\x12.video.FlaggedViewR\x05views\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"S\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\tR\rblockedUserId\"/\n" +
+	"\x13UnblockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"a\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tblockedAt\"`\n" +
+	"\x17ListBlockedUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"Z\n" +
+	"\x18ListBlockedUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.video.BlockedUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x9c\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\x12\"\n" +
+	"\rlast_actor_id\x18\x04 \x01(\tR\vlastActorId\x12\x1f\n" +
+	"\vactor_count\x18\x05 \x01(\x03R\n" +
+	"actorCount\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x82\x01\n" +
+	"\x18ListNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"y\n" +
+	"\x19ListNotificationsResponse\x129\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x13.video.NotificationR\rnotifications\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\"U\n" +
+	"\x0fMarkReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\tR\x0fnotificationIds\"5\n" +
+	"\x10MarkReadResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x03R\vunreadCount\"8\n" +
+	"\x1dSubscribeNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x84\x01\n" +
+	"\x15RecordActivityRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x19\n" +
+	"\bvideo_id\x18\x04 \x01(\tR\avideoId\"2\n" +
+	"\x16RecordActivityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa6\x02\n" +
+	"\x0fEngagementStats\x12\x14\n" +
+	"\x05views\x18\x01 \x01(\x03R\x05views\x12%\n" +
+	"\x0eunique_viewers\x18\x02 \x01(\x03R\runiqueViewers\x12(\n" +
+	"\x10total_watch_time\x18\x03 \x01(\x03R\x0etotalWatchTime\x12,\n" +
+	"\x12average_watch_time\x18\x04 \x01(\x01R\x10averageWatchTime\x12'\n" +
+	"\x0fcompleted_views\x18\x05 \x01(\x03R\x0ecompletedViews\x12'\n" +
+	"\x0fcompletion_rate\x18\x06 \x01(\x01R\x0ecompletionRate\x12\x14\n" +
+	"\x05likes\x18\a \x01(\x03R\x05likes\x12\x16\n" +
+	"\x06shares\x18\b \x01(\x03R\x06shares\"h\n" +
+	"\n" +
+	"DailyStats\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12,\n" +
+	"\x05stats\x18\x02 \x01(\v2\x16.video.EngagementStatsR\x05stats\"X\n" +
+	"\x0eRetentionPoint\x12\x18\n" +
+	"\apercent\x18\x01 \x01(\x05R\apercent\x12\x18\n" +
+	"\aviewers\x18\x02 \x01(\x03R\aviewers\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\"U\n" +
+	"\n" +
+	"VideoStats\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12,\n" +
+	"\x05stats\x18\x02 \x01(\v2\x16.video.EngagementStatsR\x05stats\"\xc0\x01\n" +
+	"\x18GetVideoAnalyticsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x9b\x02\n" +
+	"\x19GetVideoAnalyticsResponse\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12.\n" +
+	"\x06totals\x18\x03 \x01(\v2\x16.video.EngagementStatsR\x06totals\x12'\n" +
+	"\x05daily\x18\x04 \x03(\v2\x11.video.DailyStatsR\x05daily\x123\n" +
+	"\tretention\x18\x05 \x03(\v2\x15.video.RetentionPointR\tretention\"\xa7\x01\n" +
+	"\x1aGetCreatorAnalyticsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x9a\x02\n" +
+	"\x1bGetCreatorAnalyticsResponse\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12.\n" +
+	"\x06totals\x18\x03 \x01(\v2\x16.video.EngagementStatsR\x06totals\x12'\n" +
+	"\x05daily\x18\x04 \x03(\v2\x11.video.DailyStatsR\x05daily\x120\n" +
+	"\n" +
+	"top_videos\x18\x05 \x03(\v2\x11.video.VideoStatsR\ttopVideos\"Q\n" +
+	"\x15BatchGetVideosRequest\x12\x1b\n" +
+	"\tvideo_ids\x18\x01 \x03(\tR\bvideoIds\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"_\n" +
+	"\x16BatchGetVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\"\x90\x01\n" +
+	"\x10ViewerVideoState\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x14\n" +
+	"\x05liked\x18\x02 \x01(\bR\x05liked\x12\x1e\n" +
+	"\n" +
+	"bookmarked\x18\x03 \x01(\bR\n" +
+	"bookmarked\x12+\n" +
+	"\x11following_creator\x18\x04 \x01(\bR\x10followingCreator\"V\n" +
+	"\x1aBatchGetViewerStateRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\tR\bviewerId\x12\x1b\n" +
+	"\tvideo_ids\x18\x02 \x03(\tR\bvideoIds\"N\n" +
+	"\x1bBatchGetViewerStateResponse\x12/\n" +
+	"\x06states\x18\x01 \x03(\v2\x17.video.ViewerVideoStateR\x06states\"Z\n" +
+	"\x11ListDraftsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"P\n" +
+	"\x12ListDraftsResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"I\n" +
+	"\x13PublishVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\":\n" +
+	"\x14PublishVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"\x85\x01\n" +
+	"\x14ScheduleVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x129\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\";\n" +
+	"\x15ScheduleVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"Q\n" +
+	"\x1bCancelScheduledVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\"B\n" +
+	"\x1cCancelScheduledVideoResponse\x12\"\n" +
+	"\x05video\x18\x01 \x01(\v2\f.video.VideoR\x05video\"E\n" +
+	"\x0fPinVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\",\n" +
+	"\x10PinVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x11UnpinVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\".\n" +
+	"\x12UnpinVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfe\x01\n" +
+	"\bPlaylist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcover_url\x18\x05 \x01(\tR\bcoverUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x01\n" +
+	"\x15CreatePlaylistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcover_url\x18\x04 \x01(\tR\bcoverUrl\"E\n" +
+	"\x16CreatePlaylistResponse\x12+\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x0f.video.PlaylistR\bplaylist\"\xa6\x01\n" +
+	"\x15UpdatePlaylistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tcover_url\x18\x05 \x01(\tR\bcoverUrl\"E\n" +
+	"\x16UpdatePlaylistResponse\x12+\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x0f.video.PlaylistR\bplaylist\"Q\n" +
+	"\x15DeletePlaylistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\"2\n" +
+	"\x16DeletePlaylistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"o\n" +
+	"\x16ReorderPlaylistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\x12\x1b\n" +
+	"\tvideo_ids\x18\x03 \x03(\tR\bvideoIds\"3\n" +
+	"\x17ReorderPlaylistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
+	"\x17AddPlaylistVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\"4\n" +
+	"\x18AddPlaylistVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
+	"\x1aRemovePlaylistVideoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\x12\x19\n" +
+	"\bvideo_id\x18\x03 \x01(\tR\avideoId\"7\n" +
+	"\x1bRemovePlaylistVideoResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"z\n" +
+	"\x14ListPlaylistsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\\\n" +
+	"\x15ListPlaylistsResponse\x12-\n" +
+	"\tplaylists\x18\x01 \x03(\v2\x0f.video.PlaylistR\tplaylists\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x87\x01\n" +
+	"\x19ListPlaylistVideosRequest\x12\x1f\n" +
+	"\vplaylist_id\x18\x01 \x01(\tR\n" +
+	"playlistId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"X\n" +
+	"\x1aListPlaylistVideosResponse\x12$\n" +
+	"\x06videos\x18\x01 \x03(\v2\f.video.VideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"q\n" +
+	"\x16GetNextInSeriesRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1f\n" +
+	"\vplaylist_id\x18\x02 \x01(\tR\n" +
+	"playlistId\x12\x1b\n" +
+	"\tviewer_id\x18\x03 \x01(\tR\bviewerId\"s\n" +
+	"\x17GetNextInSeriesResponse\x12+\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x0f.video.PlaylistR\bplaylist\x12+\n" +
+	"\n" +
+	"next_video\x18\x02 \x01(\v2\f.video.VideoR\tnextVideo2\x8cB\n" +
+	"\fVideoService\x12_\n" +
+	"\vCreateVideo\x12\x19.video.CreateVideoRequest\x1a\x1a.video.CreateVideoResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/videos\x12X\n" +
+	"\bGetVideo\x12\x16.video.GetVideoRequest\x1a\x17.video.GetVideoResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/videos/{id}\x12Y\n" +
+	"\n" +
+	"ListVideos\x12\x18.video.ListVideosRequest\x1a\x19.video.ListVideosResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/videos\x12x\n" +
+	"\x0fGetVideosByUser\x12\x1d.video.GetVideosByUserRequest\x1a\x1e.video.GetVideosByUserResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/videos/users/{user_id}\x12d\n" +
+	"\vUpdateVideo\x12\x19.video.UpdateVideoRequest\x1a\x1a.video.UpdateVideoResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/api/v1/videos/{id}\x12a\n" +
+	"\vDeleteVideo\x12\x19.video.DeleteVideoRequest\x1a\x1a.video.DeleteVideoResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/videos/{id}\x12j\n" +
+	"\tLikeVideo\x12\x17.video.LikeVideoRequest\x1a\x18.video.LikeVideoResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/videos/{video_id}/likes\x12w\n" +
+	"\vUnlikeVideo\x12\x19.video.UnlikeVideoRequest\x1a\x1a.video.UnlikeVideoResponse\"1\x82\xd3\xe4\x93\x02+*)/api/v1/videos/{video_id}/likes/{user_id}\x12\x8f\x01\n" +
+	"\x13CheckUserLikedVideo\x12!.video.CheckUserLikedVideoRequest\x1a\".video.CheckUserLikedVideoResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/videos/{video_id}/likes/{user_id}\x12\x84\x01\n" +
+	"\x11GetVideoLikeCount\x12\x1f.video.GetVideoLikeCountRequest\x1a .video.GetVideoLikeCountResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/videos/{video_id}/like-count\x12m\n" +
+	"\n" +
+	"CreateView\x12\x18.video.CreateViewRequest\x1a\x19.video.CreateViewResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/videos/{video_id}/views\x12v\n" +
+	"\rSetVideoCover\x12\x1b.video.SetVideoCoverRequest\x1a\x1c.video.SetVideoCoverResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/api/v1/videos/{video_id}/cover\x12\x7f\n" +
+	"\x13CreateUploadSession\x12!.video.CreateUploadSessionRequest\x1a\".video.CreateUploadSessionResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/videos/uploads\x12\x7f\n" +
+	"\x10GetUploadSession\x12\x1e.video.GetUploadSessionRequest\x1a\x1f.video.GetUploadSessionResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/videos/uploads/{upload_id}\x12p\n" +
+	"\vUploadVideo\x12\x19.video.UploadVideoRequest\x1a\x1a.video.UploadVideoResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/videos/uploads/stream(\x01\x12\x81\x01\n" +
+	"\x11ListHashtagVideos\x12\x1f.video.ListHashtagVideosRequest\x1a .video.ListHashtagVideosResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/videos/hashtags/{hashtag}\x12\x81\x01\n" +
+	"\x0fGetHashtagStats\x12\x1d.video.GetHashtagStatsRequest\x1a\x1e.video.GetHashtagStatsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/videos/hashtags/{hashtag}/stats\x12\x8d\x01\n" +
+	"\x13ListMentionedVideos\x12!.video.ListMentionedVideosRequest\x1a\".video.ListMentionedVideosResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/videos/users/{user_id}/mentions\x12w\n" +
+	"\x11GetTrendingVideos\x12\x1f.video.GetTrendingVideosRequest\x1a .video.GetTrendingVideosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/videos/trending\x12\x86\x01\n" +
+	"\x13GetTrendingHashtags\x12!.video.GetTrendingHashtagsRequest\x1a\".video.GetTrendingHashtagsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/videos/trending/hashtags\x12f\n" +
+	"\fSearchVideos\x12\x1a.video.SearchVideosRequest\x1a\x1b.video.SearchVideosResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/videos/search\x12n\n" +
+	"\n" +
+	"ShareVideo\x12\x18.video.ShareVideoRequest\x1a\x19.video.ShareVideoResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/videos/{video_id}/shares\x12y\n" +
+	"\x10ResolveShareLink\x12\x1e.video.ResolveShareLinkRequest\x1a\x1f.video.ResolveShareLinkResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/videos/shares/{code}\x12\x8a\x01\n" +
+	"\x11GetShareAnalytics\x12\x1f.video.GetShareAnalyticsRequest\x1a .video.GetShareAnalyticsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/videos/{video_id}/shares/analytics\x12t\n" +
+	"\vAddFavorite\x12\x19.video.AddFavoriteRequest\x1a\x1a.video.AddFavoriteResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/videos/{video_id}/favorites\x12\x84\x01\n" +
+	"\x0eRemoveFavorite\x12\x1c.video.RemoveFavoriteRequest\x1a\x1d.video.RemoveFavoriteResponse\"5\x82\xd3\xe4\x93\x02/*-/api/v1/videos/{video_id}/favorites/{user_id}\x12|\n" +
+	"\rListFavorites\x12\x1b.video.ListFavoritesRequest\x1a\x1c.video.ListFavoritesResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/videos/users/{user_id}/favorites\x12\x8a\x01\n" +
+	"\x10CreateCollection\x12\x1e.video.CreateCollectionRequest\x1a\x1f.video.CreateCollectionResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/videos/users/{user_id}/collections\x12\x9a\x01\n" +
+	"\x10UpdateCollection\x12\x1e.video.UpdateCollectionRequest\x1a\x1f.video.UpdateCollectionResponse\"E\x82\xd3\xe4\x93\x02?:\x01*2:/api/v1/videos/users/{user_id}/collections/{collection_id}\x12\x97\x01\n" +
+	"\x10DeleteCollection\x12\x1e.video.DeleteCollectionRequest\x1a\x1f.video.DeleteCollectionResponse\"B\x82\xd3\xe4\x93\x02<*:/api/v1/videos/users/{user_id}/collections/{collection_id}\x12\x96\x01\n" +
+	"\x12ReorderCollections\x12 .video.ReorderCollectionsRequest\x1a!.video.ReorderCollectionsResponse\";\x82\xd3\xe4\x93\x025:\x01*\x1a0/api/v1/videos/users/{user_id}/collections/order\x12\x84\x01\n" +
+	"\x0fListCollections\x12\x1d.video.ListCollectionsRequest\x1a\x1e.video.ListCollectionsResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/videos/users/{user_id}/collections\x12\x93\x01\n" +
+	"\x14ListCollectionVideos\x12\".video.ListCollectionVideosRequest\x1a#.video.ListCollectionVideosResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/videos/collections/{collection_id}\x12l\n" +
+	"\tListTrash\x12\x17.video.ListTrashRequest\x1a\x18.video.ListTrashResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/videos/users/{user_id}/trash\x12u\n" +
+	"\fRestoreVideo\x12\x1a.video.RestoreVideoRequest\x1a\x1b.video.RestoreVideoResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/videos/{video_id}/restore\x12r\n" +
+	"\vReportVideo\x12\x19.video.ReportVideoRequest\x1a\x1a.video.ReportVideoResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/videos/{video_id}/reports\x12\x82\x01\n" +
+	"\x12GetModerationQueue\x12 .video.GetModerationQueueRequest\x1a!.video.GetModerationQueueResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/videos/moderation/queue\x12{\n" +
+	"\rModerateVideo\x12\x1b.video.ModerateVideoRequest\x1a\x1c.video.ModerateVideoResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/videos/{video_id}/moderation\x12\x98\x01\n" +
+	"\x15ListModerationActions\x12#.video.ListModerationActionsRequest\x1a$.video.ListModerationActionsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/videos/{video_id}/moderation/actions\x12\x84\x01\n" +
+	"\x10ListFlaggedViews\x12\x1e.video.ListFlaggedViewsRequest\x1a\x1f.video.ListFlaggedViewsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/videos/moderation/flagged-views\x12p\n" +
+	"\tBlockUser\x12\x17.video.BlockUserRequest\x1a\x18.video.BlockUserResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/videos/users/{user_id}/blocks\x12\x85\x01\n" +
+	"\vUnblockUser\x12\x19.video.UnblockUserRequest\x1a\x1a.video.UnblockUserResponse\"?\x82\xd3\xe4\x93\x029*7/api/v1/videos/users/{user_id}/blocks/{blocked_user_id}\x12\x82\x01\n" +
+	"\x10ListBlockedUsers\x12\x1e.video.ListBlockedUsersRequest\x1a\x1f.video.ListBlockedUsersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/videos/users/{user_id}/blocks\x12\x8c\x01\n" +
+	"\x11ListNotifications\x12\x1f.video.ListNotificationsRequest\x1a .video.ListNotificationsResponse\"4\x82\xd3\xe4\x93\x02.\x12,/api/v1/videos/users/{user_id}/notifications\x12y\n" +
+	"\bMarkRead\x12\x16.video.MarkReadRequest\x1a\x17.video.MarkReadResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/videos/users/{user_id}/notifications/read\x12\x92\x01\n" +
+	"\x16SubscribeNotifications\x12$.video.SubscribeNotificationsRequest\x1a\x13.video.Notification\";\x82\xd3\xe4\x93\x025\x123/api/v1/videos/users/{user_id}/notifications/stream0\x01\x12M\n" +
+	"\x0eRecordActivity\x12\x1c.video.RecordActivityRequest\x1a\x1d.video.RecordActivityResponse\x12\x8b\x01\n" +
+	"\x11GetVideoAnalytics\x12\x1f.video.GetVideoAnalyticsRequest\x1a .video.GetVideoAnalyticsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/videos/{video_id}/analytics/summary\x12\x8e\x01\n" +
+	"\x13GetCreatorAnalytics\x12!.video.GetCreatorAnalyticsRequest\x1a\".video.GetCreatorAnalyticsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/videos/users/{user_id}/analytics\x12k\n" +
+	"\x0eBatchGetVideos\x12\x1c.video.BatchGetVideosRequest\x1a\x1d.video.BatchGetVideosResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/videos/batch\x12\x81\x01\n" +
+	"\x13BatchGetViewerState\x12!.video.BatchGetViewerStateRequest\x1a\".video.BatchGetViewerStateResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/videos/viewer-state\x12p\n" +
+	"\n" +
+	"ListDrafts\x12\x18.video.ListDraftsRequest\x1a\x19.video.ListDraftsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/videos/users/{user_id}/drafts\x12u\n" +
+	"\fPublishVideo\x12\x1a.video.PublishVideoRequest\x1a\x1b.video.PublishVideoResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/videos/{video_id}/publish\x12y\n" +
+	"\rScheduleVideo\x12\x1b.video.ScheduleVideoRequest\x1a\x1c.video.ScheduleVideoResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/videos/{video_id}/schedule\x12\x8b\x01\n" +
+	"\x14CancelScheduledVideo\x12\".video.CancelScheduledVideoRequest\x1a#.video.CancelScheduledVideoResponse\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/videos/{video_id}/schedule\x12e\n" +
+	"\bPinVideo\x12\x16.video.PinVideoRequest\x1a\x17.video.PinVideoResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/videos/{video_id}/pin\x12h\n" +
+	"\n" +
+	"UnpinVideo\x12\x18.video.UnpinVideoRequest\x1a\x19.video.UnpinVideoResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/videos/{video_id}/pin\x12\x82\x01\n" +
+	"\x0eCreatePlaylist\x12\x1c.video.CreatePlaylistRequest\x1a\x1d.video.CreatePlaylistResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/videos/users/{user_id}/playlists\x12\x90\x01\n" +
+	"\x0eUpdatePlaylist\x12\x1c.video.UpdatePlaylistRequest\x1a\x1d.video.UpdatePlaylistResponse\"A\x82\xd3\xe4\x93\x02;:\x01*26/api/v1/videos/users/{user_id}/playlists/{playlist_id}\x12\x8d\x01\n" +
+	"\x0eDeletePlaylist\x12\x1c.video.DeletePlaylistRequest\x1a\x1d.video.DeletePlaylistResponse\">\x82\xd3\xe4\x93\x028*6/api/v1/videos/users/{user_id}/playlists/{playlist_id}\x12\x99\x01\n" +
+	"\x0fReorderPlaylist\x12\x1d.video.ReorderPlaylistRequest\x1a\x1e.video.ReorderPlaylistResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\x1a</api/v1/videos/users/{user_id}/playlists/{playlist_id}/order\x12\x9d\x01\n" +
+	"\x10AddPlaylistVideo\x12\x1e.video.AddPlaylistVideoRequest\x1a\x1f.video.AddPlaylistVideoResponse\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/api/v1/videos/users/{user_id}/playlists/{playlist_id}/videos\x12\xae\x01\n" +
+	"\x13RemovePlaylistVideo\x12!.video.RemovePlaylistVideoRequest\x1a\".video.RemovePlaylistVideoResponse\"P\x82\xd3\xe4\x93\x02J*H/api/v1/videos/users/{user_id}/playlists/{playlist_id}/videos/{video_id}\x12|\n" +
+	"\rListPlaylists\x12\x1b.video.ListPlaylistsRequest\x1a\x1c.video.ListPlaylistsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/videos/users/{user_id}/playlists\x12\x89\x01\n" +
+	"\x12ListPlaylistVideos\x12 .video.ListPlaylistVideosRequest\x1a!.video.ListPlaylistVideosResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/videos/playlists/{playlist_id}\x12\x7f\n" +
+	"\x0fGetNextInSeries\x12\x1d.video.GetNextInSeriesRequest\x1a\x1e.video.GetNextInSeriesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/videos/{video_id}/series/nextB\x1bZ\x19video-service/proto/videob\x06proto3"
+
+var (
+	file_proto_video_service_proto_rawDescOnce sync.Once
+	file_proto_video_service_proto_rawDescData []byte
+)
+
+func file_proto_video_service_proto_rawDescGZIP() []byte {
+	file_proto_video_service_proto_rawDescOnce.Do(func() {
+		file_proto_video_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_video_service_proto_rawDesc), len(file_proto_video_service_proto_rawDesc)))
+	})
+	return file_proto_video_service_proto_rawDescData
+}
+
+var file_proto_video_service_proto_msgTypes = make([]protoimpl.MessageInfo, 153)
+var file_proto_video_service_proto_goTypes = []any{
+	(*Video)(nil),                         // 0: video.Video
+	(*CreateVideoRequest)(nil),            // 1: video.CreateVideoRequest
+	(*CreateVideoResponse)(nil),           // 2: video.CreateVideoResponse
+	(*GetVideoRequest)(nil),               // 3: video.GetVideoRequest
+	(*GetVideoResponse)(nil),              // 4: video.GetVideoResponse
+	(*ListVideosRequest)(nil),             // 5: video.ListVideosRequest
+	(*ListVideosResponse)(nil),            // 6: video.ListVideosResponse
+	(*GetVideosByUserRequest)(nil),        // 7: video.GetVideosByUserRequest
+	(*GetVideosByUserResponse)(nil),       // 8: video.GetVideosByUserResponse
+	(*UpdateVideoRequest)(nil),            // 9: video.UpdateVideoRequest
+	(*UpdateVideoResponse)(nil),           // 10: video.UpdateVideoResponse
+	(*DeleteVideoRequest)(nil),            // 11: video.DeleteVideoRequest
+	(*DeleteVideoResponse)(nil),           // 12: video.DeleteVideoResponse
+	(*LikeVideoRequest)(nil),              // 13: video.LikeVideoRequest
+	(*LikeVideoResponse)(nil),             // 14: video.LikeVideoResponse
+	(*UnlikeVideoRequest)(nil),            // 15: video.UnlikeVideoRequest
+	(*UnlikeVideoResponse)(nil),           // 16: video.UnlikeVideoResponse
+	(*CheckUserLikedVideoRequest)(nil),    // 17: video.CheckUserLikedVideoRequest
+	(*CheckUserLikedVideoResponse)(nil),   // 18: video.CheckUserLikedVideoResponse
+	(*GetVideoLikeCountRequest)(nil),      // 19: video.GetVideoLikeCountRequest
+	(*GetVideoLikeCountResponse)(nil),     // 20: video.GetVideoLikeCountResponse
+	(*CreateViewRequest)(nil),             // 21: video.CreateViewRequest
+	(*CreateViewResponse)(nil),            // 22: video.CreateViewResponse
+	(*SetVideoCoverRequest)(nil),          // 23: video.SetVideoCoverRequest
+	(*SetVideoCoverResponse)(nil),         // 24: video.SetVideoCoverResponse
+	(*CreateUploadSessionRequest)(nil),    // 25: video.CreateUploadSessionRequest
+	(*UploadSession)(nil),                 // 26: video.UploadSession
+	(*CreateUploadSessionResponse)(nil),   // 27: video.CreateUploadSessionResponse
+	(*GetUploadSessionRequest)(nil),       // 28: video.GetUploadSessionRequest
+	(*GetUploadSessionResponse)(nil),      // 29: video.GetUploadSessionResponse
+	(*UploadStart)(nil),                   // 30: video.UploadStart
+	(*UploadVideoRequest)(nil),            // 31: video.UploadVideoRequest
+	(*UploadVideoResponse)(nil),           // 32: video.UploadVideoResponse
+	(*ListHashtagVideosRequest)(nil),      // 33: video.ListHashtagVideosRequest
+	(*ListHashtagVideosResponse)(nil),     // 34: video.ListHashtagVideosResponse
+	(*HashtagStats)(nil),                  // 35: video.HashtagStats
+	(*GetHashtagStatsRequest)(nil),        // 36: video.GetHashtagStatsRequest
+	(*GetHashtagStatsResponse)(nil),       // 37: video.GetHashtagStatsResponse
+	(*ListMentionedVideosRequest)(nil),    // 38: video.ListMentionedVideosRequest
+	(*ListMentionedVideosResponse)(nil),   // 39: video.ListMentionedVideosResponse
+	(*GetTrendingVideosRequest)(nil),      // 40: video.GetTrendingVideosRequest
+	(*GetTrendingVideosResponse)(nil),     // 41: video.GetTrendingVideosResponse
+	(*TrendingHashtag)(nil),               // 42: video.TrendingHashtag
+	(*GetTrendingHashtagsRequest)(nil),    // 43: video.GetTrendingHashtagsRequest
+	(*GetTrendingHashtagsResponse)(nil),   // 44: video.GetTrendingHashtagsResponse
+	(*SearchVideosRequest)(nil),           // 45: video.SearchVideosRequest
+	(*SearchVideosResponse)(nil),          // 46: video.SearchVideosResponse
+	(*ShareLink)(nil),                     // 47: video.ShareLink
+	(*ShareVideoRequest)(nil),             // 48: video.ShareVideoRequest
+	(*ShareVideoResponse)(nil),            // 49: video.ShareVideoResponse
+	(*ResolveShareLinkRequest)(nil),       // 50: video.ResolveShareLinkRequest
+	(*ResolveShareLinkResponse)(nil),      // 51: video.ResolveShareLinkResponse
+	(*GetShareAnalyticsRequest)(nil),      // 52: video.GetShareAnalyticsRequest
+	(*ShareChannelStats)(nil),             // 53: video.ShareChannelStats
+	(*SharerStats)(nil),                   // 54: video.SharerStats
+	(*GetShareAnalyticsResponse)(nil),     // 55: video.GetShareAnalyticsResponse
+	(*Collection)(nil),                    // 56: video.Collection
+	(*AddFavoriteRequest)(nil),            // 57: video.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),           // 58: video.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),         // 59: video.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),        // 60: video.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),          // 61: video.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),         // 62: video.ListFavoritesResponse
+	(*CreateCollectionRequest)(nil),       // 63: video.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),      // 64: video.CreateCollectionResponse
+	(*UpdateCollectionRequest)(nil),       // 65: video.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),      // 66: video.UpdateCollectionResponse
+	(*DeleteCollectionRequest)(nil),       // 67: video.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),      // 68: video.DeleteCollectionResponse
+	(*ReorderCollectionsRequest)(nil),     // 69: video.ReorderCollectionsRequest
+	(*ReorderCollectionsResponse)(nil),    // 70: video.ReorderCollectionsResponse
+	(*ListCollectionsRequest)(nil),        // 71: video.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),       // 72: video.ListCollectionsResponse
+	(*ListCollectionVideosRequest)(nil),   // 73: video.ListCollectionVideosRequest
+	(*ListCollectionVideosResponse)(nil),  // 74: video.ListCollectionVideosResponse
+	(*TrashedVideo)(nil),                  // 75: video.TrashedVideo
+	(*ListTrashRequest)(nil),              // 76: video.ListTrashRequest
+	(*ListTrashResponse)(nil),             // 77: video.ListTrashResponse
+	(*RestoreVideoRequest)(nil),           // 78: video.RestoreVideoRequest
+	(*RestoreVideoResponse)(nil),          // 79: video.RestoreVideoResponse
+	(*ReportVideoRequest)(nil),            // 80: video.ReportVideoRequest
+	(*ReportVideoResponse)(nil),           // 81: video.ReportVideoResponse
+	(*ModerationCase)(nil),                // 82: video.ModerationCase
+	(*GetModerationQueueRequest)(nil),     // 83: video.GetModerationQueueRequest
+	(*GetModerationQueueResponse)(nil),    // 84: video.GetModerationQueueResponse
+	(*ModerateVideoRequest)(nil),          // 85: video.ModerateVideoRequest
+	(*ModerateVideoResponse)(nil),         // 86: video.ModerateVideoResponse
+	(*ModerationAction)(nil),              // 87: video.ModerationAction
+	(*ListModerationActionsRequest)(nil),  // 88: video.ListModerationActionsRequest
+	(*ListModerationActionsResponse)(nil), // 89: video.ListModerationActionsResponse
+	(*FlaggedView)(nil),                   // 90: video.FlaggedView
+	(*ListFlaggedViewsRequest)(nil),       // 91: video.ListFlaggedViewsRequest
+	(*ListFlaggedViewsResponse)(nil),      // 92: video.ListFlaggedViewsResponse
+	(*BlockUserRequest)(nil),              // 93: video.BlockUserRequest
+	(*BlockUserResponse)(nil),             // 94: video.BlockUserResponse
+	(*UnblockUserRequest)(nil),            // 95: video.UnblockUserRequest
+	(*UnblockUserResponse)(nil),           // 96: video.UnblockUserResponse
+	(*BlockedUser)(nil),                   // 97: video.BlockedUser
+	(*ListBlockedUsersRequest)(nil),       // 98: video.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),      // 99: video.ListBlockedUsersResponse
+	(*Notification)(nil),                  // 100: video.Notification
+	(*ListNotificationsRequest)(nil),      // 101: video.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),     // 102: video.ListNotificationsResponse
+	(*MarkReadRequest)(nil),               // 103: video.MarkReadRequest
+	(*MarkReadResponse)(nil),              // 104: video.MarkReadResponse
+	(*SubscribeNotificationsRequest)(nil), // 105: video.SubscribeNotificationsRequest
+	(*RecordActivityRequest)(nil),         // 106: video.RecordActivityRequest
+	(*RecordActivityResponse)(nil),        // 107: video.RecordActivityResponse
+	(*EngagementStats)(nil),               // 108: video.EngagementStats
+	(*DailyStats)(nil),                    // 109: video.DailyStats
+	(*RetentionPoint)(nil),                // 110: video.RetentionPoint
+	(*VideoStats)(nil),                    // 111: video.VideoStats
+	(*GetVideoAnalyticsRequest)(nil),      // 112: video.GetVideoAnalyticsRequest
+	(*GetVideoAnalyticsResponse)(nil),     // 113: video.GetVideoAnalyticsResponse
+	(*GetCreatorAnalyticsRequest)(nil),    // 114: video.GetCreatorAnalyticsRequest
+	(*GetCreatorAnalyticsResponse)(nil),   // 115: video.GetCreatorAnalyticsResponse
+	(*BatchGetVideosRequest)(nil),         // 116: video.BatchGetVideosRequest
+	(*BatchGetVideosResponse)(nil),        // 117: video.BatchGetVideosResponse
+	(*ViewerVideoState)(nil),              // 118: video.ViewerVideoState
+	(*BatchGetViewerStateRequest)(nil),    // 119: video.BatchGetViewerStateRequest
+	(*BatchGetViewerStateResponse)(nil),   // 120: video.BatchGetViewerStateResponse
+	(*ListDraftsRequest)(nil),             // 121: video.ListDraftsRequest
+	(*ListDraftsResponse)(nil),            // 122: video.ListDraftsResponse
+	(*PublishVideoRequest)(nil),           // 123: video.PublishVideoRequest
+	(*PublishVideoResponse)(nil),          // 124: video.PublishVideoResponse
+	(*ScheduleVideoRequest)(nil),          // 125: video.ScheduleVideoRequest
+	(*ScheduleVideoResponse)(nil),         // 126: video.ScheduleVideoResponse
+	(*CancelScheduledVideoRequest)(nil),   // 127: video.CancelScheduledVideoRequest
+	(*CancelScheduledVideoResponse)(nil),  // 128: video.CancelScheduledVideoResponse
+	(*PinVideoRequest)(nil),               // 129: video.PinVideoRequest
+	(*PinVideoResponse)(nil),              // 130: video.PinVideoResponse
+	(*UnpinVideoRequest)(nil),             // 131: video.UnpinVideoRequest
+	(*UnpinVideoResponse)(nil),            // 132: video.UnpinVideoResponse
+	(*Playlist)(nil),                      // 133: video.Playlist
+	(*CreatePlaylistRequest)(nil),         // 134: video.CreatePlaylistRequest
+	(*CreatePlaylistResponse)(nil),        // 135: video.CreatePlaylistResponse
+	(*UpdatePlaylistRequest)(nil),         // 136: video.UpdatePlaylistRequest
+	(*UpdatePlaylistResponse)(nil),        // 137: video.UpdatePlaylistResponse
+	(*DeletePlaylistRequest)(nil),         // 138: video.DeletePlaylistRequest
+	(*DeletePlaylistResponse)(nil),        // 139: video.DeletePlaylistResponse
+	(*ReorderPlaylistRequest)(nil),        // 140: video.ReorderPlaylistRequest
+	(*ReorderPlaylistResponse)(nil),       // 141: video.ReorderPlaylistResponse
+	(*AddPlaylistVideoRequest)(nil),       // 142: video.AddPlaylistVideoRequest
+	(*AddPlaylistVideoResponse)(nil),      // 143: video.AddPlaylistVideoResponse
+	(*RemovePlaylistVideoRequest)(nil),    // 144: video.RemovePlaylistVideoRequest
+	(*RemovePlaylistVideoResponse)(nil),   // 145: video.RemovePlaylistVideoResponse
+	(*ListPlaylistsRequest)(nil),          // 146: video.ListPlaylistsRequest
+	(*ListPlaylistsResponse)(nil),         // 147: video.ListPlaylistsResponse
+	(*ListPlaylistVideosRequest)(nil),     // 148: video.ListPlaylistVideosRequest
+	(*ListPlaylistVideosResponse)(nil),    // 149: video.ListPlaylistVideosResponse
+	(*GetNextInSeriesRequest)(nil),        // 150: video.GetNextInSeriesRequest
+	(*GetNextInSeriesResponse)(nil),       // 151: video.GetNextInSeriesResponse
+	nil,                                   // 152: video.ModerationCase.ReasonCountsEntry
+	(*timestamppb.Timestamp)(nil),         // 153: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 154: google.protobuf.FieldMask
+}
+var file_proto_video_service_proto_depIdxs = []int32{
+	153, // 0: video.Video.created_at:type_name -> google.protobuf.Timestamp
+	153, // 1: video.Video.updated_at:type_name -> google.protobuf.Timestamp
+	153, // 2: video.Video.publish_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: video.CreateVideoResponse.video:type_name -> video.Video
+	0,   // 4: video.GetVideoResponse.video:type_name -> video.Video
+	0,   // 5: video.ListVideosResponse.videos:type_name -> video.Video
+	0,   // 6: video.GetVideosByUserResponse.videos:type_name -> video.Video
+	0,   // 7: video.GetVideosByUserResponse.pinned_videos:type_name -> video.Video
+	154, // 8: video.UpdateVideoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,   // 9: video.UpdateVideoResponse.video:type_name -> video.Video
+	0,   // 10: video.SetVideoCoverResponse.video:type_name -> video.Video
+	153, // 11: video.UploadSession.expires_at:type_name -> google.protobuf.Timestamp
+	26,  // 12: video.CreateUploadSessionResponse.session:type_name -> video.UploadSession
+	26,  // 13: video.GetUploadSessionResponse.session:type_name -> video.UploadSession
+	30,  // 14: video.UploadVideoRequest.start:type_name -> video.UploadStart
+	26,  // 15: video.UploadVideoResponse.session:type_name -> video.UploadSession
+	0,   // 16: video.UploadVideoResponse.video:type_name -> video.Video
+	0,   // 17: video.ListHashtagVideosResponse.videos:type_name -> video.Video
+	35,  // 18: video.GetHashtagStatsResponse.stats:type_name -> video.HashtagStats
+	0,   // 19: video.ListMentionedVideosResponse.videos:type_name -> video.Video
+	0,   // 20: video.GetTrendingVideosResponse.videos:type_name -> video.Video
+	42,  // 21: video.GetTrendingHashtagsResponse.hashtags:type_name -> video.TrendingHashtag
+	153, // 22: video.SearchVideosRequest.created_after:type_name -> google.protobuf.Timestamp
+	153, // 23: video.SearchVideosRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,   // 24: video.SearchVideosResponse.videos:type_name -> video.Video
+	153, // 25: video.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	47,  // 26: video.ShareVideoResponse.share_link:type_name -> video.ShareLink
+	0,   // 27: video.ResolveShareLinkResponse.video:type_name -> video.Video
+	53,  // 28: video.GetShareAnalyticsResponse.channels:type_name -> video.ShareChannelStats
+	54,  // 29: video.GetShareAnalyticsResponse.top_sharers:type_name -> video.SharerStats
+	153, // 30: video.Collection.created_at:type_name -> google.protobuf.Timestamp
+	153, // 31: video.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 32: video.ListFavoritesResponse.videos:type_name -> video.Video
+	56,  // 33: video.CreateCollectionResponse.collection:type_name -> video.Collection
+	56,  // 34: video.UpdateCollectionResponse.collection:type_name -> video.Collection
+	56,  // 35: video.ListCollectionsResponse.collections:type_name -> video.Collection
+	0,   // 36: video.ListCollectionVideosResponse.videos:type_name -> video.Video
+	0,   // 37: video.TrashedVideo.video:type_name -> video.Video
+	153, // 38: video.TrashedVideo.deleted_at:type_name -> google.protobuf.Timestamp
+	153, // 39: video.TrashedVideo.purge_at:type_name -> google.protobuf.Timestamp
+	75,  // 40: video.ListTrashResponse.videos:type_name -> video.TrashedVideo
+	0,   // 41: video.RestoreVideoResponse.video:type_name -> video.Video
+	0,   // 42: video.ModerationCase.video:type_name -> video.Video
+	152, // 43: video.ModerationCase.reason_counts:type_name -> video.ModerationCase.ReasonCountsEntry
+	153, // 44: video.ModerationCase.first_reported_at:type_name -> google.protobuf.Timestamp
+	153, // 45: video.ModerationCase.last_reported_at:type_name -> google.protobuf.Timestamp
+	82,  // 46: video.GetModerationQueueResponse.cases:type_name -> video.ModerationCase
+	0,   // 47: video.ModerateVideoResponse.video:type_name -> video.Video
+	153, // 48: video.ModerationAction.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 49: video.ListModerationActionsResponse.actions:type_name -> video.ModerationAction
+	153, // 50: video.FlaggedView.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 51: video.ListFlaggedViewsResponse.views:type_name -> video.FlaggedView
+	153, // 52: video.BlockedUser.blocked_at:type_name -> google.protobuf.Timestamp
+	97,  // 53: video.ListBlockedUsersResponse.users:type_name -> video.BlockedUser
+	153, // 54: video.Notification.created_at:type_name -> google.protobuf.Timestamp
+	153, // 55: video.Notification.updated_at:type_name -> google.protobuf.Timestamp
+	100, // 56: video.ListNotificationsResponse.notifications:type_name -> video.Notification
+	153, // 57: video.DailyStats.day:type_name -> google.protobuf.Timestamp
+	108, // 58: video.DailyStats.stats:type_name -> video.EngagementStats
+	108, // 59: video.VideoStats.stats:type_name -> video.EngagementStats
+	153, // 60: video.GetVideoAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 61: video.GetVideoAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	153, // 62: video.GetVideoAnalyticsResponse.start_date:type_name -> google.protobuf.Timestamp
+	153, // 63: video.GetVideoAnalyticsResponse.end_date:type_name -> google.protobuf.Timestamp
+	108, // 64: video.GetVideoAnalyticsResponse.totals:type_name -> video.EngagementStats
+	109, // 65: video.GetVideoAnalyticsResponse.daily:type_name -> video.DailyStats
+	110, // 66: video.GetVideoAnalyticsResponse.retention:type_name -> video.RetentionPoint
+	153, // 67: video.GetCreatorAnalyticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	153, // 68: video.GetCreatorAnalyticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	153, // 69: video.GetCreatorAnalyticsResponse.start_date:type_name -> google.protobuf.Timestamp
+	153, // 70: video.GetCreatorAnalyticsResponse.end_date:type_name -> google.protobuf.Timestamp
+	108, // 71: video.GetCreatorAnalyticsResponse.totals:type_name -> video.EngagementStats
+	109, // 72: video.GetCreatorAnalyticsResponse.daily:type_name -> video.DailyStats
+	111, // 73: video.GetCreatorAnalyticsResponse.top_videos:type_name -> video.VideoStats
+	0,   // 74: video.BatchGetVideosResponse.videos:type_name -> video.Video
+	118, // 75: video.BatchGetViewerStateResponse.states:type_name -> video.ViewerVideoState
+	0,   // 76: video.ListDraftsResponse.videos:type_name -> video.Video
+	0,   // 77: video.PublishVideoResponse.video:type_name -> video.Video
+	153, // 78: video.ScheduleVideoRequest.publish_at:type_name -> google.protobuf.Timestamp
+	0,   // 79: video.ScheduleVideoResponse.video:type_name -> video.Video
+	0,   // 80: video.CancelScheduledVideoResponse.video:type_name -> video.Video
+	153, // 81: video.Playlist.created_at:type_name -> google.protobuf.Timestamp
+	153, // 82: video.Playlist.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 83: video.CreatePlaylistResponse.playlist:type_name -> video.Playlist
+	133, // 84: video.UpdatePlaylistResponse.playlist:type_name -> video.Playlist
+	133, // 85: video.ListPlaylistsResponse.playlists:type_name -> video.Playlist
+	0,   // 86: video.ListPlaylistVideosResponse.videos:type_name -> video.Video
+	133, // 87: video.GetNextInSeriesResponse.playlist:type_name -> video.Playlist
+	0,   // 88: video.GetNextInSeriesResponse.next_video:type_name -> video.Video
+	1,   // 89: video.VideoService.CreateVideo:input_type -> video.CreateVideoRequest
+	3,   // 90: video.VideoService.GetVideo:input_type -> video.GetVideoRequest
+	5,   // 91: video.VideoService.ListVideos:input_type -> video.ListVideosRequest
+	7,   // 92: video.VideoService.GetVideosByUser:input_type -> video.GetVideosByUserRequest
+	9,   // 93: video.VideoService.UpdateVideo:input_type -> video.UpdateVideoRequest
+	11,  // 94: video.VideoService.DeleteVideo:input_type -> video.DeleteVideoRequest
+	13,  // 95: video.VideoService.LikeVideo:input_type -> video.LikeVideoRequest
+	15,  // 96: video.VideoService.UnlikeVideo:input_type -> video.UnlikeVideoRequest
+	17,  // 97: video.VideoService.CheckUserLikedVideo:input_type -> video.CheckUserLikedVideoRequest
+	19,  // 98: video.VideoService.GetVideoLikeCount:input_type -> video.GetVideoLikeCountRequest
+	21,  // 99: video.VideoService.CreateView:input_type -> video.CreateViewRequest
+	23,  // 100: video.VideoService.SetVideoCover:input_type -> video.SetVideoCoverRequest
+	25,  // 101: video.VideoService.CreateUploadSession:input_type -> video.CreateUploadSessionRequest
+	28,  // 102: video.VideoService.GetUploadSession:input_type -> video.GetUploadSessionRequest
+	31,  // 103: video.VideoService.UploadVideo:input_type -> video.UploadVideoRequest
+	33,  // 104: video.VideoService.ListHashtagVideos:input_type -> video.ListHashtagVideosRequest
+	36,  // 105: video.VideoService.GetHashtagStats:input_type -> video.GetHashtagStatsRequest
+	38,  // 106: video.VideoService.ListMentionedVideos:input_type -> video.ListMentionedVideosRequest
+	40,  // 107: video.VideoService.GetTrendingVideos:input_type -> video.GetTrendingVideosRequest
+	43,  // 108: video.VideoService.GetTrendingHashtags:input_type -> video.GetTrendingHashtagsRequest
+	45,  // 109: video.VideoService.SearchVideos:input_type -> video.SearchVideosRequest
+	48,  // 110: video.VideoService.ShareVideo:input_type -> video.ShareVideoRequest
+	50,  // 111: video.VideoService.ResolveShareLink:input_type -> video.ResolveShareLinkRequest
+	52,  // 112: video.VideoService.GetShareAnalytics:input_type -> video.GetShareAnalyticsRequest
+	57,  // 113: video.VideoService.AddFavorite:input_type -> video.AddFavoriteRequest
+	59,  // 114: video.VideoService.RemoveFavorite:input_type -> video.RemoveFavoriteRequest
+	61,  // 115: video.VideoService.ListFavorites:input_type -> video.ListFavoritesRequest
+	63,  // 116: video.VideoService.CreateCollection:input_type -> video.CreateCollectionRequest
+	65,  // 117: video.VideoService.UpdateCollection:input_type -> video.UpdateCollectionRequest
+	67,  // 118: video.VideoService.DeleteCollection:input_type -> video.DeleteCollectionRequest
+	69,  // 119: video.VideoService.ReorderCollections:input_type -> video.ReorderCollectionsRequest
+	71,  // 120: video.VideoService.ListCollections:input_type -> video.ListCollectionsRequest
+	73,  // 121: video.VideoService.ListCollectionVideos:input_type -> video.ListCollectionVideosRequest
+	76,  // 122: video.VideoService.ListTrash:input_type -> video.ListTrashRequest
+	78,  // 123: video.VideoService.RestoreVideo:input_type -> video.RestoreVideoRequest
+	80,  // 124: video.VideoService.ReportVideo:input_type -> video.ReportVideoRequest
+	83,  // 125: video.VideoService.GetModerationQueue:input_type -> video.GetModerationQueueRequest
+	85,  // 126: video.VideoService.ModerateVideo:input_type -> video.ModerateVideoRequest
+	88,  // 127: video.VideoService.ListModerationActions:input_type -> video.ListModerationActionsRequest
+	91,  // 128: video.VideoService.ListFlaggedViews:input_type -> video.ListFlaggedViewsRequest
+	93,  // 129: video.VideoService.BlockUser:input_type -> video.BlockUserRequest
+	95,  // 130: video.VideoService.UnblockUser:input_type -> video.UnblockUserRequest
+	98,  // 131: video.VideoService.ListBlockedUsers:input_type -> video.ListBlockedUsersRequest
+	101, // 132: video.VideoService.ListNotifications:input_type -> video.ListNotificationsRequest
+	103, // 133: video.VideoService.MarkRead:input_type -> video.MarkReadRequest
+	105, // 134: video.VideoService.SubscribeNotifications:input_type -> video.SubscribeNotificationsRequest
+	106, // 135: video.VideoService.RecordActivity:input_type -> video.RecordActivityRequest
+	112, // 136: video.VideoService.GetVideoAnalytics:input_type -> video.GetVideoAnalyticsRequest
+	114, // 137: video.VideoService.GetCreatorAnalytics:input_type -> video.GetCreatorAnalyticsRequest
+	116, // 138: video.VideoService.BatchGetVideos:input_type -> video.BatchGetVideosRequest
+	119, // 139: video.VideoService.BatchGetViewerState:input_type -> video.BatchGetViewerStateRequest
+	121, // 140: video.VideoService.ListDrafts:input_type -> video.ListDraftsRequest
+	123, // 141: video.VideoService.PublishVideo:input_type -> video.PublishVideoRequest
+	125, // 142: video.VideoService.ScheduleVideo:input_type -> video.ScheduleVideoRequest
+	127, // 143: video.VideoService.CancelScheduledVideo:input_type -> video.CancelScheduledVideoRequest
+	129, // 144: video.VideoService.PinVideo:input_type -> video.PinVideoRequest
+	131, // 145: video.VideoService.UnpinVideo:input_type -> video.UnpinVideoRequest
+	134, // 146: video.VideoService.CreatePlaylist:input_type -> video.CreatePlaylistRequest
+	136, // 147: video.VideoService.UpdatePlaylist:input_type -> video.UpdatePlaylistRequest
+	138, // 148: video.VideoService.DeletePlaylist:input_type -> video.DeletePlaylistRequest
+	140, // 149: video.VideoService.ReorderPlaylist:input_type -> video.ReorderPlaylistRequest
+	142, // 150: video.VideoService.AddPlaylistVideo:input_type -> video.AddPlaylistVideoRequest
+	144, // 151: video.VideoService.RemovePlaylistVideo:input_type -> video.RemovePlaylistVideoRequest
+	146, // 152: video.VideoService.ListPlaylists:input_type -> video.ListPlaylistsRequest
+	148, // 153: video.VideoService.ListPlaylistVideos:input_type -> video.ListPlaylistVideosRequest
+	150, // 154: video.VideoService.GetNextInSeries:input_type -> video.GetNextInSeriesRequest
+	2,   // 155: video.VideoService.CreateVideo:output_type -> video.CreateVideoResponse
+	4,   // 156: video.VideoService.GetVideo:output_type -> video.GetVideoResponse
+	6,   // 157: video.VideoService.ListVideos:output_type -> video.ListVideosResponse
+	8,   // 158: video.VideoService.GetVideosByUser:output_type -> video.GetVideosByUserResponse
+	10,  // 159: video.VideoService.UpdateVideo:output_type -> video.UpdateVideoResponse
+	12,  // 160: video.VideoService.DeleteVideo:output_type -> video.DeleteVideoResponse
+	14,  // 161: video.VideoService.LikeVideo:output_type -> video.LikeVideoResponse
+	16,  // 162: video.VideoService.UnlikeVideo:output_type -> video.UnlikeVideoResponse
+	18,  // 163: video.VideoService.CheckUserLikedVideo:output_type -> video.CheckUserLikedVideoResponse
+	20,  // 164: video.VideoService.GetVideoLikeCount:output_type -> video.GetVideoLikeCountResponse
+	22,  // 165: video.VideoService.CreateView:output_type -> video.CreateViewResponse
+	24,  // 166: video.VideoService.SetVideoCover:output_type -> video.SetVideoCoverResponse
+	27,  // 167: video.VideoService.CreateUploadSession:output_type -> video.CreateUploadSessionResponse
+	29,  // 168: video.VideoService.GetUploadSession:output_type -> video.GetUploadSessionResponse
+	32,  // 169: video.VideoService.UploadVideo:output_type -> video.UploadVideoResponse
+	34,  // 170: video.VideoService.ListHashtagVideos:output_type -> video.ListHashtagVideosResponse
+	37,  // 171: video.VideoService.GetHashtagStats:output_type -> video.GetHashtagStatsResponse
+	39,  // 172: video.VideoService.ListMentionedVideos:output_type -> video.ListMentionedVideosResponse
+	41,  // 173: video.VideoService.GetTrendingVideos:output_type -> video.GetTrendingVideosResponse
+	44,  // 174: video.VideoService.GetTrendingHashtags:output_type -> video.GetTrendingHashtagsResponse
+	46,  // 175: video.VideoService.SearchVideos:output_type -> video.SearchVideosResponse
+	49,  // 176: video.VideoService.ShareVideo:output_type -> video.ShareVideoResponse
+	51,  // 177: video.VideoService.ResolveShareLink:output_type -> video.ResolveShareLinkResponse
+	55,  // 178: video.VideoService.GetShareAnalytics:output_type -> video.GetShareAnalyticsResponse
+	58,  // 179: video.VideoService.AddFavorite:output_type -> video.AddFavoriteResponse
+	60,  // 180: video.VideoService.RemoveFavorite:output_type -> video.RemoveFavoriteResponse
+	62,  // 181: video.VideoService.ListFavorites:output_type -> video.ListFavoritesResponse
+	64,  // 182: video.VideoService.CreateCollection:output_type -> video.CreateCollectionResponse
+	66,  // 183: video.VideoService.UpdateCollection:output_type -> video.UpdateCollectionResponse
+	68,  // 184: video.VideoService.DeleteCollection:output_type -> video.DeleteCollectionResponse
+	70,  // 185: video.VideoService.ReorderCollections:output_type -> video.ReorderCollectionsResponse
+	72,  // 186: video.VideoService.ListCollections:output_type -> video.ListCollectionsResponse
+	74,  // 187: video.VideoService.ListCollectionVideos:output_type -> video.ListCollectionVideosResponse
+	77,  // 188: video.VideoService.ListTrash:output_type -> video.ListTrashResponse
+	79,  // 189: video.VideoService.RestoreVideo:output_type -> video.RestoreVideoResponse
+	81,  // 190: video.VideoService.ReportVideo:output_type -> video.ReportVideoResponse
+	84,  // 191: video.VideoService.GetModerationQueue:output_type -> video.GetModerationQueueResponse
+	86,  // 192: video.VideoService.ModerateVideo:output_type -> video.ModerateVideoResponse
+	89,  // 193: video.VideoService.ListModerationActions:output_type -> video.ListModerationActionsResponse
+	92,  // 194: video.VideoService.ListFlaggedViews:output_type -> video.ListFlaggedViewsResponse
+	94,  // 195: video.VideoService.BlockUser:output_type -> video.BlockUserResponse
+	96,  // 196: video.VideoService.UnblockUser:output_type -> video.UnblockUserResponse
+	99,  // 197: video.VideoService.ListBlockedUsers:output_type -> video.ListBlockedUsersResponse
+	102, // 198: video.VideoService.ListNotifications:output_type -> video.ListNotificationsResponse
+	104, // 199: video.VideoService.MarkRead:output_type -> video.MarkReadResponse
+	100, // 200: video.VideoService.SubscribeNotifications:output_type -> video.Notification
+	107, // 201: video.VideoService.RecordActivity:output_type -> video.RecordActivityResponse
+	113, // 202: video.VideoService.GetVideoAnalytics:output_type -> video.GetVideoAnalyticsResponse
+	115, // 203: video.VideoService.GetCreatorAnalytics:output_type -> video.GetCreatorAnalyticsResponse
+	117, // 204: video.VideoService.BatchGetVideos:output_type -> video.BatchGetVideosResponse
+	120, // 205: video.VideoService.BatchGetViewerState:output_type -> video.BatchGetViewerStateResponse
+	122, // 206: video.VideoService.ListDrafts:output_type -> video.ListDraftsResponse
+	124, // 207: video.VideoService.PublishVideo:output_type -> video.PublishVideoResponse
+	126, // 208: video.VideoService.ScheduleVideo:output_type -> video.ScheduleVideoResponse
+	128, // 209: video.VideoService.CancelScheduledVideo:output_type -> video.CancelScheduledVideoResponse
+	130, // 210: video.VideoService.PinVideo:output_type -> video.PinVideoResponse
+	132, // 211: video.VideoService.UnpinVideo:output_type -> video.UnpinVideoResponse
+	135, // 212: video.VideoService.CreatePlaylist:output_type -> video.CreatePlaylistResponse
+	137, // 213: video.VideoService.UpdatePlaylist:output_type -> video.UpdatePlaylistResponse
+	139, // 214: video.VideoService.DeletePlaylist:output_type -> video.DeletePlaylistResponse
+	141, // 215: video.VideoService.ReorderPlaylist:output_type -> video.ReorderPlaylistResponse
+	143, // 216: video.VideoService.AddPlaylistVideo:output_type -> video.AddPlaylistVideoResponse
+	145, // 217: video.VideoService.RemovePlaylistVideo:output_type -> video.RemovePlaylistVideoResponse
+	147, // 218: video.VideoService.ListPlaylists:output_type -> video.ListPlaylistsResponse
+	149, // 219: video.VideoService.ListPlaylistVideos:output_type -> video.ListPlaylistVideosResponse
+	151, // 220: video.VideoService.GetNextInSeries:output_type -> video.GetNextInSeriesResponse
+	155, // [155:221] is the sub-list for method output_type
+	89,  // [89:155] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_proto_video_service_proto_init() }
@@ -1507,13 +10188,17 @@ func file_proto_video_service_proto_init() {
 	if File_proto_video_service_proto != nil {
 		return
 	}
+	file_proto_video_service_proto_msgTypes[31].OneofWrappers = []any{
+		(*UploadVideoRequest_Start)(nil),
+		(*UploadVideoRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_video_service_proto_rawDesc), len(file_proto_video_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   153,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,17 +19,72 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VideoService_CreateVideo_FullMethodName         = "/video.VideoService/CreateVideo"
-	VideoService_GetVideo_FullMethodName            = "/video.VideoService/GetVideo"
-	VideoService_ListVideos_FullMethodName          = "/video.VideoService/ListVideos"
-	VideoService_GetVideosByUser_FullMethodName     = "/video.VideoService/GetVideosByUser"
-	VideoService_UpdateVideo_FullMethodName         = "/video.VideoService/UpdateVideo"
-	VideoService_DeleteVideo_FullMethodName         = "/video.VideoService/DeleteVideo"
-	VideoService_LikeVideo_FullMethodName           = "/video.VideoService/LikeVideo"
-	VideoService_UnlikeVideo_FullMethodName         = "/video.VideoService/UnlikeVideo"
-	VideoService_CheckUserLikedVideo_FullMethodName = "/video.VideoService/CheckUserLikedVideo"
-	VideoService_GetVideoLikeCount_FullMethodName   = "/video.VideoService/GetVideoLikeCount"
-	VideoService_CreateView_FullMethodName          = "/video.VideoService/CreateView"
+	VideoService_CreateVideo_FullMethodName            = "/video.VideoService/CreateVideo"
+	VideoService_GetVideo_FullMethodName               = "/video.VideoService/GetVideo"
+	VideoService_ListVideos_FullMethodName             = "/video.VideoService/ListVideos"
+	VideoService_GetVideosByUser_FullMethodName        = "/video.VideoService/GetVideosByUser"
+	VideoService_UpdateVideo_FullMethodName            = "/video.VideoService/UpdateVideo"
+	VideoService_DeleteVideo_FullMethodName            = "/video.VideoService/DeleteVideo"
+	VideoService_LikeVideo_FullMethodName              = "/video.VideoService/LikeVideo"
+	VideoService_UnlikeVideo_FullMethodName            = "/video.VideoService/UnlikeVideo"
+	VideoService_CheckUserLikedVideo_FullMethodName    = "/video.VideoService/CheckUserLikedVideo"
+	VideoService_GetVideoLikeCount_FullMethodName      = "/video.VideoService/GetVideoLikeCount"
+	VideoService_CreateView_FullMethodName             = "/video.VideoService/CreateView"
+	VideoService_SetVideoCover_FullMethodName          = "/video.VideoService/SetVideoCover"
+	VideoService_CreateUploadSession_FullMethodName    = "/video.VideoService/CreateUploadSession"
+	VideoService_GetUploadSession_FullMethodName       = "/video.VideoService/GetUploadSession"
+	VideoService_UploadVideo_FullMethodName            = "/video.VideoService/UploadVideo"
+	VideoService_ListHashtagVideos_FullMethodName      = "/video.VideoService/ListHashtagVideos"
+	VideoService_GetHashtagStats_FullMethodName        = "/video.VideoService/GetHashtagStats"
+	VideoService_ListMentionedVideos_FullMethodName    = "/video.VideoService/ListMentionedVideos"
+	VideoService_GetTrendingVideos_FullMethodName      = "/video.VideoService/GetTrendingVideos"
+	VideoService_GetTrendingHashtags_FullMethodName    = "/video.VideoService/GetTrendingHashtags"
+	VideoService_SearchVideos_FullMethodName           = "/video.VideoService/SearchVideos"
+	VideoService_ShareVideo_FullMethodName             = "/video.VideoService/ShareVideo"
+	VideoService_ResolveShareLink_FullMethodName       = "/video.VideoService/ResolveShareLink"
+	VideoService_GetShareAnalytics_FullMethodName      = "/video.VideoService/GetShareAnalytics"
+	VideoService_AddFavorite_FullMethodName            = "/video.VideoService/AddFavorite"
+	VideoService_RemoveFavorite_FullMethodName         = "/video.VideoService/RemoveFavorite"
+	VideoService_ListFavorites_FullMethodName          = "/video.VideoService/ListFavorites"
+	VideoService_CreateCollection_FullMethodName       = "/video.VideoService/CreateCollection"
+	VideoService_UpdateCollection_FullMethodName       = "/video.VideoService/UpdateCollection"
+	VideoService_DeleteCollection_FullMethodName       = "/video.VideoService/DeleteCollection"
+	VideoService_ReorderCollections_FullMethodName     = "/video.VideoService/ReorderCollections"
+	VideoService_ListCollections_FullMethodName        = "/video.VideoService/ListCollections"
+	VideoService_ListCollectionVideos_FullMethodName   = "/video.VideoService/ListCollectionVideos"
+	VideoService_ListTrash_FullMethodName              = "/video.VideoService/ListTrash"
+	VideoService_RestoreVideo_FullMethodName           = "/video.VideoService/RestoreVideo"
+	VideoService_ReportVideo_FullMethodName            = "/video.VideoService/ReportVideo"
+	VideoService_GetModerationQueue_FullMethodName     = "/video.VideoService/GetModerationQueue"
+	VideoService_ModerateVideo_FullMethodName          = "/video.VideoService/ModerateVideo"
+	VideoService_ListModerationActions_FullMethodName  = "/video.VideoService/ListModerationActions"
+	VideoService_ListFlaggedViews_FullMethodName       = "/video.VideoService/ListFlaggedViews"
+	VideoService_BlockUser_FullMethodName              = "/video.VideoService/BlockUser"
+	VideoService_UnblockUser_FullMethodName            = "/video.VideoService/UnblockUser"
+	VideoService_ListBlockedUsers_FullMethodName       = "/video.VideoService/ListBlockedUsers"
+	VideoService_ListNotifications_FullMethodName      = "/video.VideoService/ListNotifications"
+	VideoService_MarkRead_FullMethodName               = "/video.VideoService/MarkRead"
+	VideoService_SubscribeNotifications_FullMethodName = "/video.VideoService/SubscribeNotifications"
+	VideoService_RecordActivity_FullMethodName         = "/video.VideoService/RecordActivity"
+	VideoService_GetVideoAnalytics_FullMethodName      = "/video.VideoService/GetVideoAnalytics"
+	VideoService_GetCreatorAnalytics_FullMethodName    = "/video.VideoService/GetCreatorAnalytics"
+	VideoService_BatchGetVideos_FullMethodName         = "/video.VideoService/BatchGetVideos"
+	VideoService_BatchGetViewerState_FullMethodName    = "/video.VideoService/BatchGetViewerState"
+	VideoService_ListDrafts_FullMethodName             = "/video.VideoService/ListDrafts"
+	VideoService_PublishVideo_FullMethodName           = "/video.VideoService/PublishVideo"
+	VideoService_ScheduleVideo_FullMethodName          = "/video.VideoService/ScheduleVideo"
+	VideoService_CancelScheduledVideo_FullMethodName   = "/video.VideoService/CancelScheduledVideo"
+	VideoService_PinVideo_FullMethodName               = "/video.VideoService/PinVideo"
+	VideoService_UnpinVideo_FullMethodName             = "/video.VideoService/UnpinVideo"
+	VideoService_CreatePlaylist_FullMethodName         = "/video.VideoService/CreatePlaylist"
+	VideoService_UpdatePlaylist_FullMethodName         = "/video.VideoService/UpdatePlaylist"
+	VideoService_DeletePlaylist_FullMethodName         = "/video.VideoService/DeletePlaylist"
+	VideoService_ReorderPlaylist_FullMethodName        = "/video.VideoService/ReorderPlaylist"
+	VideoService_AddPlaylistVideo_FullMethodName       = "/video.VideoService/AddPlaylistVideo"
+	VideoService_RemovePlaylistVideo_FullMethodName    = "/video.VideoService/RemovePlaylistVideo"
+	VideoService_ListPlaylists_FullMethodName          = "/video.VideoService/ListPlaylists"
+	VideoService_ListPlaylistVideos_FullMethodName     = "/video.VideoService/ListPlaylistVideos"
+	VideoService_GetNextInSeries_FullMethodName        = "/video.VideoService/GetNextInSeries"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -47,6 +102,63 @@ type VideoServiceClient interface {
 	CheckUserLikedVideo(ctx context.Context, in *CheckUserLikedVideoRequest, opts ...grpc.CallOption) (*CheckUserLikedVideoResponse, error)
 	GetVideoLikeCount(ctx context.Context, in *GetVideoLikeCountRequest, opts ...grpc.CallOption) (*GetVideoLikeCountResponse, error)
 	CreateView(ctx context.Context, in *CreateViewRequest, opts ...grpc.CallOption) (*CreateViewResponse, error)
+	SetVideoCover(ctx context.Context, in *SetVideoCoverRequest, opts ...grpc.CallOption) (*SetVideoCoverResponse, error)
+	CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error)
+	GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*GetUploadSessionResponse, error)
+	UploadVideo(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadVideoRequest, UploadVideoResponse], error)
+	ListHashtagVideos(ctx context.Context, in *ListHashtagVideosRequest, opts ...grpc.CallOption) (*ListHashtagVideosResponse, error)
+	GetHashtagStats(ctx context.Context, in *GetHashtagStatsRequest, opts ...grpc.CallOption) (*GetHashtagStatsResponse, error)
+	ListMentionedVideos(ctx context.Context, in *ListMentionedVideosRequest, opts ...grpc.CallOption) (*ListMentionedVideosResponse, error)
+	GetTrendingVideos(ctx context.Context, in *GetTrendingVideosRequest, opts ...grpc.CallOption) (*GetTrendingVideosResponse, error)
+	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
+	SearchVideos(ctx context.Context, in *SearchVideosRequest, opts ...grpc.CallOption) (*SearchVideosResponse, error)
+	ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoResponse, error)
+	ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error)
+	GetShareAnalytics(ctx context.Context, in *GetShareAnalyticsRequest, opts ...grpc.CallOption) (*GetShareAnalyticsResponse, error)
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error)
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	ReorderCollections(ctx context.Context, in *ReorderCollectionsRequest, opts ...grpc.CallOption) (*ReorderCollectionsResponse, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	ListCollectionVideos(ctx context.Context, in *ListCollectionVideosRequest, opts ...grpc.CallOption) (*ListCollectionVideosResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*RestoreVideoResponse, error)
+	ReportVideo(ctx context.Context, in *ReportVideoRequest, opts ...grpc.CallOption) (*ReportVideoResponse, error)
+	GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error)
+	ModerateVideo(ctx context.Context, in *ModerateVideoRequest, opts ...grpc.CallOption) (*ModerateVideoResponse, error)
+	ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error)
+	ListFlaggedViews(ctx context.Context, in *ListFlaggedViewsRequest, opts ...grpc.CallOption) (*ListFlaggedViewsResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
+	SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
+	// RecordActivity is only for other services on the internal network, so
+	// it has no REST binding on the public gateway.
+	RecordActivity(ctx context.Context, in *RecordActivityRequest, opts ...grpc.CallOption) (*RecordActivityResponse, error)
+	GetVideoAnalytics(ctx context.Context, in *GetVideoAnalyticsRequest, opts ...grpc.CallOption) (*GetVideoAnalyticsResponse, error)
+	GetCreatorAnalytics(ctx context.Context, in *GetCreatorAnalyticsRequest, opts ...grpc.CallOption) (*GetCreatorAnalyticsResponse, error)
+	BatchGetVideos(ctx context.Context, in *BatchGetVideosRequest, opts ...grpc.CallOption) (*BatchGetVideosResponse, error)
+	BatchGetViewerState(ctx context.Context, in *BatchGetViewerStateRequest, opts ...grpc.CallOption) (*BatchGetViewerStateResponse, error)
+	ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error)
+	PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...grpc.CallOption) (*PublishVideoResponse, error)
+	ScheduleVideo(ctx context.Context, in *ScheduleVideoRequest, opts ...grpc.CallOption) (*ScheduleVideoResponse, error)
+	CancelScheduledVideo(ctx context.Context, in *CancelScheduledVideoRequest, opts ...grpc.CallOption) (*CancelScheduledVideoResponse, error)
+	PinVideo(ctx context.Context, in *PinVideoRequest, opts ...grpc.CallOption) (*PinVideoResponse, error)
+	UnpinVideo(ctx context.Context, in *UnpinVideoRequest, opts ...grpc.CallOption) (*UnpinVideoResponse, error)
+	CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*CreatePlaylistResponse, error)
+	UpdatePlaylist(ctx context.Context, in *UpdatePlaylistRequest, opts ...grpc.CallOption) (*UpdatePlaylistResponse, error)
+	DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error)
+	ReorderPlaylist(ctx context.Context, in *ReorderPlaylistRequest, opts ...grpc.CallOption) (*ReorderPlaylistResponse, error)
+	AddPlaylistVideo(ctx context.Context, in *AddPlaylistVideoRequest, opts ...grpc.CallOption) (*AddPlaylistVideoResponse, error)
+	RemovePlaylistVideo(ctx context.Context, in *RemovePlaylistVideoRequest, opts ...grpc.CallOption) (*RemovePlaylistVideoResponse, error)
+	ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error)
+	ListPlaylistVideos(ctx context.Context, in *ListPlaylistVideosRequest, opts ...grpc.CallOption) (*ListPlaylistVideosResponse, error)
+	GetNextInSeries(ctx context.Context, in *GetNextInSeriesRequest, opts ...grpc.CallOption) (*GetNextInSeriesResponse, error)
 }
 
 type videoServiceClient struct {
@@ -167,6 +279,568 @@ func (c *videoServiceClient) CreateView(ctx context.Context, in *CreateViewReque
 	return out, nil
 }
 
+func (c *videoServiceClient) SetVideoCover(ctx context.Context, in *SetVideoCoverRequest, opts ...grpc.CallOption) (*SetVideoCoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVideoCoverResponse)
+	err := c.cc.Invoke(ctx, VideoService_SetVideoCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreateUploadSession(ctx context.Context, in *CreateUploadSessionRequest, opts ...grpc.CallOption) (*CreateUploadSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUploadSessionResponse)
+	err := c.cc.Invoke(ctx, VideoService_CreateUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetUploadSession(ctx context.Context, in *GetUploadSessionRequest, opts ...grpc.CallOption) (*GetUploadSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadSessionResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UploadVideo(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadVideoRequest, UploadVideoResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VideoService_ServiceDesc.Streams[0], VideoService_UploadVideo_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadVideoRequest, UploadVideoResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VideoService_UploadVideoClient = grpc.ClientStreamingClient[UploadVideoRequest, UploadVideoResponse]
+
+func (c *videoServiceClient) ListHashtagVideos(ctx context.Context, in *ListHashtagVideosRequest, opts ...grpc.CallOption) (*ListHashtagVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHashtagVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListHashtagVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetHashtagStats(ctx context.Context, in *GetHashtagStatsRequest, opts ...grpc.CallOption) (*GetHashtagStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHashtagStatsResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetHashtagStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListMentionedVideos(ctx context.Context, in *ListMentionedVideosRequest, opts ...grpc.CallOption) (*ListMentionedVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMentionedVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListMentionedVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTrendingVideos(ctx context.Context, in *GetTrendingVideosRequest, opts ...grpc.CallOption) (*GetTrendingVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetTrendingVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrendingHashtagsResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetTrendingHashtags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SearchVideos(ctx context.Context, in *SearchVideosRequest, opts ...grpc.CallOption) (*SearchVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_SearchVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ShareVideo(ctx context.Context, in *ShareVideoRequest, opts ...grpc.CallOption) (*ShareVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_ShareVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*ResolveShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveShareLinkResponse)
+	err := c.cc.Invoke(ctx, VideoService_ResolveShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetShareAnalytics(ctx context.Context, in *GetShareAnalyticsRequest, opts ...grpc.CallOption) (*GetShareAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShareAnalyticsResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetShareAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFavoriteResponse)
+	err := c.cc.Invoke(ctx, VideoService_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteResponse)
+	err := c.cc.Invoke(ctx, VideoService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CreateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCollectionResponse)
+	err := c.cc.Invoke(ctx, VideoService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCollectionResponse)
+	err := c.cc.Invoke(ctx, VideoService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, VideoService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReorderCollections(ctx context.Context, in *ReorderCollectionsRequest, opts ...grpc.CallOption) (*ReorderCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCollectionsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ReorderCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListCollectionVideos(ctx context.Context, in *ListCollectionVideosRequest, opts ...grpc.CallOption) (*ListCollectionVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListCollectionVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*RestoreVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_RestoreVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReportVideo(ctx context.Context, in *ReportVideoRequest, opts ...grpc.CallOption) (*ReportVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_ReportVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModerationQueueResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ModerateVideo(ctx context.Context, in *ModerateVideoRequest, opts ...grpc.CallOption) (*ModerateVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_ModerateVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationActionsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListModerationActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListFlaggedViews(ctx context.Context, in *ListFlaggedViewsRequest, opts ...grpc.CallOption) (*ListFlaggedViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedViewsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListFlaggedViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, VideoService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, VideoService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, VideoService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VideoService_ServiceDesc.Streams[1], VideoService_SubscribeNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeNotificationsRequest, Notification]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VideoService_SubscribeNotificationsClient = grpc.ServerStreamingClient[Notification]
+
+func (c *videoServiceClient) RecordActivity(ctx context.Context, in *RecordActivityRequest, opts ...grpc.CallOption) (*RecordActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordActivityResponse)
+	err := c.cc.Invoke(ctx, VideoService_RecordActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetVideoAnalytics(ctx context.Context, in *GetVideoAnalyticsRequest, opts ...grpc.CallOption) (*GetVideoAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoAnalyticsResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetVideoAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetCreatorAnalytics(ctx context.Context, in *GetCreatorAnalyticsRequest, opts ...grpc.CallOption) (*GetCreatorAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCreatorAnalyticsResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetCreatorAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) BatchGetVideos(ctx context.Context, in *BatchGetVideosRequest, opts ...grpc.CallOption) (*BatchGetVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_BatchGetVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) BatchGetViewerState(ctx context.Context, in *BatchGetViewerStateRequest, opts ...grpc.CallOption) (*BatchGetViewerStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetViewerStateResponse)
+	err := c.cc.Invoke(ctx, VideoService_BatchGetViewerState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListDrafts(ctx context.Context, in *ListDraftsRequest, opts ...grpc.CallOption) (*ListDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDraftsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) PublishVideo(ctx context.Context, in *PublishVideoRequest, opts ...grpc.CallOption) (*PublishVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_PublishVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ScheduleVideo(ctx context.Context, in *ScheduleVideoRequest, opts ...grpc.CallOption) (*ScheduleVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_ScheduleVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CancelScheduledVideo(ctx context.Context, in *CancelScheduledVideoRequest, opts ...grpc.CallOption) (*CancelScheduledVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_CancelScheduledVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) PinVideo(ctx context.Context, in *PinVideoRequest, opts ...grpc.CallOption) (*PinVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_PinVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UnpinVideo(ctx context.Context, in *UnpinVideoRequest, opts ...grpc.CallOption) (*UnpinVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_UnpinVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*CreatePlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlaylistResponse)
+	err := c.cc.Invoke(ctx, VideoService_CreatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdatePlaylist(ctx context.Context, in *UpdatePlaylistRequest, opts ...grpc.CallOption) (*UpdatePlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlaylistResponse)
+	err := c.cc.Invoke(ctx, VideoService_UpdatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlaylistResponse)
+	err := c.cc.Invoke(ctx, VideoService_DeletePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReorderPlaylist(ctx context.Context, in *ReorderPlaylistRequest, opts ...grpc.CallOption) (*ReorderPlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderPlaylistResponse)
+	err := c.cc.Invoke(ctx, VideoService_ReorderPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddPlaylistVideo(ctx context.Context, in *AddPlaylistVideoRequest, opts ...grpc.CallOption) (*AddPlaylistVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPlaylistVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_AddPlaylistVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemovePlaylistVideo(ctx context.Context, in *RemovePlaylistVideoRequest, opts ...grpc.CallOption) (*RemovePlaylistVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePlaylistVideoResponse)
+	err := c.cc.Invoke(ctx, VideoService_RemovePlaylistVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlaylistsResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListPlaylists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListPlaylistVideos(ctx context.Context, in *ListPlaylistVideosRequest, opts ...grpc.CallOption) (*ListPlaylistVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlaylistVideosResponse)
+	err := c.cc.Invoke(ctx, VideoService_ListPlaylistVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetNextInSeries(ctx context.Context, in *GetNextInSeriesRequest, opts ...grpc.CallOption) (*GetNextInSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNextInSeriesResponse)
+	err := c.cc.Invoke(ctx, VideoService_GetNextInSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServiceServer is the server API for VideoService service.
 // All implementations must embed UnimplementedVideoServiceServer
 // for forward compatibility.
@@ -182,264 +856,1458 @@ type VideoServiceServer interface {
 	CheckUserLikedVideo(context.Context, *CheckUserLikedVideoRequest) (*CheckUserLikedVideoResponse, error)
 	GetVideoLikeCount(context.Context, *GetVideoLikeCountRequest) (*GetVideoLikeCountResponse, error)
 	CreateView(context.Context, *CreateViewRequest) (*CreateViewResponse, error)
+	SetVideoCover(context.Context, *SetVideoCoverRequest) (*SetVideoCoverResponse, error)
+	CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error)
+	GetUploadSession(context.Context, *GetUploadSessionRequest) (*GetUploadSessionResponse, error)
+	UploadVideo(grpc.ClientStreamingServer[UploadVideoRequest, UploadVideoResponse]) error
+	ListHashtagVideos(context.Context, *ListHashtagVideosRequest) (*ListHashtagVideosResponse, error)
+	GetHashtagStats(context.Context, *GetHashtagStatsRequest) (*GetHashtagStatsResponse, error)
+	ListMentionedVideos(context.Context, *ListMentionedVideosRequest) (*ListMentionedVideosResponse, error)
+	GetTrendingVideos(context.Context, *GetTrendingVideosRequest) (*GetTrendingVideosResponse, error)
+	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
+	SearchVideos(context.Context, *SearchVideosRequest) (*SearchVideosResponse, error)
+	ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error)
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error)
+	GetShareAnalytics(context.Context, *GetShareAnalyticsRequest) (*GetShareAnalyticsResponse, error)
+	AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error)
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	ReorderCollections(context.Context, *ReorderCollectionsRequest) (*ReorderCollectionsResponse, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	ListCollectionVideos(context.Context, *ListCollectionVideosRequest) (*ListCollectionVideosResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreVideo(context.Context, *RestoreVideoRequest) (*RestoreVideoResponse, error)
+	ReportVideo(context.Context, *ReportVideoRequest) (*ReportVideoResponse, error)
+	GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error)
+	ModerateVideo(context.Context, *ModerateVideoRequest) (*ModerateVideoResponse, error)
+	ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error)
+	ListFlaggedViews(context.Context, *ListFlaggedViewsRequest) (*ListFlaggedViewsResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
+	SubscribeNotifications(*SubscribeNotificationsRequest, grpc.ServerStreamingServer[Notification]) error
+	// RecordActivity is only for other services on the internal network, so
+	// it has no REST binding on the public gateway.
+	RecordActivity(context.Context, *RecordActivityRequest) (*RecordActivityResponse, error)
+	GetVideoAnalytics(context.Context, *GetVideoAnalyticsRequest) (*GetVideoAnalyticsResponse, error)
+	GetCreatorAnalytics(context.Context, *GetCreatorAnalyticsRequest) (*GetCreatorAnalyticsResponse, error)
+	BatchGetVideos(context.Context, *BatchGetVideosRequest) (*BatchGetVideosResponse, error)
+	BatchGetViewerState(context.Context, *BatchGetViewerStateRequest) (*BatchGetViewerStateResponse, error)
+	ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error)
+	PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoResponse, error)
+	ScheduleVideo(context.Context, *ScheduleVideoRequest) (*ScheduleVideoResponse, error)
+	CancelScheduledVideo(context.Context, *CancelScheduledVideoRequest) (*CancelScheduledVideoResponse, error)
+	PinVideo(context.Context, *PinVideoRequest) (*PinVideoResponse, error)
+	UnpinVideo(context.Context, *UnpinVideoRequest) (*UnpinVideoResponse, error)
+	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error)
+	UpdatePlaylist(context.Context, *UpdatePlaylistRequest) (*UpdatePlaylistResponse, error)
+	DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error)
+	ReorderPlaylist(context.Context, *ReorderPlaylistRequest) (*ReorderPlaylistResponse, error)
+	AddPlaylistVideo(context.Context, *AddPlaylistVideoRequest) (*AddPlaylistVideoResponse, error)
+	RemovePlaylistVideo(context.Context, *RemovePlaylistVideoRequest) (*RemovePlaylistVideoResponse, error)
+	ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error)
+	ListPlaylistVideos(context.Context, *ListPlaylistVideosRequest) (*ListPlaylistVideosResponse, error)
+	GetNextInSeries(context.Context, *GetNextInSeriesRequest) (*GetNextInSeriesResponse, error)
+	mustEmbedUnimplementedVideoServiceServer()
+}
+
+// UnimplementedVideoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVideoServiceServer struct{}
+
+func (UnimplementedVideoServiceServer) CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) GetVideo(context.Context, *GetVideoRequest) (*GetVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) GetVideosByUser(context.Context, *GetVideosByUserRequest) (*GetVideosByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideosByUser not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdateVideo(context.Context, *UpdateVideoRequest) (*UpdateVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) LikeVideo(context.Context, *LikeVideoRequest) (*LikeVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) UnlikeVideo(context.Context, *UnlikeVideoRequest) (*UnlikeVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) CheckUserLikedVideo(context.Context, *CheckUserLikedVideoRequest) (*CheckUserLikedVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserLikedVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) GetVideoLikeCount(context.Context, *GetVideoLikeCountRequest) (*GetVideoLikeCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoLikeCount not implemented")
+}
+func (UnimplementedVideoServiceServer) CreateView(context.Context, *CreateViewRequest) (*CreateViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateView not implemented")
+}
+func (UnimplementedVideoServiceServer) SetVideoCover(context.Context, *SetVideoCoverRequest) (*SetVideoCoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVideoCover not implemented")
+}
+func (UnimplementedVideoServiceServer) CreateUploadSession(context.Context, *CreateUploadSessionRequest) (*CreateUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedVideoServiceServer) GetUploadSession(context.Context, *GetUploadSessionRequest) (*GetUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadSession not implemented")
+}
+func (UnimplementedVideoServiceServer) UploadVideo(grpc.ClientStreamingServer[UploadVideoRequest, UploadVideoResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ListHashtagVideos(context.Context, *ListHashtagVideosRequest) (*ListHashtagVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHashtagVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) GetHashtagStats(context.Context, *GetHashtagStatsRequest) (*GetHashtagStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagStats not implemented")
+}
+func (UnimplementedVideoServiceServer) ListMentionedVideos(context.Context, *ListMentionedVideosRequest) (*ListMentionedVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentionedVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTrendingVideos(context.Context, *GetTrendingVideosRequest) (*GetTrendingVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingHashtags not implemented")
+}
+func (UnimplementedVideoServiceServer) SearchVideos(context.Context, *SearchVideosRequest) (*SearchVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) ShareVideo(context.Context, *ShareVideoRequest) (*ShareVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*ResolveShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveShareLink not implemented")
+}
+func (UnimplementedVideoServiceServer) GetShareAnalytics(context.Context, *GetShareAnalyticsRequest) (*GetShareAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareAnalytics not implemented")
+}
+func (UnimplementedVideoServiceServer) AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedVideoServiceServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedVideoServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedVideoServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedVideoServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedVideoServiceServer) ReorderCollections(context.Context, *ReorderCollectionsRequest) (*ReorderCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollections not implemented")
+}
+func (UnimplementedVideoServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedVideoServiceServer) ListCollectionVideos(context.Context, *ListCollectionVideosRequest) (*ListCollectionVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedVideoServiceServer) RestoreVideo(context.Context, *RestoreVideoRequest) (*RestoreVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ReportVideo(context.Context, *ReportVideoRequest) (*ReportVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
+func (UnimplementedVideoServiceServer) ModerateVideo(context.Context, *ModerateVideoRequest) (*ModerateVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationActions not implemented")
+}
+func (UnimplementedVideoServiceServer) ListFlaggedViews(context.Context, *ListFlaggedViewsRequest) (*ListFlaggedViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedViews not implemented")
+}
+func (UnimplementedVideoServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedVideoServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedVideoServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedVideoServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedVideoServiceServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedVideoServiceServer) SubscribeNotifications(*SubscribeNotificationsRequest, grpc.ServerStreamingServer[Notification]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNotifications not implemented")
+}
+func (UnimplementedVideoServiceServer) RecordActivity(context.Context, *RecordActivityRequest) (*RecordActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordActivity not implemented")
+}
+func (UnimplementedVideoServiceServer) GetVideoAnalytics(context.Context, *GetVideoAnalyticsRequest) (*GetVideoAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoAnalytics not implemented")
+}
+func (UnimplementedVideoServiceServer) GetCreatorAnalytics(context.Context, *GetCreatorAnalyticsRequest) (*GetCreatorAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatorAnalytics not implemented")
+}
+func (UnimplementedVideoServiceServer) BatchGetVideos(context.Context, *BatchGetVideosRequest) (*BatchGetVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) BatchGetViewerState(context.Context, *BatchGetViewerStateRequest) (*BatchGetViewerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetViewerState not implemented")
+}
+func (UnimplementedVideoServiceServer) ListDrafts(context.Context, *ListDraftsRequest) (*ListDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (UnimplementedVideoServiceServer) PublishVideo(context.Context, *PublishVideoRequest) (*PublishVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ScheduleVideo(context.Context, *ScheduleVideoRequest) (*ScheduleVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) CancelScheduledVideo(context.Context, *CancelScheduledVideoRequest) (*CancelScheduledVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) PinVideo(context.Context, *PinVideoRequest) (*PinVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) UnpinVideo(context.Context, *UnpinVideoRequest) (*UnpinVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*CreatePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdatePlaylist(context.Context, *UpdatePlaylistRequest) (*UpdatePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) ReorderPlaylist(context.Context, *ReorderPlaylistRequest) (*ReorderPlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) AddPlaylistVideo(context.Context, *AddPlaylistVideoRequest) (*AddPlaylistVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlaylistVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) RemovePlaylistVideo(context.Context, *RemovePlaylistVideoRequest) (*RemovePlaylistVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaylistVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedVideoServiceServer) ListPlaylistVideos(context.Context, *ListPlaylistVideosRequest) (*ListPlaylistVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylistVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) GetNextInSeries(context.Context, *GetNextInSeriesRequest) (*GetNextInSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextInSeries not implemented")
+}
+func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
+func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeVideoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VideoServiceServer will
+// result in compilation errors.
+type UnsafeVideoServiceServer interface {
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
-// UnimplementedVideoServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedVideoServiceServer struct{}
+func RegisterVideoServiceServer(s grpc.ServiceRegistrar, srv VideoServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVideoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VideoService_ServiceDesc, srv)
+}
+
+func _VideoService_CreateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CreateVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateVideo(ctx, req.(*CreateVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideo(ctx, req.(*GetVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListVideos(ctx, req.(*ListVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideosByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideosByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideosByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideosByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideosByUser(ctx, req.(*GetVideosByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UpdateVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdateVideo(ctx, req.(*UpdateVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeleteVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_DeleteVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeleteVideo(ctx, req.(*DeleteVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_LikeVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).LikeVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_LikeVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).LikeVideo(ctx, req.(*LikeVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UnlikeVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UnlikeVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UnlikeVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UnlikeVideo(ctx, req.(*UnlikeVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CheckUserLikedVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserLikedVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CheckUserLikedVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CheckUserLikedVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CheckUserLikedVideo(ctx, req.(*CheckUserLikedVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideoLikeCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoLikeCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoLikeCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideoLikeCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoLikeCount(ctx, req.(*GetVideoLikeCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CreateView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateView(ctx, req.(*CreateViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetVideoCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVideoCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetVideoCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SetVideoCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetVideoCover(ctx, req.(*SetVideoCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CreateUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateUploadSession(ctx, req.(*CreateUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetUploadSession(ctx, req.(*GetUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UploadVideo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VideoServiceServer).UploadVideo(&grpc.GenericServerStream[UploadVideoRequest, UploadVideoResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VideoService_UploadVideoServer = grpc.ClientStreamingServer[UploadVideoRequest, UploadVideoResponse]
+
+func _VideoService_ListHashtagVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHashtagVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListHashtagVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListHashtagVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListHashtagVideos(ctx, req.(*ListHashtagVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetHashtagStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetHashtagStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetHashtagStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetHashtagStats(ctx, req.(*GetHashtagStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListMentionedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionedVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListMentionedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListMentionedVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListMentionedVideos(ctx, req.(*ListMentionedVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTrendingVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTrendingVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetTrendingVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTrendingVideos(ctx, req.(*GetTrendingVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTrendingHashtags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingHashtagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTrendingHashtags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetTrendingHashtags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTrendingHashtags(ctx, req.(*GetTrendingHashtagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SearchVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SearchVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SearchVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SearchVideos(ctx, req.(*SearchVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ShareVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ShareVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ShareVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ShareVideo(ctx, req.(*ShareVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ResolveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ResolveShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetShareAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetShareAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetShareAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetShareAnalytics(ctx, req.(*GetShareAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReorderCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReorderCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ReorderCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReorderCollections(ctx, req.(*ReorderCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedVideoServiceServer) CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVideo not implemented")
+func _VideoService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) GetVideo(context.Context, *GetVideoRequest) (*GetVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideo not implemented")
+
+func _VideoService_ListCollectionVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListCollectionVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListCollectionVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListCollectionVideos(ctx, req.(*ListCollectionVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVideos not implemented")
+
+func _VideoService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) GetVideosByUser(context.Context, *GetVideosByUserRequest) (*GetVideosByUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideosByUser not implemented")
+
+func _VideoService_RestoreVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RestoreVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RestoreVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RestoreVideo(ctx, req.(*RestoreVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) UpdateVideo(context.Context, *UpdateVideoRequest) (*UpdateVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVideo not implemented")
+
+func _VideoService_ReportVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReportVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ReportVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReportVideo(ctx, req.(*ReportVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideo not implemented")
+
+func _VideoService_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetModerationQueue(ctx, req.(*GetModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) LikeVideo(context.Context, *LikeVideoRequest) (*LikeVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeVideo not implemented")
+
+func _VideoService_ModerateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ModerateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ModerateVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ModerateVideo(ctx, req.(*ModerateVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) UnlikeVideo(context.Context, *UnlikeVideoRequest) (*UnlikeVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlikeVideo not implemented")
+
+func _VideoService_ListModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListModerationActions(ctx, req.(*ListModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListFlaggedViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListFlaggedViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListFlaggedViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListFlaggedViews(ctx, req.(*ListFlaggedViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SubscribeNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VideoServiceServer).SubscribeNotifications(m, &grpc.GenericServerStream[SubscribeNotificationsRequest, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VideoService_SubscribeNotificationsServer = grpc.ServerStreamingServer[Notification]
+
+func _VideoService_RecordActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RecordActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RecordActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RecordActivity(ctx, req.(*RecordActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetVideoAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetVideoAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetVideoAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetVideoAnalytics(ctx, req.(*GetVideoAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetCreatorAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreatorAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetCreatorAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_GetCreatorAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetCreatorAnalytics(ctx, req.(*GetCreatorAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_BatchGetVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).BatchGetVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_BatchGetVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).BatchGetVideos(ctx, req.(*BatchGetVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) CheckUserLikedVideo(context.Context, *CheckUserLikedVideoRequest) (*CheckUserLikedVideoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckUserLikedVideo not implemented")
+
+func _VideoService_BatchGetViewerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetViewerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).BatchGetViewerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_BatchGetViewerState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).BatchGetViewerState(ctx, req.(*BatchGetViewerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) GetVideoLikeCount(context.Context, *GetVideoLikeCountRequest) (*GetVideoLikeCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVideoLikeCount not implemented")
+
+func _VideoService_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListDrafts(ctx, req.(*ListDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) CreateView(context.Context, *CreateViewRequest) (*CreateViewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateView not implemented")
+
+func _VideoService_PublishVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).PublishVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_PublishVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).PublishVideo(ctx, req.(*PublishVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
-func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeVideoServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to VideoServiceServer will
-// result in compilation errors.
-type UnsafeVideoServiceServer interface {
-	mustEmbedUnimplementedVideoServiceServer()
+func _VideoService_ScheduleVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ScheduleVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ScheduleVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ScheduleVideo(ctx, req.(*ScheduleVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterVideoServiceServer(s grpc.ServiceRegistrar, srv VideoServiceServer) {
-	// If the following call pancis, it indicates UnimplementedVideoServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _VideoService_CancelScheduledVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&VideoService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CancelScheduledVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CancelScheduledVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CancelScheduledVideo(ctx, req.(*CancelScheduledVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_CreateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVideoRequest)
+func _VideoService_PinVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinVideoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).CreateVideo(ctx, in)
+		return srv.(VideoServiceServer).PinVideo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_CreateVideo_FullMethodName,
+		FullMethod: VideoService_PinVideo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).CreateVideo(ctx, req.(*CreateVideoRequest))
+		return srv.(VideoServiceServer).PinVideo(ctx, req.(*PinVideoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoRequest)
+func _VideoService_UnpinVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinVideoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideo(ctx, in)
+		return srv.(VideoServiceServer).UnpinVideo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_GetVideo_FullMethodName,
+		FullMethod: VideoService_UnpinVideo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideo(ctx, req.(*GetVideoRequest))
+		return srv.(VideoServiceServer).UnpinVideo(ctx, req.(*UnpinVideoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_ListVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListVideosRequest)
+func _VideoService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).ListVideos(ctx, in)
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_ListVideos_FullMethodName,
+		FullMethod: VideoService_CreatePlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).ListVideos(ctx, req.(*ListVideosRequest))
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideosByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideosByUserRequest)
+func _VideoService_UpdatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideosByUser(ctx, in)
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_GetVideosByUser_FullMethodName,
+		FullMethod: VideoService_UpdatePlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideosByUser(ctx, req.(*GetVideosByUserRequest))
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, req.(*UpdatePlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_UpdateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVideoRequest)
+func _VideoService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).UpdateVideo(ctx, in)
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_UpdateVideo_FullMethodName,
+		FullMethod: VideoService_DeletePlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).UpdateVideo(ctx, req.(*UpdateVideoRequest))
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, req.(*DeletePlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVideoRequest)
+func _VideoService_ReorderPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPlaylistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).DeleteVideo(ctx, in)
+		return srv.(VideoServiceServer).ReorderPlaylist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_DeleteVideo_FullMethodName,
+		FullMethod: VideoService_ReorderPlaylist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).DeleteVideo(ctx, req.(*DeleteVideoRequest))
+		return srv.(VideoServiceServer).ReorderPlaylist(ctx, req.(*ReorderPlaylistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_LikeVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeVideoRequest)
+func _VideoService_AddPlaylistVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlaylistVideoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).LikeVideo(ctx, in)
+		return srv.(VideoServiceServer).AddPlaylistVideo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_LikeVideo_FullMethodName,
+		FullMethod: VideoService_AddPlaylistVideo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).LikeVideo(ctx, req.(*LikeVideoRequest))
+		return srv.(VideoServiceServer).AddPlaylistVideo(ctx, req.(*AddPlaylistVideoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_UnlikeVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlikeVideoRequest)
+func _VideoService_RemovePlaylistVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlaylistVideoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).UnlikeVideo(ctx, in)
+		return srv.(VideoServiceServer).RemovePlaylistVideo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_UnlikeVideo_FullMethodName,
+		FullMethod: VideoService_RemovePlaylistVideo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).UnlikeVideo(ctx, req.(*UnlikeVideoRequest))
+		return srv.(VideoServiceServer).RemovePlaylistVideo(ctx, req.(*RemovePlaylistVideoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_CheckUserLikedVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserLikedVideoRequest)
+func _VideoService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).CheckUserLikedVideo(ctx, in)
+		return srv.(VideoServiceServer).ListPlaylists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_CheckUserLikedVideo_FullMethodName,
+		FullMethod: VideoService_ListPlaylists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).CheckUserLikedVideo(ctx, req.(*CheckUserLikedVideoRequest))
+		return srv.(VideoServiceServer).ListPlaylists(ctx, req.(*ListPlaylistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_GetVideoLikeCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoLikeCountRequest)
+func _VideoService_ListPlaylistVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistVideosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).GetVideoLikeCount(ctx, in)
+		return srv.(VideoServiceServer).ListPlaylistVideos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_GetVideoLikeCount_FullMethodName,
+		FullMethod: VideoService_ListPlaylistVideos_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).GetVideoLikeCount(ctx, req.(*GetVideoLikeCountRequest))
+		return srv.(VideoServiceServer).ListPlaylistVideos(ctx, req.(*ListPlaylistVideosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VideoService_CreateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateViewRequest)
+func _VideoService_GetNextInSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextInSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VideoServiceServer).CreateView(ctx, in)
+		return srv.(VideoServiceServer).GetNextInSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VideoService_CreateView_FullMethodName,
+		FullMethod: VideoService_GetNextInSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VideoServiceServer).CreateView(ctx, req.(*CreateViewRequest))
+		return srv.(VideoServiceServer).GetNextInSeries(ctx, req.(*GetNextInSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -495,7 +2363,230 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateView",
 			Handler:    _VideoService_CreateView_Handler,
 		},
+		{
+			MethodName: "SetVideoCover",
+			Handler:    _VideoService_SetVideoCover_Handler,
+		},
+		{
+			MethodName: "CreateUploadSession",
+			Handler:    _VideoService_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "GetUploadSession",
+			Handler:    _VideoService_GetUploadSession_Handler,
+		},
+		{
+			MethodName: "ListHashtagVideos",
+			Handler:    _VideoService_ListHashtagVideos_Handler,
+		},
+		{
+			MethodName: "GetHashtagStats",
+			Handler:    _VideoService_GetHashtagStats_Handler,
+		},
+		{
+			MethodName: "ListMentionedVideos",
+			Handler:    _VideoService_ListMentionedVideos_Handler,
+		},
+		{
+			MethodName: "GetTrendingVideos",
+			Handler:    _VideoService_GetTrendingVideos_Handler,
+		},
+		{
+			MethodName: "GetTrendingHashtags",
+			Handler:    _VideoService_GetTrendingHashtags_Handler,
+		},
+		{
+			MethodName: "SearchVideos",
+			Handler:    _VideoService_SearchVideos_Handler,
+		},
+		{
+			MethodName: "ShareVideo",
+			Handler:    _VideoService_ShareVideo_Handler,
+		},
+		{
+			MethodName: "ResolveShareLink",
+			Handler:    _VideoService_ResolveShareLink_Handler,
+		},
+		{
+			MethodName: "GetShareAnalytics",
+			Handler:    _VideoService_GetShareAnalytics_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _VideoService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _VideoService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _VideoService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _VideoService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _VideoService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _VideoService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "ReorderCollections",
+			Handler:    _VideoService_ReorderCollections_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _VideoService_ListCollections_Handler,
+		},
+		{
+			MethodName: "ListCollectionVideos",
+			Handler:    _VideoService_ListCollectionVideos_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _VideoService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreVideo",
+			Handler:    _VideoService_RestoreVideo_Handler,
+		},
+		{
+			MethodName: "ReportVideo",
+			Handler:    _VideoService_ReportVideo_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _VideoService_GetModerationQueue_Handler,
+		},
+		{
+			MethodName: "ModerateVideo",
+			Handler:    _VideoService_ModerateVideo_Handler,
+		},
+		{
+			MethodName: "ListModerationActions",
+			Handler:    _VideoService_ListModerationActions_Handler,
+		},
+		{
+			MethodName: "ListFlaggedViews",
+			Handler:    _VideoService_ListFlaggedViews_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _VideoService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _VideoService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _VideoService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _VideoService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _VideoService_MarkRead_Handler,
+		},
+		{
+			MethodName: "RecordActivity",
+			Handler:    _VideoService_RecordActivity_Handler,
+		},
+		{
+			MethodName: "GetVideoAnalytics",
+			Handler:    _VideoService_GetVideoAnalytics_Handler,
+		},
+		{
+			MethodName: "GetCreatorAnalytics",
+			Handler:    _VideoService_GetCreatorAnalytics_Handler,
+		},
+		{
+			MethodName: "BatchGetVideos",
+			Handler:    _VideoService_BatchGetVideos_Handler,
+		},
+		{
+			MethodName: "BatchGetViewerState",
+			Handler:    _VideoService_BatchGetViewerState_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _VideoService_ListDrafts_Handler,
+		},
+		{
+			MethodName: "PublishVideo",
+			Handler:    _VideoService_PublishVideo_Handler,
+		},
+		{
+			MethodName: "ScheduleVideo",
+			Handler:    _VideoService_ScheduleVideo_Handler,
+		},
+		{
+			MethodName: "CancelScheduledVideo",
+			Handler:    _VideoService_CancelScheduledVideo_Handler,
+		},
+		{
+			MethodName: "PinVideo",
+			Handler:    _VideoService_PinVideo_Handler,
+		},
+		{
+			MethodName: "UnpinVideo",
+			Handler:    _VideoService_UnpinVideo_Handler,
+		},
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _VideoService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "UpdatePlaylist",
+			Handler:    _VideoService_UpdatePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _VideoService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "ReorderPlaylist",
+			Handler:    _VideoService_ReorderPlaylist_Handler,
+		},
+		{
+			MethodName: "AddPlaylistVideo",
+			Handler:    _VideoService_AddPlaylistVideo_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistVideo",
+			Handler:    _VideoService_RemovePlaylistVideo_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _VideoService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "ListPlaylistVideos",
+			Handler:    _VideoService_ListPlaylistVideos_Handler,
+		},
+		{
+			MethodName: "GetNextInSeries",
+			Handler:    _VideoService_GetNextInSeries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadVideo",
+			Handler:       _VideoService_UploadVideo_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNotifications",
+			Handler:       _VideoService_SubscribeNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/video_service.proto",
 }